@@ -106,16 +106,21 @@ type Board struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoardID    uint64                 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
-	UserID     uint64                 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Visibility string                 `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
-	Members    []*BoardMember         `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
-	Lists      []*List                `protobuf:"bytes,6,rep,name=lists,proto3" json:"lists,omitempty"`
-	Cards      []*CardMeta            `protobuf:"bytes,7,rep,name=cards,proto3" json:"cards,omitempty"`
-	Labels     []*Label               `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
-	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BoardID          uint64                 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	UserID           uint64                 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Visibility       string                 `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Members          []*BoardMember         `protobuf:"bytes,5,rep,name=members,proto3" json:"members,omitempty"`
+	Lists            []*List                `protobuf:"bytes,6,rep,name=lists,proto3" json:"lists,omitempty"`
+	Cards            []*CardMeta            `protobuf:"bytes,7,rep,name=cards,proto3" json:"cards,omitempty"`
+	Labels           []*Label               `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CustomFields     []*CustomField         `protobuf:"bytes,11,rep,name=custom_fields,json=customFields,proto3" json:"custom_fields,omitempty"`
+	Version          uint64                 `protobuf:"varint,12,opt,name=version,proto3" json:"version,omitempty"`
+	CardHistoryLimit int32                  `protobuf:"varint,13,opt,name=card_history_limit,json=cardHistoryLimit,proto3" json:"card_history_limit,omitempty"` // Versions kept per card, zero keeps all
+	CardHistoryDays  int32                  `protobuf:"varint,14,opt,name=card_history_days,json=cardHistoryDays,proto3" json:"card_history_days,omitempty"`    // Days card versions are kept for, zero keeps them forever
+	WipLimitPolicy   string                 `protobuf:"bytes,15,opt,name=wip_limit_policy,json=wipLimitPolicy,proto3" json:"wip_limit_policy,omitempty"`        // refuse or warn when a list is at its WIP limit
 }
 
 func (x *Board) Reset() {
@@ -220,15 +225,54 @@ func (x *Board) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Board) GetCustomFields() []*CustomField {
+	if x != nil {
+		return x.CustomFields
+	}
+	return nil
+}
+
+func (x *Board) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Board) GetCardHistoryLimit() int32 {
+	if x != nil {
+		return x.CardHistoryLimit
+	}
+	return 0
+}
+
+func (x *Board) GetCardHistoryDays() int32 {
+	if x != nil {
+		return x.CardHistoryDays
+	}
+	return 0
+}
+
+func (x *Board) GetWipLimitPolicy() string {
+	if x != nil {
+		return x.WipLimitPolicy
+	}
+	return ""
+}
+
 type List struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ListID   uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
-	BoardID  uint64 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
-	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Position int64  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	ListID       uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+	BoardID      uint64 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Position     int64  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Version      uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	WipLimit     int32  `protobuf:"varint,6,opt,name=wip_limit,json=wipLimit,proto3" json:"wip_limit,omitempty"` // Maximum unarchived cards, zero means no limit
+	CardCount    int64  `protobuf:"varint,7,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	OverWipLimit bool   `protobuf:"varint,8,opt,name=over_wip_limit,json=overWipLimit,proto3" json:"over_wip_limit,omitempty"`
 }
 
 func (x *List) Reset() {
@@ -291,6 +335,34 @@ func (x *List) GetPosition() int64 {
 	return 0
 }
 
+func (x *List) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *List) GetWipLimit() int32 {
+	if x != nil {
+		return x.WipLimit
+	}
+	return 0
+}
+
+func (x *List) GetCardCount() int64 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *List) GetOverWipLimit() bool {
+	if x != nil {
+		return x.OverWipLimit
+	}
+	return false
+}
+
 type Card struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -310,6 +382,7 @@ type Card struct {
 	DueDate     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
 	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version     uint64                 `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *Card) Reset() {
@@ -442,25 +515,34 @@ func (x *Card) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Card) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CardMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID          uint64                 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	BoardID         uint64                 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
-	ListID          uint64                 `protobuf:"varint,3,opt,name=listID,proto3" json:"listID,omitempty"`
-	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Position        int64                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
-	Labels          []uint64               `protobuf:"varint,6,rep,packed,name=labels,proto3" json:"labels,omitempty"`
-	Members         []uint64               `protobuf:"varint,7,rep,packed,name=members,proto3" json:"members,omitempty"`
-	TotalAttachment uint64                 `protobuf:"varint,8,opt,name=totalAttachment,proto3" json:"totalAttachment,omitempty"`
-	TotalComment    uint64                 `protobuf:"varint,9,opt,name=totalComment,proto3" json:"totalComment,omitempty"`
-	IsCompleted     bool                   `protobuf:"varint,10,opt,name=isCompleted,proto3" json:"isCompleted,omitempty"`
-	StartDate       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	DueDate         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CardID            uint64                 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	BoardID           uint64                 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	ListID            uint64                 `protobuf:"varint,3,opt,name=listID,proto3" json:"listID,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Position          int64                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	Labels            []uint64               `protobuf:"varint,6,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Members           []uint64               `protobuf:"varint,7,rep,packed,name=members,proto3" json:"members,omitempty"`
+	TotalAttachment   uint64                 `protobuf:"varint,8,opt,name=totalAttachment,proto3" json:"totalAttachment,omitempty"`
+	TotalComment      uint64                 `protobuf:"varint,9,opt,name=totalComment,proto3" json:"totalComment,omitempty"`
+	IsCompleted       bool                   `protobuf:"varint,10,opt,name=isCompleted,proto3" json:"isCompleted,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	DueDate           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CustomFieldValues []*CustomFieldValue    `protobuf:"bytes,15,rep,name=custom_field_values,json=customFieldValues,proto3" json:"custom_field_values,omitempty"`
+	Version           uint64                 `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *CardMeta) Reset() {
@@ -593,6 +675,20 @@ func (x *CardMeta) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CardMeta) GetCustomFieldValues() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFieldValues
+	}
+	return nil
+}
+
+func (x *CardMeta) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type Label struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -664,21 +760,18 @@ func (x *Label) GetBoardID() uint64 {
 	return 0
 }
 
-type Attachment struct {
+type CustomFieldOption struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttachmentID uint64 `protobuf:"varint,1,opt,name=attachmentID,proto3" json:"attachmentID,omitempty"`
-	CardID       uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	FileName     string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	FilePath     string `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
-	Type         string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
-	Thumbnail    string `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	OptionID uint64 `protobuf:"varint,1,opt,name=optionID,proto3" json:"optionID,omitempty"`
+	Value    string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Color    string `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
 }
 
-func (x *Attachment) Reset() {
-	*x = Attachment{}
+func (x *CustomFieldOption) Reset() {
+	*x = CustomFieldOption{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_board_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -686,13 +779,13 @@ func (x *Attachment) Reset() {
 	}
 }
 
-func (x *Attachment) String() string {
+func (x *CustomFieldOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attachment) ProtoMessage() {}
+func (*CustomFieldOption) ProtoMessage() {}
 
-func (x *Attachment) ProtoReflect() protoreflect.Message {
+func (x *CustomFieldOption) ProtoReflect() protoreflect.Message {
 	mi := &file_board_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -704,67 +797,47 @@ func (x *Attachment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
-func (*Attachment) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomFieldOption.ProtoReflect.Descriptor instead.
+func (*CustomFieldOption) Descriptor() ([]byte, []int) {
 	return file_board_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Attachment) GetAttachmentID() uint64 {
-	if x != nil {
-		return x.AttachmentID
-	}
-	return 0
-}
-
-func (x *Attachment) GetCardID() uint64 {
+func (x *CustomFieldOption) GetOptionID() uint64 {
 	if x != nil {
-		return x.CardID
+		return x.OptionID
 	}
 	return 0
 }
 
-func (x *Attachment) GetFileName() string {
-	if x != nil {
-		return x.FileName
-	}
-	return ""
-}
-
-func (x *Attachment) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
-	}
-	return ""
-}
-
-func (x *Attachment) GetType() string {
+func (x *CustomFieldOption) GetValue() string {
 	if x != nil {
-		return x.Type
+		return x.Value
 	}
 	return ""
 }
 
-func (x *Attachment) GetThumbnail() string {
+func (x *CustomFieldOption) GetColor() string {
 	if x != nil {
-		return x.Thumbnail
+		return x.Color
 	}
 	return ""
 }
 
-type BoardMeta struct {
+type CustomField struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoardID    uint64                 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
-	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Visibility string                 `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
-	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CustomFieldID uint64               `protobuf:"varint,1,opt,name=customFieldID,proto3" json:"customFieldID,omitempty"`
+	BoardID       uint64               `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	Name          string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string               `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Position      int64                `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	Options       []*CustomFieldOption `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *BoardMeta) Reset() {
-	*x = BoardMeta{}
+func (x *CustomField) Reset() {
+	*x = CustomField{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_board_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -772,13 +845,13 @@ func (x *BoardMeta) Reset() {
 	}
 }
 
-func (x *BoardMeta) String() string {
+func (x *CustomField) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardMeta) ProtoMessage() {}
+func (*CustomField) ProtoMessage() {}
 
-func (x *BoardMeta) ProtoReflect() protoreflect.Message {
+func (x *CustomField) ProtoReflect() protoreflect.Message {
 	mi := &file_board_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -790,60 +863,70 @@ func (x *BoardMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardMeta.ProtoReflect.Descriptor instead.
-func (*BoardMeta) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomField.ProtoReflect.Descriptor instead.
+func (*CustomField) Descriptor() ([]byte, []int) {
 	return file_board_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *BoardMeta) GetBoardID() uint64 {
+func (x *CustomField) GetCustomFieldID() uint64 {
+	if x != nil {
+		return x.CustomFieldID
+	}
+	return 0
+}
+
+func (x *CustomField) GetBoardID() uint64 {
 	if x != nil {
 		return x.BoardID
 	}
 	return 0
 }
 
-func (x *BoardMeta) GetName() string {
+func (x *CustomField) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *BoardMeta) GetVisibility() string {
+func (x *CustomField) GetType() string {
 	if x != nil {
-		return x.Visibility
+		return x.Type
 	}
 	return ""
 }
 
-func (x *BoardMeta) GetCreatedAt() *timestamppb.Timestamp {
+func (x *CustomField) GetPosition() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Position
 	}
-	return nil
+	return 0
 }
 
-func (x *BoardMeta) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *CustomField) GetOptions() []*CustomFieldOption {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Options
 	}
 	return nil
 }
 
-type BoardMember struct {
+type CustomFieldValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoardID  uint64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
-	UserID   uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Fullname string `protobuf:"bytes,4,opt,name=fullname,proto3" json:"fullname,omitempty"`
-	Role     string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
+	CustomFieldID uint64 `protobuf:"varint,1,opt,name=customFieldID,proto3" json:"customFieldID,omitempty"`
+	// Types that are assignable to Value:
+	//	*CustomFieldValue_TextValue
+	//	*CustomFieldValue_NumberValue
+	//	*CustomFieldValue_DateValue
+	//	*CustomFieldValue_CheckboxValue
+	//	*CustomFieldValue_OptionID
+	Value isCustomFieldValue_Value `protobuf_oneof:"value"`
 }
 
-func (x *BoardMember) Reset() {
-	*x = BoardMember{}
+func (x *CustomFieldValue) Reset() {
+	*x = CustomFieldValue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_board_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -851,13 +934,13 @@ func (x *BoardMember) Reset() {
 	}
 }
 
-func (x *BoardMember) String() string {
+func (x *CustomFieldValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoardMember) ProtoMessage() {}
+func (*CustomFieldValue) ProtoMessage() {}
 
-func (x *BoardMember) ProtoReflect() protoreflect.Message {
+func (x *CustomFieldValue) ProtoReflect() protoreflect.Message {
 	mi := &file_board_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,119 +952,124 @@ func (x *BoardMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoardMember.ProtoReflect.Descriptor instead.
-func (*BoardMember) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomFieldValue.ProtoReflect.Descriptor instead.
+func (*CustomFieldValue) Descriptor() ([]byte, []int) {
 	return file_board_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *BoardMember) GetBoardID() uint64 {
+func (x *CustomFieldValue) GetCustomFieldID() uint64 {
 	if x != nil {
-		return x.BoardID
+		return x.CustomFieldID
 	}
 	return 0
 }
 
-func (x *BoardMember) GetUserID() uint64 {
-	if x != nil {
-		return x.UserID
+func (m *CustomFieldValue) GetValue() isCustomFieldValue_Value {
+	if m != nil {
+		return m.Value
 	}
-	return 0
+	return nil
 }
 
-func (x *BoardMember) GetUsername() string {
-	if x != nil {
-		return x.Username
+func (x *CustomFieldValue) GetTextValue() string {
+	if x, ok := x.GetValue().(*CustomFieldValue_TextValue); ok {
+		return x.TextValue
 	}
 	return ""
 }
 
-func (x *BoardMember) GetFullname() string {
-	if x != nil {
-		return x.Fullname
+func (x *CustomFieldValue) GetNumberValue() float64 {
+	if x, ok := x.GetValue().(*CustomFieldValue_NumberValue); ok {
+		return x.NumberValue
 	}
-	return ""
+	return 0
 }
 
-func (x *BoardMember) GetRole() string {
-	if x != nil {
-		return x.Role
+func (x *CustomFieldValue) GetDateValue() *timestamppb.Timestamp {
+	if x, ok := x.GetValue().(*CustomFieldValue_DateValue); ok {
+		return x.DateValue
 	}
-	return ""
+	return nil
 }
 
-// Request and Response Messages
-type CreateBoardRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (x *CustomFieldValue) GetCheckboxValue() bool {
+	if x, ok := x.GetValue().(*CustomFieldValue_CheckboxValue); ok {
+		return x.CheckboxValue
+	}
+	return false
 }
 
-func (x *CreateBoardRequest) Reset() {
-	*x = CreateBoardRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CustomFieldValue) GetOptionID() uint64 {
+	if x, ok := x.GetValue().(*CustomFieldValue_OptionID); ok {
+		return x.OptionID
 	}
+	return 0
 }
 
-func (x *CreateBoardRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isCustomFieldValue_Value interface {
+	isCustomFieldValue_Value()
 }
 
-func (*CreateBoardRequest) ProtoMessage() {}
+type CustomFieldValue_TextValue struct {
+	TextValue string `protobuf:"bytes,2,opt,name=text_value,json=textValue,proto3,oneof"`
+}
 
-func (x *CreateBoardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type CustomFieldValue_NumberValue struct {
+	NumberValue float64 `protobuf:"fixed64,3,opt,name=number_value,json=numberValue,proto3,oneof"`
 }
 
-// Deprecated: Use CreateBoardRequest.ProtoReflect.Descriptor instead.
-func (*CreateBoardRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{9}
+type CustomFieldValue_DateValue struct {
+	DateValue *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_value,json=dateValue,proto3,oneof"`
 }
 
-func (x *CreateBoardRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type CustomFieldValue_CheckboxValue struct {
+	CheckboxValue bool `protobuf:"varint,5,opt,name=checkbox_value,json=checkboxValue,proto3,oneof"`
 }
 
-type CreateBoardResponse struct {
+type CustomFieldValue_OptionID struct {
+	OptionID uint64 `protobuf:"varint,6,opt,name=optionID,proto3,oneof"`
+}
+
+func (*CustomFieldValue_TextValue) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_NumberValue) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_DateValue) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_CheckboxValue) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_OptionID) isCustomFieldValue_Value() {}
+
+type Attachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	AttachmentID uint64 `protobuf:"varint,1,opt,name=attachmentID,proto3" json:"attachmentID,omitempty"`
+	CardID       uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	FileName     string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FilePath     string `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	Type         string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Thumbnail    string `protobuf:"bytes,6,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
 }
 
-func (x *CreateBoardResponse) Reset() {
-	*x = CreateBoardResponse{}
+func (x *Attachment) Reset() {
+	*x = Attachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[10]
+		mi := &file_board_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateBoardResponse) String() string {
+func (x *Attachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBoardResponse) ProtoMessage() {}
+func (*Attachment) ProtoMessage() {}
 
-func (x *CreateBoardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[10]
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -992,81 +1080,83 @@ func (x *CreateBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBoardResponse.ProtoReflect.Descriptor instead.
-func (*CreateBoardResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateBoardResponse) GetBoard() *Board {
+func (x *Attachment) GetAttachmentID() uint64 {
 	if x != nil {
-		return x.Board
+		return x.AttachmentID
 	}
-	return nil
-}
-
-type GetBoardByIDRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *GetBoardByIDRequest) Reset() {
-	*x = GetBoardByIDRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Attachment) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
 	}
+	return 0
 }
 
-func (x *GetBoardByIDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
 }
 
-func (*GetBoardByIDRequest) ProtoMessage() {}
+func (x *Attachment) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
 
-func (x *GetBoardByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Attachment) GetType() string {
+	if x != nil {
+		return x.Type
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetBoardByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetBoardByIDRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{11}
+func (x *Attachment) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
 }
 
-type GetBoardByIDResponse struct {
+type BoardMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Board *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
+	BoardID    uint64                 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	Name       string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Visibility string                 `protobuf:"bytes,4,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Version    uint64                 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (x *GetBoardByIDResponse) Reset() {
-	*x = GetBoardByIDResponse{}
+func (x *BoardMeta) Reset() {
+	*x = BoardMeta{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[12]
+		mi := &file_board_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBoardByIDResponse) String() string {
+func (x *BoardMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardByIDResponse) ProtoMessage() {}
+func (*BoardMeta) ProtoMessage() {}
 
-func (x *GetBoardByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[12]
+func (x *BoardMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1077,44 +1167,82 @@ func (x *GetBoardByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetBoardByIDResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use BoardMeta.ProtoReflect.Descriptor instead.
+func (*BoardMeta) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetBoardByIDResponse) GetBoard() *Board {
+func (x *BoardMeta) GetBoardID() uint64 {
 	if x != nil {
-		return x.Board
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *BoardMeta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BoardMeta) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *BoardMeta) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type GetBoardListRequest struct {
+func (x *BoardMeta) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *BoardMeta) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type BoardMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageNumber uint64 `protobuf:"varint,1,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
-	PageSize   uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	BoardID  uint64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	UserID   uint64 `protobuf:"varint,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Fullname string `protobuf:"bytes,4,opt,name=fullname,proto3" json:"fullname,omitempty"`
+	Role     string `protobuf:"bytes,5,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *GetBoardListRequest) Reset() {
-	*x = GetBoardListRequest{}
+func (x *BoardMember) Reset() {
+	*x = BoardMember{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[13]
+		mi := &file_board_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBoardListRequest) String() string {
+func (x *BoardMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardListRequest) ProtoMessage() {}
+func (*BoardMember) ProtoMessage() {}
 
-func (x *GetBoardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[13]
+func (x *BoardMember) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1125,51 +1253,72 @@ func (x *GetBoardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardListRequest.ProtoReflect.Descriptor instead.
-func (*GetBoardListRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use BoardMember.ProtoReflect.Descriptor instead.
+func (*BoardMember) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetBoardListRequest) GetPageNumber() uint64 {
+func (x *BoardMember) GetBoardID() uint64 {
 	if x != nil {
-		return x.PageNumber
+		return x.BoardID
 	}
 	return 0
 }
 
-func (x *GetBoardListRequest) GetPageSize() uint64 {
+func (x *BoardMember) GetUserID() uint64 {
 	if x != nil {
-		return x.PageSize
+		return x.UserID
 	}
 	return 0
 }
 
-type GetBoardListResponse struct {
+func (x *BoardMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BoardMember) GetFullname() string {
+	if x != nil {
+		return x.Fullname
+	}
+	return ""
+}
+
+func (x *BoardMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// Request and Response Messages
+type CreateBoardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Boards     []*BoardMeta `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
-	Pagination *Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetBoardListResponse) Reset() {
-	*x = GetBoardListResponse{}
+func (x *CreateBoardRequest) Reset() {
+	*x = CreateBoardRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[14]
+		mi := &file_board_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBoardListResponse) String() string {
+func (x *CreateBoardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardListResponse) ProtoMessage() {}
+func (*CreateBoardRequest) ProtoMessage() {}
 
-func (x *GetBoardListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[14]
+func (x *CreateBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,48 +1329,43 @@ func (x *GetBoardListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardListResponse.ProtoReflect.Descriptor instead.
-func (*GetBoardListResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetBoardListResponse) GetBoards() []*BoardMeta {
-	if x != nil {
-		return x.Boards
-	}
-	return nil
+// Deprecated: Use CreateBoardRequest.ProtoReflect.Descriptor instead.
+func (*CreateBoardRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetBoardListResponse) GetPagination() *Pagination {
+func (x *CreateBoardRequest) GetName() string {
 	if x != nil {
-		return x.Pagination
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetBoardMembersRequest struct {
+type CreateBoardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Board *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
 }
 
-func (x *GetBoardMembersRequest) Reset() {
-	*x = GetBoardMembersRequest{}
+func (x *CreateBoardResponse) Reset() {
+	*x = CreateBoardResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[15]
+		mi := &file_board_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBoardMembersRequest) String() string {
+func (x *CreateBoardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardMembersRequest) ProtoMessage() {}
+func (*CreateBoardResponse) ProtoMessage() {}
 
-func (x *GetBoardMembersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[15]
+func (x *CreateBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,36 +1376,41 @@ func (x *GetBoardMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardMembersRequest.ProtoReflect.Descriptor instead.
-func (*GetBoardMembersRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CreateBoardResponse.ProtoReflect.Descriptor instead.
+func (*CreateBoardResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{13}
 }
 
-type GetBoardMembersResponse struct {
+func (x *CreateBoardResponse) GetBoard() *Board {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+type GetBoardByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Members []*BoardMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *GetBoardMembersResponse) Reset() {
-	*x = GetBoardMembersResponse{}
+func (x *GetBoardByIDRequest) Reset() {
+	*x = GetBoardByIDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[16]
+		mi := &file_board_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBoardMembersResponse) String() string {
+func (x *GetBoardByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardMembersResponse) ProtoMessage() {}
+func (*GetBoardByIDRequest) ProtoMessage() {}
 
-func (x *GetBoardMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[16]
+func (x *GetBoardByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1272,44 +1421,36 @@ func (x *GetBoardMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardMembersResponse.ProtoReflect.Descriptor instead.
-func (*GetBoardMembersResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *GetBoardMembersResponse) GetMembers() []*BoardMember {
-	if x != nil {
-		return x.Members
-	}
-	return nil
+// Deprecated: Use GetBoardByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardByIDRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{14}
 }
 
-type UpdateBoardNameRequest struct {
+type GetBoardByIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uint64 boardID = 1;
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Board *Board `protobuf:"bytes,1,opt,name=board,proto3" json:"board,omitempty"`
 }
 
-func (x *UpdateBoardNameRequest) Reset() {
-	*x = UpdateBoardNameRequest{}
+func (x *GetBoardByIDResponse) Reset() {
+	*x = GetBoardByIDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[17]
+		mi := &file_board_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateBoardNameRequest) String() string {
+func (x *GetBoardByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBoardNameRequest) ProtoMessage() {}
+func (*GetBoardByIDResponse) ProtoMessage() {}
 
-func (x *UpdateBoardNameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[17]
+func (x *GetBoardByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,43 +1461,44 @@ func (x *UpdateBoardNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBoardNameRequest.ProtoReflect.Descriptor instead.
-func (*UpdateBoardNameRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetBoardByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardByIDResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateBoardNameRequest) GetName() string {
+func (x *GetBoardByIDResponse) GetBoard() *Board {
 	if x != nil {
-		return x.Name
+		return x.Board
 	}
-	return ""
+	return nil
 }
 
-type UpdateBoardNameResponse struct {
+type GetBoardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PageNumber uint64 `protobuf:"varint,1,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	PageSize   uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
 }
 
-func (x *UpdateBoardNameResponse) Reset() {
-	*x = UpdateBoardNameResponse{}
+func (x *GetBoardListRequest) Reset() {
+	*x = GetBoardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[18]
+		mi := &file_board_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateBoardNameResponse) String() string {
+func (x *GetBoardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBoardNameResponse) ProtoMessage() {}
+func (*GetBoardListRequest) ProtoMessage() {}
 
-func (x *UpdateBoardNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[18]
+func (x *GetBoardListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1367,45 +1509,51 @@ func (x *UpdateBoardNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateBoardNameResponse.ProtoReflect.Descriptor instead.
-func (*UpdateBoardNameResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetBoardListRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardListRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateBoardNameResponse) GetMessage() string {
+func (x *GetBoardListRequest) GetPageNumber() uint64 {
 	if x != nil {
-		return x.Message
+		return x.PageNumber
 	}
-	return ""
+	return 0
 }
 
-type AddBoardUsersRequest struct {
+func (x *GetBoardListRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetBoardListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uint64 boardID = 1;
-	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
-	Role    string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Boards     []*BoardMeta `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
+	Pagination *Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *AddBoardUsersRequest) Reset() {
-	*x = AddBoardUsersRequest{}
+func (x *GetBoardListResponse) Reset() {
+	*x = GetBoardListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[19]
+		mi := &file_board_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddBoardUsersRequest) String() string {
+func (x *GetBoardListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBoardUsersRequest) ProtoMessage() {}
+func (*GetBoardListResponse) ProtoMessage() {}
 
-func (x *AddBoardUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[19]
+func (x *GetBoardListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,50 +1564,48 @@ func (x *AddBoardUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBoardUsersRequest.ProtoReflect.Descriptor instead.
-func (*AddBoardUsersRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetBoardListResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardListResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *AddBoardUsersRequest) GetUserIDs() []uint64 {
+func (x *GetBoardListResponse) GetBoards() []*BoardMeta {
 	if x != nil {
-		return x.UserIDs
+		return x.Boards
 	}
 	return nil
 }
 
-func (x *AddBoardUsersRequest) GetRole() string {
+func (x *GetBoardListResponse) GetPagination() *Pagination {
 	if x != nil {
-		return x.Role
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type AddBoardUsersResponse struct {
+type GetBoardMembersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *AddBoardUsersResponse) Reset() {
-	*x = AddBoardUsersResponse{}
+func (x *GetBoardMembersRequest) Reset() {
+	*x = GetBoardMembersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[20]
+		mi := &file_board_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddBoardUsersResponse) String() string {
+func (x *GetBoardMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBoardUsersResponse) ProtoMessage() {}
+func (*GetBoardMembersRequest) ProtoMessage() {}
 
-func (x *AddBoardUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[20]
+func (x *GetBoardMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1470,44 +1616,36 @@ func (x *AddBoardUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBoardUsersResponse.ProtoReflect.Descriptor instead.
-func (*AddBoardUsersResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *AddBoardUsersResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use GetBoardMembersRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardMembersRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{18}
 }
 
-type RemoveBoardUsersRequest struct {
+type GetBoardMembersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uint64 boardID = 1;
-	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	Members []*BoardMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *RemoveBoardUsersRequest) Reset() {
-	*x = RemoveBoardUsersRequest{}
+func (x *GetBoardMembersResponse) Reset() {
+	*x = GetBoardMembersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[21]
+		mi := &file_board_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveBoardUsersRequest) String() string {
+func (x *GetBoardMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBoardUsersRequest) ProtoMessage() {}
+func (*GetBoardMembersResponse) ProtoMessage() {}
 
-func (x *RemoveBoardUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[21]
+func (x *GetBoardMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1518,43 +1656,45 @@ func (x *RemoveBoardUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBoardUsersRequest.ProtoReflect.Descriptor instead.
-func (*RemoveBoardUsersRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetBoardMembersResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardMembersResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *RemoveBoardUsersRequest) GetUserIDs() []uint64 {
+func (x *GetBoardMembersResponse) GetMembers() []*BoardMember {
 	if x != nil {
-		return x.UserIDs
+		return x.Members
 	}
 	return nil
 }
 
-type RemoveBoardUsersResponse struct {
+type UpdateBoardNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// uint64 boardID = 1;
+	Name            string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
 }
 
-func (x *RemoveBoardUsersResponse) Reset() {
-	*x = RemoveBoardUsersResponse{}
+func (x *UpdateBoardNameRequest) Reset() {
+	*x = UpdateBoardNameRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[22]
+		mi := &file_board_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveBoardUsersResponse) String() string {
+func (x *UpdateBoardNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBoardUsersResponse) ProtoMessage() {}
+func (*UpdateBoardNameRequest) ProtoMessage() {}
 
-func (x *RemoveBoardUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[22]
+func (x *UpdateBoardNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1565,45 +1705,50 @@ func (x *RemoveBoardUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBoardUsersResponse.ProtoReflect.Descriptor instead.
-func (*RemoveBoardUsersResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UpdateBoardNameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBoardNameRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *RemoveBoardUsersResponse) GetMessage() string {
+func (x *UpdateBoardNameRequest) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-type AssignBoardUsersRoleRequest struct {
+func (x *UpdateBoardNameRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateBoardNameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uint64 boardID = 1;
-	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
-	Role    string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *AssignBoardUsersRoleRequest) Reset() {
-	*x = AssignBoardUsersRoleRequest{}
+func (x *UpdateBoardNameResponse) Reset() {
+	*x = UpdateBoardNameResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[23]
+		mi := &file_board_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignBoardUsersRoleRequest) String() string {
+func (x *UpdateBoardNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignBoardUsersRoleRequest) ProtoMessage() {}
+func (*UpdateBoardNameResponse) ProtoMessage() {}
 
-func (x *AssignBoardUsersRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[23]
+func (x *UpdateBoardNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,50 +1759,45 @@ func (x *AssignBoardUsersRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignBoardUsersRoleRequest.ProtoReflect.Descriptor instead.
-func (*AssignBoardUsersRoleRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *AssignBoardUsersRoleRequest) GetUserIDs() []uint64 {
-	if x != nil {
-		return x.UserIDs
-	}
-	return nil
+// Deprecated: Use UpdateBoardNameResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBoardNameResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AssignBoardUsersRoleRequest) GetRole() string {
+func (x *UpdateBoardNameResponse) GetMessage() string {
 	if x != nil {
-		return x.Role
+		return x.Message
 	}
 	return ""
 }
 
-type AssignBoardUsersRoleResponse struct {
+type AddBoardUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// uint64 boardID = 1;
+	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	Role    string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *AssignBoardUsersRoleResponse) Reset() {
-	*x = AssignBoardUsersRoleResponse{}
+func (x *AddBoardUsersRequest) Reset() {
+	*x = AddBoardUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[24]
+		mi := &file_board_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AssignBoardUsersRoleResponse) String() string {
+func (x *AddBoardUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssignBoardUsersRoleResponse) ProtoMessage() {}
+func (*AddBoardUsersRequest) ProtoMessage() {}
 
-func (x *AssignBoardUsersRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[24]
+func (x *AddBoardUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1668,44 +1808,50 @@ func (x *AssignBoardUsersRoleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssignBoardUsersRoleResponse.ProtoReflect.Descriptor instead.
-func (*AssignBoardUsersRoleResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use AddBoardUsersRequest.ProtoReflect.Descriptor instead.
+func (*AddBoardUsersRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AssignBoardUsersRoleResponse) GetMessage() string {
+func (x *AddBoardUsersRequest) GetUserIDs() []uint64 {
 	if x != nil {
-		return x.Message
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *AddBoardUsersRequest) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
 	return ""
 }
 
-type ChangeBoardOwnerRequest struct {
+type AddBoardUsersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uint64 boardID = 1;
-	NewOwnerID uint64 `protobuf:"varint,1,opt,name=newOwnerID,proto3" json:"newOwnerID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ChangeBoardOwnerRequest) Reset() {
-	*x = ChangeBoardOwnerRequest{}
+func (x *AddBoardUsersResponse) Reset() {
+	*x = AddBoardUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[25]
+		mi := &file_board_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeBoardOwnerRequest) String() string {
+func (x *AddBoardUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeBoardOwnerRequest) ProtoMessage() {}
+func (*AddBoardUsersResponse) ProtoMessage() {}
 
-func (x *ChangeBoardOwnerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[25]
+func (x *AddBoardUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,43 +1862,44 @@ func (x *ChangeBoardOwnerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeBoardOwnerRequest.ProtoReflect.Descriptor instead.
-func (*ChangeBoardOwnerRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AddBoardUsersResponse.ProtoReflect.Descriptor instead.
+func (*AddBoardUsersResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *ChangeBoardOwnerRequest) GetNewOwnerID() uint64 {
+func (x *AddBoardUsersResponse) GetMessage() string {
 	if x != nil {
-		return x.NewOwnerID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type ChangeBoardOwnerResponse struct {
+type RemoveBoardUsersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// uint64 boardID = 1;
+	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
 }
 
-func (x *ChangeBoardOwnerResponse) Reset() {
-	*x = ChangeBoardOwnerResponse{}
+func (x *RemoveBoardUsersRequest) Reset() {
+	*x = RemoveBoardUsersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[26]
+		mi := &file_board_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeBoardOwnerResponse) String() string {
+func (x *RemoveBoardUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeBoardOwnerResponse) ProtoMessage() {}
+func (*RemoveBoardUsersRequest) ProtoMessage() {}
 
-func (x *ChangeBoardOwnerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[26]
+func (x *RemoveBoardUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1763,44 +1910,43 @@ func (x *ChangeBoardOwnerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeBoardOwnerResponse.ProtoReflect.Descriptor instead.
-func (*ChangeBoardOwnerResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use RemoveBoardUsersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBoardUsersRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ChangeBoardOwnerResponse) GetMessage() string {
+func (x *RemoveBoardUsersRequest) GetUserIDs() []uint64 {
 	if x != nil {
-		return x.Message
+		return x.UserIDs
 	}
-	return ""
+	return nil
 }
 
-type ChangeBoardVisibilityRequest struct {
+type RemoveBoardUsersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uint64 boardID = 1;
-	Visibility string `protobuf:"bytes,1,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ChangeBoardVisibilityRequest) Reset() {
-	*x = ChangeBoardVisibilityRequest{}
+func (x *RemoveBoardUsersResponse) Reset() {
+	*x = RemoveBoardUsersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[27]
+		mi := &file_board_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeBoardVisibilityRequest) String() string {
+func (x *RemoveBoardUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeBoardVisibilityRequest) ProtoMessage() {}
+func (*RemoveBoardUsersResponse) ProtoMessage() {}
 
-func (x *ChangeBoardVisibilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[27]
+func (x *RemoveBoardUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1811,43 +1957,45 @@ func (x *ChangeBoardVisibilityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeBoardVisibilityRequest.ProtoReflect.Descriptor instead.
-func (*ChangeBoardVisibilityRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use RemoveBoardUsersResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBoardUsersResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ChangeBoardVisibilityRequest) GetVisibility() string {
+func (x *RemoveBoardUsersResponse) GetMessage() string {
 	if x != nil {
-		return x.Visibility
+		return x.Message
 	}
 	return ""
 }
 
-type ChangeBoardVisibilityResponse struct {
+type AssignBoardUsersRoleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// uint64 boardID = 1;
+	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	Role    string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
 }
 
-func (x *ChangeBoardVisibilityResponse) Reset() {
-	*x = ChangeBoardVisibilityResponse{}
+func (x *AssignBoardUsersRoleRequest) Reset() {
+	*x = AssignBoardUsersRoleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[28]
+		mi := &file_board_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeBoardVisibilityResponse) String() string {
+func (x *AssignBoardUsersRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeBoardVisibilityResponse) ProtoMessage() {}
+func (*AssignBoardUsersRoleRequest) ProtoMessage() {}
 
-func (x *ChangeBoardVisibilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[28]
+func (x *AssignBoardUsersRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,45 +2006,50 @@ func (x *ChangeBoardVisibilityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeBoardVisibilityResponse.ProtoReflect.Descriptor instead.
-func (*ChangeBoardVisibilityResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use AssignBoardUsersRoleRequest.ProtoReflect.Descriptor instead.
+func (*AssignBoardUsersRoleRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ChangeBoardVisibilityResponse) GetMessage() string {
+func (x *AssignBoardUsersRoleRequest) GetUserIDs() []uint64 {
 	if x != nil {
-		return x.Message
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *AssignBoardUsersRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
 	return ""
 }
 
-type GetArchivedBoardListRequest struct {
+type AssignBoardUsersRoleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PageNumber uint64 `protobuf:"varint,1,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
-	PageSize   uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
-	UserID     uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetArchivedBoardListRequest) Reset() {
-	*x = GetArchivedBoardListRequest{}
+func (x *AssignBoardUsersRoleResponse) Reset() {
+	*x = AssignBoardUsersRoleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[29]
+		mi := &file_board_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArchivedBoardListRequest) String() string {
+func (x *AssignBoardUsersRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArchivedBoardListRequest) ProtoMessage() {}
+func (*AssignBoardUsersRoleResponse) ProtoMessage() {}
 
-func (x *GetArchivedBoardListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[29]
+func (x *AssignBoardUsersRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1907,58 +2060,44 @@ func (x *GetArchivedBoardListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArchivedBoardListRequest.ProtoReflect.Descriptor instead.
-func (*GetArchivedBoardListRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *GetArchivedBoardListRequest) GetPageNumber() uint64 {
-	if x != nil {
-		return x.PageNumber
-	}
-	return 0
-}
-
-func (x *GetArchivedBoardListRequest) GetPageSize() uint64 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use AssignBoardUsersRoleResponse.ProtoReflect.Descriptor instead.
+func (*AssignBoardUsersRoleResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetArchivedBoardListRequest) GetUserID() uint64 {
+func (x *AssignBoardUsersRoleResponse) GetMessage() string {
 	if x != nil {
-		return x.UserID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GetArchivedBoardListResponse struct {
+type ChangeBoardOwnerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Boards     []*BoardMeta `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
-	Pagination *Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// uint64 boardID = 1;
+	NewOwnerID uint64 `protobuf:"varint,1,opt,name=newOwnerID,proto3" json:"newOwnerID,omitempty"`
 }
 
-func (x *GetArchivedBoardListResponse) Reset() {
-	*x = GetArchivedBoardListResponse{}
+func (x *ChangeBoardOwnerRequest) Reset() {
+	*x = ChangeBoardOwnerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[30]
+		mi := &file_board_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArchivedBoardListResponse) String() string {
+func (x *ChangeBoardOwnerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArchivedBoardListResponse) ProtoMessage() {}
+func (*ChangeBoardOwnerRequest) ProtoMessage() {}
 
-func (x *GetArchivedBoardListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[30]
+func (x *ChangeBoardOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1969,48 +2108,43 @@ func (x *GetArchivedBoardListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArchivedBoardListResponse.ProtoReflect.Descriptor instead.
-func (*GetArchivedBoardListResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *GetArchivedBoardListResponse) GetBoards() []*BoardMeta {
-	if x != nil {
-		return x.Boards
-	}
-	return nil
+// Deprecated: Use ChangeBoardOwnerRequest.ProtoReflect.Descriptor instead.
+func (*ChangeBoardOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetArchivedBoardListResponse) GetPagination() *Pagination {
+func (x *ChangeBoardOwnerRequest) GetNewOwnerID() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.NewOwnerID
 	}
-	return nil
+	return 0
 }
 
-type RestoreBoardRequest struct {
+type ChangeBoardOwnerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RestoreBoardRequest) Reset() {
-	*x = RestoreBoardRequest{}
+func (x *ChangeBoardOwnerResponse) Reset() {
+	*x = ChangeBoardOwnerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[31]
+		mi := &file_board_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RestoreBoardRequest) String() string {
+func (x *ChangeBoardOwnerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreBoardRequest) ProtoMessage() {}
+func (*ChangeBoardOwnerResponse) ProtoMessage() {}
 
-func (x *RestoreBoardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[31]
+func (x *ChangeBoardOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2021,36 +2155,45 @@ func (x *RestoreBoardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreBoardRequest.ProtoReflect.Descriptor instead.
-func (*RestoreBoardRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ChangeBoardOwnerResponse.ProtoReflect.Descriptor instead.
+func (*ChangeBoardOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{29}
 }
 
-type RestoreBoardResponse struct {
+func (x *ChangeBoardOwnerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ChangeBoardVisibilityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// uint64 boardID = 1;
+	Visibility      string `protobuf:"bytes,1,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
 }
 
-func (x *RestoreBoardResponse) Reset() {
-	*x = RestoreBoardResponse{}
+func (x *ChangeBoardVisibilityRequest) Reset() {
+	*x = ChangeBoardVisibilityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[32]
+		mi := &file_board_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RestoreBoardResponse) String() string {
+func (x *ChangeBoardVisibilityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreBoardResponse) ProtoMessage() {}
+func (*ChangeBoardVisibilityRequest) ProtoMessage() {}
 
-func (x *RestoreBoardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[32]
+func (x *ChangeBoardVisibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2061,45 +2204,50 @@ func (x *RestoreBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreBoardResponse.ProtoReflect.Descriptor instead.
-func (*RestoreBoardResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use ChangeBoardVisibilityRequest.ProtoReflect.Descriptor instead.
+func (*ChangeBoardVisibilityRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RestoreBoardResponse) GetMessage() string {
+func (x *ChangeBoardVisibilityRequest) GetVisibility() string {
 	if x != nil {
-		return x.Message
+		return x.Visibility
 	}
 	return ""
 }
 
-type AddLabelRequest struct {
+func (x *ChangeBoardVisibilityRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ChangeBoardVisibilityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uint64 boardID = 1;
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Color string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *AddLabelRequest) Reset() {
-	*x = AddLabelRequest{}
+func (x *ChangeBoardVisibilityResponse) Reset() {
+	*x = ChangeBoardVisibilityResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[33]
+		mi := &file_board_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddLabelRequest) String() string {
+func (x *ChangeBoardVisibilityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLabelRequest) ProtoMessage() {}
+func (*ChangeBoardVisibilityResponse) ProtoMessage() {}
 
-func (x *AddLabelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[33]
+func (x *ChangeBoardVisibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2110,50 +2258,45 @@ func (x *AddLabelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLabelRequest.ProtoReflect.Descriptor instead.
-func (*AddLabelRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *AddLabelRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ChangeBoardVisibilityResponse.ProtoReflect.Descriptor instead.
+func (*ChangeBoardVisibilityResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AddLabelRequest) GetColor() string {
+func (x *ChangeBoardVisibilityResponse) GetMessage() string {
 	if x != nil {
-		return x.Color
+		return x.Message
 	}
 	return ""
 }
 
-type AddLabelResponse struct {
+type SetCardHistoryRetentionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Label *Label `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	CardHistoryLimit int32  `protobuf:"varint,1,opt,name=card_history_limit,json=cardHistoryLimit,proto3" json:"card_history_limit,omitempty"` // Versions kept per card, zero keeps all
+	CardHistoryDays  int32  `protobuf:"varint,2,opt,name=card_history_days,json=cardHistoryDays,proto3" json:"card_history_days,omitempty"`    // Days card versions are kept for, zero keeps them forever
+	ExpectedVersion  uint64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`      // Version the change is based on, zero skips the check
 }
 
-func (x *AddLabelResponse) Reset() {
-	*x = AddLabelResponse{}
+func (x *SetCardHistoryRetentionRequest) Reset() {
+	*x = SetCardHistoryRetentionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[34]
+		mi := &file_board_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddLabelResponse) String() string {
+func (x *SetCardHistoryRetentionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddLabelResponse) ProtoMessage() {}
+func (*SetCardHistoryRetentionRequest) ProtoMessage() {}
 
-func (x *AddLabelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[34]
+func (x *SetCardHistoryRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2164,44 +2307,57 @@ func (x *AddLabelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddLabelResponse.ProtoReflect.Descriptor instead.
-func (*AddLabelResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use SetCardHistoryRetentionRequest.ProtoReflect.Descriptor instead.
+func (*SetCardHistoryRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *AddLabelResponse) GetLabel() *Label {
+func (x *SetCardHistoryRetentionRequest) GetCardHistoryLimit() int32 {
 	if x != nil {
-		return x.Label
+		return x.CardHistoryLimit
 	}
-	return nil
+	return 0
 }
 
-type RemoveLabelRequest struct {
+func (x *SetCardHistoryRetentionRequest) GetCardHistoryDays() int32 {
+	if x != nil {
+		return x.CardHistoryDays
+	}
+	return 0
+}
+
+func (x *SetCardHistoryRetentionRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type SetCardHistoryRetentionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// uint64 boardID = 1;
-	LabelID uint64 `protobuf:"varint,1,opt,name=labelID,proto3" json:"labelID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RemoveLabelRequest) Reset() {
-	*x = RemoveLabelRequest{}
+func (x *SetCardHistoryRetentionResponse) Reset() {
+	*x = SetCardHistoryRetentionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[35]
+		mi := &file_board_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveLabelRequest) String() string {
+func (x *SetCardHistoryRetentionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveLabelRequest) ProtoMessage() {}
+func (*SetCardHistoryRetentionResponse) ProtoMessage() {}
 
-func (x *RemoveLabelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[35]
+func (x *SetCardHistoryRetentionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2212,43 +2368,44 @@ func (x *RemoveLabelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveLabelRequest.ProtoReflect.Descriptor instead.
-func (*RemoveLabelRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use SetCardHistoryRetentionResponse.ProtoReflect.Descriptor instead.
+func (*SetCardHistoryRetentionResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RemoveLabelRequest) GetLabelID() uint64 {
+func (x *SetCardHistoryRetentionResponse) GetMessage() string {
 	if x != nil {
-		return x.LabelID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type RemoveLabelResponse struct {
+type SetWIPLimitPolicyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	WipLimitPolicy  string `protobuf:"bytes,1,opt,name=wip_limit_policy,json=wipLimitPolicy,proto3" json:"wip_limit_policy,omitempty"`   // refuse or warn
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
 }
 
-func (x *RemoveLabelResponse) Reset() {
-	*x = RemoveLabelResponse{}
+func (x *SetWIPLimitPolicyRequest) Reset() {
+	*x = SetWIPLimitPolicyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[36]
+		mi := &file_board_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveLabelResponse) String() string {
+func (x *SetWIPLimitPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveLabelResponse) ProtoMessage() {}
+func (*SetWIPLimitPolicyRequest) ProtoMessage() {}
 
-func (x *RemoveLabelResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[36]
+func (x *SetWIPLimitPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2259,41 +2416,50 @@ func (x *RemoveLabelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveLabelResponse.ProtoReflect.Descriptor instead.
-func (*RemoveLabelResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use SetWIPLimitPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetWIPLimitPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *RemoveLabelResponse) GetMessage() string {
+func (x *SetWIPLimitPolicyRequest) GetWipLimitPolicy() string {
 	if x != nil {
-		return x.Message
+		return x.WipLimitPolicy
 	}
 	return ""
 }
 
-type ArchiveBoardRequest struct {
+func (x *SetWIPLimitPolicyRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type SetWIPLimitPolicyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ArchiveBoardRequest) Reset() {
-	*x = ArchiveBoardRequest{}
+func (x *SetWIPLimitPolicyResponse) Reset() {
+	*x = SetWIPLimitPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[37]
+		mi := &file_board_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArchiveBoardRequest) String() string {
+func (x *SetWIPLimitPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveBoardRequest) ProtoMessage() {}
+func (*SetWIPLimitPolicyResponse) ProtoMessage() {}
 
-func (x *ArchiveBoardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[37]
+func (x *SetWIPLimitPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2304,36 +2470,45 @@ func (x *ArchiveBoardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveBoardRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveBoardRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use SetWIPLimitPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetWIPLimitPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{35}
 }
 
-type ArchiveBoardResponse struct {
+func (x *SetWIPLimitPolicyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetArchivedBoardListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	PageNumber uint64 `protobuf:"varint,1,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	PageSize   uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	UserID     uint64 `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *ArchiveBoardResponse) Reset() {
-	*x = ArchiveBoardResponse{}
+func (x *GetArchivedBoardListRequest) Reset() {
+	*x = GetArchivedBoardListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[38]
+		mi := &file_board_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArchiveBoardResponse) String() string {
+func (x *GetArchivedBoardListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveBoardResponse) ProtoMessage() {}
+func (*GetArchivedBoardListRequest) ProtoMessage() {}
 
-func (x *ArchiveBoardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[38]
+func (x *GetArchivedBoardListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2344,41 +2519,58 @@ func (x *ArchiveBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveBoardResponse.ProtoReflect.Descriptor instead.
-func (*ArchiveBoardResponse) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetArchivedBoardListRequest.ProtoReflect.Descriptor instead.
+func (*GetArchivedBoardListRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ArchiveBoardResponse) GetMessage() string {
+func (x *GetArchivedBoardListRequest) GetPageNumber() uint64 {
 	if x != nil {
-		return x.Message
+		return x.PageNumber
 	}
-	return ""
+	return 0
 }
 
-type DeleteBoardRequest struct {
+func (x *GetArchivedBoardListRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetArchivedBoardListRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type GetArchivedBoardListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Boards     []*BoardMeta `protobuf:"bytes,1,rep,name=boards,proto3" json:"boards,omitempty"`
+	Pagination *Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *DeleteBoardRequest) Reset() {
-	*x = DeleteBoardRequest{}
+func (x *GetArchivedBoardListResponse) Reset() {
+	*x = GetArchivedBoardListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_board_proto_msgTypes[39]
+		mi := &file_board_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteBoardRequest) String() string {
+func (x *GetArchivedBoardListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBoardRequest) ProtoMessage() {}
+func (*GetArchivedBoardListResponse) ProtoMessage() {}
 
-func (x *DeleteBoardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_board_proto_msgTypes[39]
+func (x *GetArchivedBoardListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2389,21 +2581,132 @@ func (x *DeleteBoardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBoardRequest.ProtoReflect.Descriptor instead.
-func (*DeleteBoardRequest) Descriptor() ([]byte, []int) {
-	return file_board_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetArchivedBoardListResponse.ProtoReflect.Descriptor instead.
+func (*GetArchivedBoardListResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{37}
 }
 
-type DeleteBoardResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *GetArchivedBoardListResponse) GetBoards() []*BoardMeta {
+	if x != nil {
+		return x.Boards
+	}
+	return nil
+}
+
+func (x *GetArchivedBoardListResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type RestoreBoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uint64 boardID = 1;
+	ExpectedVersion uint64 `protobuf:"varint,1,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
+}
+
+func (x *RestoreBoardRequest) Reset() {
+	*x = RestoreBoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBoardRequest) ProtoMessage() {}
+
+func (x *RestoreBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBoardRequest.ProtoReflect.Descriptor instead.
+func (*RestoreBoardRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RestoreBoardRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RestoreBoardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *DeleteBoardResponse) Reset() {
-	*x = DeleteBoardResponse{}
+func (x *RestoreBoardResponse) Reset() {
+	*x = RestoreBoardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBoardResponse) ProtoMessage() {}
+
+func (x *RestoreBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBoardResponse.ProtoReflect.Descriptor instead.
+func (*RestoreBoardResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestoreBoardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uint64 boardID = 1;
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+}
+
+func (x *AddLabelRequest) Reset() {
+	*x = AddLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_board_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2411,13 +2714,13 @@ func (x *DeleteBoardResponse) Reset() {
 	}
 }
 
-func (x *DeleteBoardResponse) String() string {
+func (x *AddLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBoardResponse) ProtoMessage() {}
+func (*AddLabelRequest) ProtoMessage() {}
 
-func (x *DeleteBoardResponse) ProtoReflect() protoreflect.Message {
+func (x *AddLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_board_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2429,28 +2732,35 @@ func (x *DeleteBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteBoardResponse.ProtoReflect.Descriptor instead.
-func (*DeleteBoardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddLabelRequest.ProtoReflect.Descriptor instead.
+func (*AddLabelRequest) Descriptor() ([]byte, []int) {
 	return file_board_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DeleteBoardResponse) GetMessage() string {
+func (x *AddLabelRequest) GetName() string {
 	if x != nil {
-		return x.Message
+		return x.Name
 	}
 	return ""
 }
 
-type GetBoardIDByListRequest struct {
+func (x *AddLabelRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type AddLabelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ListID uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+	Label *Label `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *GetBoardIDByListRequest) Reset() {
-	*x = GetBoardIDByListRequest{}
+func (x *AddLabelResponse) Reset() {
+	*x = AddLabelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_board_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2458,13 +2768,13 @@ func (x *GetBoardIDByListRequest) Reset() {
 	}
 }
 
-func (x *GetBoardIDByListRequest) String() string {
+func (x *AddLabelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardIDByListRequest) ProtoMessage() {}
+func (*AddLabelResponse) ProtoMessage() {}
 
-func (x *GetBoardIDByListRequest) ProtoReflect() protoreflect.Message {
+func (x *AddLabelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_board_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2476,28 +2786,29 @@ func (x *GetBoardIDByListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardIDByListRequest.ProtoReflect.Descriptor instead.
-func (*GetBoardIDByListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddLabelResponse.ProtoReflect.Descriptor instead.
+func (*AddLabelResponse) Descriptor() ([]byte, []int) {
 	return file_board_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetBoardIDByListRequest) GetListID() uint64 {
+func (x *AddLabelResponse) GetLabel() *Label {
 	if x != nil {
-		return x.ListID
+		return x.Label
 	}
-	return 0
+	return nil
 }
 
-type GetBoardIDByListResponse struct {
+type RemoveLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoardID uint64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	// uint64 boardID = 1;
+	LabelID uint64 `protobuf:"varint,1,opt,name=labelID,proto3" json:"labelID,omitempty"`
 }
 
-func (x *GetBoardIDByListResponse) Reset() {
-	*x = GetBoardIDByListResponse{}
+func (x *RemoveLabelRequest) Reset() {
+	*x = RemoveLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_board_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2505,13 +2816,13 @@ func (x *GetBoardIDByListResponse) Reset() {
 	}
 }
 
-func (x *GetBoardIDByListResponse) String() string {
+func (x *RemoveLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardIDByListResponse) ProtoMessage() {}
+func (*RemoveLabelRequest) ProtoMessage() {}
 
-func (x *GetBoardIDByListResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_board_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2523,28 +2834,28 @@ func (x *GetBoardIDByListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardIDByListResponse.ProtoReflect.Descriptor instead.
-func (*GetBoardIDByListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveLabelRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLabelRequest) Descriptor() ([]byte, []int) {
 	return file_board_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetBoardIDByListResponse) GetBoardID() uint64 {
+func (x *RemoveLabelRequest) GetLabelID() uint64 {
 	if x != nil {
-		return x.BoardID
+		return x.LabelID
 	}
 	return 0
 }
 
-type GetBoardIDByCardRequest struct {
+type RemoveLabelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *GetBoardIDByCardRequest) Reset() {
-	*x = GetBoardIDByCardRequest{}
+func (x *RemoveLabelResponse) Reset() {
+	*x = RemoveLabelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_board_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2552,13 +2863,13 @@ func (x *GetBoardIDByCardRequest) Reset() {
 	}
 }
 
-func (x *GetBoardIDByCardRequest) String() string {
+func (x *RemoveLabelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardIDByCardRequest) ProtoMessage() {}
+func (*RemoveLabelResponse) ProtoMessage() {}
 
-func (x *GetBoardIDByCardRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveLabelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_board_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2570,28 +2881,31 @@ func (x *GetBoardIDByCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardIDByCardRequest.ProtoReflect.Descriptor instead.
-func (*GetBoardIDByCardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveLabelResponse.ProtoReflect.Descriptor instead.
+func (*RemoveLabelResponse) Descriptor() ([]byte, []int) {
 	return file_board_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetBoardIDByCardRequest) GetCardID() uint64 {
+func (x *RemoveLabelResponse) GetMessage() string {
 	if x != nil {
-		return x.CardID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type GetBoardIDByCardResponse struct {
+type AddCustomFieldRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BoardID uint64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	// uint64 boardID = 1;
+	Name    string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type    string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Options []*CustomFieldOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *GetBoardIDByCardResponse) Reset() {
-	*x = GetBoardIDByCardResponse{}
+func (x *AddCustomFieldRequest) Reset() {
+	*x = AddCustomFieldRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_board_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2599,13 +2913,13 @@ func (x *GetBoardIDByCardResponse) Reset() {
 	}
 }
 
-func (x *GetBoardIDByCardResponse) String() string {
+func (x *AddCustomFieldRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBoardIDByCardResponse) ProtoMessage() {}
+func (*AddCustomFieldRequest) ProtoMessage() {}
 
-func (x *GetBoardIDByCardResponse) ProtoReflect() protoreflect.Message {
+func (x *AddCustomFieldRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_board_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2617,541 +2931,3519 @@ func (x *GetBoardIDByCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBoardIDByCardResponse.ProtoReflect.Descriptor instead.
-func (*GetBoardIDByCardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*AddCustomFieldRequest) Descriptor() ([]byte, []int) {
 	return file_board_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetBoardIDByCardResponse) GetBoardID() uint64 {
+func (x *AddCustomFieldRequest) GetName() string {
 	if x != nil {
-		return x.BoardID
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-var File_board_proto protoreflect.FileDescriptor
+func (x *AddCustomFieldRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
 
-var file_board_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x50, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0x89, 0x03, 0x0a,
-	0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
-	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x07,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x05,
-	0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74,
-	0x73, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x4d,
-	0x65, 0x74, 0x61, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x68, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x80, 0x04, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63,
-	0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72,
-	0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a,
-	0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
-	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x74,
-	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03,
-	0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69,
-	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a,
-	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8e, 0x04, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65,
-	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x74,
-	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39,
-	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65,
-	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x65, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
-	0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0xb4, 0x01,
-	0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61,
-	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e,
-	0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62,
-	0x6e, 0x61, 0x69, 0x6c, 0x22, 0xcf, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65,
-	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3b,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x47,
-	0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x3c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x22, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
-	0x69, 0x7a, 0x65, 0x22, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x52,
-	0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x22, 0x2c, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x33, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61,
-	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x41, 0x64,
-	0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a,
-	0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x73, 0x22, 0x34, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x1b, 0x41, 0x73, 0x73, 0x69,
-	0x67, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x38, 0x0a, 0x1c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x39, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x77,
-	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65,
-	0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x18, 0x43, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x3e, 0x0a, 0x1c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x56,
-	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x22, 0x39, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x56,
-	0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x71, 0x0a, 0x1b, 0x47,
-	0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x7f,
-	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
-	0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65,
-	0x74, 0x61, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x15, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3b, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22,
-	0x2e, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x22,
-	0x2f, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x15, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x30, 0x0a, 0x14, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x2f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c,
-	0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73,
-	0x74, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49,
-	0x44, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x43, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x49, 0x44, 0x32, 0xf3, 0x0b, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
-	0x0c, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x2e,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x24, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
-	0x1f, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f,
-	0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x63, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x62, 0x6f, 0x61, 0x72,
-	0x64, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x66, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x56, 0x69,
-	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x56, 0x69,
-	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x26, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64,
-	0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x4b, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12,
-	0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x42,
-	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
-	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61,
-	0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x61,
-	0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49,
-	0x44, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x43,
-	0x61, 0x72, 0x64, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x43, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x38, 0x38, 0x38, 0x73, 0x6d, 0x2f, 0x68,
-	0x61, 0x6c, 0x74, 0x65, 0x6e, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x62, 0x6f,
-	0x61, 0x72, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x62,
-	0x6f, 0x61, 0x72, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *AddCustomFieldRequest) GetOptions() []*CustomFieldOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
 }
 
-var (
-	file_board_proto_rawDescOnce sync.Once
-	file_board_proto_rawDescData = file_board_proto_rawDesc
-)
+type AddCustomFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_board_proto_rawDescGZIP() []byte {
-	file_board_proto_rawDescOnce.Do(func() {
-		file_board_proto_rawDescData = protoimpl.X.CompressGZIP(file_board_proto_rawDescData)
-	})
-	return file_board_proto_rawDescData
+	CustomField *CustomField `protobuf:"bytes,1,opt,name=custom_field,json=customField,proto3" json:"custom_field,omitempty"`
 }
 
-var file_board_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
-var file_board_proto_goTypes = []interface{}{
-	(*Pagination)(nil),                    // 0: boardpb.Pagination
-	(*Board)(nil),                         // 1: boardpb.Board
-	(*List)(nil),                          // 2: boardpb.List
-	(*Card)(nil),                          // 3: boardpb.Card
-	(*CardMeta)(nil),                      // 4: boardpb.CardMeta
-	(*Label)(nil),                         // 5: boardpb.Label
-	(*Attachment)(nil),                    // 6: boardpb.Attachment
-	(*BoardMeta)(nil),                     // 7: boardpb.BoardMeta
-	(*BoardMember)(nil),                   // 8: boardpb.BoardMember
-	(*CreateBoardRequest)(nil),            // 9: boardpb.CreateBoardRequest
-	(*CreateBoardResponse)(nil),           // 10: boardpb.CreateBoardResponse
-	(*GetBoardByIDRequest)(nil),           // 11: boardpb.GetBoardByIDRequest
-	(*GetBoardByIDResponse)(nil),          // 12: boardpb.GetBoardByIDResponse
-	(*GetBoardListRequest)(nil),           // 13: boardpb.GetBoardListRequest
-	(*GetBoardListResponse)(nil),          // 14: boardpb.GetBoardListResponse
-	(*GetBoardMembersRequest)(nil),        // 15: boardpb.GetBoardMembersRequest
-	(*GetBoardMembersResponse)(nil),       // 16: boardpb.GetBoardMembersResponse
-	(*UpdateBoardNameRequest)(nil),        // 17: boardpb.UpdateBoardNameRequest
-	(*UpdateBoardNameResponse)(nil),       // 18: boardpb.UpdateBoardNameResponse
-	(*AddBoardUsersRequest)(nil),          // 19: boardpb.AddBoardUsersRequest
-	(*AddBoardUsersResponse)(nil),         // 20: boardpb.AddBoardUsersResponse
-	(*RemoveBoardUsersRequest)(nil),       // 21: boardpb.RemoveBoardUsersRequest
-	(*RemoveBoardUsersResponse)(nil),      // 22: boardpb.RemoveBoardUsersResponse
-	(*AssignBoardUsersRoleRequest)(nil),   // 23: boardpb.AssignBoardUsersRoleRequest
-	(*AssignBoardUsersRoleResponse)(nil),  // 24: boardpb.AssignBoardUsersRoleResponse
-	(*ChangeBoardOwnerRequest)(nil),       // 25: boardpb.ChangeBoardOwnerRequest
-	(*ChangeBoardOwnerResponse)(nil),      // 26: boardpb.ChangeBoardOwnerResponse
-	(*ChangeBoardVisibilityRequest)(nil),  // 27: boardpb.ChangeBoardVisibilityRequest
-	(*ChangeBoardVisibilityResponse)(nil), // 28: boardpb.ChangeBoardVisibilityResponse
-	(*GetArchivedBoardListRequest)(nil),   // 29: boardpb.GetArchivedBoardListRequest
-	(*GetArchivedBoardListResponse)(nil),  // 30: boardpb.GetArchivedBoardListResponse
-	(*RestoreBoardRequest)(nil),           // 31: boardpb.RestoreBoardRequest
-	(*RestoreBoardResponse)(nil),          // 32: boardpb.RestoreBoardResponse
-	(*AddLabelRequest)(nil),               // 33: boardpb.AddLabelRequest
-	(*AddLabelResponse)(nil),              // 34: boardpb.AddLabelResponse
-	(*RemoveLabelRequest)(nil),            // 35: boardpb.RemoveLabelRequest
-	(*RemoveLabelResponse)(nil),           // 36: boardpb.RemoveLabelResponse
-	(*ArchiveBoardRequest)(nil),           // 37: boardpb.ArchiveBoardRequest
-	(*ArchiveBoardResponse)(nil),          // 38: boardpb.ArchiveBoardResponse
-	(*DeleteBoardRequest)(nil),            // 39: boardpb.DeleteBoardRequest
-	(*DeleteBoardResponse)(nil),           // 40: boardpb.DeleteBoardResponse
-	(*GetBoardIDByListRequest)(nil),       // 41: boardpb.GetBoardIDByListRequest
-	(*GetBoardIDByListResponse)(nil),      // 42: boardpb.GetBoardIDByListResponse
-	(*GetBoardIDByCardRequest)(nil),       // 43: boardpb.GetBoardIDByCardRequest
-	(*GetBoardIDByCardResponse)(nil),      // 44: boardpb.GetBoardIDByCardResponse
-	(*timestamppb.Timestamp)(nil),         // 45: google.protobuf.Timestamp
+func (x *AddCustomFieldResponse) Reset() {
+	*x = AddCustomFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_board_proto_depIdxs = []int32{
-	8,  // 0: boardpb.Board.members:type_name -> boardpb.BoardMember
-	2,  // 1: boardpb.Board.lists:type_name -> boardpb.List
-	4,  // 2: boardpb.Board.cards:type_name -> boardpb.CardMeta
-	5,  // 3: boardpb.Board.labels:type_name -> boardpb.Label
-	45, // 4: boardpb.Board.created_at:type_name -> google.protobuf.Timestamp
-	45, // 5: boardpb.Board.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 6: boardpb.Card.start_date:type_name -> google.protobuf.Timestamp
-	45, // 7: boardpb.Card.due_date:type_name -> google.protobuf.Timestamp
-	45, // 8: boardpb.Card.created_at:type_name -> google.protobuf.Timestamp
-	45, // 9: boardpb.Card.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 10: boardpb.CardMeta.start_date:type_name -> google.protobuf.Timestamp
-	45, // 11: boardpb.CardMeta.due_date:type_name -> google.protobuf.Timestamp
-	45, // 12: boardpb.CardMeta.created_at:type_name -> google.protobuf.Timestamp
-	45, // 13: boardpb.CardMeta.updated_at:type_name -> google.protobuf.Timestamp
-	45, // 14: boardpb.BoardMeta.created_at:type_name -> google.protobuf.Timestamp
-	45, // 15: boardpb.BoardMeta.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 16: boardpb.CreateBoardResponse.board:type_name -> boardpb.Board
-	1,  // 17: boardpb.GetBoardByIDResponse.board:type_name -> boardpb.Board
-	7,  // 18: boardpb.GetBoardListResponse.boards:type_name -> boardpb.BoardMeta
-	0,  // 19: boardpb.GetBoardListResponse.pagination:type_name -> boardpb.Pagination
-	8,  // 20: boardpb.GetBoardMembersResponse.members:type_name -> boardpb.BoardMember
-	7,  // 21: boardpb.GetArchivedBoardListResponse.boards:type_name -> boardpb.BoardMeta
-	0,  // 22: boardpb.GetArchivedBoardListResponse.pagination:type_name -> boardpb.Pagination
-	5,  // 23: boardpb.AddLabelResponse.label:type_name -> boardpb.Label
-	9,  // 24: boardpb.BoardService.CreateBoard:input_type -> boardpb.CreateBoardRequest
-	11, // 25: boardpb.BoardService.GetBoardByID:input_type -> boardpb.GetBoardByIDRequest
-	13, // 26: boardpb.BoardService.GetBoardList:input_type -> boardpb.GetBoardListRequest
-	29, // 27: boardpb.BoardService.GetArchivedBoardList:input_type -> boardpb.GetArchivedBoardListRequest
-	15, // 28: boardpb.BoardService.GetBoardMembers:input_type -> boardpb.GetBoardMembersRequest
-	17, // 29: boardpb.BoardService.UpdateBoardName:input_type -> boardpb.UpdateBoardNameRequest
-	19, // 30: boardpb.BoardService.AddBoardUsers:input_type -> boardpb.AddBoardUsersRequest
-	21, // 31: boardpb.BoardService.RemoveBoardUsers:input_type -> boardpb.RemoveBoardUsersRequest
-	23, // 32: boardpb.BoardService.AssignBoardUsersRole:input_type -> boardpb.AssignBoardUsersRoleRequest
-	25, // 33: boardpb.BoardService.ChangeBoardOwner:input_type -> boardpb.ChangeBoardOwnerRequest
-	27, // 34: boardpb.BoardService.ChangeBoardVisibility:input_type -> boardpb.ChangeBoardVisibilityRequest
-	33, // 35: boardpb.BoardService.AddLabel:input_type -> boardpb.AddLabelRequest
-	35, // 36: boardpb.BoardService.RemoveLabel:input_type -> boardpb.RemoveLabelRequest
-	31, // 37: boardpb.BoardService.RestoreBoard:input_type -> boardpb.RestoreBoardRequest
-	37, // 38: boardpb.BoardService.ArchiveBoard:input_type -> boardpb.ArchiveBoardRequest
-	39, // 39: boardpb.BoardService.DeleteBoard:input_type -> boardpb.DeleteBoardRequest
-	41, // 40: boardpb.BoardService.GetBoardIDByList:input_type -> boardpb.GetBoardIDByListRequest
-	43, // 41: boardpb.BoardService.GetBoardIDByCard:input_type -> boardpb.GetBoardIDByCardRequest
-	10, // 42: boardpb.BoardService.CreateBoard:output_type -> boardpb.CreateBoardResponse
-	12, // 43: boardpb.BoardService.GetBoardByID:output_type -> boardpb.GetBoardByIDResponse
-	14, // 44: boardpb.BoardService.GetBoardList:output_type -> boardpb.GetBoardListResponse
-	30, // 45: boardpb.BoardService.GetArchivedBoardList:output_type -> boardpb.GetArchivedBoardListResponse
-	16, // 46: boardpb.BoardService.GetBoardMembers:output_type -> boardpb.GetBoardMembersResponse
-	18, // 47: boardpb.BoardService.UpdateBoardName:output_type -> boardpb.UpdateBoardNameResponse
-	20, // 48: boardpb.BoardService.AddBoardUsers:output_type -> boardpb.AddBoardUsersResponse
-	22, // 49: boardpb.BoardService.RemoveBoardUsers:output_type -> boardpb.RemoveBoardUsersResponse
-	24, // 50: boardpb.BoardService.AssignBoardUsersRole:output_type -> boardpb.AssignBoardUsersRoleResponse
-	26, // 51: boardpb.BoardService.ChangeBoardOwner:output_type -> boardpb.ChangeBoardOwnerResponse
-	28, // 52: boardpb.BoardService.ChangeBoardVisibility:output_type -> boardpb.ChangeBoardVisibilityResponse
-	34, // 53: boardpb.BoardService.AddLabel:output_type -> boardpb.AddLabelResponse
-	36, // 54: boardpb.BoardService.RemoveLabel:output_type -> boardpb.RemoveLabelResponse
-	32, // 55: boardpb.BoardService.RestoreBoard:output_type -> boardpb.RestoreBoardResponse
-	38, // 56: boardpb.BoardService.ArchiveBoard:output_type -> boardpb.ArchiveBoardResponse
-	40, // 57: boardpb.BoardService.DeleteBoard:output_type -> boardpb.DeleteBoardResponse
-	42, // 58: boardpb.BoardService.GetBoardIDByList:output_type -> boardpb.GetBoardIDByListResponse
-	44, // 59: boardpb.BoardService.GetBoardIDByCard:output_type -> boardpb.GetBoardIDByCardResponse
-	42, // [42:60] is the sub-list for method output_type
-	24, // [24:42] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+
+func (x *AddCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_board_proto_init() }
-func file_board_proto_init() {
-	if File_board_proto != nil {
-		return
+func (*AddCustomFieldResponse) ProtoMessage() {}
+
+func (x *AddCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_board_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pagination); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*AddCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AddCustomFieldResponse) GetCustomField() *CustomField {
+	if x != nil {
+		return x.CustomField
+	}
+	return nil
+}
+
+type UpdateCustomFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uint64 boardID = 1;
+	CustomFieldID uint64 `protobuf:"varint,1,opt,name=customFieldID,proto3" json:"customFieldID,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Options without an optionID are added, missing ones are removed
+	Options []*CustomFieldOption `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *UpdateCustomFieldRequest) Reset() {
+	*x = UpdateCustomFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldRequest) ProtoMessage() {}
+
+func (x *UpdateCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateCustomFieldRequest) GetCustomFieldID() uint64 {
+	if x != nil {
+		return x.CustomFieldID
+	}
+	return 0
+}
+
+func (x *UpdateCustomFieldRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCustomFieldRequest) GetOptions() []*CustomFieldOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type UpdateCustomFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomField *CustomField `protobuf:"bytes,1,opt,name=custom_field,json=customField,proto3" json:"custom_field,omitempty"`
+}
+
+func (x *UpdateCustomFieldResponse) Reset() {
+	*x = UpdateCustomFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomFieldResponse) ProtoMessage() {}
+
+func (x *UpdateCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateCustomFieldResponse) GetCustomField() *CustomField {
+	if x != nil {
+		return x.CustomField
+	}
+	return nil
+}
+
+type RemoveCustomFieldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uint64 boardID = 1;
+	CustomFieldID uint64 `protobuf:"varint,1,opt,name=customFieldID,proto3" json:"customFieldID,omitempty"`
+}
+
+func (x *RemoveCustomFieldRequest) Reset() {
+	*x = RemoveCustomFieldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCustomFieldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCustomFieldRequest) ProtoMessage() {}
+
+func (x *RemoveCustomFieldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCustomFieldRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCustomFieldRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RemoveCustomFieldRequest) GetCustomFieldID() uint64 {
+	if x != nil {
+		return x.CustomFieldID
+	}
+	return 0
+}
+
+type RemoveCustomFieldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RemoveCustomFieldResponse) Reset() {
+	*x = RemoveCustomFieldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCustomFieldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCustomFieldResponse) ProtoMessage() {}
+
+func (x *RemoveCustomFieldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCustomFieldResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCustomFieldResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RemoveCustomFieldResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ArchiveBoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uint64 boardID = 1;
+	ExpectedVersion uint64 `protobuf:"varint,1,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
+}
+
+func (x *ArchiveBoardRequest) Reset() {
+	*x = ArchiveBoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveBoardRequest) ProtoMessage() {}
+
+func (x *ArchiveBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveBoardRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveBoardRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ArchiveBoardRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ArchiveBoardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ArchiveBoardResponse) Reset() {
+	*x = ArchiveBoardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveBoardResponse) ProtoMessage() {}
+
+func (x *ArchiveBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveBoardResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveBoardResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ArchiveBoardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteBoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBoardRequest) Reset() {
+	*x = DeleteBoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardRequest) ProtoMessage() {}
+
+func (x *DeleteBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBoardRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{52}
+}
+
+type DeleteBoardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteBoardResponse) Reset() {
+	*x = DeleteBoardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBoardResponse) ProtoMessage() {}
+
+func (x *DeleteBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBoardResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBoardResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteBoardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetBoardIDByListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListID uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+}
+
+func (x *GetBoardIDByListRequest) Reset() {
+	*x = GetBoardIDByListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBoardIDByListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardIDByListRequest) ProtoMessage() {}
+
+func (x *GetBoardIDByListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardIDByListRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardIDByListRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetBoardIDByListRequest) GetListID() uint64 {
+	if x != nil {
+		return x.ListID
+	}
+	return 0
+}
+
+type GetBoardIDByListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardID uint64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+}
+
+func (x *GetBoardIDByListResponse) Reset() {
+	*x = GetBoardIDByListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBoardIDByListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardIDByListResponse) ProtoMessage() {}
+
+func (x *GetBoardIDByListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardIDByListResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardIDByListResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetBoardIDByListResponse) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+type GetBoardIDByCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+}
+
+func (x *GetBoardIDByCardRequest) Reset() {
+	*x = GetBoardIDByCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBoardIDByCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardIDByCardRequest) ProtoMessage() {}
+
+func (x *GetBoardIDByCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardIDByCardRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardIDByCardRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetBoardIDByCardRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+type GetBoardIDByCardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardID uint64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+}
+
+func (x *GetBoardIDByCardResponse) Reset() {
+	*x = GetBoardIDByCardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBoardIDByCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardIDByCardResponse) ProtoMessage() {}
+
+func (x *GetBoardIDByCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardIDByCardResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardIDByCardResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetBoardIDByCardResponse) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+type TrashItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type           string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // board, list or card
+	Id             uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	BoardID        uint64                 `protobuf:"varint,3,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	Name           string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	DeletedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`                      // When the item is deleted for good
+	DeletionStatus string                 `protobuf:"bytes,7,opt,name=deletion_status,json=deletionStatus,proto3" json:"deletion_status,omitempty"` // deleting or failed once a board is being deleted for good
+	DeletionError  string                 `protobuf:"bytes,8,opt,name=deletion_error,json=deletionError,proto3" json:"deletion_error,omitempty"`    // Why the deletion failed
+}
+
+func (x *TrashItem) Reset() {
+	*x = TrashItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrashItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashItem) ProtoMessage() {}
+
+func (x *TrashItem) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashItem.ProtoReflect.Descriptor instead.
+func (*TrashItem) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TrashItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TrashItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrashItem) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *TrashItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *TrashItem) GetPurgeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return nil
+}
+
+func (x *TrashItem) GetDeletionStatus() string {
+	if x != nil {
+		return x.DeletionStatus
+	}
+	return ""
+}
+
+func (x *TrashItem) GetDeletionError() string {
+	if x != nil {
+		return x.DeletionError
+	}
+	return ""
+}
+
+type GetTrashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageNumber uint64 `protobuf:"varint,1,opt,name=pageNumber,proto3" json:"pageNumber,omitempty"`
+	PageSize   uint64 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+}
+
+func (x *GetTrashRequest) Reset() {
+	*x = GetTrashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashRequest) ProtoMessage() {}
+
+func (x *GetTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashRequest.ProtoReflect.Descriptor instead.
+func (*GetTrashRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetTrashRequest) GetPageNumber() uint64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *GetTrashRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetTrashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items      []*TrashItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Pagination *Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetTrashResponse) Reset() {
+	*x = GetTrashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrashResponse) ProtoMessage() {}
+
+func (x *GetTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrashResponse.ProtoReflect.Descriptor instead.
+func (*GetTrashResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetTrashResponse) GetItems() []*TrashItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetTrashResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type RestoreTrashItemRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uint64 boardID = 1;
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // board, list or card
+	Id   uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreTrashItemRequest) Reset() {
+	*x = RestoreTrashItemRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreTrashItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTrashItemRequest) ProtoMessage() {}
+
+func (x *RestoreTrashItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTrashItemRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTrashItemRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RestoreTrashItemRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RestoreTrashItemRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreTrashItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RestoreTrashItemResponse) Reset() {
+	*x = RestoreTrashItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreTrashItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTrashItemResponse) ProtoMessage() {}
+
+func (x *RestoreTrashItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTrashItemResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTrashItemResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RestoreTrashItemResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type UndoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UndoRequest) Reset() {
+	*x = UndoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoRequest) ProtoMessage() {}
+
+func (x *UndoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoRequest.ProtoReflect.Descriptor instead.
+func (*UndoRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{63}
+}
+
+type UndoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message    string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	BoardID    uint64 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	EntityType string `protobuf:"bytes,3,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // board, list or card
+	EntityID   uint64 `protobuf:"varint,4,opt,name=entityID,proto3" json:"entityID,omitempty"`
+	Action     string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"` // The action that was undone: archive, restore, move or delete
+}
+
+func (x *UndoResponse) Reset() {
+	*x = UndoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndoResponse) ProtoMessage() {}
+
+func (x *UndoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndoResponse.ProtoReflect.Descriptor instead.
+func (*UndoResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UndoResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UndoResponse) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *UndoResponse) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *UndoResponse) GetEntityID() uint64 {
+	if x != nil {
+		return x.EntityID
+	}
+	return 0
+}
+
+func (x *UndoResponse) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type BoardExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportID    uint64                 `protobuf:"varint,1,opt,name=exportID,proto3" json:"exportID,omitempty"`
+	BoardID     uint64                 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	Format      string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`      // json, csv or markdown
+	Status      string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`      // pending, running, completed or failed
+	Progress    int32                  `protobuf:"varint,5,opt,name=progress,proto3" json:"progress,omitempty"` // Percentage
+	FileName    string                 `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Error       string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *BoardExport) Reset() {
+	*x = BoardExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardExport) ProtoMessage() {}
+
+func (x *BoardExport) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardExport.ProtoReflect.Descriptor instead.
+func (*BoardExport) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *BoardExport) GetExportID() uint64 {
+	if x != nil {
+		return x.ExportID
+	}
+	return 0
+}
+
+func (x *BoardExport) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *BoardExport) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *BoardExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BoardExport) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *BoardExport) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *BoardExport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BoardExport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BoardExport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type ExportBoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uint64 boardID = 1;
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // json, csv or markdown
+}
+
+func (x *ExportBoardRequest) Reset() {
+	*x = ExportBoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBoardRequest) ProtoMessage() {}
+
+func (x *ExportBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBoardRequest.ProtoReflect.Descriptor instead.
+func (*ExportBoardRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExportBoardRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportBoardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Export  *BoardExport `protobuf:"bytes,2,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *ExportBoardResponse) Reset() {
+	*x = ExportBoardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBoardResponse) ProtoMessage() {}
+
+func (x *ExportBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBoardResponse.ProtoReflect.Descriptor instead.
+func (*ExportBoardResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExportBoardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportBoardResponse) GetExport() *BoardExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetBoardExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uint64 boardID = 1;
+	ExportID uint64 `protobuf:"varint,1,opt,name=exportID,proto3" json:"exportID,omitempty"`
+}
+
+func (x *GetBoardExportRequest) Reset() {
+	*x = GetBoardExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBoardExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardExportRequest) ProtoMessage() {}
+
+func (x *GetBoardExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardExportRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardExportRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetBoardExportRequest) GetExportID() uint64 {
+	if x != nil {
+		return x.ExportID
+	}
+	return 0
+}
+
+type GetBoardExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Export *BoardExport `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+}
+
+func (x *GetBoardExportResponse) Reset() {
+	*x = GetBoardExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBoardExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardExportResponse) ProtoMessage() {}
+
+func (x *GetBoardExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardExportResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardExportResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetBoardExportResponse) GetExport() *BoardExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type DownloadBoardExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uint64 boardID = 1;
+	ExportID uint64 `protobuf:"varint,1,opt,name=exportID,proto3" json:"exportID,omitempty"`
+}
+
+func (x *DownloadBoardExportRequest) Reset() {
+	*x = DownloadBoardExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadBoardExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBoardExportRequest) ProtoMessage() {}
+
+func (x *DownloadBoardExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBoardExportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadBoardExportRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DownloadBoardExportRequest) GetExportID() uint64 {
+	if x != nil {
+		return x.ExportID
+	}
+	return 0
+}
+
+// The first message carries the file name and content type, every message carries a chunk of the
+// file
+type DownloadBoardExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileName    string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Chunk       []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *DownloadBoardExportResponse) Reset() {
+	*x = DownloadBoardExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadBoardExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBoardExportResponse) ProtoMessage() {}
+
+func (x *DownloadBoardExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadBoardExportResponse.ProtoReflect.Descriptor instead.
+func (*DownloadBoardExportResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DownloadBoardExportResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DownloadBoardExportResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DownloadBoardExportResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Published as board.export, picked up by the export worker
+type BoardExportRequestedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportID uint64 `protobuf:"varint,1,opt,name=exportID,proto3" json:"exportID,omitempty"`
+	BoardID  uint64 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+}
+
+func (x *BoardExportRequestedEvent) Reset() {
+	*x = BoardExportRequestedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardExportRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardExportRequestedEvent) ProtoMessage() {}
+
+func (x *BoardExportRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardExportRequestedEvent.ProtoReflect.Descriptor instead.
+func (*BoardExportRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BoardExportRequestedEvent) GetExportID() uint64 {
+	if x != nil {
+		return x.ExportID
+	}
+	return 0
+}
+
+func (x *BoardExportRequestedEvent) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+type ImportReportItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`         // member, card, comment, attachment...
+	SourceID string `protobuf:"bytes,2,opt,name=sourceID,proto3" json:"sourceID,omitempty"` // ID in the tool the board is imported from
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ImportReportItem) Reset() {
+	*x = ImportReportItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportReportItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReportItem) ProtoMessage() {}
+
+func (x *ImportReportItem) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReportItem.ProtoReflect.Descriptor instead.
+func (*ImportReportItem) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ImportReportItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ImportReportItem) GetSourceID() string {
+	if x != nil {
+		return x.SourceID
+	}
+	return ""
+}
+
+func (x *ImportReportItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportReportItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ImportReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lists      int32               `protobuf:"varint,1,opt,name=lists,proto3" json:"lists,omitempty"`
+	Labels     int32               `protobuf:"varint,2,opt,name=labels,proto3" json:"labels,omitempty"`
+	Cards      int32               `protobuf:"varint,3,opt,name=cards,proto3" json:"cards,omitempty"`
+	Checklists int32               `protobuf:"varint,4,opt,name=checklists,proto3" json:"checklists,omitempty"`
+	Comments   int32               `protobuf:"varint,5,opt,name=comments,proto3" json:"comments,omitempty"`
+	Members    int32               `protobuf:"varint,6,opt,name=members,proto3" json:"members,omitempty"`
+	Skipped    []*ImportReportItem `protobuf:"bytes,7,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	Truncated  []*ImportReportItem `protobuf:"bytes,8,rep,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReport) ProtoMessage() {}
+
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ImportReport) GetLists() int32 {
+	if x != nil {
+		return x.Lists
+	}
+	return 0
+}
+
+func (x *ImportReport) GetLabels() int32 {
+	if x != nil {
+		return x.Labels
+	}
+	return 0
+}
+
+func (x *ImportReport) GetCards() int32 {
+	if x != nil {
+		return x.Cards
+	}
+	return 0
+}
+
+func (x *ImportReport) GetChecklists() int32 {
+	if x != nil {
+		return x.Checklists
+	}
+	return 0
+}
+
+func (x *ImportReport) GetComments() int32 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *ImportReport) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *ImportReport) GetSkipped() []*ImportReportItem {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *ImportReport) GetTruncated() []*ImportReportItem {
+	if x != nil {
+		return x.Truncated
+	}
+	return nil
+}
+
+type BoardImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportID    uint64                 `protobuf:"varint,1,opt,name=importID,proto3" json:"importID,omitempty"`
+	Source      string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"` // trello
+	DryRun      bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Status      string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`    // pending, running, completed or failed
+	BoardID     uint64                 `protobuf:"varint,5,opt,name=boardID,proto3" json:"boardID,omitempty"` // The created board, never set for a dry run
+	Report      *ImportReport          `protobuf:"bytes,6,opt,name=report,proto3" json:"report,omitempty"`    // Set once completed
+	Error       string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+}
+
+func (x *BoardImport) Reset() {
+	*x = BoardImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardImport) ProtoMessage() {}
+
+func (x *BoardImport) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardImport.ProtoReflect.Descriptor instead.
+func (*BoardImport) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *BoardImport) GetImportID() uint64 {
+	if x != nil {
+		return x.ImportID
+	}
+	return 0
+}
+
+func (x *BoardImport) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *BoardImport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BoardImport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BoardImport) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *BoardImport) GetReport() *ImportReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *BoardImport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BoardImport) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *BoardImport) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type ImportBoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source  string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`                // trello
+	DryRun  bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Only report what would be imported
+	Content []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`              // The exported board
+}
+
+func (x *ImportBoardRequest) Reset() {
+	*x = ImportBoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBoardRequest) ProtoMessage() {}
+
+func (x *ImportBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBoardRequest.ProtoReflect.Descriptor instead.
+func (*ImportBoardRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ImportBoardRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ImportBoardRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportBoardRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ImportBoardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string       `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Import  *BoardImport `protobuf:"bytes,2,opt,name=import,proto3" json:"import,omitempty"`
+}
+
+func (x *ImportBoardResponse) Reset() {
+	*x = ImportBoardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportBoardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportBoardResponse) ProtoMessage() {}
+
+func (x *ImportBoardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportBoardResponse.ProtoReflect.Descriptor instead.
+func (*ImportBoardResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ImportBoardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportBoardResponse) GetImport() *BoardImport {
+	if x != nil {
+		return x.Import
+	}
+	return nil
+}
+
+type GetBoardImportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportID uint64 `protobuf:"varint,1,opt,name=importID,proto3" json:"importID,omitempty"`
+}
+
+func (x *GetBoardImportRequest) Reset() {
+	*x = GetBoardImportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBoardImportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardImportRequest) ProtoMessage() {}
+
+func (x *GetBoardImportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardImportRequest.ProtoReflect.Descriptor instead.
+func (*GetBoardImportRequest) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetBoardImportRequest) GetImportID() uint64 {
+	if x != nil {
+		return x.ImportID
+	}
+	return 0
+}
+
+type GetBoardImportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Import *BoardImport `protobuf:"bytes,1,opt,name=import,proto3" json:"import,omitempty"`
+}
+
+func (x *GetBoardImportResponse) Reset() {
+	*x = GetBoardImportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBoardImportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBoardImportResponse) ProtoMessage() {}
+
+func (x *GetBoardImportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBoardImportResponse.ProtoReflect.Descriptor instead.
+func (*GetBoardImportResponse) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetBoardImportResponse) GetImport() *BoardImport {
+	if x != nil {
+		return x.Import
+	}
+	return nil
+}
+
+// Published as board.import, picked up by the import worker
+type BoardImportRequestedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportID uint64 `protobuf:"varint,1,opt,name=importID,proto3" json:"importID,omitempty"`
+}
+
+func (x *BoardImportRequestedEvent) Reset() {
+	*x = BoardImportRequestedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardImportRequestedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardImportRequestedEvent) ProtoMessage() {}
+
+func (x *BoardImportRequestedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardImportRequestedEvent.ProtoReflect.Descriptor instead.
+func (*BoardImportRequestedEvent) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *BoardImportRequestedEvent) GetImportID() uint64 {
+	if x != nil {
+		return x.ImportID
+	}
+	return 0
+}
+
+// Published as board.deleted once a board leaves the trash for good, asking card-service to remove
+// its cards
+type BoardDeletedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardID uint64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+}
+
+func (x *BoardDeletedEvent) Reset() {
+	*x = BoardDeletedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardDeletedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardDeletedEvent) ProtoMessage() {}
+
+func (x *BoardDeletedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardDeletedEvent.ProtoReflect.Descriptor instead.
+func (*BoardDeletedEvent) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BoardDeletedEvent) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+// Published as board.lists_cleanup_requested to ask a service to remove its part of a board deleted
+// for good, and as board.cleanup_completed by the service once it did
+type BoardCleanupEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardID uint64 `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	Step    string `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"` // cards or lists
+}
+
+func (x *BoardCleanupEvent) Reset() {
+	*x = BoardCleanupEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_board_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardCleanupEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardCleanupEvent) ProtoMessage() {}
+
+func (x *BoardCleanupEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_board_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardCleanupEvent.ProtoReflect.Descriptor instead.
+func (*BoardCleanupEvent) Descriptor() ([]byte, []int) {
+	return file_board_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BoardCleanupEvent) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *BoardCleanupEvent) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+var File_board_proto protoreflect.FileDescriptor
+
+var file_board_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x50, 0x65, 0x72, 0x50, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f, 0x72, 0x65, 0x22, 0xe2, 0x04, 0x0a,
+	0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x05,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74,
+	0x73, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a,
+	0x12, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x61, 0x72, 0x64, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63,
+	0x61, 0x72, 0x64, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x63, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x44, 0x61, 0x79, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x70, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x77, 0x69, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x22, 0xe4, 0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x70, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x69, 0x70, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x70, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72,
+	0x57, 0x69, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9a, 0x04, 0x0a, 0x04, 0x43, 0x61, 0x72,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf3, 0x04, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x28,
+	0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x49, 0x0a, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x05, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x22, 0x5b, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22,
+	0xc7, 0x01, 0x0a, 0x0b, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x02, 0x0a, 0x10, 0x43, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24,
+	0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x65, 0x78, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x27, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x62, 0x6f, 0x78, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x62, 0x6f, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1c, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x07,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb4, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x22, 0xe9,
+	0x01, 0x0a, 0x09, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x75, 0x6c, 0x6c,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x3b, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x22,
+	0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
+	0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x05, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x22, 0x51, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x57, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x33,
+	0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x31, 0x0a, 0x15, 0x41, 0x64, 0x64,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x33, 0x0a, 0x17,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x22, 0x34, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4b, 0x0a, 0x1b, 0x41, 0x73, 0x73, 0x69, 0x67,
+	0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x22, 0x38, 0x0a, 0x1c, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x39,
+	0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x77,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e,
+	0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x18, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x69, 0x0a, 0x1c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x56, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x1d, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa5, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x61, 0x72, 0x64,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x63, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3b, 0x0a,
+	0x1f, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6f, 0x0a, 0x18, 0x53, 0x65,
+	0x74, 0x57, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x69, 0x70, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x77, 0x69, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x19, 0x53,
+	0x65, 0x74, 0x57, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x71, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x7f, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x06, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x40, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3b, 0x0a, 0x0f, 0x41, 0x64,
+	0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x38, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x22, 0x2e, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49,
+	0x44, 0x22, 0x2f, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x75, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x16, 0x41, 0x64, 0x64,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x8a, 0x01, 0x0a,
+	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x54, 0x0a, 0x19, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22,
+	0x40, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49,
+	0x44, 0x22, 0x35, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x40, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x14, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x14, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x31, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x43, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22,
+	0x34, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x43,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x9f, 0x02, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x35, 0x0a, 0x08, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x41, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4d, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x71, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x17, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x0d,
+	0x0a, 0x0b, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x97, 0x01,
+	0x0a, 0x0c, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbc, 0x02, 0x0a, 0x0b, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x22, 0x5d, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x22, 0x38, 0x0a, 0x1a, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x22, 0x73, 0x0a, 0x1b, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22,
+	0x51, 0x0a, 0x19, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x22, 0x6e, 0x0a, 0x10, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x22, 0x96, 0x02, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0xcb, 0x02, 0x0a, 0x0b,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5f, 0x0a, 0x12, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x13, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x69,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x06, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x33, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x22, 0x46,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x37, 0x0a, 0x19, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x22,
+	0x2d, 0x0a, 0x11, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x41,
+	0x0a, 0x11, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x65,
+	0x70, 0x32, 0xb5, 0x14, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x2e, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x42,
+	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1f,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x63, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66,
+	0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x56, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x56, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x27, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x57, 0x49, 0x50, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x57, 0x49, 0x50, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3f, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18, 0x2e, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x41, 0x64, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x41,
+	0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x21, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x21, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x1b, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x42, 0x79, 0x43, 0x61, 0x72, 0x64, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x42, 0x79,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a,
+	0x10, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x72, 0x61, 0x73, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x14,
+	0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x64, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55,
+	0x6e, 0x64, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0b, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x23, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0b,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x38, 0x38, 0x38, 0x73, 0x6d, 0x2f,
+	0x68, 0x61, 0x6c, 0x74, 0x65, 0x6e, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x2f,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_board_proto_rawDescOnce sync.Once
+	file_board_proto_rawDescData = file_board_proto_rawDesc
+)
+
+func file_board_proto_rawDescGZIP() []byte {
+	file_board_proto_rawDescOnce.Do(func() {
+		file_board_proto_rawDescData = protoimpl.X.CompressGZIP(file_board_proto_rawDescData)
+	})
+	return file_board_proto_rawDescData
+}
+
+var file_board_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
+var file_board_proto_goTypes = []interface{}{
+	(*Pagination)(nil),                      // 0: boardpb.Pagination
+	(*Board)(nil),                           // 1: boardpb.Board
+	(*List)(nil),                            // 2: boardpb.List
+	(*Card)(nil),                            // 3: boardpb.Card
+	(*CardMeta)(nil),                        // 4: boardpb.CardMeta
+	(*Label)(nil),                           // 5: boardpb.Label
+	(*CustomFieldOption)(nil),               // 6: boardpb.CustomFieldOption
+	(*CustomField)(nil),                     // 7: boardpb.CustomField
+	(*CustomFieldValue)(nil),                // 8: boardpb.CustomFieldValue
+	(*Attachment)(nil),                      // 9: boardpb.Attachment
+	(*BoardMeta)(nil),                       // 10: boardpb.BoardMeta
+	(*BoardMember)(nil),                     // 11: boardpb.BoardMember
+	(*CreateBoardRequest)(nil),              // 12: boardpb.CreateBoardRequest
+	(*CreateBoardResponse)(nil),             // 13: boardpb.CreateBoardResponse
+	(*GetBoardByIDRequest)(nil),             // 14: boardpb.GetBoardByIDRequest
+	(*GetBoardByIDResponse)(nil),            // 15: boardpb.GetBoardByIDResponse
+	(*GetBoardListRequest)(nil),             // 16: boardpb.GetBoardListRequest
+	(*GetBoardListResponse)(nil),            // 17: boardpb.GetBoardListResponse
+	(*GetBoardMembersRequest)(nil),          // 18: boardpb.GetBoardMembersRequest
+	(*GetBoardMembersResponse)(nil),         // 19: boardpb.GetBoardMembersResponse
+	(*UpdateBoardNameRequest)(nil),          // 20: boardpb.UpdateBoardNameRequest
+	(*UpdateBoardNameResponse)(nil),         // 21: boardpb.UpdateBoardNameResponse
+	(*AddBoardUsersRequest)(nil),            // 22: boardpb.AddBoardUsersRequest
+	(*AddBoardUsersResponse)(nil),           // 23: boardpb.AddBoardUsersResponse
+	(*RemoveBoardUsersRequest)(nil),         // 24: boardpb.RemoveBoardUsersRequest
+	(*RemoveBoardUsersResponse)(nil),        // 25: boardpb.RemoveBoardUsersResponse
+	(*AssignBoardUsersRoleRequest)(nil),     // 26: boardpb.AssignBoardUsersRoleRequest
+	(*AssignBoardUsersRoleResponse)(nil),    // 27: boardpb.AssignBoardUsersRoleResponse
+	(*ChangeBoardOwnerRequest)(nil),         // 28: boardpb.ChangeBoardOwnerRequest
+	(*ChangeBoardOwnerResponse)(nil),        // 29: boardpb.ChangeBoardOwnerResponse
+	(*ChangeBoardVisibilityRequest)(nil),    // 30: boardpb.ChangeBoardVisibilityRequest
+	(*ChangeBoardVisibilityResponse)(nil),   // 31: boardpb.ChangeBoardVisibilityResponse
+	(*SetCardHistoryRetentionRequest)(nil),  // 32: boardpb.SetCardHistoryRetentionRequest
+	(*SetCardHistoryRetentionResponse)(nil), // 33: boardpb.SetCardHistoryRetentionResponse
+	(*SetWIPLimitPolicyRequest)(nil),        // 34: boardpb.SetWIPLimitPolicyRequest
+	(*SetWIPLimitPolicyResponse)(nil),       // 35: boardpb.SetWIPLimitPolicyResponse
+	(*GetArchivedBoardListRequest)(nil),     // 36: boardpb.GetArchivedBoardListRequest
+	(*GetArchivedBoardListResponse)(nil),    // 37: boardpb.GetArchivedBoardListResponse
+	(*RestoreBoardRequest)(nil),             // 38: boardpb.RestoreBoardRequest
+	(*RestoreBoardResponse)(nil),            // 39: boardpb.RestoreBoardResponse
+	(*AddLabelRequest)(nil),                 // 40: boardpb.AddLabelRequest
+	(*AddLabelResponse)(nil),                // 41: boardpb.AddLabelResponse
+	(*RemoveLabelRequest)(nil),              // 42: boardpb.RemoveLabelRequest
+	(*RemoveLabelResponse)(nil),             // 43: boardpb.RemoveLabelResponse
+	(*AddCustomFieldRequest)(nil),           // 44: boardpb.AddCustomFieldRequest
+	(*AddCustomFieldResponse)(nil),          // 45: boardpb.AddCustomFieldResponse
+	(*UpdateCustomFieldRequest)(nil),        // 46: boardpb.UpdateCustomFieldRequest
+	(*UpdateCustomFieldResponse)(nil),       // 47: boardpb.UpdateCustomFieldResponse
+	(*RemoveCustomFieldRequest)(nil),        // 48: boardpb.RemoveCustomFieldRequest
+	(*RemoveCustomFieldResponse)(nil),       // 49: boardpb.RemoveCustomFieldResponse
+	(*ArchiveBoardRequest)(nil),             // 50: boardpb.ArchiveBoardRequest
+	(*ArchiveBoardResponse)(nil),            // 51: boardpb.ArchiveBoardResponse
+	(*DeleteBoardRequest)(nil),              // 52: boardpb.DeleteBoardRequest
+	(*DeleteBoardResponse)(nil),             // 53: boardpb.DeleteBoardResponse
+	(*GetBoardIDByListRequest)(nil),         // 54: boardpb.GetBoardIDByListRequest
+	(*GetBoardIDByListResponse)(nil),        // 55: boardpb.GetBoardIDByListResponse
+	(*GetBoardIDByCardRequest)(nil),         // 56: boardpb.GetBoardIDByCardRequest
+	(*GetBoardIDByCardResponse)(nil),        // 57: boardpb.GetBoardIDByCardResponse
+	(*TrashItem)(nil),                       // 58: boardpb.TrashItem
+	(*GetTrashRequest)(nil),                 // 59: boardpb.GetTrashRequest
+	(*GetTrashResponse)(nil),                // 60: boardpb.GetTrashResponse
+	(*RestoreTrashItemRequest)(nil),         // 61: boardpb.RestoreTrashItemRequest
+	(*RestoreTrashItemResponse)(nil),        // 62: boardpb.RestoreTrashItemResponse
+	(*UndoRequest)(nil),                     // 63: boardpb.UndoRequest
+	(*UndoResponse)(nil),                    // 64: boardpb.UndoResponse
+	(*BoardExport)(nil),                     // 65: boardpb.BoardExport
+	(*ExportBoardRequest)(nil),              // 66: boardpb.ExportBoardRequest
+	(*ExportBoardResponse)(nil),             // 67: boardpb.ExportBoardResponse
+	(*GetBoardExportRequest)(nil),           // 68: boardpb.GetBoardExportRequest
+	(*GetBoardExportResponse)(nil),          // 69: boardpb.GetBoardExportResponse
+	(*DownloadBoardExportRequest)(nil),      // 70: boardpb.DownloadBoardExportRequest
+	(*DownloadBoardExportResponse)(nil),     // 71: boardpb.DownloadBoardExportResponse
+	(*BoardExportRequestedEvent)(nil),       // 72: boardpb.BoardExportRequestedEvent
+	(*ImportReportItem)(nil),                // 73: boardpb.ImportReportItem
+	(*ImportReport)(nil),                    // 74: boardpb.ImportReport
+	(*BoardImport)(nil),                     // 75: boardpb.BoardImport
+	(*ImportBoardRequest)(nil),              // 76: boardpb.ImportBoardRequest
+	(*ImportBoardResponse)(nil),             // 77: boardpb.ImportBoardResponse
+	(*GetBoardImportRequest)(nil),           // 78: boardpb.GetBoardImportRequest
+	(*GetBoardImportResponse)(nil),          // 79: boardpb.GetBoardImportResponse
+	(*BoardImportRequestedEvent)(nil),       // 80: boardpb.BoardImportRequestedEvent
+	(*BoardDeletedEvent)(nil),               // 81: boardpb.BoardDeletedEvent
+	(*BoardCleanupEvent)(nil),               // 82: boardpb.BoardCleanupEvent
+	(*timestamppb.Timestamp)(nil),           // 83: google.protobuf.Timestamp
+}
+var file_board_proto_depIdxs = []int32{
+	11, // 0: boardpb.Board.members:type_name -> boardpb.BoardMember
+	2,  // 1: boardpb.Board.lists:type_name -> boardpb.List
+	4,  // 2: boardpb.Board.cards:type_name -> boardpb.CardMeta
+	5,  // 3: boardpb.Board.labels:type_name -> boardpb.Label
+	83, // 4: boardpb.Board.created_at:type_name -> google.protobuf.Timestamp
+	83, // 5: boardpb.Board.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 6: boardpb.Board.custom_fields:type_name -> boardpb.CustomField
+	83, // 7: boardpb.Card.start_date:type_name -> google.protobuf.Timestamp
+	83, // 8: boardpb.Card.due_date:type_name -> google.protobuf.Timestamp
+	83, // 9: boardpb.Card.created_at:type_name -> google.protobuf.Timestamp
+	83, // 10: boardpb.Card.updated_at:type_name -> google.protobuf.Timestamp
+	83, // 11: boardpb.CardMeta.start_date:type_name -> google.protobuf.Timestamp
+	83, // 12: boardpb.CardMeta.due_date:type_name -> google.protobuf.Timestamp
+	83, // 13: boardpb.CardMeta.created_at:type_name -> google.protobuf.Timestamp
+	83, // 14: boardpb.CardMeta.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 15: boardpb.CardMeta.custom_field_values:type_name -> boardpb.CustomFieldValue
+	6,  // 16: boardpb.CustomField.options:type_name -> boardpb.CustomFieldOption
+	83, // 17: boardpb.CustomFieldValue.date_value:type_name -> google.protobuf.Timestamp
+	83, // 18: boardpb.BoardMeta.created_at:type_name -> google.protobuf.Timestamp
+	83, // 19: boardpb.BoardMeta.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 20: boardpb.CreateBoardResponse.board:type_name -> boardpb.Board
+	1,  // 21: boardpb.GetBoardByIDResponse.board:type_name -> boardpb.Board
+	10, // 22: boardpb.GetBoardListResponse.boards:type_name -> boardpb.BoardMeta
+	0,  // 23: boardpb.GetBoardListResponse.pagination:type_name -> boardpb.Pagination
+	11, // 24: boardpb.GetBoardMembersResponse.members:type_name -> boardpb.BoardMember
+	10, // 25: boardpb.GetArchivedBoardListResponse.boards:type_name -> boardpb.BoardMeta
+	0,  // 26: boardpb.GetArchivedBoardListResponse.pagination:type_name -> boardpb.Pagination
+	5,  // 27: boardpb.AddLabelResponse.label:type_name -> boardpb.Label
+	6,  // 28: boardpb.AddCustomFieldRequest.options:type_name -> boardpb.CustomFieldOption
+	7,  // 29: boardpb.AddCustomFieldResponse.custom_field:type_name -> boardpb.CustomField
+	6,  // 30: boardpb.UpdateCustomFieldRequest.options:type_name -> boardpb.CustomFieldOption
+	7,  // 31: boardpb.UpdateCustomFieldResponse.custom_field:type_name -> boardpb.CustomField
+	83, // 32: boardpb.TrashItem.deleted_at:type_name -> google.protobuf.Timestamp
+	83, // 33: boardpb.TrashItem.purge_at:type_name -> google.protobuf.Timestamp
+	58, // 34: boardpb.GetTrashResponse.items:type_name -> boardpb.TrashItem
+	0,  // 35: boardpb.GetTrashResponse.pagination:type_name -> boardpb.Pagination
+	83, // 36: boardpb.BoardExport.created_at:type_name -> google.protobuf.Timestamp
+	83, // 37: boardpb.BoardExport.completed_at:type_name -> google.protobuf.Timestamp
+	65, // 38: boardpb.ExportBoardResponse.export:type_name -> boardpb.BoardExport
+	65, // 39: boardpb.GetBoardExportResponse.export:type_name -> boardpb.BoardExport
+	73, // 40: boardpb.ImportReport.skipped:type_name -> boardpb.ImportReportItem
+	73, // 41: boardpb.ImportReport.truncated:type_name -> boardpb.ImportReportItem
+	74, // 42: boardpb.BoardImport.report:type_name -> boardpb.ImportReport
+	83, // 43: boardpb.BoardImport.created_at:type_name -> google.protobuf.Timestamp
+	83, // 44: boardpb.BoardImport.completed_at:type_name -> google.protobuf.Timestamp
+	75, // 45: boardpb.ImportBoardResponse.import:type_name -> boardpb.BoardImport
+	75, // 46: boardpb.GetBoardImportResponse.import:type_name -> boardpb.BoardImport
+	12, // 47: boardpb.BoardService.CreateBoard:input_type -> boardpb.CreateBoardRequest
+	14, // 48: boardpb.BoardService.GetBoardByID:input_type -> boardpb.GetBoardByIDRequest
+	16, // 49: boardpb.BoardService.GetBoardList:input_type -> boardpb.GetBoardListRequest
+	36, // 50: boardpb.BoardService.GetArchivedBoardList:input_type -> boardpb.GetArchivedBoardListRequest
+	18, // 51: boardpb.BoardService.GetBoardMembers:input_type -> boardpb.GetBoardMembersRequest
+	20, // 52: boardpb.BoardService.UpdateBoardName:input_type -> boardpb.UpdateBoardNameRequest
+	22, // 53: boardpb.BoardService.AddBoardUsers:input_type -> boardpb.AddBoardUsersRequest
+	24, // 54: boardpb.BoardService.RemoveBoardUsers:input_type -> boardpb.RemoveBoardUsersRequest
+	26, // 55: boardpb.BoardService.AssignBoardUsersRole:input_type -> boardpb.AssignBoardUsersRoleRequest
+	28, // 56: boardpb.BoardService.ChangeBoardOwner:input_type -> boardpb.ChangeBoardOwnerRequest
+	30, // 57: boardpb.BoardService.ChangeBoardVisibility:input_type -> boardpb.ChangeBoardVisibilityRequest
+	32, // 58: boardpb.BoardService.SetCardHistoryRetention:input_type -> boardpb.SetCardHistoryRetentionRequest
+	34, // 59: boardpb.BoardService.SetWIPLimitPolicy:input_type -> boardpb.SetWIPLimitPolicyRequest
+	40, // 60: boardpb.BoardService.AddLabel:input_type -> boardpb.AddLabelRequest
+	42, // 61: boardpb.BoardService.RemoveLabel:input_type -> boardpb.RemoveLabelRequest
+	44, // 62: boardpb.BoardService.AddCustomField:input_type -> boardpb.AddCustomFieldRequest
+	46, // 63: boardpb.BoardService.UpdateCustomField:input_type -> boardpb.UpdateCustomFieldRequest
+	48, // 64: boardpb.BoardService.RemoveCustomField:input_type -> boardpb.RemoveCustomFieldRequest
+	38, // 65: boardpb.BoardService.RestoreBoard:input_type -> boardpb.RestoreBoardRequest
+	50, // 66: boardpb.BoardService.ArchiveBoard:input_type -> boardpb.ArchiveBoardRequest
+	52, // 67: boardpb.BoardService.DeleteBoard:input_type -> boardpb.DeleteBoardRequest
+	54, // 68: boardpb.BoardService.GetBoardIDByList:input_type -> boardpb.GetBoardIDByListRequest
+	56, // 69: boardpb.BoardService.GetBoardIDByCard:input_type -> boardpb.GetBoardIDByCardRequest
+	59, // 70: boardpb.BoardService.GetTrash:input_type -> boardpb.GetTrashRequest
+	61, // 71: boardpb.BoardService.RestoreTrashItem:input_type -> boardpb.RestoreTrashItemRequest
+	63, // 72: boardpb.BoardService.Undo:input_type -> boardpb.UndoRequest
+	66, // 73: boardpb.BoardService.ExportBoard:input_type -> boardpb.ExportBoardRequest
+	68, // 74: boardpb.BoardService.GetBoardExport:input_type -> boardpb.GetBoardExportRequest
+	70, // 75: boardpb.BoardService.DownloadBoardExport:input_type -> boardpb.DownloadBoardExportRequest
+	76, // 76: boardpb.BoardService.ImportBoard:input_type -> boardpb.ImportBoardRequest
+	78, // 77: boardpb.BoardService.GetBoardImport:input_type -> boardpb.GetBoardImportRequest
+	13, // 78: boardpb.BoardService.CreateBoard:output_type -> boardpb.CreateBoardResponse
+	15, // 79: boardpb.BoardService.GetBoardByID:output_type -> boardpb.GetBoardByIDResponse
+	17, // 80: boardpb.BoardService.GetBoardList:output_type -> boardpb.GetBoardListResponse
+	37, // 81: boardpb.BoardService.GetArchivedBoardList:output_type -> boardpb.GetArchivedBoardListResponse
+	19, // 82: boardpb.BoardService.GetBoardMembers:output_type -> boardpb.GetBoardMembersResponse
+	21, // 83: boardpb.BoardService.UpdateBoardName:output_type -> boardpb.UpdateBoardNameResponse
+	23, // 84: boardpb.BoardService.AddBoardUsers:output_type -> boardpb.AddBoardUsersResponse
+	25, // 85: boardpb.BoardService.RemoveBoardUsers:output_type -> boardpb.RemoveBoardUsersResponse
+	27, // 86: boardpb.BoardService.AssignBoardUsersRole:output_type -> boardpb.AssignBoardUsersRoleResponse
+	29, // 87: boardpb.BoardService.ChangeBoardOwner:output_type -> boardpb.ChangeBoardOwnerResponse
+	31, // 88: boardpb.BoardService.ChangeBoardVisibility:output_type -> boardpb.ChangeBoardVisibilityResponse
+	33, // 89: boardpb.BoardService.SetCardHistoryRetention:output_type -> boardpb.SetCardHistoryRetentionResponse
+	35, // 90: boardpb.BoardService.SetWIPLimitPolicy:output_type -> boardpb.SetWIPLimitPolicyResponse
+	41, // 91: boardpb.BoardService.AddLabel:output_type -> boardpb.AddLabelResponse
+	43, // 92: boardpb.BoardService.RemoveLabel:output_type -> boardpb.RemoveLabelResponse
+	45, // 93: boardpb.BoardService.AddCustomField:output_type -> boardpb.AddCustomFieldResponse
+	47, // 94: boardpb.BoardService.UpdateCustomField:output_type -> boardpb.UpdateCustomFieldResponse
+	49, // 95: boardpb.BoardService.RemoveCustomField:output_type -> boardpb.RemoveCustomFieldResponse
+	39, // 96: boardpb.BoardService.RestoreBoard:output_type -> boardpb.RestoreBoardResponse
+	51, // 97: boardpb.BoardService.ArchiveBoard:output_type -> boardpb.ArchiveBoardResponse
+	53, // 98: boardpb.BoardService.DeleteBoard:output_type -> boardpb.DeleteBoardResponse
+	55, // 99: boardpb.BoardService.GetBoardIDByList:output_type -> boardpb.GetBoardIDByListResponse
+	57, // 100: boardpb.BoardService.GetBoardIDByCard:output_type -> boardpb.GetBoardIDByCardResponse
+	60, // 101: boardpb.BoardService.GetTrash:output_type -> boardpb.GetTrashResponse
+	62, // 102: boardpb.BoardService.RestoreTrashItem:output_type -> boardpb.RestoreTrashItemResponse
+	64, // 103: boardpb.BoardService.Undo:output_type -> boardpb.UndoResponse
+	67, // 104: boardpb.BoardService.ExportBoard:output_type -> boardpb.ExportBoardResponse
+	69, // 105: boardpb.BoardService.GetBoardExport:output_type -> boardpb.GetBoardExportResponse
+	71, // 106: boardpb.BoardService.DownloadBoardExport:output_type -> boardpb.DownloadBoardExportResponse
+	77, // 107: boardpb.BoardService.ImportBoard:output_type -> boardpb.ImportBoardResponse
+	79, // 108: boardpb.BoardService.GetBoardImport:output_type -> boardpb.GetBoardImportResponse
+	78, // [78:109] is the sub-list for method output_type
+	47, // [47:78] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
+}
+
+func init() { file_board_proto_init() }
+func file_board_proto_init() {
+	if File_board_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_board_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Board); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*List); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Card); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Label); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomFieldOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomFieldValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBoardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBoardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardByIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardMembersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardMembersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBoardNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBoardNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBoardUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBoardUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBoardUsersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBoardUsersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignBoardUsersRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3162,8 +6454,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Board); i {
+		file_board_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssignBoardUsersRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3174,8 +6466,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*List); i {
+		file_board_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeBoardOwnerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3186,8 +6478,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Card); i {
+		file_board_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeBoardOwnerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3198,8 +6490,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardMeta); i {
+		file_board_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeBoardVisibilityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3210,8 +6502,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Label); i {
+		file_board_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeBoardVisibilityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3222,8 +6514,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Attachment); i {
+		file_board_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardHistoryRetentionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3234,8 +6526,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardMeta); i {
+		file_board_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardHistoryRetentionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3246,8 +6538,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoardMember); i {
+		file_board_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWIPLimitPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3258,8 +6550,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBoardRequest); i {
+		file_board_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetWIPLimitPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3270,8 +6562,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBoardResponse); i {
+		file_board_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchivedBoardListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3282,8 +6574,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardByIDRequest); i {
+		file_board_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchivedBoardListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3294,8 +6586,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardByIDResponse); i {
+		file_board_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreBoardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3306,8 +6598,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardListRequest); i {
+		file_board_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreBoardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3318,8 +6610,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardListResponse); i {
+		file_board_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLabelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3330,8 +6622,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardMembersRequest); i {
+		file_board_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddLabelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3342,8 +6634,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardMembersResponse); i {
+		file_board_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLabelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3354,8 +6646,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBoardNameRequest); i {
+		file_board_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLabelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3366,8 +6658,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateBoardNameResponse); i {
+		file_board_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCustomFieldRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3378,8 +6670,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddBoardUsersRequest); i {
+		file_board_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCustomFieldResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3390,8 +6682,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddBoardUsersResponse); i {
+		file_board_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCustomFieldRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3402,8 +6694,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveBoardUsersRequest); i {
+		file_board_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCustomFieldResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3414,8 +6706,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveBoardUsersResponse); i {
+		file_board_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCustomFieldRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3426,8 +6718,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignBoardUsersRoleRequest); i {
+		file_board_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCustomFieldResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3438,8 +6730,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AssignBoardUsersRoleResponse); i {
+		file_board_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveBoardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3450,8 +6742,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeBoardOwnerRequest); i {
+		file_board_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveBoardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3462,8 +6754,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeBoardOwnerResponse); i {
+		file_board_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBoardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3474,8 +6766,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeBoardVisibilityRequest); i {
+		file_board_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBoardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3486,8 +6778,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeBoardVisibilityResponse); i {
+		file_board_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardIDByListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3498,8 +6790,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetArchivedBoardListRequest); i {
+		file_board_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardIDByListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3510,8 +6802,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetArchivedBoardListResponse); i {
+		file_board_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardIDByCardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3522,8 +6814,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreBoardRequest); i {
+		file_board_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardIDByCardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3534,8 +6826,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreBoardResponse); i {
+		file_board_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrashItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3546,8 +6838,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLabelRequest); i {
+		file_board_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrashRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3558,8 +6850,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddLabelResponse); i {
+		file_board_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrashResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3570,8 +6862,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveLabelRequest); i {
+		file_board_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreTrashItemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3582,8 +6874,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveLabelResponse); i {
+		file_board_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreTrashItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3594,8 +6886,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveBoardRequest); i {
+		file_board_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3606,8 +6898,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveBoardResponse); i {
+		file_board_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3618,8 +6910,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBoardRequest); i {
+		file_board_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardExport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3630,8 +6922,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBoardResponse); i {
+		file_board_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportBoardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3642,8 +6934,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardIDByListRequest); i {
+		file_board_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportBoardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3654,8 +6946,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardIDByListResponse); i {
+		file_board_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3666,8 +6958,8 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardIDByCardRequest); i {
+		file_board_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3678,8 +6970,152 @@ func file_board_proto_init() {
 				return nil
 			}
 		}
-		file_board_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBoardIDByCardResponse); i {
+		file_board_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadBoardExportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadBoardExportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardExportRequestedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportReportItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportBoardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportBoardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardImportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBoardImportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardImportRequestedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardDeletedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_board_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardCleanupEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3691,13 +7127,20 @@ func file_board_proto_init() {
 			}
 		}
 	}
+	file_board_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*CustomFieldValue_TextValue)(nil),
+		(*CustomFieldValue_NumberValue)(nil),
+		(*CustomFieldValue_DateValue)(nil),
+		(*CustomFieldValue_CheckboxValue)(nil),
+		(*CustomFieldValue_OptionID)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_board_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   45,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,13 +33,26 @@ type BoardServiceClient interface {
 	AssignBoardUsersRole(ctx context.Context, in *AssignBoardUsersRoleRequest, opts ...grpc.CallOption) (*AssignBoardUsersRoleResponse, error)
 	ChangeBoardOwner(ctx context.Context, in *ChangeBoardOwnerRequest, opts ...grpc.CallOption) (*ChangeBoardOwnerResponse, error)
 	ChangeBoardVisibility(ctx context.Context, in *ChangeBoardVisibilityRequest, opts ...grpc.CallOption) (*ChangeBoardVisibilityResponse, error)
+	SetCardHistoryRetention(ctx context.Context, in *SetCardHistoryRetentionRequest, opts ...grpc.CallOption) (*SetCardHistoryRetentionResponse, error)
+	SetWIPLimitPolicy(ctx context.Context, in *SetWIPLimitPolicyRequest, opts ...grpc.CallOption) (*SetWIPLimitPolicyResponse, error)
 	AddLabel(ctx context.Context, in *AddLabelRequest, opts ...grpc.CallOption) (*AddLabelResponse, error)
 	RemoveLabel(ctx context.Context, in *RemoveLabelRequest, opts ...grpc.CallOption) (*RemoveLabelResponse, error)
+	AddCustomField(ctx context.Context, in *AddCustomFieldRequest, opts ...grpc.CallOption) (*AddCustomFieldResponse, error)
+	UpdateCustomField(ctx context.Context, in *UpdateCustomFieldRequest, opts ...grpc.CallOption) (*UpdateCustomFieldResponse, error)
+	RemoveCustomField(ctx context.Context, in *RemoveCustomFieldRequest, opts ...grpc.CallOption) (*RemoveCustomFieldResponse, error)
 	RestoreBoard(ctx context.Context, in *RestoreBoardRequest, opts ...grpc.CallOption) (*RestoreBoardResponse, error)
 	ArchiveBoard(ctx context.Context, in *ArchiveBoardRequest, opts ...grpc.CallOption) (*ArchiveBoardResponse, error)
 	DeleteBoard(ctx context.Context, in *DeleteBoardRequest, opts ...grpc.CallOption) (*DeleteBoardResponse, error)
 	GetBoardIDByList(ctx context.Context, in *GetBoardIDByListRequest, opts ...grpc.CallOption) (*GetBoardIDByListResponse, error)
 	GetBoardIDByCard(ctx context.Context, in *GetBoardIDByCardRequest, opts ...grpc.CallOption) (*GetBoardIDByCardResponse, error)
+	GetTrash(ctx context.Context, in *GetTrashRequest, opts ...grpc.CallOption) (*GetTrashResponse, error)
+	RestoreTrashItem(ctx context.Context, in *RestoreTrashItemRequest, opts ...grpc.CallOption) (*RestoreTrashItemResponse, error)
+	Undo(ctx context.Context, in *UndoRequest, opts ...grpc.CallOption) (*UndoResponse, error)
+	ExportBoard(ctx context.Context, in *ExportBoardRequest, opts ...grpc.CallOption) (*ExportBoardResponse, error)
+	GetBoardExport(ctx context.Context, in *GetBoardExportRequest, opts ...grpc.CallOption) (*GetBoardExportResponse, error)
+	DownloadBoardExport(ctx context.Context, in *DownloadBoardExportRequest, opts ...grpc.CallOption) (BoardService_DownloadBoardExportClient, error)
+	ImportBoard(ctx context.Context, in *ImportBoardRequest, opts ...grpc.CallOption) (*ImportBoardResponse, error)
+	GetBoardImport(ctx context.Context, in *GetBoardImportRequest, opts ...grpc.CallOption) (*GetBoardImportResponse, error)
 }
 
 type boardServiceClient struct {
@@ -149,6 +162,24 @@ func (c *boardServiceClient) ChangeBoardVisibility(ctx context.Context, in *Chan
 	return out, nil
 }
 
+func (c *boardServiceClient) SetCardHistoryRetention(ctx context.Context, in *SetCardHistoryRetentionRequest, opts ...grpc.CallOption) (*SetCardHistoryRetentionResponse, error) {
+	out := new(SetCardHistoryRetentionResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/SetCardHistoryRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) SetWIPLimitPolicy(ctx context.Context, in *SetWIPLimitPolicyRequest, opts ...grpc.CallOption) (*SetWIPLimitPolicyResponse, error) {
+	out := new(SetWIPLimitPolicyResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/SetWIPLimitPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardServiceClient) AddLabel(ctx context.Context, in *AddLabelRequest, opts ...grpc.CallOption) (*AddLabelResponse, error) {
 	out := new(AddLabelResponse)
 	err := c.cc.Invoke(ctx, "/boardpb.BoardService/AddLabel", in, out, opts...)
@@ -167,6 +198,33 @@ func (c *boardServiceClient) RemoveLabel(ctx context.Context, in *RemoveLabelReq
 	return out, nil
 }
 
+func (c *boardServiceClient) AddCustomField(ctx context.Context, in *AddCustomFieldRequest, opts ...grpc.CallOption) (*AddCustomFieldResponse, error) {
+	out := new(AddCustomFieldResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/AddCustomField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) UpdateCustomField(ctx context.Context, in *UpdateCustomFieldRequest, opts ...grpc.CallOption) (*UpdateCustomFieldResponse, error) {
+	out := new(UpdateCustomFieldResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/UpdateCustomField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) RemoveCustomField(ctx context.Context, in *RemoveCustomFieldRequest, opts ...grpc.CallOption) (*RemoveCustomFieldResponse, error) {
+	out := new(RemoveCustomFieldResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/RemoveCustomField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boardServiceClient) RestoreBoard(ctx context.Context, in *RestoreBoardRequest, opts ...grpc.CallOption) (*RestoreBoardResponse, error) {
 	out := new(RestoreBoardResponse)
 	err := c.cc.Invoke(ctx, "/boardpb.BoardService/RestoreBoard", in, out, opts...)
@@ -212,6 +270,101 @@ func (c *boardServiceClient) GetBoardIDByCard(ctx context.Context, in *GetBoardI
 	return out, nil
 }
 
+func (c *boardServiceClient) GetTrash(ctx context.Context, in *GetTrashRequest, opts ...grpc.CallOption) (*GetTrashResponse, error) {
+	out := new(GetTrashResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/GetTrash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) RestoreTrashItem(ctx context.Context, in *RestoreTrashItemRequest, opts ...grpc.CallOption) (*RestoreTrashItemResponse, error) {
+	out := new(RestoreTrashItemResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/RestoreTrashItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) Undo(ctx context.Context, in *UndoRequest, opts ...grpc.CallOption) (*UndoResponse, error) {
+	out := new(UndoResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/Undo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) ExportBoard(ctx context.Context, in *ExportBoardRequest, opts ...grpc.CallOption) (*ExportBoardResponse, error) {
+	out := new(ExportBoardResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/ExportBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) GetBoardExport(ctx context.Context, in *GetBoardExportRequest, opts ...grpc.CallOption) (*GetBoardExportResponse, error) {
+	out := new(GetBoardExportResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/GetBoardExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) DownloadBoardExport(ctx context.Context, in *DownloadBoardExportRequest, opts ...grpc.CallOption) (BoardService_DownloadBoardExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BoardService_ServiceDesc.Streams[0], "/boardpb.BoardService/DownloadBoardExport", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &boardServiceDownloadBoardExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BoardService_DownloadBoardExportClient interface {
+	Recv() (*DownloadBoardExportResponse, error)
+	grpc.ClientStream
+}
+
+type boardServiceDownloadBoardExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *boardServiceDownloadBoardExportClient) Recv() (*DownloadBoardExportResponse, error) {
+	m := new(DownloadBoardExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *boardServiceClient) ImportBoard(ctx context.Context, in *ImportBoardRequest, opts ...grpc.CallOption) (*ImportBoardResponse, error) {
+	out := new(ImportBoardResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/ImportBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boardServiceClient) GetBoardImport(ctx context.Context, in *GetBoardImportRequest, opts ...grpc.CallOption) (*GetBoardImportResponse, error) {
+	out := new(GetBoardImportResponse)
+	err := c.cc.Invoke(ctx, "/boardpb.BoardService/GetBoardImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BoardServiceServer is the server API for BoardService service.
 // All implementations must embed UnimplementedBoardServiceServer
 // for forward compatibility
@@ -227,13 +380,26 @@ type BoardServiceServer interface {
 	AssignBoardUsersRole(context.Context, *AssignBoardUsersRoleRequest) (*AssignBoardUsersRoleResponse, error)
 	ChangeBoardOwner(context.Context, *ChangeBoardOwnerRequest) (*ChangeBoardOwnerResponse, error)
 	ChangeBoardVisibility(context.Context, *ChangeBoardVisibilityRequest) (*ChangeBoardVisibilityResponse, error)
+	SetCardHistoryRetention(context.Context, *SetCardHistoryRetentionRequest) (*SetCardHistoryRetentionResponse, error)
+	SetWIPLimitPolicy(context.Context, *SetWIPLimitPolicyRequest) (*SetWIPLimitPolicyResponse, error)
 	AddLabel(context.Context, *AddLabelRequest) (*AddLabelResponse, error)
 	RemoveLabel(context.Context, *RemoveLabelRequest) (*RemoveLabelResponse, error)
+	AddCustomField(context.Context, *AddCustomFieldRequest) (*AddCustomFieldResponse, error)
+	UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldResponse, error)
+	RemoveCustomField(context.Context, *RemoveCustomFieldRequest) (*RemoveCustomFieldResponse, error)
 	RestoreBoard(context.Context, *RestoreBoardRequest) (*RestoreBoardResponse, error)
 	ArchiveBoard(context.Context, *ArchiveBoardRequest) (*ArchiveBoardResponse, error)
 	DeleteBoard(context.Context, *DeleteBoardRequest) (*DeleteBoardResponse, error)
 	GetBoardIDByList(context.Context, *GetBoardIDByListRequest) (*GetBoardIDByListResponse, error)
 	GetBoardIDByCard(context.Context, *GetBoardIDByCardRequest) (*GetBoardIDByCardResponse, error)
+	GetTrash(context.Context, *GetTrashRequest) (*GetTrashResponse, error)
+	RestoreTrashItem(context.Context, *RestoreTrashItemRequest) (*RestoreTrashItemResponse, error)
+	Undo(context.Context, *UndoRequest) (*UndoResponse, error)
+	ExportBoard(context.Context, *ExportBoardRequest) (*ExportBoardResponse, error)
+	GetBoardExport(context.Context, *GetBoardExportRequest) (*GetBoardExportResponse, error)
+	DownloadBoardExport(*DownloadBoardExportRequest, BoardService_DownloadBoardExportServer) error
+	ImportBoard(context.Context, *ImportBoardRequest) (*ImportBoardResponse, error)
+	GetBoardImport(context.Context, *GetBoardImportRequest) (*GetBoardImportResponse, error)
 	mustEmbedUnimplementedBoardServiceServer()
 }
 
@@ -274,12 +440,27 @@ func (UnimplementedBoardServiceServer) ChangeBoardOwner(context.Context, *Change
 func (UnimplementedBoardServiceServer) ChangeBoardVisibility(context.Context, *ChangeBoardVisibilityRequest) (*ChangeBoardVisibilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeBoardVisibility not implemented")
 }
+func (UnimplementedBoardServiceServer) SetCardHistoryRetention(context.Context, *SetCardHistoryRetentionRequest) (*SetCardHistoryRetentionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCardHistoryRetention not implemented")
+}
+func (UnimplementedBoardServiceServer) SetWIPLimitPolicy(context.Context, *SetWIPLimitPolicyRequest) (*SetWIPLimitPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWIPLimitPolicy not implemented")
+}
 func (UnimplementedBoardServiceServer) AddLabel(context.Context, *AddLabelRequest) (*AddLabelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddLabel not implemented")
 }
 func (UnimplementedBoardServiceServer) RemoveLabel(context.Context, *RemoveLabelRequest) (*RemoveLabelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveLabel not implemented")
 }
+func (UnimplementedBoardServiceServer) AddCustomField(context.Context, *AddCustomFieldRequest) (*AddCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCustomField not implemented")
+}
+func (UnimplementedBoardServiceServer) UpdateCustomField(context.Context, *UpdateCustomFieldRequest) (*UpdateCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomField not implemented")
+}
+func (UnimplementedBoardServiceServer) RemoveCustomField(context.Context, *RemoveCustomFieldRequest) (*RemoveCustomFieldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCustomField not implemented")
+}
 func (UnimplementedBoardServiceServer) RestoreBoard(context.Context, *RestoreBoardRequest) (*RestoreBoardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestoreBoard not implemented")
 }
@@ -295,6 +476,30 @@ func (UnimplementedBoardServiceServer) GetBoardIDByList(context.Context, *GetBoa
 func (UnimplementedBoardServiceServer) GetBoardIDByCard(context.Context, *GetBoardIDByCardRequest) (*GetBoardIDByCardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBoardIDByCard not implemented")
 }
+func (UnimplementedBoardServiceServer) GetTrash(context.Context, *GetTrashRequest) (*GetTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrash not implemented")
+}
+func (UnimplementedBoardServiceServer) RestoreTrashItem(context.Context, *RestoreTrashItemRequest) (*RestoreTrashItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTrashItem not implemented")
+}
+func (UnimplementedBoardServiceServer) Undo(context.Context, *UndoRequest) (*UndoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undo not implemented")
+}
+func (UnimplementedBoardServiceServer) ExportBoard(context.Context, *ExportBoardRequest) (*ExportBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBoard not implemented")
+}
+func (UnimplementedBoardServiceServer) GetBoardExport(context.Context, *GetBoardExportRequest) (*GetBoardExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardExport not implemented")
+}
+func (UnimplementedBoardServiceServer) DownloadBoardExport(*DownloadBoardExportRequest, BoardService_DownloadBoardExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadBoardExport not implemented")
+}
+func (UnimplementedBoardServiceServer) ImportBoard(context.Context, *ImportBoardRequest) (*ImportBoardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBoard not implemented")
+}
+func (UnimplementedBoardServiceServer) GetBoardImport(context.Context, *GetBoardImportRequest) (*GetBoardImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardImport not implemented")
+}
 func (UnimplementedBoardServiceServer) mustEmbedUnimplementedBoardServiceServer() {}
 
 // UnsafeBoardServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -506,6 +711,42 @@ func _BoardService_ChangeBoardVisibility_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_SetCardHistoryRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCardHistoryRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).SetCardHistoryRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/SetCardHistoryRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).SetCardHistoryRetention(ctx, req.(*SetCardHistoryRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_SetWIPLimitPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWIPLimitPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).SetWIPLimitPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/SetWIPLimitPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).SetWIPLimitPolicy(ctx, req.(*SetWIPLimitPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoardService_AddLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddLabelRequest)
 	if err := dec(in); err != nil {
@@ -542,6 +783,60 @@ func _BoardService_RemoveLabel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_AddCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).AddCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/AddCustomField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).AddCustomField(ctx, req.(*AddCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_UpdateCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).UpdateCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/UpdateCustomField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).UpdateCustomField(ctx, req.(*UpdateCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_RemoveCustomField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCustomFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).RemoveCustomField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/RemoveCustomField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).RemoveCustomField(ctx, req.(*RemoveCustomFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BoardService_RestoreBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RestoreBoardRequest)
 	if err := dec(in); err != nil {
@@ -632,6 +927,153 @@ func _BoardService_GetBoardIDByCard_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BoardService_GetTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).GetTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/GetTrash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).GetTrash(ctx, req.(*GetTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_RestoreTrashItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTrashItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).RestoreTrashItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/RestoreTrashItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).RestoreTrashItem(ctx, req.(*RestoreTrashItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_Undo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).Undo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/Undo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).Undo(ctx, req.(*UndoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_ExportBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).ExportBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/ExportBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).ExportBoard(ctx, req.(*ExportBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_GetBoardExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).GetBoardExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/GetBoardExport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).GetBoardExport(ctx, req.(*GetBoardExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_DownloadBoardExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadBoardExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BoardServiceServer).DownloadBoardExport(m, &boardServiceDownloadBoardExportServer{stream})
+}
+
+type BoardService_DownloadBoardExportServer interface {
+	Send(*DownloadBoardExportResponse) error
+	grpc.ServerStream
+}
+
+type boardServiceDownloadBoardExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *boardServiceDownloadBoardExportServer) Send(m *DownloadBoardExportResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BoardService_ImportBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).ImportBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/ImportBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).ImportBoard(ctx, req.(*ImportBoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BoardService_GetBoardImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBoardImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoardServiceServer).GetBoardImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/boardpb.BoardService/GetBoardImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoardServiceServer).GetBoardImport(ctx, req.(*GetBoardImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BoardService_ServiceDesc is the grpc.ServiceDesc for BoardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -683,6 +1125,14 @@ var BoardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeBoardVisibility",
 			Handler:    _BoardService_ChangeBoardVisibility_Handler,
 		},
+		{
+			MethodName: "SetCardHistoryRetention",
+			Handler:    _BoardService_SetCardHistoryRetention_Handler,
+		},
+		{
+			MethodName: "SetWIPLimitPolicy",
+			Handler:    _BoardService_SetWIPLimitPolicy_Handler,
+		},
 		{
 			MethodName: "AddLabel",
 			Handler:    _BoardService_AddLabel_Handler,
@@ -691,6 +1141,18 @@ var BoardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveLabel",
 			Handler:    _BoardService_RemoveLabel_Handler,
 		},
+		{
+			MethodName: "AddCustomField",
+			Handler:    _BoardService_AddCustomField_Handler,
+		},
+		{
+			MethodName: "UpdateCustomField",
+			Handler:    _BoardService_UpdateCustomField_Handler,
+		},
+		{
+			MethodName: "RemoveCustomField",
+			Handler:    _BoardService_RemoveCustomField_Handler,
+		},
 		{
 			MethodName: "RestoreBoard",
 			Handler:    _BoardService_RestoreBoard_Handler,
@@ -711,7 +1173,41 @@ var BoardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBoardIDByCard",
 			Handler:    _BoardService_GetBoardIDByCard_Handler,
 		},
+		{
+			MethodName: "GetTrash",
+			Handler:    _BoardService_GetTrash_Handler,
+		},
+		{
+			MethodName: "RestoreTrashItem",
+			Handler:    _BoardService_RestoreTrashItem_Handler,
+		},
+		{
+			MethodName: "Undo",
+			Handler:    _BoardService_Undo_Handler,
+		},
+		{
+			MethodName: "ExportBoard",
+			Handler:    _BoardService_ExportBoard_Handler,
+		},
+		{
+			MethodName: "GetBoardExport",
+			Handler:    _BoardService_GetBoardExport_Handler,
+		},
+		{
+			MethodName: "ImportBoard",
+			Handler:    _BoardService_ImportBoard_Handler,
+		},
+		{
+			MethodName: "GetBoardImport",
+			Handler:    _BoardService_GetBoardImport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadBoardExport",
+			Handler:       _BoardService_DownloadBoardExport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "board.proto",
 }
@@ -3,13 +3,24 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
+
+	"github.com/sm888sm/halten-backend/common/constants/messagebuses"
 )
 
 type Config struct {
-	Port     int
-	Database DatabaseConfig
-	RabbitMQ RabbitMQConfig
-	Services ServiceConfig
+	Port        int
+	Database    DatabaseConfig
+	RabbitMQ    RabbitMQConfig
+	Services    ServiceConfig
+	Scheduler   SchedulerConfig
+	Trash       TrashConfig
+	Undo        UndoConfig
+	Export      ExportConfig
+	Outbox      OutboxConfig
+	Deletion    DeletionConfig
+	Saga        SagaConfig
+	MetricsAddr string // Serves the consumer metrics when set, such as :9090
 }
 
 type DatabaseConfig struct {
@@ -30,6 +41,40 @@ type ServiceConfig struct {
 
 type RabbitMQConfig struct { // Add this struct
 	URL string
+	Bus string // messagebuses.RabbitMQ, or messagebuses.Memory when every service runs in one process
+}
+
+type SchedulerConfig struct {
+	Interval time.Duration
+	LockKey  int64 // PostgreSQL advisory lock key shared by every board-service replica
+}
+
+type TrashConfig struct {
+	Retention     time.Duration // How long deleted boards, lists and cards are kept before they are purged
+	AttachmentDir string        // Directory attachment file paths are relative to
+}
+
+type UndoConfig struct {
+	Window time.Duration // How long after an action it can be undone
+}
+
+type ExportConfig struct {
+	Dir       string        // Directory export files are written to
+	Retention time.Duration // How long exports are kept before they are deleted
+}
+
+type DeletionConfig struct {
+	StepTimeout time.Duration // How long a service has to confirm its step of deleting a board before it's asked again
+	MaxAttempts int           // Requests of a step before the deletion fails
+}
+
+type SagaConfig struct {
+	StepTimeout time.Duration // How long a step of a saga may run before the saga is compensated
+}
+
+type OutboxConfig struct {
+	Interval  time.Duration // How often the relay publishes the messages waiting in the outbox
+	Retention time.Duration // How long sent messages are kept
 }
 
 func LoadConfig() (*Config, error) {
@@ -43,6 +88,66 @@ func LoadConfig() (*Config, error) {
 		dbPort = 5432 // Default PostgreSQL port
 	}
 
+	schedulerInterval, err := strconv.Atoi(os.Getenv("SCHEDULER_INTERVAL_SECONDS"))
+	if err != nil {
+		schedulerInterval = 3600 // Default scheduler interval
+	}
+
+	schedulerLockKey, err := strconv.ParseInt(os.Getenv("SCHEDULER_LOCK_KEY"), 10, 64)
+	if err != nil {
+		schedulerLockKey = 52001 // Default board scheduler lock key
+	}
+
+	trashRetentionDays, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS"))
+	if err != nil {
+		trashRetentionDays = 30 // Default trash retention
+	}
+
+	undoWindow, err := strconv.Atoi(os.Getenv("UNDO_WINDOW_SECONDS"))
+	if err != nil {
+		undoWindow = 300 // Default undo window
+	}
+
+	exportDir := os.Getenv("EXPORT_DIR")
+	if exportDir == "" {
+		exportDir = "exports" // Default export directory
+	}
+
+	exportRetentionDays, err := strconv.Atoi(os.Getenv("EXPORT_RETENTION_DAYS"))
+	if err != nil {
+		exportRetentionDays = 7 // Default export retention
+	}
+
+	outboxInterval, err := strconv.Atoi(os.Getenv("OUTBOX_INTERVAL_MILLISECONDS"))
+	if err != nil {
+		outboxInterval = 500 // Default outbox relay interval
+	}
+
+	outboxRetentionHours, err := strconv.Atoi(os.Getenv("OUTBOX_RETENTION_HOURS"))
+	if err != nil {
+		outboxRetentionHours = 24 // Default outbox retention
+	}
+
+	deletionStepTimeout, err := strconv.Atoi(os.Getenv("BOARD_DELETION_STEP_TIMEOUT_MINUTES"))
+	if err != nil {
+		deletionStepTimeout = 15 // Default step timeout, longer than the retries of a failed message
+	}
+
+	deletionMaxAttempts, err := strconv.Atoi(os.Getenv("BOARD_DELETION_MAX_ATTEMPTS"))
+	if err != nil {
+		deletionMaxAttempts = 3 // Default attempts of a deletion step
+	}
+
+	sagaStepTimeout, err := strconv.Atoi(os.Getenv("SAGA_STEP_TIMEOUT_SECONDS"))
+	if err != nil {
+		sagaStepTimeout = 120 // Default saga step timeout
+	}
+
+	messageBus := os.Getenv("MESSAGE_BUS")
+	if messageBus == "" {
+		messageBus = messagebuses.RabbitMQ // Default message bus
+	}
+
 	return &Config{
 		Port: port, // Or your default
 		Database: DatabaseConfig{
@@ -61,6 +166,34 @@ func LoadConfig() (*Config, error) {
 		},
 		RabbitMQ: RabbitMQConfig{ // Add this line
 			URL: os.Getenv("RABBITMQ_URL"),
+			Bus: messageBus,
+		},
+		MetricsAddr: os.Getenv("METRICS_ADDR"),
+		Scheduler: SchedulerConfig{
+			Interval: time.Duration(schedulerInterval) * time.Second,
+			LockKey:  schedulerLockKey,
+		},
+		Trash: TrashConfig{
+			Retention:     time.Duration(trashRetentionDays) * 24 * time.Hour,
+			AttachmentDir: os.Getenv("ATTACHMENT_DIR"),
+		},
+		Undo: UndoConfig{
+			Window: time.Duration(undoWindow) * time.Second,
+		},
+		Export: ExportConfig{
+			Dir:       exportDir,
+			Retention: time.Duration(exportRetentionDays) * 24 * time.Hour,
+		},
+		Outbox: OutboxConfig{
+			Interval:  time.Duration(outboxInterval) * time.Millisecond,
+			Retention: time.Duration(outboxRetentionHours) * time.Hour,
+		},
+		Deletion: DeletionConfig{
+			StepTimeout: time.Duration(deletionStepTimeout) * time.Minute,
+			MaxAttempts: deletionMaxAttempts,
+		},
+		Saga: SagaConfig{
+			StepTimeout: time.Duration(sagaStepTimeout) * time.Second,
 		},
 	}, nil
 }
@@ -0,0 +1,293 @@
+package export
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sm888sm/halten-backend/models"
+)
+
+// SchemaVersion is bumped whenever the shape of Document changes, so importers can tell exports
+// apart
+const SchemaVersion = 1
+
+// Document is the full content of a board export
+type Document struct {
+	SchemaVersion int       `json:"schemaVersion"`
+	ExportedAt    time.Time `json:"exportedAt"`
+	Board         Board     `json:"board"`
+}
+
+type Board struct {
+	ID           uint64        `json:"id"`
+	Name         string        `json:"name"`
+	Visibility   string        `json:"visibility"`
+	IsArchived   bool          `json:"isArchived"`
+	CreatedAt    time.Time     `json:"createdAt"`
+	Members      []Member      `json:"members"`
+	Labels       []Label       `json:"labels"`
+	CustomFields []CustomField `json:"customFields"`
+	Lists        []List        `json:"lists"`
+}
+
+type Member struct {
+	UserID   uint64 `json:"userID"`
+	Username string `json:"username"`
+	Fullname string `json:"fullname"`
+	Role     string `json:"role"`
+}
+
+type Label struct {
+	ID    uint64 `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type CustomField struct {
+	ID      uint64              `json:"id"`
+	Name    string              `json:"name"`
+	Type    string              `json:"type"`
+	Options []CustomFieldOption `json:"options,omitempty"`
+}
+
+type CustomFieldOption struct {
+	ID    uint64 `json:"id"`
+	Value string `json:"value"`
+	Color string `json:"color,omitempty"`
+}
+
+type List struct {
+	ID         uint64 `json:"id"`
+	Name       string `json:"name"`
+	IsArchived bool   `json:"isArchived"`
+	WIPLimit   int    `json:"wipLimit"`
+	Cards      []Card `json:"cards"`
+}
+
+type Card struct {
+	ID                uint64             `json:"id"`
+	Name              string             `json:"name"`
+	Description       string             `json:"description"`
+	IsArchived        bool               `json:"isArchived"`
+	IsCompleted       bool               `json:"isCompleted"`
+	StartDate         *time.Time         `json:"startDate"`
+	DueDate           *time.Time         `json:"dueDate"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
+	LabelIDs          []uint64           `json:"labelIDs"`
+	MemberIDs         []uint64           `json:"memberIDs"`
+	CustomFieldValues []CustomFieldValue `json:"customFieldValues"`
+	Comments          []Comment          `json:"comments"`
+	Attachments       []Attachment       `json:"attachments"`
+}
+
+type CustomFieldValue struct {
+	CustomFieldID uint64     `json:"customFieldID"`
+	Text          *string    `json:"text,omitempty"`
+	Number        *float64   `json:"number,omitempty"`
+	Date          *time.Time `json:"date,omitempty"`
+	Checkbox      *bool      `json:"checkbox,omitempty"`
+	OptionID      *uint64    `json:"optionID,omitempty"`
+}
+
+type Comment struct {
+	ID        uint64     `json:"id"`
+	ParentID  *uint64    `json:"parentID,omitempty"`
+	UserID    uint64     `json:"userID"`
+	Username  string     `json:"username"`
+	Content   string     `json:"content"`
+	CreatedAt time.Time  `json:"createdAt"`
+	EditedAt  *time.Time `json:"editedAt,omitempty"`
+}
+
+// Attachment only describes the file, the file itself isn't part of the export
+type Attachment struct {
+	ID        uint64    `json:"id"`
+	FileName  string    `json:"fileName"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// NewDocument starts the document of a board. Lists are added with AddList as their cards are
+// loaded.
+func NewDocument(board *models.Board, users []models.User, exportedAt time.Time) *Document {
+	usersByID := make(map[uint64]models.User, len(users))
+	for _, user := range users {
+		usersByID[user.ID] = user
+	}
+
+	doc := &Document{
+		SchemaVersion: SchemaVersion,
+		ExportedAt:    exportedAt,
+		Board: Board{
+			ID:           board.ID,
+			Name:         board.Name,
+			Visibility:   board.Visibility,
+			IsArchived:   board.IsArchived,
+			CreatedAt:    board.CreatedAt,
+			Members:      []Member{},
+			Labels:       []Label{},
+			CustomFields: []CustomField{},
+			Lists:        []List{},
+		},
+	}
+
+	for _, member := range board.Members {
+		user := usersByID[member.UserID]
+		doc.Board.Members = append(doc.Board.Members, Member{
+			UserID:   member.UserID,
+			Username: user.Username,
+			Fullname: user.Fullname,
+			Role:     member.Role,
+		})
+	}
+
+	for _, label := range board.Labels {
+		doc.Board.Labels = append(doc.Board.Labels, Label{ID: label.ID, Name: label.Name, Color: label.Color})
+	}
+
+	for _, customField := range board.CustomFields {
+		field := CustomField{ID: customField.ID, Name: customField.Name, Type: customField.Type}
+		for _, option := range customField.Options {
+			field.Options = append(field.Options, CustomFieldOption{ID: option.ID, Value: option.Value, Color: option.Color})
+		}
+		doc.Board.CustomFields = append(doc.Board.CustomFields, field)
+	}
+
+	return doc
+}
+
+// AddList appends a list with its cards, given in their order in the list
+func (d *Document) AddList(list *models.List, cards []models.Card) {
+	exported := List{
+		ID:         list.ID,
+		Name:       list.Name,
+		IsArchived: list.IsArchived,
+		WIPLimit:   list.WIPLimit,
+		Cards:      []Card{},
+	}
+
+	for _, card := range cards {
+		exported.Cards = append(exported.Cards, convertCard(&card))
+	}
+
+	d.Board.Lists = append(d.Board.Lists, exported)
+}
+
+func convertCard(card *models.Card) Card {
+	exported := Card{
+		ID:                card.ID,
+		Name:              card.Name,
+		Description:       card.Description,
+		IsArchived:        card.IsArchived,
+		IsCompleted:       card.IsCompleted,
+		StartDate:         card.StartDate,
+		DueDate:           card.DueDate,
+		CreatedAt:         card.CreatedAt,
+		UpdatedAt:         card.UpdatedAt,
+		LabelIDs:          []uint64{},
+		MemberIDs:         []uint64{},
+		CustomFieldValues: []CustomFieldValue{},
+		Comments:          []Comment{},
+		Attachments:       []Attachment{},
+	}
+
+	for _, label := range card.Labels {
+		exported.LabelIDs = append(exported.LabelIDs, label.ID)
+	}
+	sort.Slice(exported.LabelIDs, func(i, j int) bool { return exported.LabelIDs[i] < exported.LabelIDs[j] })
+
+	for _, member := range card.Members {
+		exported.MemberIDs = append(exported.MemberIDs, member.UserID)
+	}
+
+	for _, value := range card.CustomFieldValues {
+		exported.CustomFieldValues = append(exported.CustomFieldValues, CustomFieldValue{
+			CustomFieldID: value.CustomFieldID,
+			Text:          value.TextValue,
+			Number:        value.NumberValue,
+			Date:          value.DateValue,
+			Checkbox:      value.CheckboxValue,
+			OptionID:      value.OptionID,
+		})
+	}
+
+	for _, comment := range card.Comments {
+		exported.Comments = append(exported.Comments, Comment{
+			ID:        comment.ID,
+			ParentID:  comment.ParentID,
+			UserID:    comment.UserID,
+			Username:  comment.User.Username,
+			Content:   comment.Content,
+			CreatedAt: comment.CreatedAt,
+			EditedAt:  comment.EditedAt,
+		})
+	}
+
+	for _, attachment := range card.Attachments {
+		exported.Attachments = append(exported.Attachments, Attachment{
+			ID:        attachment.ID,
+			FileName:  attachment.FileName,
+			Type:      attachment.Type,
+			CreatedAt: attachment.CreatedAt,
+		})
+	}
+
+	return exported
+}
+
+// labelNames returns the names of the labels with the given IDs
+func (d *Document) labelNames(labelIDs []uint64) []string {
+	var names []string
+	for _, labelID := range labelIDs {
+		for _, label := range d.Board.Labels {
+			if label.ID == labelID {
+				names = append(names, label.Name)
+			}
+		}
+	}
+	return names
+}
+
+// memberNames returns the usernames of the members with the given user IDs
+func (d *Document) memberNames(userIDs []uint64) []string {
+	var names []string
+	for _, userID := range userIDs {
+		name := strconv.FormatUint(userID, 10)
+		for _, member := range d.Board.Members {
+			if member.UserID == userID && member.Username != "" {
+				name = member.Username
+			}
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// customFieldValue formats the value a card has for a custom field, empty when it has none
+func (d *Document) customFieldValue(card *Card, customField *CustomField) string {
+	for _, value := range card.CustomFieldValues {
+		if value.CustomFieldID != customField.ID {
+			continue
+		}
+
+		switch {
+		case value.Text != nil:
+			return *value.Text
+		case value.Number != nil:
+			return strconv.FormatFloat(*value.Number, 'f', -1, 64)
+		case value.Date != nil:
+			return value.Date.Format(time.RFC3339)
+		case value.Checkbox != nil:
+			return strconv.FormatBool(*value.Checkbox)
+		case value.OptionID != nil:
+			for _, option := range customField.Options {
+				if option.ID == *value.OptionID {
+					return option.Value
+				}
+			}
+		}
+	}
+	return ""
+}
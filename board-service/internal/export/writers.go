@@ -0,0 +1,162 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sm888sm/halten-backend/common/constants/exportformats"
+)
+
+// FileName returns the name the export of the document is downloaded as
+func FileName(doc *Document, format string) string {
+	extension := map[string]string{
+		exportformats.JSON:     "json",
+		exportformats.CSV:      "csv",
+		exportformats.Markdown: "md",
+	}[format]
+
+	return fmt.Sprintf("board-%d-%s.%s", doc.Board.ID, doc.ExportedAt.Format("20060102-150405"), extension)
+}
+
+// ContentType returns the media type of exports in the format
+func ContentType(format string) string {
+	switch format {
+	case exportformats.CSV:
+		return "text/csv; charset=utf-8"
+	case exportformats.Markdown:
+		return "text/markdown; charset=utf-8"
+	}
+	return "application/json"
+}
+
+// Write writes the document in the format
+func Write(w io.Writer, doc *Document, format string) error {
+	switch format {
+	case exportformats.JSON:
+		return writeJSON(w, doc)
+	case exportformats.CSV:
+		return writeCSV(w, doc)
+	case exportformats.Markdown:
+		return writeMarkdown(w, doc)
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
+
+func writeJSON(w io.Writer, doc *Document) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+// writeCSV flattens the cards of the board into one row each, with a column per custom field
+func writeCSV(w io.Writer, doc *Document) error {
+	writer := csv.NewWriter(w)
+
+	header := []string{"Card ID", "Name", "Description", "List", "Labels", "Members", "Start Date", "Due Date", "Completed", "Archived", "Comments", "Attachments", "Created At"}
+	for _, customField := range doc.Board.CustomFields {
+		header = append(header, customField.Name)
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, list := range doc.Board.Lists {
+		for _, card := range list.Cards {
+			row := []string{
+				strconv.FormatUint(card.ID, 10),
+				card.Name,
+				card.Description,
+				list.Name,
+				strings.Join(doc.labelNames(card.LabelIDs), ", "),
+				strings.Join(doc.memberNames(card.MemberIDs), ", "),
+				formatDate(card.StartDate),
+				formatDate(card.DueDate),
+				strconv.FormatBool(card.IsCompleted),
+				strconv.FormatBool(card.IsArchived || list.IsArchived),
+				strconv.Itoa(len(card.Comments)),
+				strconv.Itoa(len(card.Attachments)),
+				card.CreatedAt.Format(time.RFC3339),
+			}
+			for i := range doc.Board.CustomFields {
+				row = append(row, doc.customFieldValue(&card, &doc.Board.CustomFields[i]))
+			}
+
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeMarkdown outlines the board as a heading per list and a checklist item per card
+func writeMarkdown(w io.Writer, doc *Document) error {
+	writer := bufio.NewWriter(w)
+
+	fmt.Fprintf(writer, "# %s\n\n", doc.Board.Name)
+	fmt.Fprintf(writer, "Exported %s\n", doc.ExportedAt.Format(time.RFC3339))
+
+	for _, list := range doc.Board.Lists {
+		fmt.Fprintf(writer, "\n## %s", list.Name)
+		if list.IsArchived {
+			fmt.Fprint(writer, " (archived)")
+		}
+		fmt.Fprint(writer, "\n\n")
+
+		if len(list.Cards) == 0 {
+			fmt.Fprint(writer, "_No cards_\n")
+			continue
+		}
+
+		for _, card := range list.Cards {
+			check := " "
+			if card.IsCompleted {
+				check = "x"
+			}
+			fmt.Fprintf(writer, "- [%s] %s", check, card.Name)
+			if card.IsArchived {
+				fmt.Fprint(writer, " (archived)")
+			}
+			fmt.Fprint(writer, "\n")
+
+			if card.DueDate != nil {
+				fmt.Fprintf(writer, "  - Due: %s\n", formatDate(card.DueDate))
+			}
+			if labels := doc.labelNames(card.LabelIDs); len(labels) > 0 {
+				fmt.Fprintf(writer, "  - Labels: %s\n", strings.Join(labels, ", "))
+			}
+			if members := doc.memberNames(card.MemberIDs); len(members) > 0 {
+				fmt.Fprintf(writer, "  - Members: %s\n", strings.Join(members, ", "))
+			}
+			for i := range doc.Board.CustomFields {
+				if value := doc.customFieldValue(&card, &doc.Board.CustomFields[i]); value != "" {
+					fmt.Fprintf(writer, "  - %s: %s\n", doc.Board.CustomFields[i].Name, value)
+				}
+			}
+			if card.Description != "" {
+				fmt.Fprint(writer, "\n")
+				for _, line := range strings.Split(card.Description, "\n") {
+					fmt.Fprintf(writer, "  > %s\n", line)
+				}
+				fmt.Fprint(writer, "\n")
+			}
+		}
+	}
+
+	return writer.Flush()
+}
+
+func formatDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.Format(time.RFC3339)
+}
@@ -0,0 +1,73 @@
+package importer
+
+import (
+	"unicode/utf8"
+
+	"github.com/sm888sm/halten-backend/models"
+)
+
+// MaxContentSize is the largest file accepted for an import
+const MaxContentSize = 32 << 20
+
+// Limits of the columns imported text is written to
+const (
+	maxNameLength        = 50
+	maxDescriptionLength = 16384
+	maxCommentLength     = 1024
+)
+
+// Plan is a board ready to be created, built from the file of an import. Nothing in it is saved
+// yet, so it's also what a dry run reports on.
+type Plan struct {
+	Board         models.Board
+	MemberUserIDs []uint64 // Users added to the board as members, besides the importing user
+	Labels        []models.Label
+	Lists         []PlannedList
+	Report        Report
+}
+
+type PlannedList struct {
+	List  models.List
+	Cards []PlannedCard
+}
+
+type PlannedCard struct {
+	Card          models.Card
+	LabelIndexes  []int    // Indexes into Plan.Labels
+	MemberUserIDs []uint64 // Always among the board members
+	Comments      []models.Comment
+}
+
+// Report tells the user what an import created and what it left out
+type Report struct {
+	Lists      int          `json:"lists"`
+	Labels     int          `json:"labels"`
+	Cards      int          `json:"cards"`
+	Checklists int          `json:"checklists"`
+	Comments   int          `json:"comments"`
+	Members    int          `json:"members"`
+	Skipped    []ReportItem `json:"skipped"`
+	Truncated  []ReportItem `json:"truncated"`
+}
+
+// ReportItem is an item of the imported file, identified by its ID in the source tool
+type ReportItem struct {
+	Type     string `json:"type"` // member, card, comment, attachment...
+	SourceID string `json:"sourceID"`
+	Name     string `json:"name"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+func (r *Report) skip(itemType, sourceID, name, reason string) {
+	r.Skipped = append(r.Skipped, ReportItem{Type: itemType, SourceID: sourceID, Name: name, Reason: reason})
+}
+
+// truncate cuts text to at most maxLength characters, reporting the item when it had to
+func (r *Report) truncate(itemType, sourceID, name, text string, maxLength int) string {
+	if utf8.RuneCountInString(text) <= maxLength {
+		return text
+	}
+
+	r.Truncated = append(r.Truncated, ReportItem{Type: itemType, SourceID: sourceID, Name: name})
+	return string([]rune(text)[:maxLength])
+}
@@ -0,0 +1,351 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sm888sm/halten-backend/common/positions"
+	"github.com/sm888sm/halten-backend/models"
+)
+
+// TrelloBoard is the part of a Trello board export (Menu > Print and export > Export as JSON) that
+// is imported
+type TrelloBoard struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Closed     bool              `json:"closed"`
+	Labels     []trelloLabel     `json:"labels"`
+	Lists      []trelloList      `json:"lists"`
+	Cards      []trelloCard      `json:"cards"`
+	Checklists []trelloChecklist `json:"checklists"`
+	Actions    []trelloAction    `json:"actions"`
+	Members    []trelloMember    `json:"members"`
+}
+
+type trelloLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type trelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+type trelloCard struct {
+	ID          string             `json:"id"`
+	IDList      string             `json:"idList"`
+	Name        string             `json:"name"`
+	Desc        string             `json:"desc"`
+	Closed      bool               `json:"closed"`
+	Pos         float64            `json:"pos"`
+	Start       *time.Time         `json:"start"`
+	Due         *time.Time         `json:"due"`
+	DueComplete bool               `json:"dueComplete"`
+	IDLabels    []string           `json:"idLabels"`
+	IDMembers   []string           `json:"idMembers"`
+	Attachments []trelloAttachment `json:"attachments"`
+}
+
+type trelloAttachment struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type trelloChecklist struct {
+	ID         string            `json:"id"`
+	IDCard     string            `json:"idCard"`
+	Name       string            `json:"name"`
+	Pos        float64           `json:"pos"`
+	CheckItems []trelloCheckItem `json:"checkItems"`
+}
+
+type trelloCheckItem struct {
+	Name  string  `json:"name"`
+	State string  `json:"state"` // complete or incomplete
+	Pos   float64 `json:"pos"`
+}
+
+type trelloAction struct {
+	ID            string       `json:"id"`
+	Type          string       `json:"type"`
+	Date          time.Time    `json:"date"`
+	MemberCreator trelloMember `json:"memberCreator"`
+	Data          struct {
+		Text string `json:"text"`
+		Card struct {
+			ID string `json:"id"`
+		} `json:"card"`
+	} `json:"data"`
+}
+
+type trelloMember struct {
+	ID       string `json:"id"`
+	FullName string `json:"fullName"`
+	Username string `json:"username"`
+	Email    string `json:"email"` // Only in exports made by an admin of the workspace
+}
+
+// trelloLabelColors maps Trello label colors to hex. The light and dark variants of newer boards
+// fall back to their base color.
+var trelloLabelColors = map[string]string{
+	"green":  "#61bd4f",
+	"yellow": "#f2d600",
+	"orange": "#ff9f1a",
+	"red":    "#eb5a46",
+	"purple": "#c377e0",
+	"blue":   "#0079bf",
+	"sky":    "#00c2e0",
+	"lime":   "#51e898",
+	"pink":   "#ff78cb",
+	"black":  "#344563",
+}
+
+// trelloNoColor is used for labels without a color
+const trelloNoColor = "#b3bac5"
+
+// ParseTrello reads a Trello board export
+func ParseTrello(content []byte) (*TrelloBoard, error) {
+	var board TrelloBoard
+	if err := json.Unmarshal(content, &board); err != nil {
+		return nil, fmt.Errorf("invalid Trello export: %w", err)
+	}
+
+	if board.ID == "" || board.Lists == nil || board.Cards == nil {
+		return nil, errors.New("invalid Trello export: not a board")
+	}
+
+	return &board, nil
+}
+
+// Emails returns the emails of the board's members found in the export, to match them with users
+func (b *TrelloBoard) Emails() []string {
+	var emails []string
+	for _, member := range b.Members {
+		if member.Email != "" {
+			emails = append(emails, strings.ToLower(member.Email))
+		}
+	}
+	return emails
+}
+
+// PlanTrello builds the board to create for a Trello export. usersByEmail holds the users matching
+// the emails of Emails, keyed by lowercase email. The importing user owns the board, comments by
+// members that couldn't be matched are attributed to them.
+func PlanTrello(board *TrelloBoard, usersByEmail map[string]uint64, userID uint64) *Plan {
+	plan := &Plan{}
+	report := &plan.Report
+
+	name := board.Name
+	if name == "" {
+		name = "Imported board"
+	}
+	plan.Board = models.Board{
+		UserID:     userID,
+		Name:       report.truncate("board", board.ID, name, name, maxNameLength),
+		IsArchived: board.Closed,
+	}
+
+	// Members are matched by email, the only identity shared by both tools
+	memberUserIDs := make(map[string]uint64)
+	added := map[uint64]bool{userID: true}
+	for _, member := range board.Members {
+		memberUserID, ok := usersByEmail[strings.ToLower(member.Email)]
+		switch {
+		case member.Email == "":
+			report.skip("member", member.ID, member.FullName, "No email in the export")
+			continue
+		case !ok:
+			report.skip("member", member.ID, member.FullName, "No user with this email")
+			continue
+		}
+
+		memberUserIDs[member.ID] = memberUserID
+		if !added[memberUserID] {
+			added[memberUserID] = true
+			plan.MemberUserIDs = append(plan.MemberUserIDs, memberUserID)
+		}
+	}
+	report.Members = len(plan.MemberUserIDs)
+
+	labelIndexes := make(map[string]int)
+	for _, label := range board.Labels {
+		baseColor := strings.TrimSuffix(strings.TrimSuffix(label.Color, "_light"), "_dark")
+		color, ok := trelloLabelColors[baseColor]
+		if !ok {
+			color = trelloNoColor
+		}
+
+		name := label.Name
+		if name == "" {
+			name = baseColor
+		}
+		if name == "" {
+			name = "Unnamed"
+		}
+
+		labelIndexes[label.ID] = len(plan.Labels)
+		plan.Labels = append(plan.Labels, models.Label{
+			Name:  report.truncate("label", label.ID, name, name, maxNameLength),
+			Color: color,
+		})
+	}
+	report.Labels = len(plan.Labels)
+
+	lists := append([]trelloList(nil), board.Lists...)
+	sort.SliceStable(lists, func(i, j int) bool { return lists[i].Pos < lists[j].Pos })
+
+	listIndexes := make(map[string]int)
+	for i, list := range lists {
+		listIndexes[list.ID] = i
+		plan.Lists = append(plan.Lists, PlannedList{
+			List: models.List{
+				Name:       report.truncate("list", list.ID, list.Name, list.Name, maxNameLength),
+				Position:   int64(i+1) * positions.Gap,
+				IsArchived: list.Closed,
+			},
+		})
+	}
+	report.Lists = len(plan.Lists)
+
+	checklists := make(map[string][]trelloChecklist)
+	for _, checklist := range board.Checklists {
+		checklists[checklist.IDCard] = append(checklists[checklist.IDCard], checklist)
+	}
+
+	comments := make(map[string][]trelloAction)
+	for _, action := range board.Actions {
+		if action.Type == "commentCard" {
+			comments[action.Data.Card.ID] = append(comments[action.Data.Card.ID], action)
+		}
+	}
+
+	cards := append([]trelloCard(nil), board.Cards...)
+	sort.SliceStable(cards, func(i, j int) bool { return cards[i].Pos < cards[j].Pos })
+
+	imported := make(map[string]bool)
+	for _, card := range cards {
+		listIndex, ok := listIndexes[card.IDList]
+		if !ok {
+			report.skip("card", card.ID, card.Name, "Its list isn't in the export")
+			continue
+		}
+		imported[card.ID] = true
+
+		description := strings.TrimLeft(card.Desc+checklistMarkdown(checklists[card.ID]), "\n")
+		report.Checklists += len(checklists[card.ID])
+
+		planned := PlannedCard{
+			Card: models.Card{
+				Name:        report.truncate("card", card.ID, card.Name, card.Name, maxNameLength),
+				Description: report.truncate("card", card.ID, card.Name, description, maxDescriptionLength),
+				IsArchived:  card.Closed,
+				IsCompleted: card.DueComplete,
+				StartDate:   card.Start,
+				DueDate:     card.Due,
+			},
+		}
+		planned.Card.CreatedAt = trelloCreatedAt(card.ID)
+
+		for _, labelID := range card.IDLabels {
+			if labelIndex, ok := labelIndexes[labelID]; ok {
+				planned.LabelIndexes = append(planned.LabelIndexes, labelIndex)
+			}
+		}
+
+		// Members that couldn't be matched are already reported
+		for _, memberID := range card.IDMembers {
+			if memberUserID, ok := memberUserIDs[memberID]; ok {
+				planned.MemberUserIDs = append(planned.MemberUserIDs, memberUserID)
+			}
+		}
+
+		for _, attachment := range card.Attachments {
+			report.skip("attachment", attachment.ID, attachment.Name, "Attachment files aren't imported")
+		}
+
+		cardComments := comments[card.ID]
+		sort.SliceStable(cardComments, func(i, j int) bool { return cardComments[i].Date.Before(cardComments[j].Date) })
+		for _, action := range cardComments {
+			if strings.TrimSpace(action.Data.Text) == "" {
+				continue
+			}
+
+			content := action.Data.Text
+			authorID, ok := memberUserIDs[action.MemberCreator.ID]
+			if !ok {
+				authorID = userID
+				content = fmt.Sprintf("Posted by %s on Trello:\n\n%s", action.MemberCreator.FullName, content)
+			}
+
+			comment := models.Comment{
+				UserID:  authorID,
+				Content: report.truncate("comment", action.ID, card.Name, content, maxCommentLength),
+			}
+			comment.CreatedAt = action.Date
+			planned.Comments = append(planned.Comments, comment)
+		}
+		report.Comments += len(planned.Comments)
+
+		plan.Lists[listIndex].Cards = append(plan.Lists[listIndex].Cards, planned)
+		report.Cards++
+	}
+
+	for _, action := range board.Actions {
+		if action.Type == "commentCard" && !imported[action.Data.Card.ID] {
+			report.skip("comment", action.ID, "", "Its card isn't imported")
+		}
+	}
+
+	for i := range plan.Lists {
+		for j := range plan.Lists[i].Cards {
+			plan.Lists[i].Cards[j].Card.Position = int64(j+1) * positions.Gap
+		}
+	}
+
+	return plan
+}
+
+// checklistMarkdown renders the checklists of a card as Markdown task lists. Cards have no
+// checklists of their own, so they're kept in the description.
+func checklistMarkdown(checklists []trelloChecklist) string {
+	sort.SliceStable(checklists, func(i, j int) bool { return checklists[i].Pos < checklists[j].Pos })
+
+	var sb strings.Builder
+	for _, checklist := range checklists {
+		fmt.Fprintf(&sb, "\n\n### %s\n", checklist.Name)
+
+		items := append([]trelloCheckItem(nil), checklist.CheckItems...)
+		sort.SliceStable(items, func(i, j int) bool { return items[i].Pos < items[j].Pos })
+		for _, item := range items {
+			check := " "
+			if item.State == "complete" {
+				check = "x"
+			}
+			fmt.Fprintf(&sb, "\n- [%s] %s", check, item.Name)
+		}
+	}
+	return sb.String()
+}
+
+// trelloCreatedAt reads the creation time Trello encodes in the first 4 bytes of its IDs. It's zero
+// for an ID it can't read, which lets the database set the time of the import.
+func trelloCreatedAt(id string) time.Time {
+	if len(id) < 8 {
+		return time.Time{}
+	}
+
+	seconds, err := strconv.ParseInt(id[:8], 16, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
+}
@@ -0,0 +1,51 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/sm888sm/halten-backend/board-service/internal/repositories"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+)
+
+type BoardDeletionJob struct {
+	boardRepo   repositories.BoardRepository
+	publisher   publishers.Publisher
+	stepTimeout time.Duration
+	maxAttempts int
+}
+
+func NewBoardDeletionJob(boardRepo repositories.BoardRepository, publisher publishers.Publisher, stepTimeout time.Duration, maxAttempts int) *BoardDeletionJob {
+	return &BoardDeletionJob{
+		boardRepo:   boardRepo,
+		publisher:   publisher,
+		stepTimeout: stepTimeout,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Run asks again for the steps of deleting boards that weren't confirmed in time, and reports the
+// deletions that failed
+func (j *BoardDeletionJob) Run(ctx context.Context) error {
+	res, err := j.boardRepo.RetryBoardDeletions(&repositories.RetryBoardDeletionsRequest{
+		StartedBefore: time.Now().Add(-j.stepTimeout),
+		MaxAttempts:   j.maxAttempts,
+		Cleanup: func(step string) publishers.Event {
+			return publishers.NewBoardCleanupEvent(j.publisher, events.Metadata{}, step)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, deletion := range res.Retried {
+		log.Printf("Requested the %s step of deleting board %d again, attempt %d", deletion.Step, deletion.BoardID, deletion.Attempts)
+	}
+	for _, deletion := range res.Failed {
+		log.Printf("Failed to delete board %d: %s", deletion.BoardID, deletion.LastError)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sm888sm/halten-backend/board-service/internal/repositories"
+)
+
+type ExportCleanupJob struct {
+	boardRepo repositories.BoardRepository
+	retention time.Duration
+	exportDir string
+}
+
+func NewExportCleanupJob(boardRepo repositories.BoardRepository, retention time.Duration, exportDir string) *ExportCleanupJob {
+	return &ExportCleanupJob{
+		boardRepo: boardRepo,
+		retention: retention,
+		exportDir: exportDir,
+	}
+}
+
+// Run deletes exports older than the retention window together with their files
+func (j *ExportCleanupJob) Run(ctx context.Context) error {
+	filePaths, err := j.boardRepo.PruneBoardExports(&repositories.PruneBoardExportsRequest{CreatedBefore: time.Now().Add(-j.retention)})
+	if err != nil {
+		return err
+	}
+
+	// The rows are gone at this point, so a file that can't be removed is only logged
+	for _, path := range filePaths {
+		if err := os.Remove(filepath.Join(j.exportDir, path)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Printf("Failed to remove export file %s: %v", path, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sm888sm/halten-backend/board-service/internal/repositories"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+)
+
+type TrashPurgeJob struct {
+	boardRepo     repositories.BoardRepository
+	publisher     publishers.Publisher
+	retention     time.Duration
+	attachmentDir string
+}
+
+func NewTrashPurgeJob(boardRepo repositories.BoardRepository, publisher publishers.Publisher, retention time.Duration, attachmentDir string) *TrashPurgeJob {
+	return &TrashPurgeJob{
+		boardRepo:     boardRepo,
+		publisher:     publisher,
+		retention:     retention,
+		attachmentDir: attachmentDir,
+	}
+}
+
+// Run deletes lists and cards that have been in the trash longer than the retention window, then
+// removes the files of their attachments. Boards are deleted by each service owning a part of them,
+// so their deletion is only started.
+func (j *TrashPurgeJob) Run(ctx context.Context) error {
+	res, err := j.boardRepo.PurgeTrash(&repositories.PurgeTrashRequest{
+		DeletedBefore: time.Now().Add(-j.retention),
+		Cleanup: func(step string) publishers.Event {
+			return publishers.NewBoardCleanupEvent(j.publisher, events.Metadata{}, step)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if res.Boards > 0 || res.Lists > 0 || res.Cards > 0 {
+		log.Printf("Purged %d lists and %d cards from the trash, started deleting %d boards", res.Lists, res.Cards, res.Boards)
+	}
+
+	// The rows are gone at this point, so a file that can't be removed is only logged
+	for _, path := range res.AttachmentPaths {
+		if err := os.Remove(filepath.Join(j.attachmentDir, path)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			log.Printf("Failed to remove attachment file %s: %v", path, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/sm888sm/halten-backend/board-service/internal/repositories"
+)
+
+type UndoLogCleanupJob struct {
+	boardRepo repositories.BoardRepository
+	window    time.Duration
+}
+
+func NewUndoLogCleanupJob(boardRepo repositories.BoardRepository, window time.Duration) *UndoLogCleanupJob {
+	return &UndoLogCleanupJob{boardRepo: boardRepo, window: window}
+}
+
+// Run drops undo log entries that are past the undo window
+func (j *UndoLogCleanupJob) Run(ctx context.Context) error {
+	_, err := j.boardRepo.PruneUndoLog(&repositories.PruneUndoLogRequest{CreatedBefore: time.Now().Add(-j.window)})
+	return err
+}
@@ -2,90 +2,111 @@ package consumer
 
 import (
 	"context"
-	"fmt"
+	"log"
 
 	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
 	"github.com/sm888sm/halten-backend/board-service/internal/services"
-	"github.com/streadway/amqp"
-	"google.golang.org/protobuf/proto"
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/consumers"
+	"gorm.io/gorm"
 )
 
 type BoardConsumer struct {
-	Channel      *amqp.Channel
+	Bus          bus.Bus
+	DB           *gorm.DB
 	BoardService *services.BoardService
 }
 
-func NewBoardConsumer(ch *amqp.Channel, boardService *services.BoardService) *BoardConsumer {
-	return &BoardConsumer{Channel: ch, BoardService: boardService}
+func NewBoardConsumer(b bus.Bus, db *gorm.DB, boardService *services.BoardService) *BoardConsumer {
+	return &BoardConsumer{Bus: b, DB: db, BoardService: boardService}
 }
 
 func (c *BoardConsumer) ConsumeBoardMessages(ctx context.Context) error {
-	ch := c.Channel
-
-	q, err := ch.QueueDeclare(
-		"",
-		false,
-		false,
-		true,
-		false,
-		nil)
-	if err != nil {
-		return err
-	}
+	consumer := consumers.NewConsumer(c.DB, &consumers.Queue{
+		Name:        "board-service.board",
+		Prefetch:    10,
+		RetryDelays: consumers.DefaultRetryDelays,
+	})
 
-	// Bind the queue to multiple routing keys
-	err = ch.QueueBind(
-		q.Name,
-		"board.*", // Use a wildboard to match any routing key that starts with "board."
-		"halten",
-		false,
-		nil)
-	if err != nil {
-		return err
-	}
+	consumer.Handle(events.BoardDelete, func(ctx context.Context, msg *consumers.Message) error {
+		req := &pb_board.DeleteBoardRequest{}
+		if err := events.Unpack(msg.Event, req); err != nil {
+			return consumers.Permanent(err)
+		}
 
-	msgs, err := ch.Consume(
-		q.Name,
-		"",
-		true,
-		false,
-		false,
-		false,
-		nil)
-	if err != nil {
+		_, err := c.BoardService.DeleteBoard(ctx, req)
 		return err
-	}
+	})
+
+	return consumer.Run(ctx, c.Bus)
+}
+
+// ConsumeExportMessages generates queued board exports
+func (c *BoardConsumer) ConsumeExportMessages(ctx context.Context) error {
+	consumer := consumers.NewConsumer(c.DB, jobQueue("board-service.exports"))
+
+	consumer.HandleIdempotent(events.BoardExport, func(ctx context.Context, msg *consumers.Message) error {
+		event := &pb_board.BoardExportRequestedEvent{}
+		if err := events.Unpack(msg.Event, event); err != nil {
+			return consumers.Permanent(err)
+		}
 
-	go func() {
-		for d := range msgs {
-			fmt.Println("Received a message: ", d.RoutingKey)
-			switch d.RoutingKey {
-			case "board.delete":
-				req := &pb_board.DeleteBoardRequest{}
-				err := proto.Unmarshal(d.Body, req)
-				if err != nil {
-					// Log the error or handle it as needed
-					continue
-				}
-
-				_, err = c.BoardService.DeleteBoard(ctx, req)
-				if err != nil {
-					// TODO: Log the error or handle it as needed
-				}
-
-				continue
-
-			case "board.update":
-				// Handle board.update messages
-				// ...
-
-				// Add more cases as needed
-			default:
-				// Log or handle unknown routing keys
-				// ...
-			}
+		// A failed export is marked as failed by RunBoardExport and requested again by the user
+		if err := c.BoardService.RunBoardExport(ctx, event.ExportID); err != nil {
+			log.Printf("Export %d not generated: %v", event.ExportID, err)
 		}
-	}()
+		return nil
+	})
+
+	return consumer.Run(ctx, c.Bus)
+}
+
+// ConsumeImportMessages runs queued board imports
+func (c *BoardConsumer) ConsumeImportMessages(ctx context.Context) error {
+	consumer := consumers.NewConsumer(c.DB, jobQueue("board-service.imports"))
 
-	return nil
+	consumer.HandleIdempotent(events.BoardImport, func(ctx context.Context, msg *consumers.Message) error {
+		event := &pb_board.BoardImportRequestedEvent{}
+		if err := events.Unpack(msg.Event, event); err != nil {
+			return consumers.Permanent(err)
+		}
+
+		// A failed import is marked as failed by RunBoardImport and requested again by the user
+		if err := c.BoardService.RunBoardImport(ctx, event.ImportID); err != nil {
+			log.Printf("Import %d not run: %v", event.ImportID, err)
+		}
+		return nil
+	})
+
+	return consumer.Run(ctx, c.Bus)
+}
+
+// ConsumeDeletionMessages moves the deletions of boards forward as services confirm their steps
+func (c *BoardConsumer) ConsumeDeletionMessages(ctx context.Context) error {
+	consumer := consumers.NewConsumer(c.DB, &consumers.Queue{
+		Name:        "board-service.deletions",
+		Prefetch:    10,
+		RetryDelays: consumers.DefaultRetryDelays,
+	})
+
+	consumer.Handle(events.BoardCleanupCompleted, func(ctx context.Context, msg *consumers.Message) error {
+		event := &pb_board.BoardCleanupEvent{}
+		if err := events.Unpack(msg.Event, event); err != nil {
+			return consumers.Permanent(err)
+		}
+
+		return c.BoardService.CompleteBoardCleanup(ctx, event)
+	})
+
+	return consumer.Run(ctx, c.Bus)
+}
+
+// jobQueue is a queue of background jobs. Jobs are slow, so a replica only takes one at a time.
+func jobQueue(name string) *consumers.Queue {
+	return &consumers.Queue{
+		Name:        name,
+		Prefetch:    1,
+		RetryDelays: consumers.DefaultRetryDelays,
+	}
 }
@@ -25,22 +25,35 @@ var (
 		"/proto.BoardService/GetBoardList":         true,
 		"/proto.BoardService/GetArchivedBoardList": true,
 		"/proto.BoardService/GetBoardMembers":      true,
+		"/proto.BoardService/GetTrash":             true,
+		"/proto.BoardService/Undo":                 true, // The role is checked against the board of the undone action
+		"/proto.BoardService/ImportBoard":          true, // Imports create a new board
+		"/proto.BoardService/GetBoardImport":       true, // Imports are only visible to the user who made them
 
 		// Add other methods here...
 	}
 
 	checkRole = map[string]string{
-		"/proto.BoardService/UpdateBoardName":       roles.AdminRole,
-		"/proto.BoardService/AddBoardUsers":         roles.AdminRole,
-		"/proto.BoardService/RemoveBoardUsers":      roles.AdminRole,
-		"/proto.BoardService/AssignBoardUsersRole":  roles.AdminRole,
-		"/proto.BoardService/ChangeBoardOwner":      roles.OwnerRole,
-		"/proto.BoardService/ChangeBoardVisibility": roles.AdminRole,
-		"/proto.BoardService/AddLabel":              roles.MemberRole,
-		"/proto.BoardService/RemoveLabel":           roles.MemberRole,
-		"/proto.BoardService/RestoreBoard":          roles.AdminRole,
-		"/proto.BoardService/ArchiveBoard":          roles.AdminRole,
-		"/proto.BoardService/DeleteBoard":           roles.OwnerRole,
+		"/proto.BoardService/UpdateBoardName":         roles.AdminRole,
+		"/proto.BoardService/AddBoardUsers":           roles.AdminRole,
+		"/proto.BoardService/RemoveBoardUsers":        roles.AdminRole,
+		"/proto.BoardService/AssignBoardUsersRole":    roles.AdminRole,
+		"/proto.BoardService/ChangeBoardOwner":        roles.OwnerRole,
+		"/proto.BoardService/ChangeBoardVisibility":   roles.AdminRole,
+		"/proto.BoardService/SetCardHistoryRetention": roles.AdminRole,
+		"/proto.BoardService/SetWIPLimitPolicy":       roles.AdminRole,
+		"/proto.BoardService/AddLabel":                roles.MemberRole,
+		"/proto.BoardService/RemoveLabel":             roles.MemberRole,
+		"/proto.BoardService/AddCustomField":          roles.AdminRole,
+		"/proto.BoardService/UpdateCustomField":       roles.AdminRole,
+		"/proto.BoardService/RemoveCustomField":       roles.AdminRole,
+		"/proto.BoardService/RestoreBoard":            roles.AdminRole,
+		"/proto.BoardService/ArchiveBoard":            roles.AdminRole,
+		"/proto.BoardService/DeleteBoard":             roles.OwnerRole,
+		"/proto.BoardService/RestoreTrashItem":        roles.MemberRole, // Restoring a board is checked against its owner
+		"/proto.BoardService/ExportBoard":             roles.ObserverRole,
+		"/proto.BoardService/GetBoardExport":          roles.ObserverRole,
+		"/proto.BoardService/DownloadBoardExport":     roles.ObserverRole,
 		// Add other methods here...
 	}
 )
@@ -51,15 +64,42 @@ type AuthInterceptor struct {
 }
 
 func NewAuthInterceptor(db *gorm.DB, svc *external_services.Services) *AuthInterceptor {
-	return &AuthInterceptor{db: db}
+	return &AuthInterceptor{db: db, svc: svc}
 }
 
 func (v *AuthInterceptor) AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := v.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
 
-	_, isException := checkRoleException[info.FullMethod]
+func (v *AuthInterceptor) StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := v.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authorizedStream carries the context holding the user and board IDs to the stream handler
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (v *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	_, isException := checkRoleException[method]
 	if !isException {
 
-		requiredRole, ok := checkRole[info.FullMethod]
+		requiredRole, ok := checkRole[method]
 		if !ok {
 			return nil, status.Errorf(codes.Unavailable, errorhandlers.NewAPIError(http.StatusNotImplemented, "Invalid method").Error())
 		}
@@ -95,5 +135,5 @@ func (v *AuthInterceptor) AuthInterceptor(ctx context.Context, req interface{},
 		}
 	}
 
-	return handler(ctx, req)
+	return ctx, nil
 }
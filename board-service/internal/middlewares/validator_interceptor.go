@@ -4,7 +4,13 @@ import (
 	"context"
 
 	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
+	"github.com/sm888sm/halten-backend/board-service/internal/importer"
+	"github.com/sm888sm/halten-backend/common/constants/customfieldtypes"
+	"github.com/sm888sm/halten-backend/common/constants/exportformats"
 	"github.com/sm888sm/halten-backend/common/constants/fielderrors"
+	"github.com/sm888sm/halten-backend/common/constants/importsources"
+	"github.com/sm888sm/halten-backend/common/constants/trashitemtypes"
+	"github.com/sm888sm/halten-backend/common/constants/wiplimitpolicies"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
 	"google.golang.org/grpc"
 	"gorm.io/gorm"
@@ -65,6 +71,18 @@ func (v *ValidatorInterceptor) ValidationInterceptor(ctx context.Context, req in
 		if err := validateRestoreBoardRequest(req.(*pb_board.RestoreBoardRequest)); err != nil {
 			return nil, err
 		}
+	case "/proto.BoardService/AddCustomField":
+		if err := validateAddCustomFieldRequest(req.(*pb_board.AddCustomFieldRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/UpdateCustomField":
+		if err := validateUpdateCustomFieldRequest(req.(*pb_board.UpdateCustomFieldRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/RemoveCustomField":
+		if err := validateRemoveCustomFieldRequest(req.(*pb_board.RemoveCustomFieldRequest)); err != nil {
+			return nil, err
+		}
 	case "/proto.BoardService/ArchiveBoard":
 		if err := validateArchiveBoardRequest(req.(*pb_board.ArchiveBoardRequest)); err != nil {
 			return nil, err
@@ -73,6 +91,42 @@ func (v *ValidatorInterceptor) ValidationInterceptor(ctx context.Context, req in
 		if err := validateDeleteBoardRequest(req.(*pb_board.DeleteBoardRequest)); err != nil {
 			return nil, err
 		}
+	case "/proto.BoardService/GetTrash":
+		if err := validateGetTrashRequest(req.(*pb_board.GetTrashRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/RestoreTrashItem":
+		if err := validateRestoreTrashItemRequest(req.(*pb_board.RestoreTrashItemRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/Undo":
+		if err := validateUndoRequest(req.(*pb_board.UndoRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/SetCardHistoryRetention":
+		if err := validateSetCardHistoryRetentionRequest(req.(*pb_board.SetCardHistoryRetentionRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/SetWIPLimitPolicy":
+		if err := validateSetWIPLimitPolicyRequest(req.(*pb_board.SetWIPLimitPolicyRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/ExportBoard":
+		if err := validateExportBoardRequest(req.(*pb_board.ExportBoardRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/GetBoardExport":
+		if err := validateGetBoardExportRequest(req.(*pb_board.GetBoardExportRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/ImportBoard":
+		if err := validateImportBoardRequest(req.(*pb_board.ImportBoardRequest)); err != nil {
+			return nil, err
+		}
+	case "/proto.BoardService/GetBoardImport":
+		if err := validateGetBoardImportRequest(req.(*pb_board.GetBoardImportRequest)); err != nil {
+			return nil, err
+		}
 	}
 
 	return handler(ctx, req)
@@ -164,6 +218,70 @@ func validateAssignBoardUsersRoleRequest(req *pb_board.AssignBoardUsersRoleReque
 	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
 }
 
+func validateSetCardHistoryRetentionRequest(req *pb_board.SetCardHistoryRetentionRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardHistoryLimit < 0 {
+		fieldErrors["CardHistoryLimit"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrOutOfRange,
+			Message: "CardHistoryLimit cannot be negative",
+			Field:   "CardHistoryLimit",
+		}
+	}
+
+	if req.CardHistoryDays < 0 {
+		fieldErrors["CardHistoryDays"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrOutOfRange,
+			Message: "CardHistoryDays cannot be negative",
+			Field:   "CardHistoryDays",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateSetWIPLimitPolicyRequest(req *pb_board.SetWIPLimitPolicyRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if !wiplimitpolicies.IsValid(req.WipLimitPolicy) {
+		fieldErrors["WIPLimitPolicy"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "WIPLimitPolicy must be refuse or warn",
+			Field:   "WIPLimitPolicy",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateExportBoardRequest(req *pb_board.ExportBoardRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if !exportformats.IsValid(req.Format) {
+		fieldErrors["Format"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "Format must be json, csv or markdown",
+			Field:   "Format",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateGetBoardExportRequest(req *pb_board.GetBoardExportRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.ExportID == 0 {
+		fieldErrors["ExportID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "ExportID is required",
+			Field:   "ExportID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
 func validateChangeBoardOwnerRequest(req *pb_board.ChangeBoardOwnerRequest) error {
 	fieldErrors := make(map[string]errorhandlers.FieldError)
 
@@ -202,3 +320,212 @@ func validateDeleteBoardRequest(_ *pb_board.DeleteBoardRequest) error {
 	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
 
 }
+
+const maxCustomFieldOptions = 50
+
+func validateAddCustomFieldRequest(req *pb_board.AddCustomFieldRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.Name == "" {
+		fieldErrors["Name"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "Name is required",
+			Field:   "Name",
+		}
+	} else if len(req.Name) > 50 {
+		fieldErrors["Name"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "Name cannot be longer than 50 characters",
+			Field:   "Name",
+		}
+	}
+
+	if !customfieldtypes.IsValid(req.Type) {
+		fieldErrors["Type"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "Type must be text, number, date, checkbox or select",
+			Field:   "Type",
+		}
+	} else if req.Type == customfieldtypes.Select {
+		validateCustomFieldOptions(req.Options, fieldErrors)
+	} else if len(req.Options) > 0 {
+		fieldErrors["Options"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrNotAllowed,
+			Message: "Only select fields have options",
+			Field:   "Options",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateUpdateCustomFieldRequest(req *pb_board.UpdateCustomFieldRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CustomFieldID == 0 {
+		fieldErrors["CustomFieldID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CustomFieldID is required",
+			Field:   "CustomFieldID",
+		}
+	}
+
+	if req.Name == "" {
+		fieldErrors["Name"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "Name is required",
+			Field:   "Name",
+		}
+	} else if len(req.Name) > 50 {
+		fieldErrors["Name"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "Name cannot be longer than 50 characters",
+			Field:   "Name",
+		}
+	}
+
+	if len(req.Options) > 0 {
+		validateCustomFieldOptions(req.Options, fieldErrors)
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateRemoveCustomFieldRequest(req *pb_board.RemoveCustomFieldRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CustomFieldID == 0 {
+		fieldErrors["CustomFieldID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CustomFieldID is required",
+			Field:   "CustomFieldID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateCustomFieldOptions(options []*pb_board.CustomFieldOption, fieldErrors map[string]errorhandlers.FieldError) {
+	if len(options) == 0 {
+		fieldErrors["Options"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "Select fields need at least one option",
+			Field:   "Options",
+		}
+		return
+	}
+
+	if len(options) > maxCustomFieldOptions {
+		fieldErrors["Options"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "A select field cannot have more than 50 options",
+			Field:   "Options",
+		}
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, option := range options {
+		if option.Value == "" || len(option.Value) > 50 {
+			fieldErrors["Options"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrInvalid,
+				Message: "Option values must be between 1 and 50 characters",
+				Field:   "Options",
+			}
+			return
+		}
+		if seen[option.Value] {
+			fieldErrors["Options"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrExists,
+				Message: "Option values must be unique",
+				Field:   "Options",
+			}
+			return
+		}
+		seen[option.Value] = true
+	}
+}
+
+func validateGetTrashRequest(req *pb_board.GetTrashRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.PageSize > 100 {
+		fieldErrors["PageSize"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrOutOfRange,
+			Message: "PageSize must be at most 100",
+			Field:   "PageSize",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateRestoreTrashItemRequest(req *pb_board.RestoreTrashItemRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if !trashitemtypes.IsValid(req.Type) {
+		fieldErrors["Type"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "Type must be board, list or card",
+			Field:   "Type",
+		}
+	}
+
+	if req.Id == 0 {
+		fieldErrors["ID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "ID is required",
+			Field:   "ID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateUndoRequest(_ *pb_board.UndoRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateImportBoardRequest(req *pb_board.ImportBoardRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if !importsources.IsValid(req.Source) {
+		fieldErrors["Source"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "Source must be trello",
+			Field:   "Source",
+		}
+	}
+
+	if len(req.Content) == 0 {
+		fieldErrors["Content"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "Content is required",
+			Field:   "Content",
+		}
+	} else if len(req.Content) > importer.MaxContentSize {
+		fieldErrors["Content"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "Content must be at most 32 MB",
+			Field:   "Content",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateGetBoardImportRequest(req *pb_board.GetBoardImportRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.ImportID == 0 {
+		fieldErrors["ImportID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "ImportID is required",
+			Field:   "ImportID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
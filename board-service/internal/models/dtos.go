@@ -5,16 +5,21 @@ import (
 )
 
 type BoardDTO struct {
-	ID         uint64
-	Name       string
-	Visibility string
-	IsArchived bool
-	Labels     []*LabelDTO
-	Members    []*BoardMemberDTO
-	Lists      []*ListMetaDTO
-	Cards      []*CardMetaDTO
-	CreatedAt  time.Time
-	UpdatedAt  time.Time
+	ID               uint64
+	Name             string
+	Visibility       string
+	IsArchived       bool
+	Version          uint64
+	CardHistoryLimit int
+	CardHistoryDays  int
+	WIPLimitPolicy   string
+	Labels           []*LabelDTO
+	CustomFields     []*CustomFieldDTO
+	Members          []*BoardMemberDTO
+	Lists            []*ListMetaDTO
+	Cards            []*CardMetaDTO
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 type BoardMetaDTO struct {
@@ -22,10 +27,61 @@ type BoardMetaDTO struct {
 	Name       string
 	Visibility string
 	IsArchived bool
+	Version    uint64
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
 
+// TrashItemDTO is a deleted board, list or card. Items deleted together with their parent are not
+// listed on their own.
+type TrashItemDTO struct {
+	Type           string
+	ID             uint64
+	BoardID        uint64
+	Name           string
+	DeletedAt      time.Time
+	DeletionStatus string // Set while a board is being deleted for good
+	DeletionError  string
+}
+
+// UndoneActionDTO describes an action that has been undone
+type UndoneActionDTO struct {
+	BoardID    uint64
+	EntityType string
+	EntityID   uint64
+	Action     string
+}
+
+// BoardExportDTO is the state of a board export
+type BoardExportDTO struct {
+	ID          uint64
+	BoardID     uint64
+	UserID      uint64
+	Format      string
+	Status      string
+	Progress    int
+	FileName    string
+	FilePath    string
+	Error       string
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+}
+
+// BoardImportDTO is the state of a board import
+type BoardImportDTO struct {
+	ID          uint64
+	UserID      uint64
+	BoardID     *uint64
+	Source      string
+	DryRun      bool
+	Status      string
+	Content     []byte // Only loaded when the import is started
+	Report      string
+	Error       string
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+}
+
 type LabelDTO struct {
 	ID      uint64
 	BoardID uint64
@@ -33,6 +89,21 @@ type LabelDTO struct {
 	Color   string
 }
 
+type CustomFieldDTO struct {
+	ID       uint64
+	BoardID  uint64
+	Name     string
+	Type     string
+	Position int64
+	Options  []*CustomFieldOptionDTO
+}
+
+type CustomFieldOptionDTO struct {
+	ID    uint64
+	Value string
+	Color string
+}
+
 type BoardMemberDTO struct {
 	ID       uint64
 	Username string
@@ -1,18 +1,31 @@
 package repositories
 
 import (
+	"database/sql"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	dtos "github.com/sm888sm/halten-backend/board-service/internal/models"
+	"github.com/sm888sm/halten-backend/common/constants/customfieldtypes"
+	"github.com/sm888sm/halten-backend/common/constants/deletionstatuses"
+	"github.com/sm888sm/halten-backend/common/constants/deletionsteps"
+	"github.com/sm888sm/halten-backend/common/constants/exportstatuses"
+	"github.com/sm888sm/halten-backend/common/constants/importstatuses"
 	"github.com/sm888sm/halten-backend/common/constants/roles"
+	"github.com/sm888sm/halten-backend/common/constants/trashitemtypes"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
 	"github.com/sm888sm/halten-backend/common/helpers"
+	"github.com/sm888sm/halten-backend/common/transactions"
+	"github.com/sm888sm/halten-backend/common/undo"
 	"github.com/sm888sm/halten-backend/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type GormBoardRepository struct {
@@ -41,6 +54,12 @@ func (r *GormBoardRepository) CreateBoard(req *CreateBoardRequest) (*CreateBoard
 			return errorhandlers.NewGrpcInternalError()
 		}
 
+		if req.Created != nil {
+			if err := req.Created(tx, &board); err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+		}
+
 		return nil
 	})
 
@@ -57,13 +76,19 @@ func (r *GormBoardRepository) GetBoardByID(req *GetBoardByIDRequest) (*GetBoardB
 
 	err := r.db.Transaction(func(tx *gorm.DB) error {
 		if err := tx.
-			Select("ID", "Name", "Visibility", "IsArchived").
+			Select("ID", "Name", "Visibility", "IsArchived", "Version", "CardHistoryLimit", "CardHistoryDays", "WIPLimitPolicy").
 			Preload("Labels", func(db *gorm.DB) *gorm.DB {
 				return db.Select("ID", "Name", "Color")
 			}).
 			Preload("Members", func(db *gorm.DB) *gorm.DB {
 				return db.Select("ID", "Role")
 			}).
+			Preload("CustomFields", func(db *gorm.DB) *gorm.DB {
+				return db.Order("position")
+			}).
+			Preload("CustomFields.Options", func(db *gorm.DB) *gorm.DB {
+				return db.Order("position")
+			}).
 			First(&board, req.BoardID).Error; err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				return errorhandlers.NewGrpcNotFoundError("Board not found")
@@ -76,6 +101,10 @@ func (r *GormBoardRepository) GetBoardByID(req *GetBoardByIDRequest) (*GetBoardB
 		boardDTO.Name = board.Name
 		boardDTO.Visibility = board.Visibility
 		boardDTO.IsArchived = board.IsArchived
+		boardDTO.Version = board.Version
+		boardDTO.CardHistoryLimit = board.CardHistoryLimit
+		boardDTO.CardHistoryDays = board.CardHistoryDays
+		boardDTO.WIPLimitPolicy = board.WIPLimitPolicy
 
 		// Map the labels
 		for _, label := range board.Labels {
@@ -86,6 +115,11 @@ func (r *GormBoardRepository) GetBoardByID(req *GetBoardByIDRequest) (*GetBoardB
 			})
 		}
 
+		// Map the custom fields
+		for i := range board.CustomFields {
+			boardDTO.CustomFields = append(boardDTO.CustomFields, convertCustomFieldToDTO(&board.CustomFields[i]))
+		}
+
 		// Map the members
 		for _, member := range board.Members {
 			var user models.User
@@ -120,7 +154,7 @@ func (r *GormBoardRepository) GetBoardList(req *GetBoardListRequest) (*GetBoardL
 	offset := (pageNumber - 1) * pageSize
 
 	err := r.db.Transaction(func(tx *gorm.DB) error {
-		err := tx.Select("boards.id, boards.created_at, boards.updated_at, boards.name, boards.visibility").
+		err := tx.Select("boards.id, boards.created_at, boards.updated_at, boards.name, boards.visibility, boards.version").
 			Joins("JOIN board_members ON board_members.board_id = boards.id").
 			Where("board_members.user_id = ? AND boards.is_archived = ?", req.UserID, false).
 			Offset(offset).Limit(pageSize).
@@ -142,6 +176,9 @@ func (r *GormBoardRepository) GetBoardList(req *GetBoardListRequest) (*GetBoardL
 				Name:       board.Name,
 				Visibility: board.Visibility,
 				IsArchived: board.IsArchived,
+				Version:    board.Version,
+				CreatedAt:  board.CreatedAt,
+				UpdatedAt:  board.UpdatedAt,
 			})
 		}
 
@@ -180,12 +217,13 @@ func (r *GormBoardRepository) GetBoardList(req *GetBoardListRequest) (*GetBoardL
 
 func (r *GormBoardRepository) UpdateBoardName(req *UpdateBoardNameRequest) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		result := tx.Model(&models.Board{}).Where("id = ?", req.BoardID).Update("name", req.Name)
-		if result.Error != nil {
-			return errorhandlers.NewGrpcInternalError()
+		board, err := r.lockBoard(tx, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
 		}
-		if result.RowsAffected == 0 {
-			return errorhandlers.NewGrpcNotFoundError("Board not found")
+
+		if err := tx.Model(board).Updates(map[string]interface{}{"name": req.Name, "version": board.Version + 1}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
 		}
 		return nil
 	})
@@ -376,12 +414,9 @@ func (r *GormBoardRepository) ChangeBoardOwner(req *ChangeBoardOwnerRequest) err
 func (r *GormBoardRepository) ChangeBoardVisibility(req *ChangeBoardVisibilityRequest) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
 		// Check if the board exists
-		var board models.Board
-		if err := tx.First(&board, req.BoardID).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errorhandlers.NewGrpcNotFoundError("Board not found")
-			}
-			return errorhandlers.NewGrpcInternalError()
+		board, err := r.lockBoard(tx, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
 		}
 
 		// Validate the visibility value
@@ -391,12 +426,47 @@ func (r *GormBoardRepository) ChangeBoardVisibility(req *ChangeBoardVisibilityRe
 		}
 
 		// Update the visibility of the board
-		result := tx.Model(&models.Board{}).Where("id = ?", req.BoardID).Update("visibility", req.Visibility)
-		if result.Error != nil {
+		if err := tx.Model(board).Updates(map[string]interface{}{"visibility": req.Visibility, "version": board.Version + 1}).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
-		if result.RowsAffected == 0 {
-			return errorhandlers.NewGrpcNotFoundError("Board not found")
+		return nil
+	})
+}
+
+// SetCardHistoryRetention changes how much history the cards of the board keep. Versions beyond
+// the new retention are dropped by the card service.
+func (r *GormBoardRepository) SetCardHistoryRetention(req *SetCardHistoryRetentionRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		board, err := r.lockBoard(tx, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(board).Updates(map[string]interface{}{
+			"card_history_limit": req.CardHistoryLimit,
+			"card_history_days":  req.CardHistoryDays,
+			"version":            board.Version + 1,
+		}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		return nil
+	})
+}
+
+// SetWIPLimitPolicy changes whether cards added to a list at its WIP limit are refused or
+// accepted with a warning.
+func (r *GormBoardRepository) SetWIPLimitPolicy(req *SetWIPLimitPolicyRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		board, err := r.lockBoard(tx, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(board).Updates(map[string]interface{}{
+			"wip_limit_policy": req.WIPLimitPolicy,
+			"version":          board.Version + 1,
+		}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
 		}
 		return nil
 	})
@@ -456,7 +526,7 @@ func (r *GormBoardRepository) GetArchivedBoardList(req *GetArchivedBoardListRequ
 	offset := (pageNumber - 1) * pageSize
 
 	err := r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Select("boards.id, boards.created_at, boards.updated_at, boards.name, boards.visibility").
+		if err := tx.Select("boards.id, boards.created_at, boards.updated_at, boards.name, boards.visibility, boards.version").
 			Joins("JOIN board_members ON board_members.board_id = boards.id").
 			Where("board_members.user_id = ? AND boards.is_archived = ?", req.UserID, true).
 			Offset(offset).Limit(pageSize).
@@ -474,6 +544,9 @@ func (r *GormBoardRepository) GetArchivedBoardList(req *GetArchivedBoardListRequ
 				Name:       board.Name,
 				Visibility: board.Visibility,
 				IsArchived: board.IsArchived,
+				Version:    board.Version,
+				CreatedAt:  board.CreatedAt,
+				UpdatedAt:  board.UpdatedAt,
 			})
 		}
 
@@ -508,39 +581,121 @@ func (r *GormBoardRepository) GetArchivedBoardList(req *GetArchivedBoardListRequ
 }
 
 func (r *GormBoardRepository) ArchiveBoard(req *ArchiveBoardRequest) error {
-	// Update the 'archived' field of the board to true
-	if err := r.db.Model(&models.Board{}).Where("id = ?", req.BoardID).Update("archived", true).Error; err != nil {
-		return errorhandlers.NewGrpcInternalError()
-	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		board, err := r.lockBoard(tx, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
 
-	return nil
+		// Update the 'is_archived' field of the board to true
+		version := board.Version + 1
+		if err := tx.Model(board).Updates(map[string]interface{}{"is_archived": true, "version": version}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    board.ID,
+			EntityType: undo.EntityBoard,
+			EntityID:   board.ID,
+			Action:     undo.ActionArchive,
+			Version:    version,
+		})
+	})
 }
 
 func (r *GormBoardRepository) RestoreBoard(req *RestoreBoardRequest) error {
-	// Update the 'archived' field of the board to false
-	if err := r.db.Model(&models.Board{}).Where("id = ?", req.BoardID).Update("archived", false).Error; err != nil {
-		return errorhandlers.NewGrpcInternalError()
-	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		board, err := r.lockBoard(tx, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
 
-	return nil
+		// Update the 'is_archived' field of the board to false
+		version := board.Version + 1
+		if err := tx.Model(board).Updates(map[string]interface{}{"is_archived": false, "version": version}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    board.ID,
+			EntityType: undo.EntityBoard,
+			EntityID:   board.ID,
+			Action:     undo.ActionRestore,
+			Version:    version,
+		})
+	})
 }
 
 func (r *GormBoardRepository) DeleteBoard(req *DeleteBoardRequest) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
-		result := tx.Where("id = ? AND is_archived = ?", req.BoardID, true).Delete(&models.Board{})
+	return transactions.Join(r.db, req.Tx, func(tx *gorm.DB) error {
+		// Lists and cards share the board's deletion time so restoring the board brings back exactly
+		// what was deleted with it
+		now := undo.Timestamp()
+		result := tx.Model(&models.Board{}).Where("id = ? AND is_archived = ?", req.BoardID, true).UpdateColumn("deleted_at", now)
 		if result.Error != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
 		if result.RowsAffected == 0 {
 			return errorhandlers.NewGrpcNotFoundError("Board not found or not archived")
 		}
-		return nil
+
+		if err := tx.Model(&models.List{}).Where("board_id = ?", req.BoardID).UpdateColumn("deleted_at", now).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if err := tx.Model(&models.Card{}).Where("board_id = ?", req.BoardID).UpdateColumn("deleted_at", now).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    req.BoardID,
+			EntityType: undo.EntityBoard,
+			EntityID:   req.BoardID,
+			Action:     undo.ActionDelete,
+			Inverse:    &undo.Delete{DeletedAt: now},
+		})
+	})
+}
+
+// DiscardBoard deletes for good a board whose creation failed, with the lists and cards created on
+// it. The board skips the trash and is deleted right away. A board already being deleted is left
+// as it is.
+func (r *GormBoardRepository) DiscardBoard(req *DiscardBoardRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var board models.Board
+		if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).First(&board, req.BoardID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		var deletions int64
+		if err := tx.Model(&models.BoardDeletion{}).Where("board_id = ?", req.BoardID).Count(&deletions).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if deletions > 0 {
+			return nil
+		}
+
+		now := time.Now()
+		if err := tx.Unscoped().Model(&models.Board{}).Where("id = ?", req.BoardID).UpdateColumn("deleted_at", now).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if err := tx.Model(&models.List{}).Where("board_id = ?", req.BoardID).UpdateColumn("deleted_at", now).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if err := tx.Model(&models.Card{}).Where("board_id = ?", req.BoardID).UpdateColumn("deleted_at", now).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return startBoardDeletions(tx, []uint64{req.BoardID}, req.Cleanup)
 	})
 }
 
 func (r *GormBoardRepository) GetBoardIDByList(req *GetBoardIDByListRequest) (uint64, error) {
 	var list models.List
-	err := r.db.First(&list, req.ListID).Error
+	// Deleted lists are included so items in the trash can still be resolved to their board
+	err := r.db.Unscoped().First(&list, req.ListID).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return 0, errorhandlers.NewGrpcNotFoundError("List not found")
@@ -552,7 +707,7 @@ func (r *GormBoardRepository) GetBoardIDByList(req *GetBoardIDByListRequest) (ui
 
 func (r *GormBoardRepository) GetBoardIDByCard(req *GetBoardIDByCardRequest) (uint64, error) {
 	var card models.Card
-	err := r.db.First(&card, req.CardID).Error
+	err := r.db.Unscoped().First(&card, req.CardID).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return 0, errorhandlers.NewGrpcNotFoundError("Card not found")
@@ -561,3 +716,786 @@ func (r *GormBoardRepository) GetBoardIDByCard(req *GetBoardIDByCardRequest) (ui
 	}
 	return card.BoardID, nil
 }
+
+func (r *GormBoardRepository) AddCustomField(req *AddCustomFieldRequest) (*dtos.CustomFieldDTO, error) {
+	var customField models.CustomField
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var maxPosition sql.NullInt64
+		if err := tx.Model(&models.CustomField{}).Where("board_id = ?", req.BoardID).Select("max(position)").Row().Scan(&maxPosition); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		customField = models.CustomField{
+			BoardID:  req.BoardID,
+			Name:     req.Name,
+			Type:     req.Type,
+			Position: maxPosition.Int64 + 1,
+		}
+
+		if err := tx.Create(&customField).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		for i, option := range req.Options {
+			customFieldOption := models.CustomFieldOption{
+				CustomFieldID: customField.ID,
+				Value:         option.Value,
+				Color:         option.Color,
+				Position:      int64(i + 1),
+			}
+			if err := tx.Create(&customFieldOption).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+			customField.Options = append(customField.Options, customFieldOption)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return convertCustomFieldToDTO(&customField), nil
+}
+
+func (r *GormBoardRepository) UpdateCustomField(req *UpdateCustomFieldRequest) (*dtos.CustomFieldDTO, error) {
+	var customField models.CustomField
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Preload("Options").Where("id = ? AND board_id = ?", req.CustomFieldID, req.BoardID).First(&customField).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorhandlers.NewGrpcNotFoundError("Custom field not found")
+			}
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		customField.Name = req.Name
+		if err := tx.Omit("Options").Save(&customField).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if customField.Type != customfieldtypes.Select {
+			return nil
+		}
+
+		existingOptions := make(map[uint64]models.CustomFieldOption)
+		for _, option := range customField.Options {
+			existingOptions[option.ID] = option
+		}
+
+		var options []models.CustomFieldOption
+		for i, option := range req.Options {
+			customFieldOption := models.CustomFieldOption{
+				CustomFieldID: customField.ID,
+				Value:         option.Value,
+				Color:         option.Color,
+				Position:      int64(i + 1),
+			}
+
+			if option.ID != 0 {
+				existingOption, ok := existingOptions[option.ID]
+				if !ok {
+					return errorhandlers.NewGrpcNotFoundError("Custom field option not found")
+				}
+				customFieldOption.BaseModel = existingOption.BaseModel
+				delete(existingOptions, option.ID)
+			}
+
+			if err := tx.Save(&customFieldOption).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+			options = append(options, customFieldOption)
+		}
+
+		// Clear the card values pointing to removed options before removing them
+		for optionID := range existingOptions {
+			if err := tx.Unscoped().Where("option_id = ?", optionID).Delete(&models.CardCustomFieldValue{}).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+			if err := tx.Delete(&models.CustomFieldOption{}, optionID).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+		}
+
+		customField.Options = options
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return convertCustomFieldToDTO(&customField), nil
+}
+
+func (r *GormBoardRepository) RemoveCustomField(req *RemoveCustomFieldRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var customField models.CustomField
+		if err := tx.Where("id = ? AND board_id = ?", req.CustomFieldID, req.BoardID).First(&customField).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorhandlers.NewGrpcNotFoundError("Custom field not found")
+			}
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		// Remove the field's values from all cards
+		if err := tx.Unscoped().Where("custom_field_id = ?", customField.ID).Delete(&models.CardCustomFieldValue{}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := tx.Where("custom_field_id = ?", customField.ID).Delete(&models.CustomFieldOption{}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := tx.Delete(&customField).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+}
+
+// trashQuery selects the deleted boards, lists and cards of the boards the user is a member of.
+// Lists and cards deleted together with their board or list share its deletion time and are left
+// out, as restoring the parent brings them back. Boards being deleted for good carry the status of
+// their deletion.
+const trashQuery = `
+	SELECT 'board' AS type, b.id, b.id AS board_id, b.name, b.deleted_at,
+		COALESCE(d.status, '') AS deletion_status, COALESCE(d.last_error, '') AS deletion_error
+	FROM boards b
+	JOIN board_members m ON m.board_id = b.id AND m.user_id = @user AND m.deleted_at IS NULL
+	LEFT JOIN board_deletions d ON d.board_id = b.id
+	WHERE b.deleted_at IS NOT NULL
+	UNION ALL
+	SELECT 'list' AS type, l.id, l.board_id, l.name, l.deleted_at, '', 
+	FROM lists l
+	JOIN boards b ON b.id = l.board_id
+	JOIN board_members m ON m.board_id = l.board_id AND m.user_id = @user AND m.deleted_at IS NULL
+	WHERE l.deleted_at IS NOT NULL AND (b.deleted_at IS NULL OR b.deleted_at <> l.deleted_at)
+	UNION ALL
+	SELECT 'card' AS type, c.id, c.board_id, c.name, c.deleted_at, '', ''
+	FROM cards c
+	JOIN lists l ON l.id = c.list_id
+	JOIN boards b ON b.id = c.board_id
+	JOIN board_members m ON m.board_id = c.board_id AND m.user_id = @user AND m.deleted_at IS NULL
+	WHERE c.deleted_at IS NOT NULL
+		AND (l.deleted_at IS NULL OR l.deleted_at <> c.deleted_at)
+		AND (b.deleted_at IS NULL OR b.deleted_at <> c.deleted_at)`
+
+func (r *GormBoardRepository) GetTrash(req *GetTrashRequest) (*GetTrashResponse, error) {
+	var items []*dtos.TrashItemDTO
+	var totalItems int64
+
+	offset := (req.PageNumber - 1) * req.PageSize
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Raw("SELECT * FROM ("+trashQuery+") trash ORDER BY deleted_at DESC, type, id LIMIT @limit OFFSET @offset",
+			sql.Named("user", req.UserID), sql.Named("limit", req.PageSize), sql.Named("offset", offset)).
+			Scan(&items).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := tx.Raw("SELECT COUNT(*) FROM ("+trashQuery+") trash", sql.Named("user", req.UserID)).
+			Scan(&totalItems).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := dtos.Pagination{
+		CurrentPage:  req.PageNumber,
+		TotalPages:   (uint64(totalItems) + req.PageSize - 1) / req.PageSize,
+		ItemsPerPage: req.PageSize,
+		TotalItems:   uint64(totalItems),
+		HasMore:      req.PageNumber*req.PageSize < uint64(totalItems),
+	}
+
+	return &GetTrashResponse{
+		Items:      items,
+		Pagination: &pagination,
+	}, nil
+}
+
+func (r *GormBoardRepository) RestoreTrashItem(req *RestoreTrashItemRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		switch req.Type {
+		case trashitemtypes.Board:
+			return r.restoreBoard(tx, req.ID, req.UserID)
+		case trashitemtypes.List:
+			return r.restoreList(tx, req.ID, req.BoardID)
+		case trashitemtypes.Card:
+			return r.restoreCard(tx, req.ID, req.BoardID)
+		}
+		return errorhandlers.NewGrpcBadRequestError("Invalid trash item type")
+	})
+}
+
+func (r *GormBoardRepository) PurgeTrash(req *PurgeTrashRequest) (*PurgeTrashResponse, error) {
+	res := &PurgeTrashResponse{}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var boardIDs, listIDs, cardIDs []uint64
+
+		// The lists and cards of deleted boards go with their board, step by step, even when they were
+		// deleted on their own
+		if err := tx.Unscoped().Model(&models.Board{}).Where("deleted_at < ? AND id NOT IN (SELECT board_id FROM board_deletions)", req.DeletedBefore).Pluck("id", &boardIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if err := tx.Unscoped().Model(&models.List{}).Where("deleted_at < ? AND board_id NOT IN (SELECT id FROM boards WHERE deleted_at IS NOT NULL)", req.DeletedBefore).Pluck("id", &listIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if err := tx.Unscoped().Model(&models.Card{}).Where("(deleted_at < ? OR list_id IN ?) AND board_id NOT IN (SELECT id FROM boards WHERE deleted_at IS NOT NULL)", req.DeletedBefore, listIDs).Pluck("id", &cardIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if len(boardIDs) == 0 && len(listIDs) == 0 && len(cardIDs) == 0 {
+			return nil
+		}
+
+		attachmentPaths, err := purgeCards(tx, cardIDs)
+		if err != nil {
+			return err
+		}
+		if err := purgeLists(tx, listIDs); err != nil {
+			return err
+		}
+
+		if err := startBoardDeletions(tx, boardIDs, req.Cleanup); err != nil {
+			return err
+		}
+
+		res.Boards, res.Lists, res.Cards = int64(len(boardIDs)), int64(len(listIDs)), int64(len(cardIDs))
+		res.AttachmentPaths = attachmentPaths
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CompleteBoardCleanup moves the deletion of a board past the step a service confirmed, requesting
+// the next one. Once every step is confirmed the board's own rows are deleted, and it returns true.
+func (r *GormBoardRepository) CompleteBoardCleanup(req *CompleteBoardCleanupRequest) (bool, error) {
+	completed := false
+
+	err := transactions.Join(r.db, req.Tx, func(tx *gorm.DB) error {
+		var deletion models.BoardDeletion
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("board_id = ?", req.BoardID).First(&deletion).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				// Confirmed again after the board was deleted
+				return nil
+			}
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		// A step requested again is confirmed again
+		if deletion.Step != req.Step {
+			return nil
+		}
+
+		switch deletion.Step {
+		case deletionsteps.Cards:
+			// A failed deletion carries on when its confirmation arrives late, such as when its
+			// dead letter is replayed
+			if err := tx.Model(&deletion).Updates(map[string]interface{}{
+				"status":          deletionstatuses.Deleting,
+				"step":            deletionsteps.Lists,
+				"attempts":        1,
+				"last_error":      "",
+				"step_started_at": time.Now(),
+			}).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+
+			if err := req.Cleanup(deletionsteps.Lists).Write(tx, deletion.BoardID); err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+
+		case deletionsteps.Lists:
+			if err := purgeBoards(tx, []uint64{deletion.BoardID}); err != nil {
+				return err
+			}
+			if err := tx.Delete(&deletion).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+			completed = true
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return completed, nil
+}
+
+// RetryBoardDeletions requests again the steps that weren't confirmed in time. A deletion whose step
+// was requested MaxAttempts times fails instead, and stays in the trash until the step is confirmed.
+func (r *GormBoardRepository) RetryBoardDeletions(req *RetryBoardDeletionsRequest) (*RetryBoardDeletionsResponse, error) {
+	res := &RetryBoardDeletionsResponse{}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var deletions []*models.BoardDeletion
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND step_started_at < ?", deletionstatuses.Deleting, req.StartedBefore).
+			Find(&deletions).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		now := time.Now()
+		for _, deletion := range deletions {
+			if deletion.Attempts >= req.MaxAttempts {
+				deletion.Status = deletionstatuses.Failed
+				deletion.LastError = fmt.Sprintf("The %s step wasn't confirmed after %d attempts", deletion.Step, deletion.Attempts)
+				if err := tx.Model(deletion).Updates(map[string]interface{}{
+					"status":     deletion.Status,
+					"last_error": deletion.LastError,
+				}).Error; err != nil {
+					return errorhandlers.NewGrpcInternalError()
+				}
+
+				res.Failed = append(res.Failed, deletion)
+				continue
+			}
+
+			deletion.Attempts++
+			deletion.StepStartedAt = now
+			if err := tx.Model(deletion).Updates(map[string]interface{}{
+				"attempts":        deletion.Attempts,
+				"step_started_at": deletion.StepStartedAt,
+			}).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+
+			if err := req.Cleanup(deletion.Step).Write(tx, deletion.BoardID); err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+			res.Retried = append(res.Retried, deletion)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *GormBoardRepository) UndoLastAction(req *UndoLastActionRequest) (*UndoLastActionResponse, error) {
+	var entry models.UndoEntry
+	var conflict error
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND session_id = ? AND created_at >= ?", req.UserID, req.SessionID, req.Since).
+			Order("id DESC").
+			First(&entry).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorhandlers.NewGrpcNotFoundError("Nothing to undo")
+			}
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := r.applyUndo(tx, &entry, req.UserID); err != nil {
+			if status.Code(err) != codes.Aborted {
+				return err
+			}
+			// A conflicting entry can never be applied, so it is dropped to let the next undo reach
+			// the actions before it
+			conflict = err
+		}
+
+		if err := tx.Unscoped().Delete(&entry).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if conflict != nil {
+		return nil, conflict
+	}
+
+	return &UndoLastActionResponse{
+		Action: &dtos.UndoneActionDTO{
+			BoardID:    entry.BoardID,
+			EntityType: entry.EntityType,
+			EntityID:   entry.EntityID,
+			Action:     entry.Action,
+		},
+	}, nil
+}
+
+func (r *GormBoardRepository) PruneUndoLog(req *PruneUndoLogRequest) (int64, error) {
+	result := r.db.Unscoped().Where("created_at < ?", req.CreatedBefore).Delete(&models.UndoEntry{})
+	if result.Error != nil {
+		return 0, errorhandlers.NewGrpcInternalError()
+	}
+
+	return result.RowsAffected, nil
+}
+
+func (r *GormBoardRepository) CreateBoardExport(req *CreateBoardExportRequest) (*dtos.BoardExportDTO, error) {
+	boardExport := &models.BoardExport{
+		BoardID: req.BoardID,
+		UserID:  req.UserID,
+		Format:  req.Format,
+		Status:  exportstatuses.Pending,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(boardExport).Error; err != nil {
+			return err
+		}
+
+		return req.Event.Write(tx, boardExport.ID)
+	})
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return convertBoardExportToDTO(boardExport), nil
+}
+
+func (r *GormBoardRepository) GetBoardExport(req *GetBoardExportRequest) (*dtos.BoardExportDTO, error) {
+	var boardExport models.BoardExport
+	if err := r.db.Where("id = ? AND board_id = ?", req.ExportID, req.BoardID).First(&boardExport).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorhandlers.NewGrpcNotFoundError("Export not found")
+		}
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return convertBoardExportToDTO(&boardExport), nil
+}
+
+// StartBoardExport claims a pending export for generation. An export delivered again after it was
+// claimed is refused, so it's only generated once.
+func (r *GormBoardRepository) StartBoardExport(exportID uint64) (*dtos.BoardExportDTO, error) {
+	var boardExport models.BoardExport
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.BoardExport{}).
+			Where("id = ? AND status = ?", exportID, exportstatuses.Pending).
+			Update("status", exportstatuses.Running)
+		if result.Error != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if result.RowsAffected == 0 {
+			return errorhandlers.NewGrpcFailedPreconditionError("Export not found or already started")
+		}
+
+		if err := tx.First(&boardExport, exportID).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return convertBoardExportToDTO(&boardExport), nil
+}
+
+// UpdateBoardExport records the progress of an export. Completed exports get their completion time.
+func (r *GormBoardRepository) UpdateBoardExport(req *UpdateBoardExportRequest) error {
+	updates := map[string]interface{}{
+		"status":    req.Status,
+		"progress":  req.Progress,
+		"file_name": req.FileName,
+		"file_path": req.FilePath,
+		"error":     req.Error,
+	}
+	if req.Status == exportstatuses.Completed || req.Status == exportstatuses.Failed {
+		updates["completed_at"] = time.Now()
+	}
+
+	if err := r.db.Model(&models.BoardExport{}).Where("id = ?", req.ExportID).Updates(updates).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	return nil
+}
+
+func (r *GormBoardRepository) GetBoardExportContent(boardID uint64) (*GetBoardExportContentResponse, error) {
+	var res GetBoardExportContentResponse
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var board models.Board
+		if err := tx.
+			Preload("Members").
+			Preload("Labels").
+			Preload("CustomFields", func(db *gorm.DB) *gorm.DB {
+				return db.Order("position")
+			}).
+			Preload("CustomFields.Options", func(db *gorm.DB) *gorm.DB {
+				return db.Order("position")
+			}).
+			First(&board, boardID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorhandlers.NewGrpcNotFoundError("Board not found")
+			}
+			return errorhandlers.NewGrpcInternalError()
+		}
+		res.Board = &board
+
+		if err := tx.Where("board_id = ?", boardID).Order("position, id").Find(&res.Lists).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		userIDs := tx.Model(&models.BoardMember{}).Select("user_id").Where("board_id = ?", boardID)
+		if err := tx.Select("id", "username", "fullname").Where("id IN (?)", userIDs).Find(&res.Users).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (r *GormBoardRepository) GetBoardExportCards(req *GetBoardExportCardsRequest) (*GetBoardExportCardsResponse, error) {
+	var res GetBoardExportCardsResponse
+
+	if err := r.db.
+		Preload("Labels").
+		Preload("Members").
+		Preload("CustomFieldValues").
+		Preload("Attachments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at")
+		}).
+		Preload("Comments", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at")
+		}).
+		Preload("Comments.User", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "username")
+		}).
+		Where("list_id = ?", req.ListID).
+		Order("position, id").
+		Find(&res.Cards).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return &res, nil
+}
+
+// PruneBoardExports deletes exports created before the cutoff and returns the paths of their files,
+// to be removed by the caller
+func (r *GormBoardRepository) PruneBoardExports(req *PruneBoardExportsRequest) ([]string, error) {
+	var filePaths []string
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var boardExports []models.BoardExport
+		if err := tx.Unscoped().Clauses(clause.Returning{Columns: []clause.Column{{Name: "file_path"}}}).
+			Where("created_at < ?", req.CreatedBefore).
+			Delete(&boardExports).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		for _, boardExport := range boardExports {
+			if boardExport.FilePath != "" {
+				filePaths = append(filePaths, boardExport.FilePath)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return filePaths, nil
+}
+
+func (r *GormBoardRepository) CreateBoardImport(req *CreateBoardImportRequest) (*dtos.BoardImportDTO, error) {
+	boardImport := &models.BoardImport{
+		UserID:  req.UserID,
+		Source:  req.Source,
+		DryRun:  req.DryRun,
+		Status:  importstatuses.Pending,
+		Content: req.Content,
+	}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(boardImport).Error; err != nil {
+			return err
+		}
+
+		return req.Event.Write(tx, boardImport.ID)
+	})
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return convertBoardImportToDTO(boardImport), nil
+}
+
+func (r *GormBoardRepository) GetBoardImport(req *GetBoardImportRequest) (*dtos.BoardImportDTO, error) {
+	var boardImport models.BoardImport
+	if err := r.db.Omit("content").Where("id = ? AND user_id = ?", req.ImportID, req.UserID).First(&boardImport).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorhandlers.NewGrpcNotFoundError("Import not found")
+		}
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return convertBoardImportToDTO(&boardImport), nil
+}
+
+// StartBoardImport claims a pending import and returns it with the uploaded file. An import
+// delivered again after it was claimed is refused, so it only runs once.
+func (r *GormBoardRepository) StartBoardImport(importID uint64) (*dtos.BoardImportDTO, error) {
+	var boardImport models.BoardImport
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.BoardImport{}).
+			Where("id = ? AND status = ?", importID, importstatuses.Pending).
+			Update("status", importstatuses.Running)
+		if result.Error != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if result.RowsAffected == 0 {
+			return errorhandlers.NewGrpcFailedPreconditionError("Import not found or already started")
+		}
+
+		if err := tx.First(&boardImport, importID).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res := convertBoardImportToDTO(&boardImport)
+	res.Content = boardImport.Content
+	return res, nil
+}
+
+// CompleteBoardImport records the outcome of an import and drops its uploaded file
+func (r *GormBoardRepository) CompleteBoardImport(req *CompleteBoardImportRequest) error {
+	if err := r.db.Model(&models.BoardImport{}).Where("id = ?", req.ImportID).Updates(map[string]interface{}{
+		"status":       req.Status,
+		"board_id":     req.BoardID,
+		"report":       req.Report,
+		"error":        req.Error,
+		"content":      nil,
+		"completed_at": time.Now(),
+	}).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	return nil
+}
+
+// GetUserIDsByEmails returns the IDs of the users with the given emails, keyed by lowercase email.
+// Only confirmed emails are matched, so nobody is added to an imported board by signing up with
+// someone else's email.
+func (r *GormBoardRepository) GetUserIDsByEmails(emails []string) (map[string]uint64, error) {
+	userIDs := make(map[string]uint64)
+	if len(emails) == 0 {
+		return userIDs, nil
+	}
+
+	var users []models.User
+	if err := r.db.Select("id", "email").
+		Where("LOWER(email) IN ? AND email_confirmed = ?", emails, true).
+		Find(&users).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	for _, user := range users {
+		userIDs[strings.ToLower(user.Email)] = user.ID
+	}
+	return userIDs, nil
+}
+
+// CreateImportedBoard creates the board of an import plan with everything in it, all or nothing
+func (r *GormBoardRepository) CreateImportedBoard(req *CreateImportedBoardRequest) (uint64, error) {
+	plan := req.Plan
+	board := plan.Board
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&board).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		boardMembers := []models.BoardMember{{BoardID: board.ID, UserID: req.UserID, Role: roles.OwnerRole}}
+		for _, userID := range plan.MemberUserIDs {
+			boardMembers = append(boardMembers, models.BoardMember{BoardID: board.ID, UserID: userID, Role: roles.MemberRole})
+		}
+		if err := tx.Create(&boardMembers).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		labels := make([]models.Label, len(plan.Labels))
+		copy(labels, plan.Labels)
+		for i := range labels {
+			labels[i].BoardID = board.ID
+		}
+		if len(labels) > 0 {
+			if err := tx.Create(&labels).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+		}
+
+		for _, plannedList := range plan.Lists {
+			list := plannedList.List
+			list.BoardID = board.ID
+			if err := tx.Create(&list).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+
+			if len(plannedList.Cards) == 0 {
+				continue
+			}
+
+			// Labels, members and comments are created along with their cards
+			cards := make([]models.Card, len(plannedList.Cards))
+			for i, plannedCard := range plannedList.Cards {
+				cards[i] = plannedCard.Card
+				cards[i].BoardID = board.ID
+				cards[i].ListID = list.ID
+				for _, labelIndex := range plannedCard.LabelIndexes {
+					cards[i].Labels = append(cards[i].Labels, labels[labelIndex])
+				}
+				for _, userID := range plannedCard.MemberUserIDs {
+					cards[i].Members = append(cards[i].Members, models.CardMember{UserID: userID})
+				}
+				cards[i].Comments = plannedCard.Comments
+			}
+
+			if err := tx.Create(&cards).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return board.ID, nil
+}
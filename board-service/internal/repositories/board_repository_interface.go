@@ -1,12 +1,21 @@
 package repositories
 
 import (
+	"time"
+
+	"github.com/sm888sm/halten-backend/board-service/internal/importer"
 	internal_models "github.com/sm888sm/halten-backend/board-service/internal/models"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+	"github.com/sm888sm/halten-backend/common/undo"
 	models "github.com/sm888sm/halten-backend/models"
+	"gorm.io/gorm"
 )
 
 type CreateBoardRequest struct {
 	Board *models.Board
+	// Created is called in the transaction creating the board when set, such as to record the step
+	// of the saga creating it
+	Created func(tx *gorm.DB, board *models.Board) error
 }
 
 type CreateBoardResponse struct {
@@ -42,8 +51,9 @@ type GetBoardMembersResponse struct {
 }
 
 type UpdateBoardNameRequest struct {
-	BoardID uint64
-	Name    string
+	BoardID         uint64
+	Name            string
+	ExpectedVersion uint64 // Zero skips the version check
 }
 
 type AddBoardUsersRequest struct {
@@ -71,8 +81,22 @@ type ChangeBoardOwnerRequest struct {
 }
 
 type ChangeBoardVisibilityRequest struct {
-	BoardID    uint64
-	Visibility string
+	BoardID         uint64
+	Visibility      string
+	ExpectedVersion uint64 // Zero skips the version check
+}
+
+type SetCardHistoryRetentionRequest struct {
+	BoardID          uint64
+	CardHistoryLimit int
+	CardHistoryDays  int
+	ExpectedVersion  uint64 // Zero skips the version check
+}
+
+type SetWIPLimitPolicyRequest struct {
+	BoardID         uint64
+	WIPLimitPolicy  string
+	ExpectedVersion uint64 // Zero skips the version check
 }
 
 type AddLabelRequest struct {
@@ -86,6 +110,25 @@ type RemoveLabelRequest struct {
 	LabelID uint64
 }
 
+type AddCustomFieldRequest struct {
+	BoardID uint64
+	Name    string
+	Type    string
+	Options []*internal_models.CustomFieldOptionDTO
+}
+
+type UpdateCustomFieldRequest struct {
+	BoardID       uint64
+	CustomFieldID uint64
+	Name          string
+	Options       []*internal_models.CustomFieldOptionDTO
+}
+
+type RemoveCustomFieldRequest struct {
+	BoardID       uint64
+	CustomFieldID uint64
+}
+
 type GetArchivedBoardListRequest struct {
 	PageNumber uint64
 	PageSize   uint64
@@ -98,15 +141,26 @@ type GetArchivedBoardListResponse struct {
 }
 
 type RestoreBoardRequest struct {
-	BoardID uint64
+	BoardID         uint64
+	ExpectedVersion uint64      // Zero skips the version check
+	Actor           *undo.Actor // Records the action in the actor's undo log when set
 }
 
 type ArchiveBoardRequest struct {
+	BoardID         uint64
+	ExpectedVersion uint64      // Zero skips the version check
+	Actor           *undo.Actor // Records the action in the actor's undo log when set
+}
+
+type DiscardBoardRequest struct {
 	BoardID uint64
+	Cleanup CleanupEvent
 }
 
 type DeleteBoardRequest struct {
 	BoardID uint64
+	Actor   *undo.Actor // Records the action in the actor's undo log when set
+	Tx      *gorm.DB    // Transaction to write in, a new one when nil
 }
 
 type GetBoardIDByListRequest struct {
@@ -117,6 +171,136 @@ type GetBoardIDByCardRequest struct {
 	CardID uint64
 }
 
+type GetTrashRequest struct {
+	PageNumber uint64
+	PageSize   uint64
+	UserID     uint64
+}
+
+type GetTrashResponse struct {
+	Items      []*internal_models.TrashItemDTO
+	Pagination *internal_models.Pagination
+}
+
+type RestoreTrashItemRequest struct {
+	Type    string
+	ID      uint64
+	BoardID uint64
+	UserID  uint64
+}
+
+type PurgeTrashRequest struct {
+	DeletedBefore time.Time
+	Cleanup       CleanupEvent
+}
+
+type PurgeTrashResponse struct {
+	Boards          int64 // Boards whose deletion started, the other services removing their part first
+	Lists           int64
+	Cards           int64
+	AttachmentPaths []string // Files of the purged attachments, to be removed once the purge is committed
+}
+
+// CleanupEvent returns the Event asking a service for its step of deleting boards for good
+type CleanupEvent func(step string) publishers.Event
+
+type CompleteBoardCleanupRequest struct {
+	BoardID uint64
+	Step    string // Step the service confirmed
+	Cleanup CleanupEvent
+	Tx      *gorm.DB // Transaction to write in, a new one when nil
+}
+
+type RetryBoardDeletionsRequest struct {
+	StartedBefore time.Time // Steps requested before weren't confirmed in time
+	MaxAttempts   int       // Requests of a step before the deletion fails
+	Cleanup       CleanupEvent
+}
+
+type RetryBoardDeletionsResponse struct {
+	Retried []*models.BoardDeletion
+	Failed  []*models.BoardDeletion
+}
+
+type UndoLastActionRequest struct {
+	UserID    uint64
+	SessionID string
+	Since     time.Time // Actions made before are past the undo window
+}
+
+type UndoLastActionResponse struct {
+	Action *internal_models.UndoneActionDTO
+}
+
+type PruneUndoLogRequest struct {
+	CreatedBefore time.Time
+}
+
+type CreateBoardExportRequest struct {
+	BoardID uint64
+	UserID  uint64
+	Format  string
+	Event   publishers.Event // Queues the export, written with the ID of the export
+}
+
+type GetBoardExportRequest struct {
+	ExportID uint64
+	BoardID  uint64
+}
+
+type UpdateBoardExportRequest struct {
+	ExportID uint64
+	Status   string
+	Progress int
+	FileName string
+	FilePath string
+	Error    string
+}
+
+type GetBoardExportContentResponse struct {
+	Board *models.Board // With its members, labels and custom fields
+	Lists []models.List // In board order
+	Users []models.User // Board members
+}
+
+type GetBoardExportCardsRequest struct {
+	ListID uint64
+}
+
+type GetBoardExportCardsResponse struct {
+	Cards []models.Card // In list order, with everything an export holds
+}
+
+type PruneBoardExportsRequest struct {
+	CreatedBefore time.Time
+}
+
+type CreateBoardImportRequest struct {
+	UserID  uint64
+	Source  string
+	DryRun  bool
+	Content []byte
+	Event   publishers.Event // Queues the import, written with the ID of the import
+}
+
+type GetBoardImportRequest struct {
+	ImportID uint64
+	UserID   uint64
+}
+
+type CompleteBoardImportRequest struct {
+	ImportID uint64
+	Status   string
+	BoardID  *uint64
+	Report   string
+	Error    string
+}
+
+type CreateImportedBoardRequest struct {
+	UserID uint64
+	Plan   *importer.Plan
+}
+
 type BoardRepository interface {
 	CreateBoard(req *CreateBoardRequest) (*CreateBoardResponse, error)
 	GetBoardByID(req *GetBoardByIDRequest) (*GetBoardByIDResponse, error)
@@ -128,12 +312,38 @@ type BoardRepository interface {
 	AssignBoardUsersRole(req *AssignBoardUsersRoleRequest) error
 	ChangeBoardOwner(req *ChangeBoardOwnerRequest) error
 	ChangeBoardVisibility(req *ChangeBoardVisibilityRequest) error
+	SetCardHistoryRetention(req *SetCardHistoryRetentionRequest) error
+	SetWIPLimitPolicy(req *SetWIPLimitPolicyRequest) error
 	AddLabel(req *AddLabelRequest) (*models.Label, error)
 	RemoveLabel(req *RemoveLabelRequest) error
+	AddCustomField(req *AddCustomFieldRequest) (*internal_models.CustomFieldDTO, error)
+	UpdateCustomField(req *UpdateCustomFieldRequest) (*internal_models.CustomFieldDTO, error)
+	RemoveCustomField(req *RemoveCustomFieldRequest) error
 	GetArchivedBoardList(req *GetArchivedBoardListRequest) (*GetArchivedBoardListResponse, error)
 	ArchiveBoard(req *ArchiveBoardRequest) error
 	RestoreBoard(req *RestoreBoardRequest) error
 	DeleteBoard(req *DeleteBoardRequest) error
+	DiscardBoard(req *DiscardBoardRequest) error
 	GetBoardIDByList(req *GetBoardIDByListRequest) (uint64, error)
 	GetBoardIDByCard(req *GetBoardIDByCardRequest) (uint64, error)
+	GetTrash(req *GetTrashRequest) (*GetTrashResponse, error)
+	RestoreTrashItem(req *RestoreTrashItemRequest) error
+	PurgeTrash(req *PurgeTrashRequest) (*PurgeTrashResponse, error)
+	CompleteBoardCleanup(req *CompleteBoardCleanupRequest) (bool, error)
+	RetryBoardDeletions(req *RetryBoardDeletionsRequest) (*RetryBoardDeletionsResponse, error)
+	UndoLastAction(req *UndoLastActionRequest) (*UndoLastActionResponse, error)
+	PruneUndoLog(req *PruneUndoLogRequest) (int64, error)
+	CreateBoardExport(req *CreateBoardExportRequest) (*internal_models.BoardExportDTO, error)
+	GetBoardExport(req *GetBoardExportRequest) (*internal_models.BoardExportDTO, error)
+	StartBoardExport(exportID uint64) (*internal_models.BoardExportDTO, error)
+	UpdateBoardExport(req *UpdateBoardExportRequest) error
+	GetBoardExportContent(boardID uint64) (*GetBoardExportContentResponse, error)
+	GetBoardExportCards(req *GetBoardExportCardsRequest) (*GetBoardExportCardsResponse, error)
+	PruneBoardExports(req *PruneBoardExportsRequest) ([]string, error)
+	CreateBoardImport(req *CreateBoardImportRequest) (*internal_models.BoardImportDTO, error)
+	GetBoardImport(req *GetBoardImportRequest) (*internal_models.BoardImportDTO, error)
+	StartBoardImport(importID uint64) (*internal_models.BoardImportDTO, error)
+	CompleteBoardImport(req *CompleteBoardImportRequest) error
+	GetUserIDsByEmails(emails []string) (map[string]uint64, error)
+	CreateImportedBoard(req *CreateImportedBoardRequest) (uint64, error)
 }
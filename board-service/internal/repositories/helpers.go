@@ -1,6 +1,25 @@
 package repositories
 
-import "github.com/sm888sm/halten-backend/common/constants/roleshierarchy"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dtos "github.com/sm888sm/halten-backend/board-service/internal/models"
+	"github.com/sm888sm/halten-backend/common/constants/deletionstatuses"
+	"github.com/sm888sm/halten-backend/common/constants/deletionsteps"
+	"github.com/sm888sm/halten-backend/common/constants/roles"
+	"github.com/sm888sm/halten-backend/common/constants/roleshierarchy"
+	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/common/undo"
+	"github.com/sm888sm/halten-backend/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
 
 func canAssignRole(currentRole string, targetRole string) bool {
 
@@ -15,3 +34,415 @@ func canAssignRole(currentRole string, targetRole string) bool {
 	// A user can't downgrade a role which is equal or higher than its role
 	return currentRoleValue > targetRoleValue
 }
+
+func convertCustomFieldToDTO(customField *models.CustomField) *dtos.CustomFieldDTO {
+	customFieldDTO := &dtos.CustomFieldDTO{
+		ID:       customField.ID,
+		BoardID:  customField.BoardID,
+		Name:     customField.Name,
+		Type:     customField.Type,
+		Position: customField.Position,
+	}
+
+	for _, option := range customField.Options {
+		customFieldDTO.Options = append(customFieldDTO.Options, &dtos.CustomFieldOptionDTO{
+			ID:    option.ID,
+			Value: option.Value,
+			Color: option.Color,
+		})
+	}
+
+	return customFieldDTO
+}
+
+// lockBoard loads the board and locks its row until the end of the transaction. When an expected
+// version is given, a board changed since the client read it is rejected as a conflict.
+func (r *GormBoardRepository) lockBoard(tx *gorm.DB, boardID uint64, expectedVersion uint64) (*models.Board, error) {
+	var board models.Board
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", boardID).First(&board).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorhandlers.NewGrpcNotFoundError("Board not found")
+		}
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	if expectedVersion != 0 && board.Version != expectedVersion {
+		return nil, errorhandlers.NewGrpcConflictError(fmt.Sprintf("Board has been modified, current version is %d", board.Version))
+	}
+
+	return &board, nil
+}
+
+// restoreBoard brings back a deleted board along with the lists and cards deleted with it. Only the
+// owner can restore a board, as only the owner can delete it.
+func (r *GormBoardRepository) restoreBoard(tx *gorm.DB, boardID uint64, userID uint64) error {
+	var board models.Board
+	if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND deleted_at IS NOT NULL", boardID).First(&board).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorhandlers.NewGrpcNotFoundError("Board not found in trash")
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	// Parts of the board may already be gone
+	var deletions int64
+	if err := tx.Model(&models.BoardDeletion{}).Where("board_id = ?", boardID).Count(&deletions).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	if deletions > 0 {
+		return errorhandlers.NewGrpcConflictError("The board is being deleted for good")
+	}
+
+	var member models.BoardMember
+	if err := tx.Where("board_id = ? AND user_id = ?", boardID, userID).First(&member).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorhandlers.NewGrpcForbiddenError("Only the board owner can restore the board")
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+	if member.Role != roles.OwnerRole {
+		return errorhandlers.NewGrpcForbiddenError("Only the board owner can restore the board")
+	}
+
+	deletedAt := board.DeletedAt.Time
+	if err := tx.Unscoped().Model(&models.List{}).Where("board_id = ? AND deleted_at = ?", boardID, deletedAt).UpdateColumn("deleted_at", nil).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	if err := tx.Unscoped().Model(&models.Card{}).Where("board_id = ? AND deleted_at = ?", boardID, deletedAt).UpdateColumn("deleted_at", nil).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	if err := tx.Unscoped().Model(&board).UpdateColumns(map[string]interface{}{"deleted_at": nil, "version": board.Version + 1}).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	return nil
+}
+
+// restoreList brings back a deleted list along with the cards deleted with it
+func (r *GormBoardRepository) restoreList(tx *gorm.DB, listID uint64, boardID uint64) error {
+	var list models.List
+	if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND board_id = ? AND deleted_at IS NOT NULL", listID, boardID).First(&list).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorhandlers.NewGrpcNotFoundError("List not found in trash")
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	if _, err := r.lockBoard(tx, boardID, 0); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return errorhandlers.NewGrpcBadRequestError("The board of the list is in the trash, restore the board first")
+		}
+		return err
+	}
+
+	if err := tx.Unscoped().Model(&models.Card{}).Where("list_id = ? AND deleted_at = ?", listID, list.DeletedAt.Time).UpdateColumn("deleted_at", nil).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	if err := tx.Unscoped().Model(&list).UpdateColumns(map[string]interface{}{"deleted_at": nil, "version": list.Version + 1}).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	return nil
+}
+
+// restoreCard brings back a deleted card into its list
+func (r *GormBoardRepository) restoreCard(tx *gorm.DB, cardID uint64, boardID uint64) error {
+	var card models.Card
+	if err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND board_id = ? AND deleted_at IS NOT NULL", cardID, boardID).First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorhandlers.NewGrpcNotFoundError("Card not found in trash")
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	var list models.List
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", card.ListID).First(&list).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorhandlers.NewGrpcBadRequestError("The list of the card is in the trash, restore the list first")
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	if err := tx.Unscoped().Model(&card).UpdateColumns(map[string]interface{}{"deleted_at": nil, "version": card.Version + 1}).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	return nil
+}
+
+// purgeCards hard-deletes the cards and everything attached to them. It returns the paths of the
+// attachment files, which the caller removes once the transaction is committed.
+func purgeCards(tx *gorm.DB, cardIDs []uint64) ([]string, error) {
+	if len(cardIDs) == 0 {
+		return nil, nil
+	}
+
+	var attachmentPaths []string
+	if err := tx.Unscoped().Model(&models.Attachment{}).Where("card_id IN ?", cardIDs).Pluck("file_path", &attachmentPaths).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	var commentIDs []uint64
+	if err := tx.Unscoped().Model(&models.Comment{}).Where("card_id IN ?", cardIDs).Pluck("id", &commentIDs).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	deletes := []struct {
+		model interface{}
+		query string
+		ids   []uint64
+	}{
+		{&models.CommentEdit{}, "comment_id IN ?", commentIDs},
+		{&models.CommentReaction{}, "comment_id IN ?", commentIDs},
+		{&models.CommentMention{}, "comment_id IN ?", commentIDs},
+		{&models.Comment{}, "card_id IN ?", cardIDs},
+		{&models.Attachment{}, "card_id IN ?", cardIDs},
+		{&models.CardMember{}, "card_id IN ?", cardIDs},
+		{&models.CardReminder{}, "card_id IN ?", cardIDs},
+		{&models.CardRecurrence{}, "card_id IN ?", cardIDs},
+		{&models.CardCustomFieldValue{}, "card_id IN ?", cardIDs},
+		{&models.CardVersion{}, "card_id IN ?", cardIDs},
+		{&models.Watch{}, "card_id IN ?", cardIDs},
+		{&models.Card{}, "id IN ?", cardIDs},
+	}
+
+	if err := tx.Exec("DELETE FROM card_labels WHERE card_id IN ?", cardIDs).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+	for _, d := range deletes {
+		if len(d.ids) == 0 {
+			continue
+		}
+		if err := tx.Unscoped().Where(d.query, d.ids).Delete(d.model).Error; err != nil {
+			return nil, errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	return attachmentPaths, nil
+}
+
+// purgeLists hard-deletes the lists, whose cards must have been purged already
+func purgeLists(tx *gorm.DB, listIDs []uint64) error {
+	if len(listIDs) == 0 {
+		return nil
+	}
+
+	if err := tx.Unscoped().Where("list_id IN ?", listIDs).Delete(&models.Watch{}).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	if err := tx.Unscoped().Where("id IN ?", listIDs).Delete(&models.List{}).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	return nil
+}
+
+// startBoardDeletions starts deleting the boards for good, asking for their cards to be deleted first
+func startBoardDeletions(tx *gorm.DB, boardIDs []uint64, cleanup CleanupEvent) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	deletions := make([]*models.BoardDeletion, 0, len(boardIDs))
+	for _, boardID := range boardIDs {
+		deletions = append(deletions, &models.BoardDeletion{
+			BoardID:       boardID,
+			Status:        deletionstatuses.Deleting,
+			Step:          deletionsteps.Cards,
+			Attempts:      1,
+			StepStartedAt: now,
+		})
+	}
+	if err := tx.Create(&deletions).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	if err := cleanup(deletionsteps.Cards).Write(tx, boardIDs...); err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	return nil
+}
+
+// purgeBoards hard-deletes the boards with their labels, custom fields, members and activity, after
+// their lists and cards have been purged
+func purgeBoards(tx *gorm.DB, boardIDs []uint64) error {
+	if len(boardIDs) == 0 {
+		return nil
+	}
+
+	var labelIDs, customFieldIDs, activityLogIDs []uint64
+	if err := tx.Unscoped().Model(&models.Label{}).Where("board_id IN ?", boardIDs).Pluck("id", &labelIDs).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	if err := tx.Unscoped().Model(&models.CustomField{}).Where("board_id IN ?", boardIDs).Pluck("id", &customFieldIDs).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	if err := tx.Unscoped().Model(&models.ActivityLog{}).Where("board_id IN ?", boardIDs).Pluck("id", &activityLogIDs).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	if len(labelIDs) > 0 {
+		if err := tx.Exec("DELETE FROM card_labels WHERE label_id IN ?", labelIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	deletes := []struct {
+		model interface{}
+		query string
+		ids   []uint64
+	}{
+		{&models.Label{}, "id IN ?", labelIDs},
+		{&models.CardCustomFieldValue{}, "custom_field_id IN ?", customFieldIDs},
+		{&models.CustomFieldOption{}, "custom_field_id IN ?", customFieldIDs},
+		{&models.CustomField{}, "id IN ?", customFieldIDs},
+		{&models.Notification{}, "activity_log_id IN ?", activityLogIDs},
+		{&models.ActivityLog{}, "id IN ?", activityLogIDs},
+		{&models.BoardMember{}, "board_id IN ?", boardIDs},
+		{&models.Watch{}, "board_id IN ?", boardIDs},
+		{&models.Board{}, "id IN ?", boardIDs},
+	}
+
+	for _, d := range deletes {
+		if len(d.ids) == 0 {
+			continue
+		}
+		if err := tx.Unscoped().Where(d.query, d.ids).Delete(d.model).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	return nil
+}
+
+// applyUndo reverses the action of the entry. The entity must not have changed since the action,
+// otherwise the undo is rejected as a conflict.
+func (r *GormBoardRepository) applyUndo(tx *gorm.DB, entry *models.UndoEntry, userID uint64) error {
+	if err := checkUndoRole(tx, entry, userID); err != nil {
+		return err
+	}
+
+	switch entry.Action {
+	case undo.ActionArchive, undo.ActionRestore:
+		// Archive and restore are each other's inverse
+		return undoUpdate(tx, entry, map[string]interface{}{"is_archived": entry.Action == undo.ActionRestore})
+
+	case undo.ActionMove:
+		var move undo.Move
+		if err := json.Unmarshal([]byte(entry.Inverse), &move); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		updates := map[string]interface{}{"position": move.Position}
+		if entry.EntityType == undo.EntityCard {
+			var list models.List
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ? AND board_id = ?", move.ListID, entry.BoardID).First(&list).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return errorhandlers.NewGrpcConflictError("The list the card was moved from no longer exists")
+				}
+				return errorhandlers.NewGrpcInternalError()
+			}
+			updates["list_id"] = move.ListID
+		}
+		return undoUpdate(tx, entry, updates)
+
+	case undo.ActionDelete:
+		var deletion undo.Delete
+		if err := json.Unmarshal([]byte(entry.Inverse), &deletion); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		var count int64
+		if err := tx.Unscoped().Table(entry.EntityType+"s").Where("id = ? AND deleted_at = ?", entry.EntityID, deletion.DeletedAt).Count(&count).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if count == 0 {
+			return errorhandlers.NewGrpcConflictError(fmt.Sprintf("The %s has been restored or purged since", entry.EntityType))
+		}
+
+		switch entry.EntityType {
+		case undo.EntityBoard:
+			return r.restoreBoard(tx, entry.EntityID, userID)
+		case undo.EntityList:
+			return r.restoreList(tx, entry.EntityID, entry.BoardID)
+		case undo.EntityCard:
+			return r.restoreCard(tx, entry.EntityID, entry.BoardID)
+		}
+	}
+
+	return errorhandlers.NewGrpcInternalError()
+}
+
+// undoUpdate writes the previous state back to the entity, provided it is still at the version the
+// action left it at
+func undoUpdate(tx *gorm.DB, entry *models.UndoEntry, updates map[string]interface{}) error {
+	updates["version"] = gorm.Expr("version + 1")
+
+	result := tx.Table(entry.EntityType+"s").Where("id = ? AND version = ? AND deleted_at IS NULL", entry.EntityID, entry.Version).Updates(updates)
+	if result.Error != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	if result.RowsAffected == 0 {
+		return errorhandlers.NewGrpcConflictError(fmt.Sprintf("The %s has been changed by someone else since", entry.EntityType))
+	}
+
+	return nil
+}
+
+// checkUndoRole makes sure the user still has the role the action required
+func checkUndoRole(tx *gorm.DB, entry *models.UndoEntry, userID uint64) error {
+	requiredRole := roles.MemberRole
+	if entry.EntityType == undo.EntityBoard {
+		requiredRole = roles.AdminRole
+		if entry.Action == undo.ActionDelete {
+			requiredRole = roles.OwnerRole
+		}
+	}
+
+	var member models.BoardMember
+	if err := tx.Where("board_id = ? AND user_id = ?", entry.BoardID, userID).First(&member).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorhandlers.NewGrpcForbiddenError("You are no longer a member of the board")
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	if roleshierarchy.RoleHierarchy[member.Role] < roleshierarchy.RoleHierarchy[requiredRole] {
+		return errorhandlers.NewGrpcForbiddenError("You no longer have the role needed to undo this action")
+	}
+
+	return nil
+}
+
+func convertBoardExportToDTO(boardExport *models.BoardExport) *dtos.BoardExportDTO {
+	return &dtos.BoardExportDTO{
+		ID:          boardExport.ID,
+		BoardID:     boardExport.BoardID,
+		UserID:      boardExport.UserID,
+		Format:      boardExport.Format,
+		Status:      boardExport.Status,
+		Progress:    boardExport.Progress,
+		FileName:    boardExport.FileName,
+		FilePath:    boardExport.FilePath,
+		Error:       boardExport.Error,
+		CreatedAt:   boardExport.CreatedAt,
+		CompletedAt: boardExport.CompletedAt,
+	}
+}
+
+func convertBoardImportToDTO(boardImport *models.BoardImport) *dtos.BoardImportDTO {
+	return &dtos.BoardImportDTO{
+		ID:          boardImport.ID,
+		UserID:      boardImport.UserID,
+		BoardID:     boardImport.BoardID,
+		Source:      boardImport.Source,
+		DryRun:      boardImport.DryRun,
+		Status:      boardImport.Status,
+		Report:      boardImport.Report,
+		Error:       boardImport.Error,
+		CreatedAt:   boardImport.CreatedAt,
+		CompletedAt: boardImport.CompletedAt,
+	}
+}
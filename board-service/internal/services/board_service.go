@@ -2,9 +2,17 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
 	"strconv"
+	"time"
 
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
@@ -13,28 +21,49 @@ import (
 
 	external_services "github.com/sm888sm/halten-backend/board-service/external/services"
 
+	"github.com/sm888sm/halten-backend/board-service/internal/export"
+	"github.com/sm888sm/halten-backend/board-service/internal/importer"
+	dtos "github.com/sm888sm/halten-backend/board-service/internal/models"
 	"github.com/sm888sm/halten-backend/board-service/internal/repositories"
 
 	"github.com/sm888sm/halten-backend/common/constants/contextkeys"
+	"github.com/sm888sm/halten-backend/common/constants/exportstatuses"
+	"github.com/sm888sm/halten-backend/common/constants/importstatuses"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
 	"github.com/sm888sm/halten-backend/common/helpers"
+	"github.com/sm888sm/halten-backend/common/saga"
+	"github.com/sm888sm/halten-backend/common/transactions"
+	"github.com/sm888sm/halten-backend/common/undo"
 
+	"github.com/sm888sm/halten-backend/common/messaging/events"
 	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
-	"github.com/sm888sm/halten-backend/models"
 )
 
 type BoardService struct {
 	boardRepo repositories.BoardRepository
 	pb_board.UnimplementedBoardServiceServer
-	services   *external_services.Services
-	publishers *publishers.Publishers
+	services       *external_services.Services
+	publishers     *publishers.Publishers
+	sagas          *saga.Orchestrator
+	trashRetention time.Duration // How long deleted items stay in the trash before they are purged
+	undoWindow     time.Duration // How long after an action it can be undone
+	exportDir      string        // Directory export file paths are relative to
 }
 
-func NewBoardService(repo repositories.BoardRepository, services *external_services.Services, publishers *publishers.Publishers) *BoardService {
-	return &BoardService{
-		boardRepo:  repo,
-		publishers: publishers,
+func NewBoardService(repo repositories.BoardRepository, services *external_services.Services, publishers *publishers.Publishers, sagas *saga.Orchestrator, trashRetention time.Duration, undoWindow time.Duration, exportDir string) *BoardService {
+	s := &BoardService{
+		boardRepo:      repo,
+		services:       services,
+		publishers:     publishers,
+		sagas:          sagas,
+		trashRetention: trashRetention,
+		undoWindow:     undoWindow,
+		exportDir:      exportDir,
 	}
+
+	sagas.Register(s.createBoardSaga())
+
+	return s
 }
 
 func (s *BoardService) CreateBoard(ctx context.Context, req *pb_board.CreateBoardRequest) (*pb_board.CreateBoardResponse, error) {
@@ -43,85 +72,22 @@ func (s *BoardService) CreateBoard(ctx context.Context, req *pb_board.CreateBoar
 		return nil, err
 	}
 
-	board := &models.Board{
-		Name:   req.Name,
-		UserID: userID,
-	}
-
-	repoReq := &repositories.CreateBoardRequest{
-		Board: board,
-	}
-
-	repoRes, err := s.boardRepo.CreateBoard(repoReq)
-	if err != nil {
-		return nil, err
-	}
-
-	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(repoRes.Board.ID, 10))
-	ctx = metadata.NewOutgoingContext(ctx, md)
-
-	listService, err := s.services.GetListClient()
-	if err != nil {
-		return nil, errorhandlers.NewGrpcInternalError()
-	}
-
-	listReq := &pb_list.CreateListRequest{
-		Name: "Default List",
-	}
-
-	listRes, err := listService.CreateList(ctx, listReq)
-	if err != nil {
-		// Delete board through repo
-		err = s.boardRepo.DeleteBoard(&repositories.DeleteBoardRequest{
-			BoardID: repoRes.Board.ID,
-		})
-
-		if err != nil {
-			return nil, errorhandlers.NewGrpcInternalError()
-		}
-
-		return nil, errorhandlers.NewGrpcInternalError()
-	}
-
-	cardService, err := s.services.GetCardClient()
-	if err != nil {
-		// Delete board through repo
-		err = s.boardRepo.DeleteBoard(&repositories.DeleteBoardRequest{
-			BoardID: repoRes.Board.ID,
-		})
-		if err != nil {
-			return nil, errorhandlers.NewGrpcInternalError()
-		}
-
-		return nil, errorhandlers.NewGrpcInternalError()
-	}
-
-	cardReq := &pb_card.CreateCardRequest{
-		ListID: listRes.List.ListID,
-		Name:   "Default Card",
-	}
-
-	_, err = cardService.CreateCard(ctx, cardReq)
-	if err != nil {
-		// Delete board through repo
-		err = s.boardRepo.DeleteBoard(&repositories.DeleteBoardRequest{
-			BoardID: repoRes.Board.ID,
-		})
-		if err != nil {
+	data := &createBoardData{UserID: userID, Name: req.Name}
+	if err := s.sagas.Start(ctx, sagaCreateBoard, data); err != nil {
+		if _, ok := status.FromError(err); !ok {
 			return nil, errorhandlers.NewGrpcInternalError()
 		}
-
 		return nil, err
 	}
 
 	return &pb_board.CreateBoardResponse{
 		Board: &pb_board.Board{
-			Name:       repoRes.Board.Name,
-			BoardID:    repoRes.Board.ID,
-			UserID:     repoRes.Board.UserID,
-			Visibility: repoRes.Board.Visibility,
-			CreatedAt:  timestamppb.New(repoRes.Board.CreatedAt),
-			UpdatedAt:  timestamppb.New(repoRes.Board.UpdatedAt),
+			Name:       data.board.Name,
+			BoardID:    data.board.ID,
+			UserID:     data.board.UserID,
+			Visibility: data.board.Visibility,
+			CreatedAt:  timestamppb.New(data.board.CreatedAt),
+			UpdatedAt:  timestamppb.New(data.board.UpdatedAt),
 		},
 	}, nil
 }
@@ -169,16 +135,21 @@ func (s *BoardService) GetBoardByID(ctx context.Context, req *pb_board.GetBoardB
 
 	board := repoRes.Board
 	boardProto := &pb_board.Board{
-		BoardID:    board.ID,
-		UserID:     board.ID,
-		Name:       board.Name,
-		Visibility: board.Visibility,
-		Members:    convertMembersToProto(board.Members),
-		Lists:      convertListsToProto(listRes.Lists),
-		Cards:      convertCardsToProto(cardRes.Cards),
-		Labels:     convertLabelsToProto(board.Labels),
-		CreatedAt:  timestamppb.New(repoRes.Board.CreatedAt),
-		UpdatedAt:  timestamppb.New(repoRes.Board.UpdatedAt),
+		BoardID:          board.ID,
+		UserID:           board.ID,
+		Name:             board.Name,
+		Visibility:       board.Visibility,
+		Members:          convertMembersToProto(board.Members),
+		Lists:            convertListsToProto(listRes.Lists),
+		Cards:            convertCardsToProto(cardRes.Cards),
+		Labels:           convertLabelsToProto(board.Labels),
+		CustomFields:     convertCustomFieldsToProto(board.CustomFields),
+		Version:          board.Version,
+		CardHistoryLimit: int32(board.CardHistoryLimit),
+		CardHistoryDays:  int32(board.CardHistoryDays),
+		WipLimitPolicy:   board.WIPLimitPolicy,
+		CreatedAt:        timestamppb.New(repoRes.Board.CreatedAt),
+		UpdatedAt:        timestamppb.New(repoRes.Board.UpdatedAt),
 	}
 
 	// Return the response
@@ -207,6 +178,7 @@ func (s *BoardService) GetBoardList(ctx context.Context, req *pb_board.GetBoardL
 			BoardID:    board.ID,
 			Name:       board.Name,
 			Visibility: board.Visibility,
+			Version:    board.Version,
 			CreatedAt:  timestamppb.New(board.CreatedAt),
 			UpdatedAt:  timestamppb.New(board.UpdatedAt),
 		})
@@ -275,6 +247,7 @@ func (s *BoardService) GetArchivedBoardList(ctx context.Context, req *pb_board.G
 			BoardID:    board.ID,
 			Name:       board.Name,
 			Visibility: board.Visibility,
+			Version:    board.Version,
 			CreatedAt:  timestamppb.New(board.CreatedAt),
 			UpdatedAt:  timestamppb.New(board.UpdatedAt),
 		})
@@ -299,7 +272,7 @@ func (s *BoardService) UpdateBoardName(ctx context.Context, req *pb_board.Update
 		return nil, errorhandlers.NewGrpcInternalError()
 	}
 
-	err := s.boardRepo.UpdateBoardName(&repositories.UpdateBoardNameRequest{BoardID: boardID, Name: req.Name})
+	err := s.boardRepo.UpdateBoardName(&repositories.UpdateBoardNameRequest{BoardID: boardID, Name: req.Name, ExpectedVersion: req.ExpectedVersion})
 	if err != nil {
 		return nil, err
 	}
@@ -412,8 +385,9 @@ func (s *BoardService) ChangeBoardVisibility(ctx context.Context, req *pb_board.
 
 	// Call the repository function to change the visibility of the board
 	err := s.boardRepo.ChangeBoardVisibility(&repositories.ChangeBoardVisibilityRequest{
-		BoardID:    boardID,
-		Visibility: req.Visibility,
+		BoardID:         boardID,
+		Visibility:      req.Visibility,
+		ExpectedVersion: req.ExpectedVersion,
 	})
 	if err != nil {
 		return nil, err
@@ -425,6 +399,47 @@ func (s *BoardService) ChangeBoardVisibility(ctx context.Context, req *pb_board.
 	}, nil
 }
 
+func (s *BoardService) SetCardHistoryRetention(ctx context.Context, req *pb_board.SetCardHistoryRetentionRequest) (*pb_board.SetCardHistoryRetentionResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	err := s.boardRepo.SetCardHistoryRetention(&repositories.SetCardHistoryRetentionRequest{
+		BoardID:          boardID,
+		CardHistoryLimit: int(req.CardHistoryLimit),
+		CardHistoryDays:  int(req.CardHistoryDays),
+		ExpectedVersion:  req.ExpectedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_board.SetCardHistoryRetentionResponse{
+		Message: "Card history retention changed successfully",
+	}, nil
+}
+
+func (s *BoardService) SetWIPLimitPolicy(ctx context.Context, req *pb_board.SetWIPLimitPolicyRequest) (*pb_board.SetWIPLimitPolicyResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	err := s.boardRepo.SetWIPLimitPolicy(&repositories.SetWIPLimitPolicyRequest{
+		BoardID:         boardID,
+		WIPLimitPolicy:  req.WipLimitPolicy,
+		ExpectedVersion: req.ExpectedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_board.SetWIPLimitPolicyResponse{
+		Message: "WIP limit policy changed successfully",
+	}, nil
+}
+
 func (s *BoardService) AddLabel(ctx context.Context, req *pb_board.AddLabelRequest) (*pb_board.AddLabelResponse, error) {
 	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
 	if !ok {
@@ -470,6 +485,70 @@ func (s *BoardService) RemoveLabel(ctx context.Context, req *pb_board.RemoveLabe
 	}, nil
 }
 
+func (s *BoardService) AddCustomField(ctx context.Context, req *pb_board.AddCustomFieldRequest) (*pb_board.AddCustomFieldResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	customField, err := s.boardRepo.AddCustomField(&repositories.AddCustomFieldRequest{
+		BoardID: boardID,
+		Name:    req.Name,
+		Type:    req.Type,
+		Options: convertCustomFieldOptionsFromProto(req.Options),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a successful response
+	return &pb_board.AddCustomFieldResponse{
+		CustomField: convertCustomFieldToProto(customField),
+	}, nil
+}
+
+func (s *BoardService) UpdateCustomField(ctx context.Context, req *pb_board.UpdateCustomFieldRequest) (*pb_board.UpdateCustomFieldResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	customField, err := s.boardRepo.UpdateCustomField(&repositories.UpdateCustomFieldRequest{
+		BoardID:       boardID,
+		CustomFieldID: req.CustomFieldID,
+		Name:          req.Name,
+		Options:       convertCustomFieldOptionsFromProto(req.Options),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a successful response
+	return &pb_board.UpdateCustomFieldResponse{
+		CustomField: convertCustomFieldToProto(customField),
+	}, nil
+}
+
+func (s *BoardService) RemoveCustomField(ctx context.Context, req *pb_board.RemoveCustomFieldRequest) (*pb_board.RemoveCustomFieldResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	err := s.boardRepo.RemoveCustomField(&repositories.RemoveCustomFieldRequest{
+		BoardID:       boardID,
+		CustomFieldID: req.CustomFieldID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a successful response
+	return &pb_board.RemoveCustomFieldResponse{
+		Message: "Custom field removed successfully",
+	}, nil
+}
+
 func (s *BoardService) ArchiveBoard(ctx context.Context, req *pb_board.ArchiveBoardRequest) (*pb_board.ArchiveBoardResponse, error) {
 	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
 	if !ok {
@@ -478,7 +557,9 @@ func (s *BoardService) ArchiveBoard(ctx context.Context, req *pb_board.ArchiveBo
 
 	// Call the repository function to archive the board
 	err := s.boardRepo.ArchiveBoard(&repositories.ArchiveBoardRequest{
-		BoardID: boardID,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
+		Actor:           undo.ActorFromContext(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -498,7 +579,9 @@ func (s *BoardService) RestoreBoard(ctx context.Context, req *pb_board.RestoreBo
 
 	// Call the repository function to restore the board
 	err := s.boardRepo.RestoreBoard(&repositories.RestoreBoardRequest{
-		BoardID: boardID,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
+		Actor:           undo.ActorFromContext(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -519,6 +602,8 @@ func (s *BoardService) DeleteBoard(ctx context.Context, req *pb_board.DeleteBoar
 	// Call the repository function
 	err := s.boardRepo.DeleteBoard(&repositories.DeleteBoardRequest{
 		BoardID: boardID,
+		Actor:   undo.ActorFromContext(ctx),
+		Tx:      transactions.FromContext(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -530,6 +615,129 @@ func (s *BoardService) DeleteBoard(ctx context.Context, req *pb_board.DeleteBoar
 	}, nil
 }
 
+// CompleteBoardCleanup records a service confirming its step of deleting a board for good, and asks
+// for the next one
+func (s *BoardService) CompleteBoardCleanup(ctx context.Context, event *pb_board.BoardCleanupEvent) error {
+	completed, err := s.boardRepo.CompleteBoardCleanup(&repositories.CompleteBoardCleanupRequest{
+		BoardID: event.BoardID,
+		Step:    event.Step,
+		Cleanup: func(step string) publishers.Event {
+			return publishers.NewBoardCleanupEvent(s.publishers.BoardPublisher, events.MetadataFromContext(ctx), step)
+		},
+		Tx: transactions.FromContext(ctx),
+	})
+	if err != nil {
+		return err
+	}
+
+	if completed {
+		transactions.AfterCommit(ctx, func() {
+			log.Printf("Board %d deleted for good", event.BoardID)
+		})
+	}
+	return nil
+}
+
+func (s *BoardService) GetTrash(ctx context.Context, req *pb_board.GetTrashRequest) (*pb_board.GetTrashResponse, error) {
+	userID, err := helpers.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageNumber, pageSize := req.PageNumber, req.PageSize
+	if pageNumber == 0 {
+		pageNumber = 1
+	}
+	if pageSize == 0 {
+		pageSize = 20
+	}
+
+	trash, err := s.boardRepo.GetTrash(&repositories.GetTrashRequest{
+		PageNumber: pageNumber,
+		PageSize:   pageSize,
+		UserID:     userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*pb_board.TrashItem
+	for _, item := range trash.Items {
+		items = append(items, &pb_board.TrashItem{
+			Type:           item.Type,
+			Id:             item.ID,
+			BoardID:        item.BoardID,
+			Name:           item.Name,
+			DeletedAt:      timestamppb.New(item.DeletedAt),
+			PurgeAt:        timestamppb.New(item.DeletedAt.Add(s.trashRetention)),
+			DeletionStatus: item.DeletionStatus,
+			DeletionError:  item.DeletionError,
+		})
+	}
+
+	return &pb_board.GetTrashResponse{
+		Items: items,
+		Pagination: &pb_board.Pagination{
+			CurrentPage:  trash.Pagination.CurrentPage,
+			TotalPages:   trash.Pagination.TotalPages,
+			ItemsPerPage: trash.Pagination.ItemsPerPage,
+			TotalItems:   trash.Pagination.TotalItems,
+			HasMore:      trash.Pagination.HasMore,
+		},
+	}, nil
+}
+
+func (s *BoardService) RestoreTrashItem(ctx context.Context, req *pb_board.RestoreTrashItemRequest) (*pb_board.RestoreTrashItemResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	err := s.boardRepo.RestoreTrashItem(&repositories.RestoreTrashItemRequest{
+		Type:    req.Type,
+		ID:      req.Id,
+		BoardID: boardID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_board.RestoreTrashItemResponse{
+		Message: "Item successfully restored",
+	}, nil
+}
+
+// Undo reverses the last archive, restore, move or delete the user made in this client session
+func (s *BoardService) Undo(ctx context.Context, req *pb_board.UndoRequest) (*pb_board.UndoResponse, error) {
+	userID, err := helpers.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.boardRepo.UndoLastAction(&repositories.UndoLastActionRequest{
+		UserID:    userID,
+		SessionID: undo.SessionFromContext(ctx),
+		Since:     time.Now().Add(-s.undoWindow),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_board.UndoResponse{
+		Message:    "Action successfully undone",
+		BoardID:    res.Action.BoardID,
+		EntityType: res.Action.EntityType,
+		EntityID:   res.Action.EntityID,
+		Action:     res.Action.Action,
+	}, nil
+}
+
 func (s *BoardService) GetBoardIDByList(ctx context.Context, req *pb_board.GetBoardIDByListRequest) (*pb_board.GetBoardIDByListResponse, error) {
 	boardID, err := s.boardRepo.GetBoardIDByList(&repositories.GetBoardIDByListRequest{ListID: req.ListID})
 	if err != nil {
@@ -551,3 +759,329 @@ func (s *BoardService) GetBoardIDByCard(ctx context.Context, req *pb_board.GetBo
 		BoardID: boardID,
 	}, nil
 }
+
+// ExportBoard queues an export of the board. The export worker picks it up from board.export and its
+// progress is followed with GetBoardExport.
+func (s *BoardService) ExportBoard(ctx context.Context, req *pb_board.ExportBoardRequest) (*pb_board.ExportBoardResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	boardExport, err := s.boardRepo.CreateBoardExport(&repositories.CreateBoardExportRequest{
+		BoardID: boardID,
+		UserID:  userID,
+		Format:  req.Format,
+		Event: publishers.NewEvent(s.publishers.BoardPublisher, publishers.ExportBoard, events.MetadataFromContext(ctx), func(ids []uint64) proto.Message {
+			return &pb_board.BoardExportRequestedEvent{ExportID: ids[0], BoardID: boardID}
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_board.ExportBoardResponse{
+		Message: "Export successfully queued",
+		Export:  convertBoardExportToProto(boardExport),
+	}, nil
+}
+
+func (s *BoardService) GetBoardExport(ctx context.Context, req *pb_board.GetBoardExportRequest) (*pb_board.GetBoardExportResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	boardExport, err := s.boardRepo.GetBoardExport(&repositories.GetBoardExportRequest{
+		ExportID: req.ExportID,
+		BoardID:  boardID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_board.GetBoardExportResponse{
+		Export: convertBoardExportToProto(boardExport),
+	}, nil
+}
+
+// DownloadBoardExport streams the file of a completed export
+func (s *BoardService) DownloadBoardExport(req *pb_board.DownloadBoardExportRequest, stream pb_board.BoardService_DownloadBoardExportServer) error {
+	boardID, ok := stream.Context().Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	if req.ExportID == 0 {
+		return errorhandlers.NewGrpcBadRequestError("ExportID is required")
+	}
+
+	boardExport, err := s.boardRepo.GetBoardExport(&repositories.GetBoardExportRequest{
+		ExportID: req.ExportID,
+		BoardID:  boardID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if boardExport.Status != exportstatuses.Completed {
+		return errorhandlers.NewGrpcFailedPreconditionError("Export isn't completed")
+	}
+
+	file, err := os.Open(filepath.Join(s.exportDir, boardExport.FilePath))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return errorhandlers.NewGrpcNotFoundError("Export file not found")
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+	defer file.Close()
+
+	res := &pb_board.DownloadBoardExportResponse{
+		FileName:    boardExport.FileName,
+		ContentType: export.ContentType(boardExport.Format),
+	}
+
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			res.Chunk = buf[:n]
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+			res = &pb_board.DownloadBoardExportResponse{}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+	}
+}
+
+// RunBoardExport generates the file of a queued export. An export is only generated once, a
+// redelivered message for an export that's already started is refused by StartBoardExport.
+func (s *BoardService) RunBoardExport(ctx context.Context, exportID uint64) error {
+	boardExport, err := s.boardRepo.StartBoardExport(exportID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.generateBoardExport(ctx, boardExport); err != nil {
+		log.Printf("Failed to generate export %d: %v", exportID, err)
+		s.failBoardExport(exportID)
+		return err
+	}
+
+	return nil
+}
+
+func (s *BoardService) generateBoardExport(ctx context.Context, boardExport *dtos.BoardExportDTO) error {
+	content, err := s.boardRepo.GetBoardExportContent(boardExport.BoardID)
+	if err != nil {
+		return err
+	}
+
+	doc := export.NewDocument(content.Board, content.Users, time.Now())
+
+	// Loading the cards is most of the work, so progress runs from 5 to 90 as lists are loaded and
+	// the rest is writing the file
+	for i := range content.Lists {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		cards, err := s.boardRepo.GetBoardExportCards(&repositories.GetBoardExportCardsRequest{ListID: content.Lists[i].ID})
+		if err != nil {
+			return err
+		}
+		doc.AddList(&content.Lists[i], cards.Cards)
+
+		if err := s.boardRepo.UpdateBoardExport(&repositories.UpdateBoardExportRequest{
+			ExportID: boardExport.ID,
+			Status:   exportstatuses.Running,
+			Progress: 5 + 85*(i+1)/len(content.Lists),
+		}); err != nil {
+			return err
+		}
+	}
+
+	fileName := export.FileName(doc, boardExport.Format)
+	filePath := filepath.Join(strconv.FormatUint(boardExport.BoardID, 10), fmt.Sprintf("%d-%s", boardExport.ID, fileName))
+	if err := s.writeBoardExport(filePath, doc, boardExport.Format); err != nil {
+		return err
+	}
+
+	return s.boardRepo.UpdateBoardExport(&repositories.UpdateBoardExportRequest{
+		ExportID: boardExport.ID,
+		Status:   exportstatuses.Completed,
+		Progress: 100,
+		FileName: fileName,
+		FilePath: filePath,
+	})
+}
+
+// writeBoardExport writes the export to a temporary file first, so a download never sees a file
+// that's half written
+func (s *BoardService) writeBoardExport(filePath string, doc *export.Document, format string) error {
+	fullPath := filepath.Join(s.exportDir, filePath)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(fullPath), ".export-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if err := export.Write(file, doc, format); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), fullPath)
+}
+
+func (s *BoardService) failBoardExport(exportID uint64) {
+	if err := s.boardRepo.UpdateBoardExport(&repositories.UpdateBoardExportRequest{
+		ExportID: exportID,
+		Status:   exportstatuses.Failed,
+		Error:    "Export failed",
+	}); err != nil {
+		log.Printf("Failed to mark export %d as failed: %v", exportID, err)
+	}
+}
+
+// ImportBoard queues an import of a board exported from another tool. The file is checked here so
+// an unreadable one is refused right away, the board is created by the import worker picking it up
+// from board.import.
+func (s *BoardService) ImportBoard(ctx context.Context, req *pb_board.ImportBoardRequest) (*pb_board.ImportBoardResponse, error) {
+	userID, err := helpers.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := importer.ParseTrello(req.Content); err != nil {
+		return nil, errorhandlers.NewGrpcBadRequestError("File isn't a Trello board export")
+	}
+
+	boardImport, err := s.boardRepo.CreateBoardImport(&repositories.CreateBoardImportRequest{
+		UserID:  userID,
+		Source:  req.Source,
+		DryRun:  req.DryRun,
+		Content: req.Content,
+		Event: publishers.NewEvent(s.publishers.BoardPublisher, publishers.ImportBoard, events.MetadataFromContext(ctx), func(ids []uint64) proto.Message {
+			return &pb_board.BoardImportRequestedEvent{ImportID: ids[0]}
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := convertBoardImportToProto(boardImport)
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return &pb_board.ImportBoardResponse{
+		Message: "Import successfully queued",
+		Import:  res,
+	}, nil
+}
+
+func (s *BoardService) GetBoardImport(ctx context.Context, req *pb_board.GetBoardImportRequest) (*pb_board.GetBoardImportResponse, error) {
+	userID, err := helpers.ExtractUserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	boardImport, err := s.boardRepo.GetBoardImport(&repositories.GetBoardImportRequest{
+		ImportID: req.ImportID,
+		UserID:   userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := convertBoardImportToProto(boardImport)
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return &pb_board.GetBoardImportResponse{
+		Import: res,
+	}, nil
+}
+
+// RunBoardImport creates the board of a queued import, or only reports on it for a dry run. An
+// import only runs once, a redelivered message for an import that's already started is refused by
+// StartBoardImport.
+func (s *BoardService) RunBoardImport(ctx context.Context, importID uint64) error {
+	boardImport, err := s.boardRepo.StartBoardImport(importID)
+	if err != nil {
+		return err
+	}
+
+	board, err := importer.ParseTrello(boardImport.Content)
+	if err != nil {
+		log.Printf("Failed to read import %d: %v", importID, err)
+		s.failBoardImport(importID)
+		return err
+	}
+
+	usersByEmail, err := s.boardRepo.GetUserIDsByEmails(board.Emails())
+	if err != nil {
+		s.failBoardImport(importID)
+		return err
+	}
+
+	plan := importer.PlanTrello(board, usersByEmail, boardImport.UserID)
+
+	report, err := json.Marshal(plan.Report)
+	if err != nil {
+		s.failBoardImport(importID)
+		return err
+	}
+
+	var boardID *uint64
+	if !boardImport.DryRun {
+		id, err := s.boardRepo.CreateImportedBoard(&repositories.CreateImportedBoardRequest{
+			UserID: boardImport.UserID,
+			Plan:   plan,
+		})
+		if err != nil {
+			log.Printf("Failed to create the board of import %d: %v", importID, err)
+			s.failBoardImport(importID)
+			return err
+		}
+		boardID = &id
+	}
+
+	return s.boardRepo.CompleteBoardImport(&repositories.CompleteBoardImportRequest{
+		ImportID: importID,
+		Status:   importstatuses.Completed,
+		BoardID:  boardID,
+		Report:   string(report),
+	})
+}
+
+func (s *BoardService) failBoardImport(importID uint64) {
+	if err := s.boardRepo.CompleteBoardImport(&repositories.CompleteBoardImportRequest{
+		ImportID: importID,
+		Status:   importstatuses.Failed,
+		Error:    "Import failed",
+	}); err != nil {
+		log.Printf("Failed to mark import %d as failed: %v", importID, err)
+	}
+}
@@ -1,10 +1,14 @@
 package services
 
 import (
+	"encoding/json"
+
 	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
 	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
 	pb_list "github.com/sm888sm/halten-backend/list-service/api/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/sm888sm/halten-backend/board-service/internal/importer"
 	dtos "github.com/sm888sm/halten-backend/board-service/internal/models"
 )
 
@@ -27,10 +31,14 @@ func convertListsToProto(lists []*pb_list.List) []*pb_board.List {
 	var boardLists []*pb_board.List
 	for _, list := range lists {
 		boardList := &pb_board.List{
-			ListID:   list.ListID,
-			BoardID:  list.BoardID,
-			Name:     list.Name,
-			Position: list.Position,
+			ListID:       list.ListID,
+			BoardID:      list.BoardID,
+			Name:         list.Name,
+			Position:     list.Position,
+			Version:      list.Version,
+			WipLimit:     list.WipLimit,
+			CardCount:    list.CardCount,
+			OverWipLimit: list.OverWipLimit,
 		}
 		boardLists = append(boardLists, boardList)
 	}
@@ -42,15 +50,17 @@ func convertCardsToProto(cards []*pb_card.CardMeta) []*pb_board.CardMeta {
 
 	for _, card := range cards {
 		boardList := &pb_board.CardMeta{
-			CardID:          card.CardID,
-			BoardID:         card.BoardID,
-			ListID:          card.ListID,
-			Name:            card.Name,
-			Position:        card.Position,
-			Labels:          card.Labels,
-			Members:         card.Members,
-			TotalAttachment: card.TotalAttachment,
-			TotalComment:    card.TotalComment,
+			CardID:            card.CardID,
+			BoardID:           card.BoardID,
+			ListID:            card.ListID,
+			Name:              card.Name,
+			Position:          card.Position,
+			Labels:            card.Labels,
+			Members:           card.Members,
+			TotalAttachment:   card.TotalAttachment,
+			TotalComment:      card.TotalComment,
+			CustomFieldValues: convertCustomFieldValuesToProto(card.CustomFieldValues),
+			Version:           card.Version,
 		}
 
 		boardLists = append(boardLists, boardList)
@@ -75,3 +85,140 @@ func convertLabelsToProto(labels []*dtos.LabelDTO) []*pb_board.Label {
 
 	return labelsProto
 }
+
+func convertCustomFieldsToProto(customFields []*dtos.CustomFieldDTO) []*pb_board.CustomField {
+	var customFieldsProto []*pb_board.CustomField
+
+	for _, customField := range customFields {
+		customFieldsProto = append(customFieldsProto, convertCustomFieldToProto(customField))
+	}
+
+	return customFieldsProto
+}
+
+func convertCustomFieldToProto(customField *dtos.CustomFieldDTO) *pb_board.CustomField {
+	customFieldProto := &pb_board.CustomField{
+		CustomFieldID: customField.ID,
+		BoardID:       customField.BoardID,
+		Name:          customField.Name,
+		Type:          customField.Type,
+		Position:      customField.Position,
+	}
+
+	for _, option := range customField.Options {
+		customFieldProto.Options = append(customFieldProto.Options, &pb_board.CustomFieldOption{
+			OptionID: option.ID,
+			Value:    option.Value,
+			Color:    option.Color,
+		})
+	}
+
+	return customFieldProto
+}
+
+func convertCustomFieldOptionsFromProto(options []*pb_board.CustomFieldOption) []*dtos.CustomFieldOptionDTO {
+	var optionDTOs []*dtos.CustomFieldOptionDTO
+
+	for _, option := range options {
+		optionDTOs = append(optionDTOs, &dtos.CustomFieldOptionDTO{
+			ID:    option.OptionID,
+			Value: option.Value,
+			Color: option.Color,
+		})
+	}
+
+	return optionDTOs
+}
+
+// convertCustomFieldValuesToProto copies the card service's values, which share the message layout
+func convertCustomFieldValuesToProto(values []*pb_card.CustomFieldValue) []*pb_board.CustomFieldValue {
+	var valuesProto []*pb_board.CustomFieldValue
+
+	for _, value := range values {
+		valueProto := &pb_board.CustomFieldValue{CustomFieldID: value.CustomFieldID}
+
+		switch v := value.Value.(type) {
+		case *pb_card.CustomFieldValue_TextValue:
+			valueProto.Value = &pb_board.CustomFieldValue_TextValue{TextValue: v.TextValue}
+		case *pb_card.CustomFieldValue_NumberValue:
+			valueProto.Value = &pb_board.CustomFieldValue_NumberValue{NumberValue: v.NumberValue}
+		case *pb_card.CustomFieldValue_DateValue:
+			valueProto.Value = &pb_board.CustomFieldValue_DateValue{DateValue: v.DateValue}
+		case *pb_card.CustomFieldValue_CheckboxValue:
+			valueProto.Value = &pb_board.CustomFieldValue_CheckboxValue{CheckboxValue: v.CheckboxValue}
+		case *pb_card.CustomFieldValue_OptionID:
+			valueProto.Value = &pb_board.CustomFieldValue_OptionID{OptionID: v.OptionID}
+		}
+
+		valuesProto = append(valuesProto, valueProto)
+	}
+
+	return valuesProto
+}
+
+func convertBoardExportToProto(boardExport *dtos.BoardExportDTO) *pb_board.BoardExport {
+	res := &pb_board.BoardExport{
+		ExportID:  boardExport.ID,
+		BoardID:   boardExport.BoardID,
+		Format:    boardExport.Format,
+		Status:    boardExport.Status,
+		Progress:  int32(boardExport.Progress),
+		FileName:  boardExport.FileName,
+		Error:     boardExport.Error,
+		CreatedAt: timestamppb.New(boardExport.CreatedAt),
+	}
+	if boardExport.CompletedAt != nil {
+		res.CompletedAt = timestamppb.New(*boardExport.CompletedAt)
+	}
+	return res
+}
+
+func convertBoardImportToProto(boardImport *dtos.BoardImportDTO) (*pb_board.BoardImport, error) {
+	res := &pb_board.BoardImport{
+		ImportID:  boardImport.ID,
+		Source:    boardImport.Source,
+		DryRun:    boardImport.DryRun,
+		Status:    boardImport.Status,
+		Error:     boardImport.Error,
+		CreatedAt: timestamppb.New(boardImport.CreatedAt),
+	}
+	if boardImport.BoardID != nil {
+		res.BoardID = *boardImport.BoardID
+	}
+	if boardImport.CompletedAt != nil {
+		res.CompletedAt = timestamppb.New(*boardImport.CompletedAt)
+	}
+
+	if boardImport.Report != "" {
+		var report importer.Report
+		if err := json.Unmarshal([]byte(boardImport.Report), &report); err != nil {
+			return nil, err
+		}
+
+		res.Report = &pb_board.ImportReport{
+			Lists:      int32(report.Lists),
+			Labels:     int32(report.Labels),
+			Cards:      int32(report.Cards),
+			Checklists: int32(report.Checklists),
+			Comments:   int32(report.Comments),
+			Members:    int32(report.Members),
+			Skipped:    convertImportReportItemsToProto(report.Skipped),
+			Truncated:  convertImportReportItemsToProto(report.Truncated),
+		}
+	}
+
+	return res, nil
+}
+
+func convertImportReportItemsToProto(items []importer.ReportItem) []*pb_board.ImportReportItem {
+	var protoItems []*pb_board.ImportReportItem
+	for _, item := range items {
+		protoItems = append(protoItems, &pb_board.ImportReportItem{
+			Type:     item.Type,
+			SourceID: item.SourceID,
+			Name:     item.Name,
+			Reason:   item.Reason,
+		})
+	}
+	return protoItems
+}
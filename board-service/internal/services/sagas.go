@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+
+	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
+	pb_list "github.com/sm888sm/halten-backend/list-service/api/pb"
+
+	"github.com/sm888sm/halten-backend/board-service/internal/repositories"
+	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+	"github.com/sm888sm/halten-backend/common/saga"
+	"github.com/sm888sm/halten-backend/models"
+)
+
+// sagaCreateBoard creates a board with its default list and card
+const sagaCreateBoard = "board.create"
+
+type createBoardData struct {
+	UserID  uint64 `json:"userID"`
+	Name    string `json:"name"`
+	BoardID uint64 `json:"boardID"`
+	ListID  uint64 `json:"listID"`
+
+	board *models.Board // Set while the saga runs, returned to the client
+}
+
+// createBoardSaga creates the board first, so discarding it undoes every step: the lists and cards
+// created on it go with it. Once the board exists its owner may see it, so a saga stopped after that
+// is carried on by Resume rather than discarding the board.
+func (s *BoardService) createBoardSaga() *saga.Definition {
+	return &saga.Definition{
+		Type:    sagaCreateBoard,
+		NewData: func() any { return &createBoardData{} },
+		Steps: []saga.Step{
+			{
+				Name: "board",
+				Action: func(ctx context.Context, data any, commit saga.Commit) error {
+					d := data.(*createBoardData)
+
+					repoRes, err := s.boardRepo.CreateBoard(&repositories.CreateBoardRequest{
+						Board: &models.Board{
+							Name:   d.Name,
+							UserID: d.UserID,
+						},
+						Created: func(tx *gorm.DB, board *models.Board) error {
+							d.BoardID = board.ID
+							return commit(tx)
+						},
+					})
+					if err != nil {
+						return err
+					}
+
+					d.board = repoRes.Board
+					return nil
+				},
+				Compensate: func(ctx context.Context, data any) error {
+					d := data.(*createBoardData)
+
+					return s.boardRepo.DiscardBoard(&repositories.DiscardBoardRequest{
+						BoardID: d.BoardID,
+						Cleanup: func(step string) publishers.Event {
+							return publishers.NewBoardCleanupEvent(s.publishers.BoardPublisher, events.MetadataFromContext(ctx), step)
+						},
+					})
+				},
+			},
+			{
+				Name: "default_list",
+				Action: func(ctx context.Context, data any, commit saga.Commit) error {
+					d := data.(*createBoardData)
+
+					listService, err := s.services.GetListClient()
+					if err != nil {
+						return errorhandlers.NewGrpcInternalError()
+					}
+
+					// A runner that stopped during the step may have created the list already
+					listsRes, err := listService.GetListsByBoard(d.outgoingContext(ctx), &pb_list.GetListsByBoardRequest{})
+					if err != nil {
+						return err
+					}
+					if len(listsRes.Lists) > 0 {
+						d.ListID = listsRes.Lists[0].ListID
+						return nil
+					}
+
+					listRes, err := listService.CreateList(d.outgoingContext(ctx), &pb_list.CreateListRequest{
+						Name: "Default List",
+					})
+					if err != nil {
+						return err
+					}
+
+					d.ListID = listRes.List.ListID
+					return nil
+				},
+				Retryable: true,
+			},
+			{
+				Name: "default_card",
+				Action: func(ctx context.Context, data any, commit saga.Commit) error {
+					d := data.(*createBoardData)
+
+					cardService, err := s.services.GetCardClient()
+					if err != nil {
+						return errorhandlers.NewGrpcInternalError()
+					}
+
+					// A runner that stopped during the step may have created the card already
+					cardsRes, err := cardService.GetCardsByList(d.outgoingContext(ctx), &pb_card.GetCardsByListRequest{ListID: d.ListID})
+					if err != nil {
+						return err
+					}
+					if len(cardsRes.Cards) > 0 {
+						return nil
+					}
+
+					_, err = cardService.CreateCard(d.outgoingContext(ctx), &pb_card.CreateCardRequest{
+						ListID: d.ListID,
+						Name:   "Default Card",
+					})
+					return err
+				},
+				Retryable: true,
+			},
+		},
+	}
+}
+
+// outgoingContext makes the calls of the saga as the user on the new board
+func (d *createBoardData) outgoingContext(ctx context.Context) context.Context {
+	md := metadata.Pairs("userID", strconv.FormatUint(d.UserID, 10), "boardID", strconv.FormatUint(d.BoardID, 10))
+	return metadata.NewOutgoingContext(ctx, md)
+}
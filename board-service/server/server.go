@@ -0,0 +1,180 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/sm888sm/halten-backend/board-service/api/pb"
+	external_services "github.com/sm888sm/halten-backend/board-service/external/services"
+	consumer "github.com/sm888sm/halten-backend/board-service/internal/messaging/rabbitmq/consumer"
+
+	"github.com/sm888sm/halten-backend/common/messaging/outbox"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/consumers"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+	"github.com/sm888sm/halten-backend/common/saga"
+	"github.com/sm888sm/halten-backend/common/scheduler"
+
+	"github.com/sm888sm/halten-backend/board-service/internal/config"
+	"github.com/sm888sm/halten-backend/board-service/internal/connections/db"
+	"github.com/sm888sm/halten-backend/board-service/internal/connections/rabbitmq"
+
+	"github.com/sm888sm/halten-backend/board-service/internal/importer"
+	"github.com/sm888sm/halten-backend/board-service/internal/jobs"
+	"github.com/sm888sm/halten-backend/board-service/internal/middlewares"
+	"github.com/sm888sm/halten-backend/board-service/internal/repositories"
+	"github.com/sm888sm/halten-backend/board-service/internal/services"
+)
+
+// Run serves board-service on addr, :<PORT> when empty, with the configuration of the environment. It
+// blocks, stopping the process when the service can't start or stops serving.
+func Run(addr string) {
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Error loading configuration: %v", err)
+	}
+
+	// Connect to database
+	err = db.Connect(&cfg.Database)
+	if err != nil {
+		log.Fatalf("Error connecting to database: %v", err)
+	} else {
+		log.Printf("Successfully connected to database.")
+	}
+
+	sqlDB, err := db.SQLConn.DB()
+	if err != nil {
+		log.Fatalf("Error getting underlying sql.DB: %v", err)
+	}
+	defer sqlDB.Close()
+
+	// Connect to RabbitMQ
+	err = rabbitmq.Connect(&cfg.RabbitMQ)
+	if err != nil {
+		log.Fatalf("Error connecting to RabbitMQ: %v", err)
+	} else {
+		log.Printf("Successfully connected to RabbitMQ.")
+	}
+
+	// Initialize repositories
+	boardRepo := repositories.NewBoardRepository(db.SQLConn)
+
+	// Initialize external services
+	svc := external_services.GetServices(&cfg.Services)
+	defer svc.Close()
+
+	// Initialize publishers
+	publishers := &publishers.Publishers{
+		BoardPublisher: publishers.NewBoardPublisher(),
+		CardPublisher:  publishers.NewCardPublisher(),
+		ListPublisher:  publishers.NewListPublisher(),
+	}
+
+	// Publish the messages written to the outbox
+	relay := outbox.NewRelay(db.SQLConn, rabbitmq.GetBus(), cfg.Outbox.Interval, cfg.Outbox.Retention)
+	go relay.Run(context.Background())
+
+	// Run the flows spanning several services as sagas
+	sagas := saga.NewOrchestrator(db.SQLConn, cfg.Saga.StepTimeout)
+
+	// Initialize services
+	boardService := services.NewBoardService(boardRepo, svc, publishers, sagas, cfg.Trash.Retention, cfg.Undo.Window, cfg.Export.Dir)
+
+	// Run scheduled jobs
+	runScheduler(cfg, boardRepo, publishers, sagas)
+
+	// Create gRPC server with validation interceptor
+
+	AuthInterceptor := middlewares.NewAuthInterceptor(db.SQLConn, svc)
+	validatorInterceptor := middlewares.NewValidatorInterceptor(db.SQLConn)
+
+	grpcServer := grpc.NewServer(
+		// Imports carry the whole exported board
+		grpc.MaxRecvMsgSize(importer.MaxContentSize+1<<20),
+		grpc.ChainUnaryInterceptor(
+			AuthInterceptor.AuthInterceptor,
+			validatorInterceptor.ValidationInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			AuthInterceptor.StreamAuthInterceptor,
+		),
+	)
+
+	// Register services
+	pb.RegisterBoardServiceServer(grpcServer, boardService)
+
+	// Run RabbitMQ Consumer
+	runBoardConsumer(boardService)
+
+	// Expose the consumer metrics
+	if cfg.MetricsAddr != "" {
+		go consumers.ServeMetrics(cfg.MetricsAddr)
+	}
+
+	// Start listening
+	if addr == "" {
+		addr = fmt.Sprintf(":%d", cfg.Port)
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+
+	log.Printf("Service listening on %s", addr)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+}
+
+func runBoardConsumer(boardService *services.BoardService) {
+	// Each queue is consumed on a subscription of its own
+	b := rabbitmq.GetBus()
+
+	// Initialize your consumer here.
+	c := consumer.NewBoardConsumer(b, db.SQLConn, boardService)
+
+	// Run the consumer in a separate goroutine because it's a blocking operation
+	go func() {
+		err := c.ConsumeBoardMessages(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to consume messages: %v", err)
+		}
+	}()
+
+	go func() {
+		err := c.ConsumeExportMessages(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to consume export messages: %v", err)
+		}
+	}()
+
+	go func() {
+		err := c.ConsumeImportMessages(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to consume import messages: %v", err)
+		}
+	}()
+
+	go func() {
+		err := c.ConsumeDeletionMessages(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to consume deletion messages: %v", err)
+		}
+	}()
+}
+
+func runScheduler(cfg *config.Config, boardRepo repositories.BoardRepository, publishers *publishers.Publishers, sagas *saga.Orchestrator) {
+	s := scheduler.NewScheduler(db.SQLConn, cfg.Scheduler.LockKey, cfg.Scheduler.Interval)
+	s.AddJob("trash_purge", jobs.NewTrashPurgeJob(boardRepo, publishers.BoardPublisher, cfg.Trash.Retention, cfg.Trash.AttachmentDir).Run)
+	s.AddJob("board_deletions", jobs.NewBoardDeletionJob(boardRepo, publishers.BoardPublisher, cfg.Deletion.StepTimeout, cfg.Deletion.MaxAttempts).Run)
+	s.AddJob("undo_log_cleanup", jobs.NewUndoLogCleanupJob(boardRepo, cfg.Undo.Window).Run)
+	s.AddJob("export_cleanup", jobs.NewExportCleanupJob(boardRepo, cfg.Export.Retention, cfg.Export.Dir).Run)
+	s.AddJob("sagas", sagas.Resume)
+
+	// Only the replica holding the advisory lock runs the jobs
+	go s.Run(context.Background())
+}
@@ -27,20 +27,25 @@ type Card struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID      uint64                 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	BoardID     uint64                 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
-	ListID      uint64                 `protobuf:"varint,3,opt,name=listID,proto3" json:"listID,omitempty"`
-	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Position    int64                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
-	Attachments []uint64               `protobuf:"varint,7,rep,packed,name=attachments,proto3" json:"attachments,omitempty"`
-	Labels      []uint64               `protobuf:"varint,8,rep,packed,name=labels,proto3" json:"labels,omitempty"`
-	Members     []uint64               `protobuf:"varint,9,rep,packed,name=members,proto3" json:"members,omitempty"`
-	IsCompleted bool                   `protobuf:"varint,10,opt,name=isCompleted,proto3" json:"isCompleted,omitempty"`
-	StartDate   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	DueDate     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CardID            uint64                 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	BoardID           uint64                 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	ListID            uint64                 `protobuf:"varint,3,opt,name=listID,proto3" json:"listID,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description       string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Position          int64                  `protobuf:"varint,6,opt,name=position,proto3" json:"position,omitempty"`
+	Attachments       []uint64               `protobuf:"varint,7,rep,packed,name=attachments,proto3" json:"attachments,omitempty"`
+	Labels            []uint64               `protobuf:"varint,8,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Members           []uint64               `protobuf:"varint,9,rep,packed,name=members,proto3" json:"members,omitempty"`
+	IsCompleted       bool                   `protobuf:"varint,10,opt,name=isCompleted,proto3" json:"isCompleted,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	DueDate           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Reminders         []*CardReminder        `protobuf:"bytes,15,rep,name=reminders,proto3" json:"reminders,omitempty"`
+	Recurrence        *CardRecurrence        `protobuf:"bytes,16,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	CustomFieldValues []*CustomFieldValue    `protobuf:"bytes,17,rep,name=custom_field_values,json=customFieldValues,proto3" json:"custom_field_values,omitempty"`
+	DescriptionHtml   string                 `protobuf:"bytes,18,opt,name=description_html,json=descriptionHtml,proto3" json:"description_html,omitempty"` // Description rendered from Markdown to sanitized HTML
+	Version           uint64                 `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *Card) Reset() {
@@ -173,25 +178,62 @@ func (x *Card) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Card) GetReminders() []*CardReminder {
+	if x != nil {
+		return x.Reminders
+	}
+	return nil
+}
+
+func (x *Card) GetRecurrence() *CardRecurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Card) GetCustomFieldValues() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFieldValues
+	}
+	return nil
+}
+
+func (x *Card) GetDescriptionHtml() string {
+	if x != nil {
+		return x.DescriptionHtml
+	}
+	return ""
+}
+
+func (x *Card) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type CardMeta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID          uint64                 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	BoardID         uint64                 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
-	ListID          uint64                 `protobuf:"varint,3,opt,name=listID,proto3" json:"listID,omitempty"`
-	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Position        int64                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
-	Labels          []uint64               `protobuf:"varint,6,rep,packed,name=labels,proto3" json:"labels,omitempty"`
-	Members         []uint64               `protobuf:"varint,7,rep,packed,name=members,proto3" json:"members,omitempty"`
-	TotalAttachment uint64                 `protobuf:"varint,8,opt,name=totalAttachment,proto3" json:"totalAttachment,omitempty"`
-	TotalComment    uint64                 `protobuf:"varint,9,opt,name=totalComment,proto3" json:"totalComment,omitempty"`
-	IsCompleted     bool                   `protobuf:"varint,10,opt,name=isCompleted,proto3" json:"isCompleted,omitempty"`
-	StartDate       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	DueDate         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CardID            uint64                 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	BoardID           uint64                 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	ListID            uint64                 `protobuf:"varint,3,opt,name=listID,proto3" json:"listID,omitempty"`
+	Name              string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Position          int64                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	Labels            []uint64               `protobuf:"varint,6,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Members           []uint64               `protobuf:"varint,7,rep,packed,name=members,proto3" json:"members,omitempty"`
+	TotalAttachment   uint64                 `protobuf:"varint,8,opt,name=totalAttachment,proto3" json:"totalAttachment,omitempty"`
+	TotalComment      uint64                 `protobuf:"varint,9,opt,name=totalComment,proto3" json:"totalComment,omitempty"`
+	IsCompleted       bool                   `protobuf:"varint,10,opt,name=isCompleted,proto3" json:"isCompleted,omitempty"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	DueDate           *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CustomFieldValues []*CustomFieldValue    `protobuf:"bytes,15,rep,name=custom_field_values,json=customFieldValues,proto3" json:"custom_field_values,omitempty"`
+	Version           uint64                 `protobuf:"varint,16,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (x *CardMeta) Reset() {
@@ -324,6 +366,292 @@ func (x *CardMeta) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *CardMeta) GetCustomFieldValues() []*CustomFieldValue {
+	if x != nil {
+		return x.CustomFieldValues
+	}
+	return nil
+}
+
+func (x *CardMeta) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type CardReminder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OffsetMinutes int64                  `protobuf:"varint,1,opt,name=offset_minutes,json=offsetMinutes,proto3" json:"offset_minutes,omitempty"`
+	NotifiedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=notified_at,json=notifiedAt,proto3" json:"notified_at,omitempty"`
+}
+
+func (x *CardReminder) Reset() {
+	*x = CardReminder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardReminder) ProtoMessage() {}
+
+func (x *CardReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardReminder.ProtoReflect.Descriptor instead.
+func (*CardReminder) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CardReminder) GetOffsetMinutes() int64 {
+	if x != nil {
+		return x.OffsetMinutes
+	}
+	return 0
+}
+
+func (x *CardReminder) GetNotifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotifiedAt
+	}
+	return nil
+}
+
+type CardRecurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frequency    string                 `protobuf:"bytes,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Interval     int32                  `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Weekdays     []string               `protobuf:"bytes,3,rep,name=weekdays,proto3" json:"weekdays,omitempty"`
+	MonthDay     int32                  `protobuf:"varint,4,opt,name=month_day,json=monthDay,proto3" json:"month_day,omitempty"`
+	TargetListID uint64                 `protobuf:"varint,5,opt,name=target_listID,json=targetListID,proto3" json:"target_listID,omitempty"`
+	NextRunAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+}
+
+func (x *CardRecurrence) Reset() {
+	*x = CardRecurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardRecurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardRecurrence) ProtoMessage() {}
+
+func (x *CardRecurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardRecurrence.ProtoReflect.Descriptor instead.
+func (*CardRecurrence) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CardRecurrence) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *CardRecurrence) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *CardRecurrence) GetWeekdays() []string {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *CardRecurrence) GetMonthDay() int32 {
+	if x != nil {
+		return x.MonthDay
+	}
+	return 0
+}
+
+func (x *CardRecurrence) GetTargetListID() uint64 {
+	if x != nil {
+		return x.TargetListID
+	}
+	return 0
+}
+
+func (x *CardRecurrence) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+type CustomFieldValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomFieldID uint64 `protobuf:"varint,1,opt,name=customFieldID,proto3" json:"customFieldID,omitempty"`
+	// Types that are assignable to Value:
+	//	*CustomFieldValue_TextValue
+	//	*CustomFieldValue_NumberValue
+	//	*CustomFieldValue_DateValue
+	//	*CustomFieldValue_CheckboxValue
+	//	*CustomFieldValue_OptionID
+	Value isCustomFieldValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *CustomFieldValue) Reset() {
+	*x = CustomFieldValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomFieldValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomFieldValue) ProtoMessage() {}
+
+func (x *CustomFieldValue) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomFieldValue.ProtoReflect.Descriptor instead.
+func (*CustomFieldValue) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CustomFieldValue) GetCustomFieldID() uint64 {
+	if x != nil {
+		return x.CustomFieldID
+	}
+	return 0
+}
+
+func (m *CustomFieldValue) GetValue() isCustomFieldValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *CustomFieldValue) GetTextValue() string {
+	if x, ok := x.GetValue().(*CustomFieldValue_TextValue); ok {
+		return x.TextValue
+	}
+	return ""
+}
+
+func (x *CustomFieldValue) GetNumberValue() float64 {
+	if x, ok := x.GetValue().(*CustomFieldValue_NumberValue); ok {
+		return x.NumberValue
+	}
+	return 0
+}
+
+func (x *CustomFieldValue) GetDateValue() *timestamppb.Timestamp {
+	if x, ok := x.GetValue().(*CustomFieldValue_DateValue); ok {
+		return x.DateValue
+	}
+	return nil
+}
+
+func (x *CustomFieldValue) GetCheckboxValue() bool {
+	if x, ok := x.GetValue().(*CustomFieldValue_CheckboxValue); ok {
+		return x.CheckboxValue
+	}
+	return false
+}
+
+func (x *CustomFieldValue) GetOptionID() uint64 {
+	if x, ok := x.GetValue().(*CustomFieldValue_OptionID); ok {
+		return x.OptionID
+	}
+	return 0
+}
+
+type isCustomFieldValue_Value interface {
+	isCustomFieldValue_Value()
+}
+
+type CustomFieldValue_TextValue struct {
+	TextValue string `protobuf:"bytes,2,opt,name=text_value,json=textValue,proto3,oneof"`
+}
+
+type CustomFieldValue_NumberValue struct {
+	NumberValue float64 `protobuf:"fixed64,3,opt,name=number_value,json=numberValue,proto3,oneof"`
+}
+
+type CustomFieldValue_DateValue struct {
+	DateValue *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=date_value,json=dateValue,proto3,oneof"`
+}
+
+type CustomFieldValue_CheckboxValue struct {
+	CheckboxValue bool `protobuf:"varint,5,opt,name=checkbox_value,json=checkboxValue,proto3,oneof"`
+}
+
+type CustomFieldValue_OptionID struct {
+	OptionID uint64 `protobuf:"varint,6,opt,name=optionID,proto3,oneof"`
+}
+
+func (*CustomFieldValue_TextValue) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_NumberValue) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_DateValue) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_CheckboxValue) isCustomFieldValue_Value() {}
+
+func (*CustomFieldValue_OptionID) isCustomFieldValue_Value() {}
+
 type Label struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -338,7 +666,7 @@ type Label struct {
 func (x *Label) Reset() {
 	*x = Label{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[2]
+		mi := &file_card_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -351,7 +679,7 @@ func (x *Label) String() string {
 func (*Label) ProtoMessage() {}
 
 func (x *Label) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[2]
+	mi := &file_card_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -364,7 +692,7 @@ func (x *Label) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Label.ProtoReflect.Descriptor instead.
 func (*Label) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{2}
+	return file_card_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Label) GetCardID() uint64 {
@@ -411,7 +739,7 @@ type Attachment struct {
 func (x *Attachment) Reset() {
 	*x = Attachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[3]
+		mi := &file_card_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -424,7 +752,7 @@ func (x *Attachment) String() string {
 func (*Attachment) ProtoMessage() {}
 
 func (x *Attachment) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[3]
+	mi := &file_card_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +765,7 @@ func (x *Attachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
 func (*Attachment) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{3}
+	return file_card_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Attachment) GetAttachmentID() uint64 {
@@ -495,7 +823,7 @@ type User struct {
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[4]
+		mi := &file_card_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -508,7 +836,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[4]
+	mi := &file_card_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +849,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{4}
+	return file_card_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *User) GetUserID() uint64 {
@@ -550,18 +878,24 @@ type Comment struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentID uint64                 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
-	CardID    uint64                 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	User      *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Content   string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CommentID   uint64                 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	CardID      uint64                 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	User        *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Content     string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ParentID    uint64                 `protobuf:"varint,7,opt,name=parentID,proto3" json:"parentID,omitempty"`
+	Replies     []*Comment             `protobuf:"bytes,8,rep,name=replies,proto3" json:"replies,omitempty"`
+	Reactions   []*CommentReaction     `protobuf:"bytes,9,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Mentions    []uint64               `protobuf:"varint,10,rep,packed,name=mentions,proto3" json:"mentions,omitempty"`
+	EditedAt    *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	ContentHtml string                 `protobuf:"bytes,12,opt,name=content_html,json=contentHtml,proto3" json:"content_html,omitempty"` // Content rendered from Markdown to sanitized HTML
 }
 
 func (x *Comment) Reset() {
 	*x = Comment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[5]
+		mi := &file_card_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -574,7 +908,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[5]
+	mi := &file_card_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -587,7 +921,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{5}
+	return file_card_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Comment) GetCommentID() uint64 {
@@ -632,86 +966,74 @@ func (x *Comment) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type CreateCardRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ListID uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+func (x *Comment) GetParentID() uint64 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
 }
 
-func (x *CreateCardRequest) Reset() {
-	*x = CreateCardRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Comment) GetReplies() []*Comment {
+	if x != nil {
+		return x.Replies
 	}
+	return nil
 }
 
-func (x *CreateCardRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateCardRequest) ProtoMessage() {}
-
-func (x *CreateCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Comment) GetReactions() []*CommentReaction {
+	if x != nil {
+		return x.Reactions
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CreateCardRequest.ProtoReflect.Descriptor instead.
-func (*CreateCardRequest) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{6}
+func (x *Comment) GetMentions() []uint64 {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
 }
 
-func (x *CreateCardRequest) GetListID() uint64 {
+func (x *Comment) GetEditedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ListID
+		return x.EditedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateCardRequest) GetName() string {
+func (x *Comment) GetContentHtml() string {
 	if x != nil {
-		return x.Name
+		return x.ContentHtml
 	}
 	return ""
 }
 
-type CreateCardResponse struct {
+type CommentReaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Card *Card `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Emoji   string   `protobuf:"bytes,1,opt,name=emoji,proto3" json:"emoji,omitempty"`
+	UserIDs []uint64 `protobuf:"varint,2,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
 }
 
-func (x *CreateCardResponse) Reset() {
-	*x = CreateCardResponse{}
+func (x *CommentReaction) Reset() {
+	*x = CommentReaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[7]
+		mi := &file_card_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateCardResponse) String() string {
+func (x *CommentReaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCardResponse) ProtoMessage() {}
+func (*CommentReaction) ProtoMessage() {}
 
-func (x *CreateCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[7]
+func (x *CommentReaction) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,43 +1044,51 @@ func (x *CreateCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCardResponse.ProtoReflect.Descriptor instead.
-func (*CreateCardResponse) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CommentReaction.ProtoReflect.Descriptor instead.
+func (*CommentReaction) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateCardResponse) GetCard() *Card {
+func (x *CommentReaction) GetEmoji() string {
 	if x != nil {
-		return x.Card
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *CommentReaction) GetUserIDs() []uint64 {
+	if x != nil {
+		return x.UserIDs
 	}
 	return nil
 }
 
-type GetCardByIDRequest struct {
+type CommentEdit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Content  string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	EditedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
 }
 
-func (x *GetCardByIDRequest) Reset() {
-	*x = GetCardByIDRequest{}
+func (x *CommentEdit) Reset() {
+	*x = CommentEdit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[8]
+		mi := &file_card_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCardByIDRequest) String() string {
+func (x *CommentEdit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCardByIDRequest) ProtoMessage() {}
+func (*CommentEdit) ProtoMessage() {}
 
-func (x *GetCardByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[8]
+func (x *CommentEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,43 +1099,54 @@ func (x *GetCardByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCardByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetCardByIDRequest) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CommentEdit.ProtoReflect.Descriptor instead.
+func (*CommentEdit) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetCardByIDRequest) GetCardID() uint64 {
+func (x *CommentEdit) GetContent() string {
 	if x != nil {
-		return x.CardID
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-type GetCardByIDResponse struct {
+func (x *CommentEdit) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+type Pagination struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Card *Card `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	CurrentPage  uint64 `protobuf:"varint,1,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	TotalPages   uint64 `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	ItemsPerPage uint64 `protobuf:"varint,3,opt,name=items_per_page,json=itemsPerPage,proto3" json:"items_per_page,omitempty"`
+	TotalItems   uint64 `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
+	HasMore      bool   `protobuf:"varint,5,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 }
 
-func (x *GetCardByIDResponse) Reset() {
-	*x = GetCardByIDResponse{}
+func (x *Pagination) Reset() {
+	*x = Pagination{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[9]
+		mi := &file_card_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCardByIDResponse) String() string {
+func (x *Pagination) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCardByIDResponse) ProtoMessage() {}
+func (*Pagination) ProtoMessage() {}
 
-func (x *GetCardByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[9]
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,81 +1157,80 @@ func (x *GetCardByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCardByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetCardByIDResponse) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetCardByIDResponse) GetCard() *Card {
+func (x *Pagination) GetCurrentPage() uint64 {
 	if x != nil {
-		return x.Card
+		return x.CurrentPage
 	}
-	return nil
-}
-
-type GetCardsByBoardRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *GetCardsByBoardRequest) Reset() {
-	*x = GetCardsByBoardRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Pagination) GetTotalPages() uint64 {
+	if x != nil {
+		return x.TotalPages
 	}
+	return 0
 }
 
-func (x *GetCardsByBoardRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Pagination) GetItemsPerPage() uint64 {
+	if x != nil {
+		return x.ItemsPerPage
+	}
+	return 0
 }
 
-func (*GetCardsByBoardRequest) ProtoMessage() {}
-
-func (x *GetCardsByBoardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Pagination) GetTotalItems() uint64 {
+	if x != nil {
+		return x.TotalItems
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCardsByBoardRequest.ProtoReflect.Descriptor instead.
-func (*GetCardsByBoardRequest) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{10}
+func (x *Pagination) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
 }
 
-type GetCardsByBoardResponse struct {
+// A card as it was at a version of its history
+type CardVersion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cards []*CardMeta `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	Version     uint64                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	StartDate   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Labels      []uint64               `protobuf:"varint,6,rep,packed,name=labels,proto3" json:"labels,omitempty"`
+	Members     []uint64               `protobuf:"varint,7,rep,packed,name=members,proto3" json:"members,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Changes     []*CardFieldChange     `protobuf:"bytes,9,rep,name=changes,proto3" json:"changes,omitempty"` // Changes from the previous version, empty for the oldest one kept
 }
 
-func (x *GetCardsByBoardResponse) Reset() {
-	*x = GetCardsByBoardResponse{}
+func (x *CardVersion) Reset() {
+	*x = CardVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[11]
+		mi := &file_card_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCardsByBoardResponse) String() string {
+func (x *CardVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCardsByBoardResponse) ProtoMessage() {}
+func (*CardVersion) ProtoMessage() {}
 
-func (x *GetCardsByBoardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[11]
+func (x *CardVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,75 +1241,89 @@ func (x *GetCardsByBoardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCardsByBoardResponse.ProtoReflect.Descriptor instead.
-func (*GetCardsByBoardResponse) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CardVersion.ProtoReflect.Descriptor instead.
+func (*CardVersion) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetCardsByBoardResponse) GetCards() []*CardMeta {
+func (x *CardVersion) GetVersion() uint64 {
 	if x != nil {
-		return x.Cards
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-type GetCardsByListRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CardVersion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
 
-	ListID uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+func (x *CardVersion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *GetCardsByListRequest) Reset() {
-	*x = GetCardsByListRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CardVersion) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
 	}
+	return nil
 }
 
-func (x *GetCardsByListRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CardVersion) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
 }
 
-func (*GetCardsByListRequest) ProtoMessage() {}
+func (x *CardVersion) GetLabels() []uint64 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
 
-func (x *GetCardsByListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CardVersion) GetMembers() []uint64 {
+	if x != nil {
+		return x.Members
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetCardsByListRequest.ProtoReflect.Descriptor instead.
-func (*GetCardsByListRequest) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{12}
+func (x *CardVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetCardsByListRequest) GetListID() uint64 {
+func (x *CardVersion) GetChanges() []*CardFieldChange {
 	if x != nil {
-		return x.ListID
+		return x.Changes
 	}
-	return 0
+	return nil
 }
 
-type GetCardsByListResponse struct {
+// Name, description and dates have old and new values, labels and members the IDs added and removed
+type CardFieldChange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cards []*CardMeta `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+	Field    string   `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue string   `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue string   `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	Added    []uint64 `protobuf:"varint,4,rep,packed,name=added,proto3" json:"added,omitempty"`
+	Removed  []uint64 `protobuf:"varint,5,rep,packed,name=removed,proto3" json:"removed,omitempty"`
 }
 
-func (x *GetCardsByListResponse) Reset() {
-	*x = GetCardsByListResponse{}
+func (x *CardFieldChange) Reset() {
+	*x = CardFieldChange{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -977,13 +1331,13 @@ func (x *GetCardsByListResponse) Reset() {
 	}
 }
 
-func (x *GetCardsByListResponse) String() string {
+func (x *CardFieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCardsByListResponse) ProtoMessage() {}
+func (*CardFieldChange) ProtoMessage() {}
 
-func (x *GetCardsByListResponse) ProtoReflect() protoreflect.Message {
+func (x *CardFieldChange) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -995,29 +1349,57 @@ func (x *GetCardsByListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCardsByListResponse.ProtoReflect.Descriptor instead.
-func (*GetCardsByListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CardFieldChange.ProtoReflect.Descriptor instead.
+func (*CardFieldChange) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetCardsByListResponse) GetCards() []*CardMeta {
+func (x *CardFieldChange) GetField() string {
 	if x != nil {
-		return x.Cards
+		return x.Field
+	}
+	return ""
+}
+
+func (x *CardFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *CardFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *CardFieldChange) GetAdded() []uint64 {
+	if x != nil {
+		return x.Added
 	}
 	return nil
 }
 
-type UpdateCardNameRequest struct {
+func (x *CardFieldChange) GetRemoved() []uint64 {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+type CreateCardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	ListID uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
 	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *UpdateCardNameRequest) Reset() {
-	*x = UpdateCardNameRequest{}
+func (x *CreateCardRequest) Reset() {
+	*x = CreateCardRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,13 +1407,13 @@ func (x *UpdateCardNameRequest) Reset() {
 	}
 }
 
-func (x *UpdateCardNameRequest) String() string {
+func (x *CreateCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCardNameRequest) ProtoMessage() {}
+func (*CreateCardRequest) ProtoMessage() {}
 
-func (x *UpdateCardNameRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateCardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,35 +1425,40 @@ func (x *UpdateCardNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCardNameRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCardNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCardRequest.ProtoReflect.Descriptor instead.
+func (*CreateCardRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateCardNameRequest) GetCardID() uint64 {
+func (x *CreateCardRequest) GetListID() uint64 {
 	if x != nil {
-		return x.CardID
+		return x.ListID
 	}
 	return 0
 }
 
-func (x *UpdateCardNameRequest) GetName() string {
+func (x *CreateCardRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-type UpdateCardNameResponse struct {
+// WIPLimitWarning is returned when a card is added to a list at its WIP limit on a board that warns
+// instead of refusing
+type WIPLimitWarning struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ListID    uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+	ListName  string `protobuf:"bytes,2,opt,name=list_name,json=listName,proto3" json:"list_name,omitempty"`
+	WipLimit  int32  `protobuf:"varint,3,opt,name=wip_limit,json=wipLimit,proto3" json:"wip_limit,omitempty"`
+	CardCount int64  `protobuf:"varint,4,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"` // Cards in the list including the one added
 }
 
-func (x *UpdateCardNameResponse) Reset() {
-	*x = UpdateCardNameResponse{}
+func (x *WIPLimitWarning) Reset() {
+	*x = WIPLimitWarning{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1079,13 +1466,13 @@ func (x *UpdateCardNameResponse) Reset() {
 	}
 }
 
-func (x *UpdateCardNameResponse) String() string {
+func (x *WIPLimitWarning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCardNameResponse) ProtoMessage() {}
+func (*WIPLimitWarning) ProtoMessage() {}
 
-func (x *UpdateCardNameResponse) ProtoReflect() protoreflect.Message {
+func (x *WIPLimitWarning) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1097,29 +1484,50 @@ func (x *UpdateCardNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCardNameResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCardNameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WIPLimitWarning.ProtoReflect.Descriptor instead.
+func (*WIPLimitWarning) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateCardNameResponse) GetMessage() string {
+func (x *WIPLimitWarning) GetListID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.ListID
+	}
+	return 0
+}
+
+func (x *WIPLimitWarning) GetListName() string {
+	if x != nil {
+		return x.ListName
 	}
 	return ""
 }
 
-type UpdateCardDescriptionRequest struct {
+func (x *WIPLimitWarning) GetWipLimit() int32 {
+	if x != nil {
+		return x.WipLimit
+	}
+	return 0
+}
+
+func (x *WIPLimitWarning) GetCardCount() int64 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+type CreateCardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID      uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Card            *Card            `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	WipLimitWarning *WIPLimitWarning `protobuf:"bytes,2,opt,name=wip_limit_warning,json=wipLimitWarning,proto3" json:"wip_limit_warning,omitempty"`
 }
 
-func (x *UpdateCardDescriptionRequest) Reset() {
-	*x = UpdateCardDescriptionRequest{}
+func (x *CreateCardResponse) Reset() {
+	*x = CreateCardResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1127,13 +1535,13 @@ func (x *UpdateCardDescriptionRequest) Reset() {
 	}
 }
 
-func (x *UpdateCardDescriptionRequest) String() string {
+func (x *CreateCardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCardDescriptionRequest) ProtoMessage() {}
+func (*CreateCardResponse) ProtoMessage() {}
 
-func (x *UpdateCardDescriptionRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateCardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1145,35 +1553,35 @@ func (x *UpdateCardDescriptionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCardDescriptionRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCardDescriptionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateCardResponse.ProtoReflect.Descriptor instead.
+func (*CreateCardResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateCardDescriptionRequest) GetCardID() uint64 {
+func (x *CreateCardResponse) GetCard() *Card {
 	if x != nil {
-		return x.CardID
+		return x.Card
 	}
-	return 0
+	return nil
 }
 
-func (x *UpdateCardDescriptionRequest) GetDescription() string {
+func (x *CreateCardResponse) GetWipLimitWarning() *WIPLimitWarning {
 	if x != nil {
-		return x.Description
+		return x.WipLimitWarning
 	}
-	return ""
+	return nil
 }
 
-type UpdateCardDescriptionResponse struct {
+type GetCardByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
 }
 
-func (x *UpdateCardDescriptionResponse) Reset() {
-	*x = UpdateCardDescriptionResponse{}
+func (x *GetCardByIDRequest) Reset() {
+	*x = GetCardByIDRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1181,13 +1589,13 @@ func (x *UpdateCardDescriptionResponse) Reset() {
 	}
 }
 
-func (x *UpdateCardDescriptionResponse) String() string {
+func (x *GetCardByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCardDescriptionResponse) ProtoMessage() {}
+func (*GetCardByIDRequest) ProtoMessage() {}
 
-func (x *UpdateCardDescriptionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCardByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1199,31 +1607,28 @@ func (x *UpdateCardDescriptionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCardDescriptionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCardDescriptionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCardByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetCardByIDRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *UpdateCardDescriptionResponse) GetMessage() string {
+func (x *GetCardByIDRequest) GetCardID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.CardID
 	}
-	return ""
+	return 0
 }
 
-type MoveCardPositionRequest struct {
+type GetCardByIDResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID    uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	Position  int64  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
-	OldListID uint64 `protobuf:"varint,3,opt,name=old_listID,json=oldListID,proto3" json:"old_listID,omitempty"`
-	NewListID uint64 `protobuf:"varint,4,opt,name=new_listID,json=newListID,proto3" json:"new_listID,omitempty"`
+	Card *Card `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
 }
 
-func (x *MoveCardPositionRequest) Reset() {
-	*x = MoveCardPositionRequest{}
+func (x *GetCardByIDResponse) Reset() {
+	*x = GetCardByIDResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1231,13 +1636,13 @@ func (x *MoveCardPositionRequest) Reset() {
 	}
 }
 
-func (x *MoveCardPositionRequest) String() string {
+func (x *GetCardByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveCardPositionRequest) ProtoMessage() {}
+func (*GetCardByIDResponse) ProtoMessage() {}
 
-func (x *MoveCardPositionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCardByIDResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1249,49 +1654,32 @@ func (x *MoveCardPositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveCardPositionRequest.ProtoReflect.Descriptor instead.
-func (*MoveCardPositionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCardByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetCardByIDResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *MoveCardPositionRequest) GetCardID() uint64 {
-	if x != nil {
-		return x.CardID
-	}
-	return 0
-}
-
-func (x *MoveCardPositionRequest) GetPosition() int64 {
-	if x != nil {
-		return x.Position
-	}
-	return 0
-}
-
-func (x *MoveCardPositionRequest) GetOldListID() uint64 {
-	if x != nil {
-		return x.OldListID
-	}
-	return 0
-}
-
-func (x *MoveCardPositionRequest) GetNewListID() uint64 {
+func (x *GetCardByIDResponse) GetCard() *Card {
 	if x != nil {
-		return x.NewListID
+		return x.Card
 	}
-	return 0
+	return nil
 }
 
-type MoveCardPositionResponse struct {
+// Operators: eq, ne, gt, lt, contains (text only), set, unset. The value is parsed by field type,
+// dates as RFC 3339 and select values as optionID.
+type CustomFieldFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CustomFieldID uint64 `protobuf:"varint,1,opt,name=customFieldID,proto3" json:"customFieldID,omitempty"`
+	Operator      string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *MoveCardPositionResponse) Reset() {
-	*x = MoveCardPositionResponse{}
+func (x *CustomFieldFilter) Reset() {
+	*x = CustomFieldFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1299,13 +1687,13 @@ func (x *MoveCardPositionResponse) Reset() {
 	}
 }
 
-func (x *MoveCardPositionResponse) String() string {
+func (x *CustomFieldFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MoveCardPositionResponse) ProtoMessage() {}
+func (*CustomFieldFilter) ProtoMessage() {}
 
-func (x *MoveCardPositionResponse) ProtoReflect() protoreflect.Message {
+func (x *CustomFieldFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1317,28 +1705,43 @@ func (x *MoveCardPositionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MoveCardPositionResponse.ProtoReflect.Descriptor instead.
-func (*MoveCardPositionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomFieldFilter.ProtoReflect.Descriptor instead.
+func (*CustomFieldFilter) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MoveCardPositionResponse) GetMessage() string {
+func (x *CustomFieldFilter) GetCustomFieldID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.CustomFieldID
+	}
+	return 0
+}
+
+func (x *CustomFieldFilter) GetOperator() string {
+	if x != nil {
+		return x.Operator
 	}
 	return ""
 }
 
-type DeleteCardRequest struct {
+func (x *CustomFieldFilter) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CustomFieldSort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	CustomFieldID uint64 `protobuf:"varint,1,opt,name=customFieldID,proto3" json:"customFieldID,omitempty"`
+	Descending    bool   `protobuf:"varint,2,opt,name=descending,proto3" json:"descending,omitempty"`
 }
 
-func (x *DeleteCardRequest) Reset() {
-	*x = DeleteCardRequest{}
+func (x *CustomFieldSort) Reset() {
+	*x = CustomFieldSort{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1346,13 +1749,13 @@ func (x *DeleteCardRequest) Reset() {
 	}
 }
 
-func (x *DeleteCardRequest) String() string {
+func (x *CustomFieldSort) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCardRequest) ProtoMessage() {}
+func (*CustomFieldSort) ProtoMessage() {}
 
-func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
+func (x *CustomFieldSort) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1364,28 +1767,37 @@ func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCardRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCardRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CustomFieldSort.ProtoReflect.Descriptor instead.
+func (*CustomFieldSort) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DeleteCardRequest) GetCardID() uint64 {
+func (x *CustomFieldSort) GetCustomFieldID() uint64 {
 	if x != nil {
-		return x.CardID
+		return x.CustomFieldID
 	}
 	return 0
 }
 
-type DeleteCardResponse struct {
+func (x *CustomFieldSort) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type GetCardsByBoardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// uint64 boardID  = 1;
+	Filters []*CustomFieldFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	Sort    *CustomFieldSort     `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
 }
 
-func (x *DeleteCardResponse) Reset() {
-	*x = DeleteCardResponse{}
+func (x *GetCardsByBoardRequest) Reset() {
+	*x = GetCardsByBoardRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1393,13 +1805,13 @@ func (x *DeleteCardResponse) Reset() {
 	}
 }
 
-func (x *DeleteCardResponse) String() string {
+func (x *GetCardsByBoardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCardResponse) ProtoMessage() {}
+func (*GetCardsByBoardRequest) ProtoMessage() {}
 
-func (x *DeleteCardResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCardsByBoardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1411,29 +1823,35 @@ func (x *DeleteCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCardResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCardResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCardsByBoardRequest.ProtoReflect.Descriptor instead.
+func (*GetCardsByBoardRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *DeleteCardResponse) GetMessage() string {
+func (x *GetCardsByBoardRequest) GetFilters() []*CustomFieldFilter {
 	if x != nil {
-		return x.Message
+		return x.Filters
 	}
-	return ""
+	return nil
 }
 
-type AddCardLabelRequest struct {
+func (x *GetCardsByBoardRequest) GetSort() *CustomFieldSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type GetCardsByBoardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID  uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	LabelID uint64 `protobuf:"varint,2,opt,name=labelID,proto3" json:"labelID,omitempty"`
+	Cards []*CardMeta `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
 }
 
-func (x *AddCardLabelRequest) Reset() {
-	*x = AddCardLabelRequest{}
+func (x *GetCardsByBoardResponse) Reset() {
+	*x = GetCardsByBoardResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1441,13 +1859,13 @@ func (x *AddCardLabelRequest) Reset() {
 	}
 }
 
-func (x *AddCardLabelRequest) String() string {
+func (x *GetCardsByBoardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardLabelRequest) ProtoMessage() {}
+func (*GetCardsByBoardResponse) ProtoMessage() {}
 
-func (x *AddCardLabelRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCardsByBoardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1459,35 +1877,30 @@ func (x *AddCardLabelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardLabelRequest.ProtoReflect.Descriptor instead.
-func (*AddCardLabelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCardsByBoardResponse.ProtoReflect.Descriptor instead.
+func (*GetCardsByBoardResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *AddCardLabelRequest) GetCardID() uint64 {
-	if x != nil {
-		return x.CardID
-	}
-	return 0
-}
-
-func (x *AddCardLabelRequest) GetLabelID() uint64 {
+func (x *GetCardsByBoardResponse) GetCards() []*CardMeta {
 	if x != nil {
-		return x.LabelID
+		return x.Cards
 	}
-	return 0
+	return nil
 }
 
-type AddCardLabelResponse struct {
+type GetCardsByListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	ListID  uint64               `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+	Filters []*CustomFieldFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	Sort    *CustomFieldSort     `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
 }
 
-func (x *AddCardLabelResponse) Reset() {
-	*x = AddCardLabelResponse{}
+func (x *GetCardsByListRequest) Reset() {
+	*x = GetCardsByListRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1495,13 +1908,13 @@ func (x *AddCardLabelResponse) Reset() {
 	}
 }
 
-func (x *AddCardLabelResponse) String() string {
+func (x *GetCardsByListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardLabelResponse) ProtoMessage() {}
+func (*GetCardsByListRequest) ProtoMessage() {}
 
-func (x *AddCardLabelResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCardsByListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1513,29 +1926,42 @@ func (x *AddCardLabelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardLabelResponse.ProtoReflect.Descriptor instead.
-func (*AddCardLabelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCardsByListRequest.ProtoReflect.Descriptor instead.
+func (*GetCardsByListRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *AddCardLabelResponse) GetMessage() string {
+func (x *GetCardsByListRequest) GetListID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.ListID
 	}
-	return ""
+	return 0
 }
 
-type RemoveCardLabelRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CardID  uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
-	LabelID uint64 `protobuf:"varint,2,opt,name=labelID,proto3" json:"labelID,omitempty"`
+func (x *GetCardsByListRequest) GetFilters() []*CustomFieldFilter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
 }
 
-func (x *RemoveCardLabelRequest) Reset() {
-	*x = RemoveCardLabelRequest{}
+func (x *GetCardsByListRequest) GetSort() *CustomFieldSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type GetCardsByListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cards []*CardMeta `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+}
+
+func (x *GetCardsByListResponse) Reset() {
+	*x = GetCardsByListResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1543,13 +1969,13 @@ func (x *RemoveCardLabelRequest) Reset() {
 	}
 }
 
-func (x *RemoveCardLabelRequest) String() string {
+func (x *GetCardsByListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCardLabelRequest) ProtoMessage() {}
+func (*GetCardsByListResponse) ProtoMessage() {}
 
-func (x *RemoveCardLabelRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCardsByListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1561,35 +1987,30 @@ func (x *RemoveCardLabelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCardLabelRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCardLabelRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCardsByListResponse.ProtoReflect.Descriptor instead.
+func (*GetCardsByListResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RemoveCardLabelRequest) GetCardID() uint64 {
-	if x != nil {
-		return x.CardID
-	}
-	return 0
-}
-
-func (x *RemoveCardLabelRequest) GetLabelID() uint64 {
+func (x *GetCardsByListResponse) GetCards() []*CardMeta {
 	if x != nil {
-		return x.LabelID
+		return x.Cards
 	}
-	return 0
+	return nil
 }
 
-type RemoveCardLabelResponse struct {
+type UpdateCardNameRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardID          uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ExpectedVersion uint64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
 }
 
-func (x *RemoveCardLabelResponse) Reset() {
-	*x = RemoveCardLabelResponse{}
+func (x *UpdateCardNameRequest) Reset() {
+	*x = UpdateCardNameRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1597,13 +2018,13 @@ func (x *RemoveCardLabelResponse) Reset() {
 	}
 }
 
-func (x *RemoveCardLabelResponse) String() string {
+func (x *UpdateCardNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCardLabelResponse) ProtoMessage() {}
+func (*UpdateCardNameRequest) ProtoMessage() {}
 
-func (x *RemoveCardLabelResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateCardNameRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1615,30 +2036,42 @@ func (x *RemoveCardLabelResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCardLabelResponse.ProtoReflect.Descriptor instead.
-func (*RemoveCardLabelResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCardNameRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCardNameRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RemoveCardLabelResponse) GetMessage() string {
+func (x *UpdateCardNameRequest) GetCardID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *UpdateCardNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-type SetCardDatesRequest struct {
+func (x *UpdateCardNameRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateCardNameResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartDate *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=StartDate,proto3" json:"StartDate,omitempty"`
-	DueDate   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=DueDate,proto3" json:"DueDate,omitempty"`
-	CardID    uint64                 `protobuf:"varint,3,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SetCardDatesRequest) Reset() {
-	*x = SetCardDatesRequest{}
+func (x *UpdateCardNameResponse) Reset() {
+	*x = UpdateCardNameResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1646,13 +2079,13 @@ func (x *SetCardDatesRequest) Reset() {
 	}
 }
 
-func (x *SetCardDatesRequest) String() string {
+func (x *UpdateCardNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetCardDatesRequest) ProtoMessage() {}
+func (*UpdateCardNameResponse) ProtoMessage() {}
 
-func (x *SetCardDatesRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateCardNameResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1664,42 +2097,30 @@ func (x *SetCardDatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetCardDatesRequest.ProtoReflect.Descriptor instead.
-func (*SetCardDatesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCardNameResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCardNameResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SetCardDatesRequest) GetStartDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartDate
-	}
-	return nil
-}
-
-func (x *SetCardDatesRequest) GetDueDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DueDate
-	}
-	return nil
-}
-
-func (x *SetCardDatesRequest) GetCardID() uint64 {
+func (x *UpdateCardNameResponse) GetMessage() string {
 	if x != nil {
-		return x.CardID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type SetCardDatesResponse struct {
+type UpdateCardDescriptionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardID          uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Description     string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ExpectedVersion uint64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
 }
 
-func (x *SetCardDatesResponse) Reset() {
-	*x = SetCardDatesResponse{}
+func (x *UpdateCardDescriptionRequest) Reset() {
+	*x = UpdateCardDescriptionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1707,13 +2128,13 @@ func (x *SetCardDatesResponse) Reset() {
 	}
 }
 
-func (x *SetCardDatesResponse) String() string {
+func (x *UpdateCardDescriptionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetCardDatesResponse) ProtoMessage() {}
+func (*UpdateCardDescriptionRequest) ProtoMessage() {}
 
-func (x *SetCardDatesResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateCardDescriptionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1725,28 +2146,42 @@ func (x *SetCardDatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetCardDatesResponse.ProtoReflect.Descriptor instead.
-func (*SetCardDatesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCardDescriptionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCardDescriptionRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *SetCardDatesResponse) GetMessage() string {
+func (x *UpdateCardDescriptionRequest) GetCardID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *UpdateCardDescriptionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-type ToggleCardCompletedRequest struct {
+func (x *UpdateCardDescriptionRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateCardDescriptionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ToggleCardCompletedRequest) Reset() {
-	*x = ToggleCardCompletedRequest{}
+func (x *UpdateCardDescriptionResponse) Reset() {
+	*x = UpdateCardDescriptionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1754,13 +2189,13 @@ func (x *ToggleCardCompletedRequest) Reset() {
 	}
 }
 
-func (x *ToggleCardCompletedRequest) String() string {
+func (x *UpdateCardDescriptionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleCardCompletedRequest) ProtoMessage() {}
+func (*UpdateCardDescriptionResponse) ProtoMessage() {}
 
-func (x *ToggleCardCompletedRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateCardDescriptionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1772,28 +2207,32 @@ func (x *ToggleCardCompletedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleCardCompletedRequest.ProtoReflect.Descriptor instead.
-func (*ToggleCardCompletedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateCardDescriptionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCardDescriptionResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ToggleCardCompletedRequest) GetCardID() uint64 {
+func (x *UpdateCardDescriptionResponse) GetMessage() string {
 	if x != nil {
-		return x.CardID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type ToggleCardCompletedResponse struct {
+type MoveCardPositionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardID          uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Position        int64  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	OldListID       uint64 `protobuf:"varint,3,opt,name=old_listID,json=oldListID,proto3" json:"old_listID,omitempty"`
+	NewListID       uint64 `protobuf:"varint,4,opt,name=new_listID,json=newListID,proto3" json:"new_listID,omitempty"`
+	ExpectedVersion uint64 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
 }
 
-func (x *ToggleCardCompletedResponse) Reset() {
-	*x = ToggleCardCompletedResponse{}
+func (x *MoveCardPositionRequest) Reset() {
+	*x = MoveCardPositionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1801,13 +2240,13 @@ func (x *ToggleCardCompletedResponse) Reset() {
 	}
 }
 
-func (x *ToggleCardCompletedResponse) String() string {
+func (x *MoveCardPositionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleCardCompletedResponse) ProtoMessage() {}
+func (*MoveCardPositionRequest) ProtoMessage() {}
 
-func (x *ToggleCardCompletedResponse) ProtoReflect() protoreflect.Message {
+func (x *MoveCardPositionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1819,29 +2258,57 @@ func (x *ToggleCardCompletedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleCardCompletedResponse.ProtoReflect.Descriptor instead.
-func (*ToggleCardCompletedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveCardPositionRequest.ProtoReflect.Descriptor instead.
+func (*MoveCardPositionRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ToggleCardCompletedResponse) GetMessage() string {
+func (x *MoveCardPositionRequest) GetCardID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.CardID
 	}
-	return ""
+	return 0
 }
 
-type AddCardAttachmentRequest struct {
+func (x *MoveCardPositionRequest) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *MoveCardPositionRequest) GetOldListID() uint64 {
+	if x != nil {
+		return x.OldListID
+	}
+	return 0
+}
+
+func (x *MoveCardPositionRequest) GetNewListID() uint64 {
+	if x != nil {
+		return x.NewListID
+	}
+	return 0
+}
+
+func (x *MoveCardPositionRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type MoveCardPositionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttachmentID uint64 `protobuf:"varint,1,opt,name=attachmentID,proto3" json:"attachmentID,omitempty"`
-	CardID       uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Message         string           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	WipLimitWarning *WIPLimitWarning `protobuf:"bytes,2,opt,name=wip_limit_warning,json=wipLimitWarning,proto3" json:"wip_limit_warning,omitempty"`
 }
 
-func (x *AddCardAttachmentRequest) Reset() {
-	*x = AddCardAttachmentRequest{}
+func (x *MoveCardPositionResponse) Reset() {
+	*x = MoveCardPositionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1849,13 +2316,13 @@ func (x *AddCardAttachmentRequest) Reset() {
 	}
 }
 
-func (x *AddCardAttachmentRequest) String() string {
+func (x *MoveCardPositionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardAttachmentRequest) ProtoMessage() {}
+func (*MoveCardPositionResponse) ProtoMessage() {}
 
-func (x *AddCardAttachmentRequest) ProtoReflect() protoreflect.Message {
+func (x *MoveCardPositionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1867,35 +2334,35 @@ func (x *AddCardAttachmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardAttachmentRequest.ProtoReflect.Descriptor instead.
-func (*AddCardAttachmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveCardPositionResponse.ProtoReflect.Descriptor instead.
+func (*MoveCardPositionResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *AddCardAttachmentRequest) GetAttachmentID() uint64 {
+func (x *MoveCardPositionResponse) GetMessage() string {
 	if x != nil {
-		return x.AttachmentID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *AddCardAttachmentRequest) GetCardID() uint64 {
+func (x *MoveCardPositionResponse) GetWipLimitWarning() *WIPLimitWarning {
 	if x != nil {
-		return x.CardID
+		return x.WipLimitWarning
 	}
-	return 0
+	return nil
 }
 
-type AddCardAttachmentResponse struct {
+type DeleteCardRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
 }
 
-func (x *AddCardAttachmentResponse) Reset() {
-	*x = AddCardAttachmentResponse{}
+func (x *DeleteCardRequest) Reset() {
+	*x = DeleteCardRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1903,13 +2370,13 @@ func (x *AddCardAttachmentResponse) Reset() {
 	}
 }
 
-func (x *AddCardAttachmentResponse) String() string {
+func (x *DeleteCardRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardAttachmentResponse) ProtoMessage() {}
+func (*DeleteCardRequest) ProtoMessage() {}
 
-func (x *AddCardAttachmentResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteCardRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1921,29 +2388,28 @@ func (x *AddCardAttachmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardAttachmentResponse.ProtoReflect.Descriptor instead.
-func (*AddCardAttachmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCardRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCardRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *AddCardAttachmentResponse) GetMessage() string {
+func (x *DeleteCardRequest) GetCardID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.CardID
 	}
-	return ""
+	return 0
 }
 
-type RemoveCardAttachmentRequest struct {
+type DeleteCardResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AttachmentID uint64 `protobuf:"varint,1,opt,name=attachmentID,proto3" json:"attachmentID,omitempty"`
-	CardID       uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RemoveCardAttachmentRequest) Reset() {
-	*x = RemoveCardAttachmentRequest{}
+func (x *DeleteCardResponse) Reset() {
+	*x = DeleteCardResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1951,13 +2417,13 @@ func (x *RemoveCardAttachmentRequest) Reset() {
 	}
 }
 
-func (x *RemoveCardAttachmentRequest) String() string {
+func (x *DeleteCardResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCardAttachmentRequest) ProtoMessage() {}
+func (*DeleteCardResponse) ProtoMessage() {}
 
-func (x *RemoveCardAttachmentRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteCardResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1969,35 +2435,29 @@ func (x *RemoveCardAttachmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCardAttachmentRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCardAttachmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteCardResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCardResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RemoveCardAttachmentRequest) GetAttachmentID() uint64 {
-	if x != nil {
-		return x.AttachmentID
-	}
-	return 0
-}
-
-func (x *RemoveCardAttachmentRequest) GetCardID() uint64 {
+func (x *DeleteCardResponse) GetMessage() string {
 	if x != nil {
-		return x.CardID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type RemoveCardAttachmentResponse struct {
+type AddCardLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardID  uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	LabelID uint64 `protobuf:"varint,2,opt,name=labelID,proto3" json:"labelID,omitempty"`
 }
 
-func (x *RemoveCardAttachmentResponse) Reset() {
-	*x = RemoveCardAttachmentResponse{}
+func (x *AddCardLabelRequest) Reset() {
+	*x = AddCardLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2005,13 +2465,13 @@ func (x *RemoveCardAttachmentResponse) Reset() {
 	}
 }
 
-func (x *RemoveCardAttachmentResponse) String() string {
+func (x *AddCardLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCardAttachmentResponse) ProtoMessage() {}
+func (*AddCardLabelRequest) ProtoMessage() {}
 
-func (x *RemoveCardAttachmentResponse) ProtoReflect() protoreflect.Message {
+func (x *AddCardLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2023,29 +2483,35 @@ func (x *RemoveCardAttachmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCardAttachmentResponse.ProtoReflect.Descriptor instead.
-func (*RemoveCardAttachmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCardLabelRequest.ProtoReflect.Descriptor instead.
+func (*AddCardLabelRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RemoveCardAttachmentResponse) GetMessage() string {
+func (x *AddCardLabelRequest) GetCardID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.CardID
 	}
-	return ""
+	return 0
 }
 
-type AddCardCommentRequest struct {
+func (x *AddCardLabelRequest) GetLabelID() uint64 {
+	if x != nil {
+		return x.LabelID
+	}
+	return 0
+}
+
+type AddCardLabelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	CardID  uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *AddCardCommentRequest) Reset() {
-	*x = AddCardCommentRequest{}
+func (x *AddCardLabelResponse) Reset() {
+	*x = AddCardLabelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2053,13 +2519,13 @@ func (x *AddCardCommentRequest) Reset() {
 	}
 }
 
-func (x *AddCardCommentRequest) String() string {
+func (x *AddCardLabelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardCommentRequest) ProtoMessage() {}
+func (*AddCardLabelResponse) ProtoMessage() {}
 
-func (x *AddCardCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *AddCardLabelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2071,35 +2537,29 @@ func (x *AddCardCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardCommentRequest.ProtoReflect.Descriptor instead.
-func (*AddCardCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddCardLabelResponse.ProtoReflect.Descriptor instead.
+func (*AddCardLabelResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *AddCardCommentRequest) GetContent() string {
+func (x *AddCardLabelResponse) GetMessage() string {
 	if x != nil {
-		return x.Content
+		return x.Message
 	}
 	return ""
 }
 
-func (x *AddCardCommentRequest) GetCardID() uint64 {
-	if x != nil {
-		return x.CardID
-	}
-	return 0
-}
-
-type AddCardCommentResponse struct {
+type RemoveCardLabelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardID  uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	LabelID uint64 `protobuf:"varint,2,opt,name=labelID,proto3" json:"labelID,omitempty"`
 }
 
-func (x *AddCardCommentResponse) Reset() {
-	*x = AddCardCommentResponse{}
+func (x *RemoveCardLabelRequest) Reset() {
+	*x = RemoveCardLabelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2107,13 +2567,13 @@ func (x *AddCardCommentResponse) Reset() {
 	}
 }
 
-func (x *AddCardCommentResponse) String() string {
+func (x *RemoveCardLabelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardCommentResponse) ProtoMessage() {}
+func (*RemoveCardLabelRequest) ProtoMessage() {}
 
-func (x *AddCardCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveCardLabelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2125,29 +2585,35 @@ func (x *AddCardCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardCommentResponse.ProtoReflect.Descriptor instead.
-func (*AddCardCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveCardLabelRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCardLabelRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *AddCardCommentResponse) GetMessage() string {
+func (x *RemoveCardLabelRequest) GetCardID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.CardID
 	}
-	return ""
+	return 0
 }
 
-type RemoveCardCommentRequest struct {
+func (x *RemoveCardLabelRequest) GetLabelID() uint64 {
+	if x != nil {
+		return x.LabelID
+	}
+	return 0
+}
+
+type RemoveCardLabelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentID uint64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
-	CardID    uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RemoveCardCommentRequest) Reset() {
-	*x = RemoveCardCommentRequest{}
+func (x *RemoveCardLabelResponse) Reset() {
+	*x = RemoveCardLabelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2155,13 +2621,13 @@ func (x *RemoveCardCommentRequest) Reset() {
 	}
 }
 
-func (x *RemoveCardCommentRequest) String() string {
+func (x *RemoveCardLabelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCardCommentRequest) ProtoMessage() {}
+func (*RemoveCardLabelResponse) ProtoMessage() {}
 
-func (x *RemoveCardCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveCardLabelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2173,35 +2639,31 @@ func (x *RemoveCardCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCardCommentRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCardCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveCardLabelResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCardLabelResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *RemoveCardCommentRequest) GetCommentID() uint64 {
-	if x != nil {
-		return x.CommentID
-	}
-	return 0
-}
-
-func (x *RemoveCardCommentRequest) GetCardID() uint64 {
+func (x *RemoveCardLabelResponse) GetMessage() string {
 	if x != nil {
-		return x.CardID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type RemoveCardCommentResponse struct {
+type SetCardDatesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=StartDate,proto3" json:"StartDate,omitempty"`
+	DueDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=DueDate,proto3" json:"DueDate,omitempty"`
+	CardID          uint64                 `protobuf:"varint,3,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	ExpectedVersion uint64                 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
 }
 
-func (x *RemoveCardCommentResponse) Reset() {
-	*x = RemoveCardCommentResponse{}
+func (x *SetCardDatesRequest) Reset() {
+	*x = SetCardDatesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2209,13 +2671,13 @@ func (x *RemoveCardCommentResponse) Reset() {
 	}
 }
 
-func (x *RemoveCardCommentResponse) String() string {
+func (x *SetCardDatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCardCommentResponse) ProtoMessage() {}
+func (*SetCardDatesRequest) ProtoMessage() {}
 
-func (x *RemoveCardCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *SetCardDatesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2227,29 +2689,49 @@ func (x *RemoveCardCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCardCommentResponse.ProtoReflect.Descriptor instead.
-func (*RemoveCardCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetCardDatesRequest.ProtoReflect.Descriptor instead.
+func (*SetCardDatesRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *RemoveCardCommentResponse) GetMessage() string {
+func (x *SetCardDatesRequest) GetStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.StartDate
 	}
-	return ""
+	return nil
 }
 
-type AddCardMembersRequest struct {
+func (x *SetCardDatesRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *SetCardDatesRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *SetCardDatesRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type SetCardDatesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
-	CardID  uint64   `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *AddCardMembersRequest) Reset() {
-	*x = AddCardMembersRequest{}
+func (x *SetCardDatesResponse) Reset() {
+	*x = SetCardDatesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2257,13 +2739,13 @@ func (x *AddCardMembersRequest) Reset() {
 	}
 }
 
-func (x *AddCardMembersRequest) String() string {
+func (x *SetCardDatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardMembersRequest) ProtoMessage() {}
+func (*SetCardDatesResponse) ProtoMessage() {}
 
-func (x *AddCardMembersRequest) ProtoReflect() protoreflect.Message {
+func (x *SetCardDatesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2275,35 +2757,29 @@ func (x *AddCardMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardMembersRequest.ProtoReflect.Descriptor instead.
-func (*AddCardMembersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetCardDatesResponse.ProtoReflect.Descriptor instead.
+func (*SetCardDatesResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *AddCardMembersRequest) GetUserIDs() []uint64 {
-	if x != nil {
-		return x.UserIDs
-	}
-	return nil
-}
-
-func (x *AddCardMembersRequest) GetCardID() uint64 {
+func (x *SetCardDatesResponse) GetMessage() string {
 	if x != nil {
-		return x.CardID
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type AddCardMembersResponse struct {
+type ToggleCardCompletedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardID          uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
 }
 
-func (x *AddCardMembersResponse) Reset() {
-	*x = AddCardMembersResponse{}
+func (x *ToggleCardCompletedRequest) Reset() {
+	*x = ToggleCardCompletedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2311,13 +2787,13 @@ func (x *AddCardMembersResponse) Reset() {
 	}
 }
 
-func (x *AddCardMembersResponse) String() string {
+func (x *ToggleCardCompletedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCardMembersResponse) ProtoMessage() {}
+func (*ToggleCardCompletedRequest) ProtoMessage() {}
 
-func (x *AddCardMembersResponse) ProtoReflect() protoreflect.Message {
+func (x *ToggleCardCompletedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2329,29 +2805,35 @@ func (x *AddCardMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCardMembersResponse.ProtoReflect.Descriptor instead.
-func (*AddCardMembersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ToggleCardCompletedRequest.ProtoReflect.Descriptor instead.
+func (*ToggleCardCompletedRequest) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AddCardMembersResponse) GetMessage() string {
+func (x *ToggleCardCompletedRequest) GetCardID() uint64 {
 	if x != nil {
-		return x.Message
+		return x.CardID
 	}
-	return ""
+	return 0
 }
 
-type RemoveCardMembersRequest struct {
+func (x *ToggleCardCompletedRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ToggleCardCompletedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
-	CardID  uint64   `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RemoveCardMembersRequest) Reset() {
-	*x = RemoveCardMembersRequest{}
+func (x *ToggleCardCompletedResponse) Reset() {
+	*x = ToggleCardCompletedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_card_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2359,13 +2841,13 @@ func (x *RemoveCardMembersRequest) Reset() {
 	}
 }
 
-func (x *RemoveCardMembersRequest) String() string {
+func (x *ToggleCardCompletedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCardMembersRequest) ProtoMessage() {}
+func (*ToggleCardCompletedResponse) ProtoMessage() {}
 
-func (x *RemoveCardMembersRequest) ProtoReflect() protoreflect.Message {
+func (x *ToggleCardCompletedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_card_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2377,26 +2859,74 @@ func (x *RemoveCardMembersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCardMembersRequest.ProtoReflect.Descriptor instead.
-func (*RemoveCardMembersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ToggleCardCompletedResponse.ProtoReflect.Descriptor instead.
+func (*ToggleCardCompletedResponse) Descriptor() ([]byte, []int) {
 	return file_card_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RemoveCardMembersRequest) GetUserIDs() []uint64 {
+func (x *ToggleCardCompletedResponse) GetMessage() string {
 	if x != nil {
-		return x.UserIDs
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *RemoveCardMembersRequest) GetCardID() uint64 {
+type AddCardAttachmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AttachmentID uint64 `protobuf:"varint,1,opt,name=attachmentID,proto3" json:"attachmentID,omitempty"`
+	CardID       uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+}
+
+func (x *AddCardAttachmentRequest) Reset() {
+	*x = AddCardAttachmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCardAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCardAttachmentRequest) ProtoMessage() {}
+
+func (x *AddCardAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCardAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*AddCardAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AddCardAttachmentRequest) GetAttachmentID() uint64 {
+	if x != nil {
+		return x.AttachmentID
+	}
+	return 0
+}
+
+func (x *AddCardAttachmentRequest) GetCardID() uint64 {
 	if x != nil {
 		return x.CardID
 	}
 	return 0
 }
 
-type RemoveCardMembersResponse struct {
+type AddCardAttachmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2404,23 +2934,23 @@ type RemoveCardMembersResponse struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *RemoveCardMembersResponse) Reset() {
-	*x = RemoveCardMembersResponse{}
+func (x *AddCardAttachmentResponse) Reset() {
+	*x = AddCardAttachmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[41]
+		mi := &file_card_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveCardMembersResponse) String() string {
+func (x *AddCardAttachmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveCardMembersResponse) ProtoMessage() {}
+func (*AddCardAttachmentResponse) ProtoMessage() {}
 
-func (x *RemoveCardMembersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[41]
+func (x *AddCardAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2431,43 +2961,44 @@ func (x *RemoveCardMembersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveCardMembersResponse.ProtoReflect.Descriptor instead.
-func (*RemoveCardMembersResponse) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use AddCardAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*AddCardAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *RemoveCardMembersResponse) GetMessage() string {
+func (x *AddCardAttachmentResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type ArchiveCardRequest struct {
+type RemoveCardAttachmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	AttachmentID uint64 `protobuf:"varint,1,opt,name=attachmentID,proto3" json:"attachmentID,omitempty"`
+	CardID       uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
 }
 
-func (x *ArchiveCardRequest) Reset() {
-	*x = ArchiveCardRequest{}
+func (x *RemoveCardAttachmentRequest) Reset() {
+	*x = RemoveCardAttachmentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[42]
+		mi := &file_card_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArchiveCardRequest) String() string {
+func (x *RemoveCardAttachmentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveCardRequest) ProtoMessage() {}
+func (*RemoveCardAttachmentRequest) ProtoMessage() {}
 
-func (x *ArchiveCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[42]
+func (x *RemoveCardAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2478,19 +3009,26 @@ func (x *ArchiveCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveCardRequest.ProtoReflect.Descriptor instead.
-func (*ArchiveCardRequest) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use RemoveCardAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCardAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ArchiveCardRequest) GetCardID() uint64 {
+func (x *RemoveCardAttachmentRequest) GetAttachmentID() uint64 {
+	if x != nil {
+		return x.AttachmentID
+	}
+	return 0
+}
+
+func (x *RemoveCardAttachmentRequest) GetCardID() uint64 {
 	if x != nil {
 		return x.CardID
 	}
 	return 0
 }
 
-type ArchiveCardResponse struct {
+type RemoveCardAttachmentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2498,23 +3036,23 @@ type ArchiveCardResponse struct {
 	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *ArchiveCardResponse) Reset() {
-	*x = ArchiveCardResponse{}
+func (x *RemoveCardAttachmentResponse) Reset() {
+	*x = RemoveCardAttachmentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[43]
+		mi := &file_card_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArchiveCardResponse) String() string {
+func (x *RemoveCardAttachmentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveCardResponse) ProtoMessage() {}
+func (*RemoveCardAttachmentResponse) ProtoMessage() {}
 
-func (x *ArchiveCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[43]
+func (x *RemoveCardAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2525,43 +3063,45 @@ func (x *ArchiveCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveCardResponse.ProtoReflect.Descriptor instead.
-func (*ArchiveCardResponse) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use RemoveCardAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCardAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ArchiveCardResponse) GetMessage() string {
+func (x *RemoveCardAttachmentResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type RestoreCardRequest struct {
+type AddCardCommentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Content  string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	CardID   uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	ParentID uint64 `protobuf:"varint,3,opt,name=parentID,proto3" json:"parentID,omitempty"`
 }
 
-func (x *RestoreCardRequest) Reset() {
-	*x = RestoreCardRequest{}
+func (x *AddCardCommentRequest) Reset() {
+	*x = AddCardCommentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[44]
+		mi := &file_card_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RestoreCardRequest) String() string {
+func (x *AddCardCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreCardRequest) ProtoMessage() {}
+func (*AddCardCommentRequest) ProtoMessage() {}
 
-func (x *RestoreCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[44]
+func (x *AddCardCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2572,583 +3112,5535 @@ func (x *RestoreCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreCardRequest.ProtoReflect.Descriptor instead.
-func (*RestoreCardRequest) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use AddCardCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCardCommentRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RestoreCardRequest) GetCardID() uint64 {
+func (x *AddCardCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *AddCardCommentRequest) GetCardID() uint64 {
 	if x != nil {
 		return x.CardID
 	}
 	return 0
 }
 
-type RestoreCardResponse struct {
+func (x *AddCardCommentRequest) GetParentID() uint64 {
+	if x != nil {
+		return x.ParentID
+	}
+	return 0
+}
+
+type AddCardCommentResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Message   string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CommentID uint64 `protobuf:"varint,2,opt,name=commentID,proto3" json:"commentID,omitempty"`
 }
 
-func (x *RestoreCardResponse) Reset() {
-	*x = RestoreCardResponse{}
+func (x *AddCardCommentResponse) Reset() {
+	*x = AddCardCommentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_card_proto_msgTypes[45]
+		mi := &file_card_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RestoreCardResponse) String() string {
+func (x *AddCardCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreCardResponse) ProtoMessage() {}
+func (*AddCardCommentResponse) ProtoMessage() {}
 
-func (x *RestoreCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_card_proto_msgTypes[45]
+func (x *AddCardCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RestoreCardResponse.ProtoReflect.Descriptor instead.
-func (*RestoreCardResponse) Descriptor() ([]byte, []int) {
-	return file_card_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *RestoreCardResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-var File_card_proto protoreflect.FileDescriptor
-
-var file_card_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x61,
-	0x72, 0x64, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x04, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74,
-	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52,
-	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20,
-	0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
-	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64,
-	0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61,
-	0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8e, 0x04, 0x0a, 0x08, 0x43, 0x61, 0x72,
-	0x64, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a,
-	0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
-	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52,
-	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
-	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44,
-	0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x63, 0x0a, 0x05, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
-	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0xb4,
-	0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49,
-	0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69,
-	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62,
-	0x6e, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d,
-	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x22, 0x4f, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x3f, 0x0a, 0x11, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x12, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x20, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x63,
-	0x61, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72,
-	0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49,
-	0x44, 0x22, 0x37, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
-	0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73,
-	0x42, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x26, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x61,
-	0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x72, 0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x40, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43,
-	0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x4d,
-	0x65, 0x74, 0x61, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x15, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
-	0x32, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
-	0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a,
-	0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x17, 0x4d, 0x6f, 0x76,
-	0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
-	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6f, 0x6c,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x77,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x18, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x61,
-	0x72, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x11,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x41, 0x64, 0x64,
-	0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x49, 0x44, 0x22, 0x30, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x4a, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61,
-	0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
-	0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44,
-	0x22, 0x33, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72,
-	0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a,
-	0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x44, 0x75, 0x65, 0x44, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
-	0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x30, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64,
-	0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x31, 0x0a, 0x17, 0x4d, 0x61, 0x72, 0x6b, 0x43,
-	0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x34, 0x0a, 0x18, 0x4d, 0x61,
-	0x72, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x56, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c,
-	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x43,
-	0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x59, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
-	0x0a, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x38, 0x0a, 0x1c, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22,
-	0x32, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x50, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
-	0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
-	0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x15,
-	0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x32, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x43, 0x61,
-	0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x18, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x19, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x22, 0x2c, 0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x2f,
-	0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x2c, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x2f, 0x0a,
-	0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x9e,
-	0x0d, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45,
-	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64,
-	0x42, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
-	0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
-	0x72, 0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
-	0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79,
-	0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x4d, 0x6f, 0x76, 0x65,
-	0x43, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x6f,
-	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61,
-	0x72, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e,
-	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
-	0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0c,
-	0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64,
-	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4b, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64,
-	0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10,
-	0x4d, 0x61, 0x72, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x61,
-	0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x43,
-	0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64,
-	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x72,
-	0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63,
-	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x63, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x61, 0x72, 0x64,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74,
-	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61,
-	0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72,
-	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70,
-	0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
-	0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20,
-	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61,
-	0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
-	0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
-	0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e,
-	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72,
-	0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
-	0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43,
-	0x61, 0x72, 0x64, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48,
-	0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x2e,
-	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61,
-	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64,
-	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
-	0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d,
-	0x38, 0x38, 0x38, 0x73, 0x6d, 0x2f, 0x68, 0x61, 0x6c, 0x74, 0x65, 0x6e, 0x2d, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x63, 0x61, 0x72, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x72, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
-}
-
-var (
-	file_card_proto_rawDescOnce sync.Once
-	file_card_proto_rawDescData = file_card_proto_rawDesc
-)
-
-func file_card_proto_rawDescGZIP() []byte {
-	file_card_proto_rawDescOnce.Do(func() {
-		file_card_proto_rawDescData = protoimpl.X.CompressGZIP(file_card_proto_rawDescData)
-	})
-	return file_card_proto_rawDescData
-}
-
-var file_card_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_card_proto_goTypes = []interface{}{
-	(*Card)(nil),                          // 0: cardpb.Card
-	(*CardMeta)(nil),                      // 1: cardpb.CardMeta
-	(*Label)(nil),                         // 2: cardpb.Label
-	(*Attachment)(nil),                    // 3: cardpb.Attachment
-	(*User)(nil),                          // 4: cardpb.User
-	(*Comment)(nil),                       // 5: cardpb.Comment
-	(*CreateCardRequest)(nil),             // 6: cardpb.CreateCardRequest
-	(*CreateCardResponse)(nil),            // 7: cardpb.CreateCardResponse
-	(*GetCardByIDRequest)(nil),            // 8: cardpb.GetCardByIDRequest
-	(*GetCardByIDResponse)(nil),           // 9: cardpb.GetCardByIDResponse
-	(*GetCardsByBoardRequest)(nil),        // 10: cardpb.GetCardsByBoardRequest
-	(*GetCardsByBoardResponse)(nil),       // 11: cardpb.GetCardsByBoardResponse
-	(*GetCardsByListRequest)(nil),         // 12: cardpb.GetCardsByListRequest
-	(*GetCardsByListResponse)(nil),        // 13: cardpb.GetCardsByListResponse
-	(*UpdateCardNameRequest)(nil),         // 14: cardpb.UpdateCardNameRequest
-	(*UpdateCardNameResponse)(nil),        // 15: cardpb.UpdateCardNameResponse
-	(*UpdateCardDescriptionRequest)(nil),  // 16: cardpb.UpdateCardDescriptionRequest
-	(*UpdateCardDescriptionResponse)(nil), // 17: cardpb.UpdateCardDescriptionResponse
-	(*MoveCardPositionRequest)(nil),       // 18: cardpb.MoveCardPositionRequest
-	(*MoveCardPositionResponse)(nil),      // 19: cardpb.MoveCardPositionResponse
-	(*DeleteCardRequest)(nil),             // 20: cardpb.DeleteCardRequest
-	(*DeleteCardResponse)(nil),            // 21: cardpb.DeleteCardResponse
-	(*AddCardLabelRequest)(nil),           // 22: cardpb.AddCardLabelRequest
-	(*AddCardLabelResponse)(nil),          // 23: cardpb.AddCardLabelResponse
-	(*RemoveCardLabelRequest)(nil),        // 24: cardpb.RemoveCardLabelRequest
-	(*RemoveCardLabelResponse)(nil),       // 25: cardpb.RemoveCardLabelResponse
-	(*SetCardDatesRequest)(nil),           // 26: cardpb.SetCardDatesRequest
-	(*SetCardDatesResponse)(nil),          // 27: cardpb.SetCardDatesResponse
-	(*ToggleCardCompletedRequest)(nil),       // 28: cardpb.ToggleCardCompletedRequest
-	(*ToggleCardCompletedResponse)(nil),      // 29: cardpb.ToggleCardCompletedResponse
-	(*AddCardAttachmentRequest)(nil),      // 30: cardpb.AddCardAttachmentRequest
-	(*AddCardAttachmentResponse)(nil),     // 31: cardpb.AddCardAttachmentResponse
-	(*RemoveCardAttachmentRequest)(nil),   // 32: cardpb.RemoveCardAttachmentRequest
-	(*RemoveCardAttachmentResponse)(nil),  // 33: cardpb.RemoveCardAttachmentResponse
-	(*AddCardCommentRequest)(nil),         // 34: cardpb.AddCardCommentRequest
-	(*AddCardCommentResponse)(nil),        // 35: cardpb.AddCardCommentResponse
-	(*RemoveCardCommentRequest)(nil),      // 36: cardpb.RemoveCardCommentRequest
-	(*RemoveCardCommentResponse)(nil),     // 37: cardpb.RemoveCardCommentResponse
-	(*AddCardMembersRequest)(nil),         // 38: cardpb.AddCardMembersRequest
-	(*AddCardMembersResponse)(nil),        // 39: cardpb.AddCardMembersResponse
-	(*RemoveCardMembersRequest)(nil),      // 40: cardpb.RemoveCardMembersRequest
-	(*RemoveCardMembersResponse)(nil),     // 41: cardpb.RemoveCardMembersResponse
-	(*ArchiveCardRequest)(nil),            // 42: cardpb.ArchiveCardRequest
-	(*ArchiveCardResponse)(nil),           // 43: cardpb.ArchiveCardResponse
-	(*RestoreCardRequest)(nil),            // 44: cardpb.RestoreCardRequest
-	(*RestoreCardResponse)(nil),           // 45: cardpb.RestoreCardResponse
-	(*timestamppb.Timestamp)(nil),         // 46: google.protobuf.Timestamp
-}
-var file_card_proto_depIdxs = []int32{
-	46, // 0: cardpb.Card.start_date:type_name -> google.protobuf.Timestamp
-	46, // 1: cardpb.Card.due_date:type_name -> google.protobuf.Timestamp
-	46, // 2: cardpb.Card.created_at:type_name -> google.protobuf.Timestamp
-	46, // 3: cardpb.Card.updated_at:type_name -> google.protobuf.Timestamp
-	46, // 4: cardpb.CardMeta.start_date:type_name -> google.protobuf.Timestamp
-	46, // 5: cardpb.CardMeta.due_date:type_name -> google.protobuf.Timestamp
-	46, // 6: cardpb.CardMeta.created_at:type_name -> google.protobuf.Timestamp
-	46, // 7: cardpb.CardMeta.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 8: cardpb.Comment.user:type_name -> cardpb.User
-	46, // 9: cardpb.Comment.created_at:type_name -> google.protobuf.Timestamp
-	46, // 10: cardpb.Comment.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 11: cardpb.CreateCardResponse.card:type_name -> cardpb.Card
-	0,  // 12: cardpb.GetCardByIDResponse.card:type_name -> cardpb.Card
-	1,  // 13: cardpb.GetCardsByBoardResponse.cards:type_name -> cardpb.CardMeta
-	1,  // 14: cardpb.GetCardsByListResponse.cards:type_name -> cardpb.CardMeta
-	46, // 15: cardpb.SetCardDatesRequest.StartDate:type_name -> google.protobuf.Timestamp
-	46, // 16: cardpb.SetCardDatesRequest.DueDate:type_name -> google.protobuf.Timestamp
-	6,  // 17: cardpb.CardService.CreateCard:input_type -> cardpb.CreateCardRequest
-	8,  // 18: cardpb.CardService.GetCardByID:input_type -> cardpb.GetCardByIDRequest
-	12, // 19: cardpb.CardService.GetCardsByList:input_type -> cardpb.GetCardsByListRequest
-	10, // 20: cardpb.CardService.GetCardsByBoard:input_type -> cardpb.GetCardsByBoardRequest
-	18, // 21: cardpb.CardService.MoveCardPosition:input_type -> cardpb.MoveCardPositionRequest
-	14, // 22: cardpb.CardService.UpdateCardName:input_type -> cardpb.UpdateCardNameRequest
-	16, // 23: cardpb.CardService.UpdateCardDescription:input_type -> cardpb.UpdateCardDescriptionRequest
-	22, // 24: cardpb.CardService.AddCardLabel:input_type -> cardpb.AddCardLabelRequest
-	24, // 25: cardpb.CardService.RemoveCardLabel:input_type -> cardpb.RemoveCardLabelRequest
-	26, // 26: cardpb.CardService.SetCardDates:input_type -> cardpb.SetCardDatesRequest
-	28, // 27: cardpb.CardService.ToggleCardCompleted:input_type -> cardpb.ToggleCardCompletedRequest
-	30, // 28: cardpb.CardService.AddCardAttachment:input_type -> cardpb.AddCardAttachmentRequest
-	32, // 29: cardpb.CardService.RemoveCardAttachment:input_type -> cardpb.RemoveCardAttachmentRequest
-	34, // 30: cardpb.CardService.AddCardComment:input_type -> cardpb.AddCardCommentRequest
-	36, // 31: cardpb.CardService.RemoveCardComment:input_type -> cardpb.RemoveCardCommentRequest
-	38, // 32: cardpb.CardService.AddCardMembers:input_type -> cardpb.AddCardMembersRequest
-	40, // 33: cardpb.CardService.RemoveCardMembers:input_type -> cardpb.RemoveCardMembersRequest
-	42, // 34: cardpb.CardService.ArchiveCard:input_type -> cardpb.ArchiveCardRequest
-	44, // 35: cardpb.CardService.RestoreCard:input_type -> cardpb.RestoreCardRequest
-	20, // 36: cardpb.CardService.DeleteCard:input_type -> cardpb.DeleteCardRequest
-	7,  // 37: cardpb.CardService.CreateCard:output_type -> cardpb.CreateCardResponse
-	9,  // 38: cardpb.CardService.GetCardByID:output_type -> cardpb.GetCardByIDResponse
-	13, // 39: cardpb.CardService.GetCardsByList:output_type -> cardpb.GetCardsByListResponse
-	11, // 40: cardpb.CardService.GetCardsByBoard:output_type -> cardpb.GetCardsByBoardResponse
-	19, // 41: cardpb.CardService.MoveCardPosition:output_type -> cardpb.MoveCardPositionResponse
-	15, // 42: cardpb.CardService.UpdateCardName:output_type -> cardpb.UpdateCardNameResponse
-	17, // 43: cardpb.CardService.UpdateCardDescription:output_type -> cardpb.UpdateCardDescriptionResponse
-	23, // 44: cardpb.CardService.AddCardLabel:output_type -> cardpb.AddCardLabelResponse
-	25, // 45: cardpb.CardService.RemoveCardLabel:output_type -> cardpb.RemoveCardLabelResponse
-	27, // 46: cardpb.CardService.SetCardDates:output_type -> cardpb.SetCardDatesResponse
-	29, // 47: cardpb.CardService.ToggleCardCompleted:output_type -> cardpb.ToggleCardCompletedResponse
-	31, // 48: cardpb.CardService.AddCardAttachment:output_type -> cardpb.AddCardAttachmentResponse
-	33, // 49: cardpb.CardService.RemoveCardAttachment:output_type -> cardpb.RemoveCardAttachmentResponse
-	35, // 50: cardpb.CardService.AddCardComment:output_type -> cardpb.AddCardCommentResponse
-	37, // 51: cardpb.CardService.RemoveCardComment:output_type -> cardpb.RemoveCardCommentResponse
-	39, // 52: cardpb.CardService.AddCardMembers:output_type -> cardpb.AddCardMembersResponse
-	41, // 53: cardpb.CardService.RemoveCardMembers:output_type -> cardpb.RemoveCardMembersResponse
-	43, // 54: cardpb.CardService.ArchiveCard:output_type -> cardpb.ArchiveCardResponse
-	45, // 55: cardpb.CardService.RestoreCard:output_type -> cardpb.RestoreCardResponse
-	21, // 56: cardpb.CardService.DeleteCard:output_type -> cardpb.DeleteCardResponse
-	37, // [37:57] is the sub-list for method output_type
-	17, // [17:37] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
-}
-
-func init() { file_card_proto_init() }
-func file_card_proto_init() {
-	if File_card_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_card_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Card); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCardCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddCardCommentResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddCardCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddCardCommentResponse) GetCommentID() uint64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+type UpdateCardCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID uint64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	CardID    uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Content   string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *UpdateCardCommentRequest) Reset() {
+	*x = UpdateCardCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCardCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCardCommentRequest) ProtoMessage() {}
+
+func (x *UpdateCardCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCardCommentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCardCommentRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateCardCommentRequest) GetCommentID() uint64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+func (x *UpdateCardCommentRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *UpdateCardCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type UpdateCardCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UpdateCardCommentResponse) Reset() {
+	*x = UpdateCardCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateCardCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCardCommentResponse) ProtoMessage() {}
+
+func (x *UpdateCardCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCardCommentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCardCommentResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *UpdateCardCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCardCommentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID     uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	PageNumber uint64 `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize   uint64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetCardCommentsRequest) Reset() {
+	*x = GetCardCommentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCardCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardCommentsRequest) ProtoMessage() {}
+
+func (x *GetCardCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardCommentsRequest.ProtoReflect.Descriptor instead.
+func (*GetCardCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetCardCommentsRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *GetCardCommentsRequest) GetPageNumber() uint64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *GetCardCommentsRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetCardCommentsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments   []*Comment  `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Pagination *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetCardCommentsResponse) Reset() {
+	*x = GetCardCommentsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCardCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardCommentsResponse) ProtoMessage() {}
+
+func (x *GetCardCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardCommentsResponse.ProtoReflect.Descriptor instead.
+func (*GetCardCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetCardCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetCardCommentsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetCardCommentHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID uint64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	CardID    uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+}
+
+func (x *GetCardCommentHistoryRequest) Reset() {
+	*x = GetCardCommentHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCardCommentHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardCommentHistoryRequest) ProtoMessage() {}
+
+func (x *GetCardCommentHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardCommentHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCardCommentHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetCardCommentHistoryRequest) GetCommentID() uint64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+func (x *GetCardCommentHistoryRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+type GetCardCommentHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Edits []*CommentEdit `protobuf:"bytes,1,rep,name=edits,proto3" json:"edits,omitempty"`
+}
+
+func (x *GetCardCommentHistoryResponse) Reset() {
+	*x = GetCardCommentHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCardCommentHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardCommentHistoryResponse) ProtoMessage() {}
+
+func (x *GetCardCommentHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardCommentHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCardCommentHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetCardCommentHistoryResponse) GetEdits() []*CommentEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+type AddCommentReactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID uint64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	CardID    uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Emoji     string `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *AddCommentReactionRequest) Reset() {
+	*x = AddCommentReactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCommentReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentReactionRequest) ProtoMessage() {}
+
+func (x *AddCommentReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentReactionRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentReactionRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddCommentReactionRequest) GetCommentID() uint64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+func (x *AddCommentReactionRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *AddCommentReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type AddCommentReactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *AddCommentReactionResponse) Reset() {
+	*x = AddCommentReactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCommentReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentReactionResponse) ProtoMessage() {}
+
+func (x *AddCommentReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentReactionResponse.ProtoReflect.Descriptor instead.
+func (*AddCommentReactionResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *AddCommentReactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveCommentReactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID uint64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	CardID    uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Emoji     string `protobuf:"bytes,3,opt,name=emoji,proto3" json:"emoji,omitempty"`
+}
+
+func (x *RemoveCommentReactionRequest) Reset() {
+	*x = RemoveCommentReactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCommentReactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCommentReactionRequest) ProtoMessage() {}
+
+func (x *RemoveCommentReactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCommentReactionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCommentReactionRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RemoveCommentReactionRequest) GetCommentID() uint64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+func (x *RemoveCommentReactionRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *RemoveCommentReactionRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+type RemoveCommentReactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RemoveCommentReactionResponse) Reset() {
+	*x = RemoveCommentReactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCommentReactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCommentReactionResponse) ProtoMessage() {}
+
+func (x *RemoveCommentReactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCommentReactionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCommentReactionResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RemoveCommentReactionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveCardCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentID uint64 `protobuf:"varint,1,opt,name=commentID,proto3" json:"commentID,omitempty"`
+	CardID    uint64 `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+}
+
+func (x *RemoveCardCommentRequest) Reset() {
+	*x = RemoveCardCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCardCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCardCommentRequest) ProtoMessage() {}
+
+func (x *RemoveCardCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCardCommentRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCardCommentRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RemoveCardCommentRequest) GetCommentID() uint64 {
+	if x != nil {
+		return x.CommentID
+	}
+	return 0
+}
+
+func (x *RemoveCardCommentRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+type RemoveCardCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RemoveCardCommentResponse) Reset() {
+	*x = RemoveCardCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCardCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCardCommentResponse) ProtoMessage() {}
+
+func (x *RemoveCardCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCardCommentResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCardCommentResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RemoveCardCommentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AddCardMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	CardID  uint64   `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+}
+
+func (x *AddCardMembersRequest) Reset() {
+	*x = AddCardMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCardMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCardMembersRequest) ProtoMessage() {}
+
+func (x *AddCardMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCardMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddCardMembersRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddCardMembersRequest) GetUserIDs() []uint64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *AddCardMembersRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+type AddCardMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *AddCardMembersResponse) Reset() {
+	*x = AddCardMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCardMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCardMembersResponse) ProtoMessage() {}
+
+func (x *AddCardMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCardMembersResponse.ProtoReflect.Descriptor instead.
+func (*AddCardMembersResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AddCardMembersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveCardMembersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []uint64 `protobuf:"varint,1,rep,packed,name=userIDs,proto3" json:"userIDs,omitempty"`
+	CardID  uint64   `protobuf:"varint,2,opt,name=cardID,proto3" json:"cardID,omitempty"`
+}
+
+func (x *RemoveCardMembersRequest) Reset() {
+	*x = RemoveCardMembersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCardMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCardMembersRequest) ProtoMessage() {}
+
+func (x *RemoveCardMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCardMembersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCardMembersRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RemoveCardMembersRequest) GetUserIDs() []uint64 {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *RemoveCardMembersRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+type RemoveCardMembersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RemoveCardMembersResponse) Reset() {
+	*x = RemoveCardMembersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCardMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCardMembersResponse) ProtoMessage() {}
+
+func (x *RemoveCardMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCardMembersResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCardMembersResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RemoveCardMembersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ArchiveCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID          uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
+}
+
+func (x *ArchiveCardRequest) Reset() {
+	*x = ArchiveCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCardRequest) ProtoMessage() {}
+
+func (x *ArchiveCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCardRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveCardRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ArchiveCardRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *ArchiveCardRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type ArchiveCardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ArchiveCardResponse) Reset() {
+	*x = ArchiveCardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveCardResponse) ProtoMessage() {}
+
+func (x *ArchiveCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveCardResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveCardResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ArchiveCardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RestoreCardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID          uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
+}
+
+func (x *RestoreCardRequest) Reset() {
+	*x = RestoreCardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCardRequest) ProtoMessage() {}
+
+func (x *RestoreCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCardRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCardRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *RestoreCardRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *RestoreCardRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RestoreCardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RestoreCardResponse) Reset() {
+	*x = RestoreCardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCardResponse) ProtoMessage() {}
+
+func (x *RestoreCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCardResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCardResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RestoreCardResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetCardRemindersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID        uint64  `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	OffsetMinutes []int64 `protobuf:"varint,2,rep,packed,name=offset_minutes,json=offsetMinutes,proto3" json:"offset_minutes,omitempty"`
+}
+
+func (x *SetCardRemindersRequest) Reset() {
+	*x = SetCardRemindersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCardRemindersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCardRemindersRequest) ProtoMessage() {}
+
+func (x *SetCardRemindersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCardRemindersRequest.ProtoReflect.Descriptor instead.
+func (*SetCardRemindersRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetCardRemindersRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *SetCardRemindersRequest) GetOffsetMinutes() []int64 {
+	if x != nil {
+		return x.OffsetMinutes
+	}
+	return nil
+}
+
+type SetCardRemindersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SetCardRemindersResponse) Reset() {
+	*x = SetCardRemindersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCardRemindersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCardRemindersResponse) ProtoMessage() {}
+
+func (x *SetCardRemindersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCardRemindersResponse.ProtoReflect.Descriptor instead.
+func (*SetCardRemindersResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetCardRemindersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetCardRecurrenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID       uint64   `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Frequency    string   `protobuf:"bytes,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Interval     int32    `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	Weekdays     []string `protobuf:"bytes,4,rep,name=weekdays,proto3" json:"weekdays,omitempty"`
+	MonthDay     int32    `protobuf:"varint,5,opt,name=month_day,json=monthDay,proto3" json:"month_day,omitempty"`
+	TargetListID uint64   `protobuf:"varint,6,opt,name=target_listID,json=targetListID,proto3" json:"target_listID,omitempty"`
+}
+
+func (x *SetCardRecurrenceRequest) Reset() {
+	*x = SetCardRecurrenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCardRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCardRecurrenceRequest) ProtoMessage() {}
+
+func (x *SetCardRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCardRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*SetCardRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetCardRecurrenceRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *SetCardRecurrenceRequest) GetFrequency() string {
+	if x != nil {
+		return x.Frequency
+	}
+	return ""
+}
+
+func (x *SetCardRecurrenceRequest) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *SetCardRecurrenceRequest) GetWeekdays() []string {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *SetCardRecurrenceRequest) GetMonthDay() int32 {
+	if x != nil {
+		return x.MonthDay
+	}
+	return 0
+}
+
+func (x *SetCardRecurrenceRequest) GetTargetListID() uint64 {
+	if x != nil {
+		return x.TargetListID
+	}
+	return 0
+}
+
+type SetCardRecurrenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SetCardRecurrenceResponse) Reset() {
+	*x = SetCardRecurrenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCardRecurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCardRecurrenceResponse) ProtoMessage() {}
+
+func (x *SetCardRecurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCardRecurrenceResponse.ProtoReflect.Descriptor instead.
+func (*SetCardRecurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetCardRecurrenceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveCardRecurrenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+}
+
+func (x *RemoveCardRecurrenceRequest) Reset() {
+	*x = RemoveCardRecurrenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCardRecurrenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCardRecurrenceRequest) ProtoMessage() {}
+
+func (x *RemoveCardRecurrenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCardRecurrenceRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCardRecurrenceRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RemoveCardRecurrenceRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+type RemoveCardRecurrenceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RemoveCardRecurrenceResponse) Reset() {
+	*x = RemoveCardRecurrenceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCardRecurrenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCardRecurrenceResponse) ProtoMessage() {}
+
+func (x *RemoveCardRecurrenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCardRecurrenceResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCardRecurrenceResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RemoveCardRecurrenceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetCardCustomFieldValueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID uint64            `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Value  *CustomFieldValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetCardCustomFieldValueRequest) Reset() {
+	*x = SetCardCustomFieldValueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCardCustomFieldValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCardCustomFieldValueRequest) ProtoMessage() {}
+
+func (x *SetCardCustomFieldValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCardCustomFieldValueRequest.ProtoReflect.Descriptor instead.
+func (*SetCardCustomFieldValueRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *SetCardCustomFieldValueRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *SetCardCustomFieldValueRequest) GetValue() *CustomFieldValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type SetCardCustomFieldValueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SetCardCustomFieldValueResponse) Reset() {
+	*x = SetCardCustomFieldValueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetCardCustomFieldValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCardCustomFieldValueResponse) ProtoMessage() {}
+
+func (x *SetCardCustomFieldValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCardCustomFieldValueResponse.ProtoReflect.Descriptor instead.
+func (*SetCardCustomFieldValueResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SetCardCustomFieldValueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type RemoveCardCustomFieldValueRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID        uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	CustomFieldID uint64 `protobuf:"varint,2,opt,name=customFieldID,proto3" json:"customFieldID,omitempty"`
+}
+
+func (x *RemoveCardCustomFieldValueRequest) Reset() {
+	*x = RemoveCardCustomFieldValueRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCardCustomFieldValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCardCustomFieldValueRequest) ProtoMessage() {}
+
+func (x *RemoveCardCustomFieldValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCardCustomFieldValueRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCardCustomFieldValueRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *RemoveCardCustomFieldValueRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *RemoveCardCustomFieldValueRequest) GetCustomFieldID() uint64 {
+	if x != nil {
+		return x.CustomFieldID
+	}
+	return 0
+}
+
+type RemoveCardCustomFieldValueResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RemoveCardCustomFieldValueResponse) Reset() {
+	*x = RemoveCardCustomFieldValueResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCardCustomFieldValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCardCustomFieldValueResponse) ProtoMessage() {}
+
+func (x *RemoveCardCustomFieldValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCardCustomFieldValueResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCardCustomFieldValueResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *RemoveCardCustomFieldValueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCardHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID     uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	PageNumber uint64 `protobuf:"varint,2,opt,name=page_number,json=pageNumber,proto3" json:"page_number,omitempty"`
+	PageSize   uint64 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetCardHistoryRequest) Reset() {
+	*x = GetCardHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCardHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardHistoryRequest) ProtoMessage() {}
+
+func (x *GetCardHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCardHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetCardHistoryRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *GetCardHistoryRequest) GetPageNumber() uint64 {
+	if x != nil {
+		return x.PageNumber
+	}
+	return 0
+}
+
+func (x *GetCardHistoryRequest) GetPageSize() uint64 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetCardHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Versions   []*CardVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	Pagination *Pagination    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetCardHistoryResponse) Reset() {
+	*x = GetCardHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCardHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCardHistoryResponse) ProtoMessage() {}
+
+func (x *GetCardHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCardHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCardHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetCardHistoryResponse) GetVersions() []*CardVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *GetCardHistoryResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type RestoreCardVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID          uint64   `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Version         uint64   `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Fields          []string `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`                                           // name, description, dates, labels or members, empty restores all of them
+	ExpectedVersion uint64   `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"` // Version the change is based on, zero skips the check
+}
+
+func (x *RestoreCardVersionRequest) Reset() {
+	*x = RestoreCardVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCardVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCardVersionRequest) ProtoMessage() {}
+
+func (x *RestoreCardVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCardVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCardVersionRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RestoreCardVersionRequest) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *RestoreCardVersionRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RestoreCardVersionRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *RestoreCardVersionRequest) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RestoreCardVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RestoreCardVersionResponse) Reset() {
+	*x = RestoreCardVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreCardVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCardVersionResponse) ProtoMessage() {}
+
+func (x *RestoreCardVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCardVersionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCardVersionResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *RestoreCardVersionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type BulkUpdateCardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardIDs   []uint64               `protobuf:"varint,1,rep,packed,name=cardIDs,proto3" json:"cardIDs,omitempty"`
+	Operation string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"` // move, archive, restore, add_label, remove_label, add_member, remove_member, set_due_date or complete
+	ListID    uint64                 `protobuf:"varint,3,opt,name=listID,proto3" json:"listID,omitempty"`      // List cards are moved to
+	LabelID   uint64                 `protobuf:"varint,4,opt,name=labelID,proto3" json:"labelID,omitempty"`    // Label added or removed
+	UserID    uint64                 `protobuf:"varint,5,opt,name=userID,proto3" json:"userID,omitempty"`      // Member added or removed
+	DueDate   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+}
+
+func (x *BulkUpdateCardsRequest) Reset() {
+	*x = BulkUpdateCardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUpdateCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCardsRequest) ProtoMessage() {}
+
+func (x *BulkUpdateCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCardsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCardsRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *BulkUpdateCardsRequest) GetCardIDs() []uint64 {
+	if x != nil {
+		return x.CardIDs
+	}
+	return nil
+}
+
+func (x *BulkUpdateCardsRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *BulkUpdateCardsRequest) GetListID() uint64 {
+	if x != nil {
+		return x.ListID
+	}
+	return 0
+}
+
+func (x *BulkUpdateCardsRequest) GetLabelID() uint64 {
+	if x != nil {
+		return x.LabelID
+	}
+	return 0
+}
+
+func (x *BulkUpdateCardsRequest) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *BulkUpdateCardsRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+type BulkCardResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID          uint64           `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	Success         bool             `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Status          int32            `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"` // HTTP status of the error the card failed with
+	Error           string           `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	WipLimitWarning *WIPLimitWarning `protobuf:"bytes,5,opt,name=wip_limit_warning,json=wipLimitWarning,proto3" json:"wip_limit_warning,omitempty"`
+}
+
+func (x *BulkCardResult) Reset() {
+	*x = BulkCardResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkCardResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkCardResult) ProtoMessage() {}
+
+func (x *BulkCardResult) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkCardResult.ProtoReflect.Descriptor instead.
+func (*BulkCardResult) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BulkCardResult) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *BulkCardResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkCardResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *BulkCardResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkCardResult) GetWipLimitWarning() *WIPLimitWarning {
+	if x != nil {
+		return x.WipLimitWarning
+	}
+	return nil
+}
+
+type BulkUpdateCardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message   string            `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Results   []*BulkCardResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded int32             `protobuf:"varint,3,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed    int32             `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *BulkUpdateCardsResponse) Reset() {
+	*x = BulkUpdateCardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUpdateCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateCardsResponse) ProtoMessage() {}
+
+func (x *BulkUpdateCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateCardsResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *BulkUpdateCardsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BulkUpdateCardsResponse) GetResults() []*BulkCardResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkUpdateCardsResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BulkUpdateCardsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ArchiveAllCardsInListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListID uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+}
+
+func (x *ArchiveAllCardsInListRequest) Reset() {
+	*x = ArchiveAllCardsInListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveAllCardsInListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAllCardsInListRequest) ProtoMessage() {}
+
+func (x *ArchiveAllCardsInListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAllCardsInListRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveAllCardsInListRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ArchiveAllCardsInListRequest) GetListID() uint64 {
+	if x != nil {
+		return x.ListID
+	}
+	return 0
+}
+
+type ArchiveAllCardsInListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardIDs []uint64 `protobuf:"varint,2,rep,packed,name=cardIDs,proto3" json:"cardIDs,omitempty"`
+}
+
+func (x *ArchiveAllCardsInListResponse) Reset() {
+	*x = ArchiveAllCardsInListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveAllCardsInListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAllCardsInListResponse) ProtoMessage() {}
+
+func (x *ArchiveAllCardsInListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAllCardsInListResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveAllCardsInListResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ArchiveAllCardsInListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ArchiveAllCardsInListResponse) GetCardIDs() []uint64 {
+	if x != nil {
+		return x.CardIDs
+	}
+	return nil
+}
+
+type MoveAllCardsInListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListID        uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+	TargetListID  uint64 `protobuf:"varint,2,opt,name=target_listID,json=targetListID,proto3" json:"target_listID,omitempty"`
+	TargetBoardID uint64 `protobuf:"varint,3,opt,name=target_boardID,json=targetBoardID,proto3" json:"target_boardID,omitempty"` // Board of the target list, zero when it's the board of the list
+}
+
+func (x *MoveAllCardsInListRequest) Reset() {
+	*x = MoveAllCardsInListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveAllCardsInListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAllCardsInListRequest) ProtoMessage() {}
+
+func (x *MoveAllCardsInListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAllCardsInListRequest.ProtoReflect.Descriptor instead.
+func (*MoveAllCardsInListRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *MoveAllCardsInListRequest) GetListID() uint64 {
+	if x != nil {
+		return x.ListID
+	}
+	return 0
+}
+
+func (x *MoveAllCardsInListRequest) GetTargetListID() uint64 {
+	if x != nil {
+		return x.TargetListID
+	}
+	return 0
+}
+
+func (x *MoveAllCardsInListRequest) GetTargetBoardID() uint64 {
+	if x != nil {
+		return x.TargetBoardID
+	}
+	return 0
+}
+
+type MoveAllCardsInListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message         string           `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardIDs         []uint64         `protobuf:"varint,2,rep,packed,name=cardIDs,proto3" json:"cardIDs,omitempty"`
+	WipLimitWarning *WIPLimitWarning `protobuf:"bytes,3,opt,name=wip_limit_warning,json=wipLimitWarning,proto3" json:"wip_limit_warning,omitempty"`
+}
+
+func (x *MoveAllCardsInListResponse) Reset() {
+	*x = MoveAllCardsInListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveAllCardsInListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAllCardsInListResponse) ProtoMessage() {}
+
+func (x *MoveAllCardsInListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAllCardsInListResponse.ProtoReflect.Descriptor instead.
+func (*MoveAllCardsInListResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *MoveAllCardsInListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MoveAllCardsInListResponse) GetCardIDs() []uint64 {
+	if x != nil {
+		return x.CardIDs
+	}
+	return nil
+}
+
+func (x *MoveAllCardsInListResponse) GetWipLimitWarning() *WIPLimitWarning {
+	if x != nil {
+		return x.WipLimitWarning
+	}
+	return nil
+}
+
+type SortCardsInListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListID        uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+	SortBy        string `protobuf:"bytes,2,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // due_date, created_at, name or custom_field
+	CustomFieldID uint64 `protobuf:"varint,3,opt,name=customFieldID,proto3" json:"customFieldID,omitempty"`
+	Descending    bool   `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
+}
+
+func (x *SortCardsInListRequest) Reset() {
+	*x = SortCardsInListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortCardsInListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortCardsInListRequest) ProtoMessage() {}
+
+func (x *SortCardsInListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortCardsInListRequest.ProtoReflect.Descriptor instead.
+func (*SortCardsInListRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SortCardsInListRequest) GetListID() uint64 {
+	if x != nil {
+		return x.ListID
+	}
+	return 0
+}
+
+func (x *SortCardsInListRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *SortCardsInListRequest) GetCustomFieldID() uint64 {
+	if x != nil {
+		return x.CustomFieldID
+	}
+	return 0
+}
+
+func (x *SortCardsInListRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type SortCardsInListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	CardIDs []uint64 `protobuf:"varint,2,rep,packed,name=cardIDs,proto3" json:"cardIDs,omitempty"`
+}
+
+func (x *SortCardsInListResponse) Reset() {
+	*x = SortCardsInListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortCardsInListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortCardsInListResponse) ProtoMessage() {}
+
+func (x *SortCardsInListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortCardsInListResponse.ProtoReflect.Descriptor instead.
+func (*SortCardsInListResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SortCardsInListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SortCardsInListResponse) GetCardIDs() []uint64 {
+	if x != nil {
+		return x.CardIDs
+	}
+	return nil
+}
+
+type ImportCardsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ListID  uint64 `protobuf:"varint,1,opt,name=listID,proto3" json:"listID,omitempty"`
+	Content []byte `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // CSV file with a header line
+	// Header to card field: name, description, labels, members, due_date or custom_field:<name>.
+	// When empty, headers are matched to card fields and custom fields by name.
+	Columns map[string]string `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Partial bool              `protobuf:"varint,4,opt,name=partial,proto3" json:"partial,omitempty"` // Import the valid rows even when other rows have errors
+}
+
+func (x *ImportCardsRequest) Reset() {
+	*x = ImportCardsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCardsRequest) ProtoMessage() {}
+
+func (x *ImportCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCardsRequest.ProtoReflect.Descriptor instead.
+func (*ImportCardsRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ImportCardsRequest) GetListID() uint64 {
+	if x != nil {
+		return x.ListID
+	}
+	return 0
+}
+
+func (x *ImportCardsRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportCardsRequest) GetColumns() map[string]string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ImportCardsRequest) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+type ImportCardsRowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line    int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // Line of the file the row starts on
+	Field   string `protobuf:"bytes,2,opt,name=field,proto3" json:"field,omitempty"`
+	Code    string `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportCardsRowError) Reset() {
+	*x = ImportCardsRowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCardsRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCardsRowError) ProtoMessage() {}
+
+func (x *ImportCardsRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCardsRowError.ProtoReflect.Descriptor instead.
+func (*ImportCardsRowError) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ImportCardsRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportCardsRowError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportCardsRowError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ImportCardsRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportCardsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message         string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Imported        int32                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	CardIDs         []uint64               `protobuf:"varint,3,rep,packed,name=cardIDs,proto3" json:"cardIDs,omitempty"`
+	LabelsCreated   int32                  `protobuf:"varint,4,opt,name=labels_created,json=labelsCreated,proto3" json:"labels_created,omitempty"`
+	Errors          []*ImportCardsRowError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	WipLimitWarning *WIPLimitWarning       `protobuf:"bytes,6,opt,name=wip_limit_warning,json=wipLimitWarning,proto3" json:"wip_limit_warning,omitempty"`
+}
+
+func (x *ImportCardsResponse) Reset() {
+	*x = ImportCardsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportCardsResponse) ProtoMessage() {}
+
+func (x *ImportCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportCardsResponse.ProtoReflect.Descriptor instead.
+func (*ImportCardsResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ImportCardsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportCardsResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportCardsResponse) GetCardIDs() []uint64 {
+	if x != nil {
+		return x.CardIDs
+	}
+	return nil
+}
+
+func (x *ImportCardsResponse) GetLabelsCreated() int32 {
+	if x != nil {
+		return x.LabelsCreated
+	}
+	return 0
+}
+
+func (x *ImportCardsResponse) GetErrors() []*ImportCardsRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportCardsResponse) GetWipLimitWarning() *WIPLimitWarning {
+	if x != nil {
+		return x.WipLimitWarning
+	}
+	return nil
+}
+
+// Published as list.cards_archived, list.cards_moved, list.cards_sorted and list.cards_imported
+type ListCardsEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoardID       uint64   `protobuf:"varint,1,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	ListID        uint64   `protobuf:"varint,2,opt,name=listID,proto3" json:"listID,omitempty"`
+	CardIDs       []uint64 `protobuf:"varint,3,rep,packed,name=cardIDs,proto3" json:"cardIDs,omitempty"`                           // Cards changed, in their new order when moved or sorted
+	TargetBoardID uint64   `protobuf:"varint,4,opt,name=target_boardID,json=targetBoardID,proto3" json:"target_boardID,omitempty"` // Set when cards were moved
+	TargetListID  uint64   `protobuf:"varint,5,opt,name=target_listID,json=targetListID,proto3" json:"target_listID,omitempty"`
+	UserID        uint64   `protobuf:"varint,6,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *ListCardsEvent) Reset() {
+	*x = ListCardsEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCardsEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCardsEvent) ProtoMessage() {}
+
+func (x *ListCardsEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCardsEvent.ProtoReflect.Descriptor instead.
+func (*ListCardsEvent) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *ListCardsEvent) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *ListCardsEvent) GetListID() uint64 {
+	if x != nil {
+		return x.ListID
+	}
+	return 0
+}
+
+func (x *ListCardsEvent) GetCardIDs() []uint64 {
+	if x != nil {
+		return x.CardIDs
+	}
+	return nil
+}
+
+func (x *ListCardsEvent) GetTargetBoardID() uint64 {
+	if x != nil {
+		return x.TargetBoardID
+	}
+	return 0
+}
+
+func (x *ListCardsEvent) GetTargetListID() uint64 {
+	if x != nil {
+		return x.TargetListID
+	}
+	return 0
+}
+
+func (x *ListCardsEvent) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+// Published as card.due_soon and card.overdue
+type CardDueEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID        uint64                 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+	BoardID       uint64                 `protobuf:"varint,2,opt,name=boardID,proto3" json:"boardID,omitempty"`
+	ListID        uint64                 `protobuf:"varint,3,opt,name=listID,proto3" json:"listID,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	OffsetMinutes int64                  `protobuf:"varint,6,opt,name=offset_minutes,json=offsetMinutes,proto3" json:"offset_minutes,omitempty"`
+	Members       []uint64               `protobuf:"varint,7,rep,packed,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *CardDueEvent) Reset() {
+	*x = CardDueEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardDueEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardDueEvent) ProtoMessage() {}
+
+func (x *CardDueEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardDueEvent.ProtoReflect.Descriptor instead.
+func (*CardDueEvent) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CardDueEvent) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+func (x *CardDueEvent) GetBoardID() uint64 {
+	if x != nil {
+		return x.BoardID
+	}
+	return 0
+}
+
+func (x *CardDueEvent) GetListID() uint64 {
+	if x != nil {
+		return x.ListID
+	}
+	return 0
+}
+
+func (x *CardDueEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CardDueEvent) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CardDueEvent) GetOffsetMinutes() int64 {
+	if x != nil {
+		return x.OffsetMinutes
+	}
+	return 0
+}
+
+func (x *CardDueEvent) GetMembers() []uint64 {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// A client joins with the first message of the stream, then sends edits and cursor moves made at
+// the latest revision it has seen
+type EditCardDescriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*EditCardDescriptionRequest_Join
+	//	*EditCardDescriptionRequest_Edit
+	//	*EditCardDescriptionRequest_Cursor
+	Payload isEditCardDescriptionRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *EditCardDescriptionRequest) Reset() {
+	*x = EditCardDescriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditCardDescriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCardDescriptionRequest) ProtoMessage() {}
+
+func (x *EditCardDescriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCardDescriptionRequest.ProtoReflect.Descriptor instead.
+func (*EditCardDescriptionRequest) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{95}
+}
+
+func (m *EditCardDescriptionRequest) GetPayload() isEditCardDescriptionRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *EditCardDescriptionRequest) GetJoin() *JoinDescriptionSession {
+	if x, ok := x.GetPayload().(*EditCardDescriptionRequest_Join); ok {
+		return x.Join
+	}
+	return nil
+}
+
+func (x *EditCardDescriptionRequest) GetEdit() *DescriptionEdit {
+	if x, ok := x.GetPayload().(*EditCardDescriptionRequest_Edit); ok {
+		return x.Edit
+	}
+	return nil
+}
+
+func (x *EditCardDescriptionRequest) GetCursor() *DescriptionCursor {
+	if x, ok := x.GetPayload().(*EditCardDescriptionRequest_Cursor); ok {
+		return x.Cursor
+	}
+	return nil
+}
+
+type isEditCardDescriptionRequest_Payload interface {
+	isEditCardDescriptionRequest_Payload()
+}
+
+type EditCardDescriptionRequest_Join struct {
+	Join *JoinDescriptionSession `protobuf:"bytes,1,opt,name=join,proto3,oneof"`
+}
+
+type EditCardDescriptionRequest_Edit struct {
+	Edit *DescriptionEdit `protobuf:"bytes,2,opt,name=edit,proto3,oneof"`
+}
+
+type EditCardDescriptionRequest_Cursor struct {
+	Cursor *DescriptionCursor `protobuf:"bytes,3,opt,name=cursor,proto3,oneof"`
+}
+
+func (*EditCardDescriptionRequest_Join) isEditCardDescriptionRequest_Payload() {}
+
+func (*EditCardDescriptionRequest_Edit) isEditCardDescriptionRequest_Payload() {}
+
+func (*EditCardDescriptionRequest_Cursor) isEditCardDescriptionRequest_Payload() {}
+
+type JoinDescriptionSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CardID uint64 `protobuf:"varint,1,opt,name=cardID,proto3" json:"cardID,omitempty"`
+}
+
+func (x *JoinDescriptionSession) Reset() {
+	*x = JoinDescriptionSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinDescriptionSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinDescriptionSession) ProtoMessage() {}
+
+func (x *JoinDescriptionSession) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinDescriptionSession.ProtoReflect.Descriptor instead.
+func (*JoinDescriptionSession) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *JoinDescriptionSession) GetCardID() uint64 {
+	if x != nil {
+		return x.CardID
+	}
+	return 0
+}
+
+// Components count characters, not bytes, and together cover the whole document
+type OperationComponent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*OperationComponent_Retain
+	//	*OperationComponent_Insert
+	//	*OperationComponent_Delete
+	Kind isOperationComponent_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *OperationComponent) Reset() {
+	*x = OperationComponent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationComponent) ProtoMessage() {}
+
+func (x *OperationComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationComponent.ProtoReflect.Descriptor instead.
+func (*OperationComponent) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{97}
+}
+
+func (m *OperationComponent) GetKind() isOperationComponent_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *OperationComponent) GetRetain() int64 {
+	if x, ok := x.GetKind().(*OperationComponent_Retain); ok {
+		return x.Retain
+	}
+	return 0
+}
+
+func (x *OperationComponent) GetInsert() string {
+	if x, ok := x.GetKind().(*OperationComponent_Insert); ok {
+		return x.Insert
+	}
+	return ""
+}
+
+func (x *OperationComponent) GetDelete() int64 {
+	if x, ok := x.GetKind().(*OperationComponent_Delete); ok {
+		return x.Delete
+	}
+	return 0
+}
+
+type isOperationComponent_Kind interface {
+	isOperationComponent_Kind()
+}
+
+type OperationComponent_Retain struct {
+	Retain int64 `protobuf:"varint,1,opt,name=retain,proto3,oneof"`
+}
+
+type OperationComponent_Insert struct {
+	Insert string `protobuf:"bytes,2,opt,name=insert,proto3,oneof"`
+}
+
+type OperationComponent_Delete struct {
+	Delete int64 `protobuf:"varint,3,opt,name=delete,proto3,oneof"`
+}
+
+func (*OperationComponent_Retain) isOperationComponent_Kind() {}
+
+func (*OperationComponent_Insert) isOperationComponent_Kind() {}
+
+func (*OperationComponent_Delete) isOperationComponent_Kind() {}
+
+type DescriptionEdit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision  uint64                `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Operation []*OperationComponent `protobuf:"bytes,2,rep,name=operation,proto3" json:"operation,omitempty"`
+	UserID    uint64                `protobuf:"varint,3,opt,name=userID,proto3" json:"userID,omitempty"` // Set on edits sent by the server
+}
+
+func (x *DescriptionEdit) Reset() {
+	*x = DescriptionEdit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptionEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionEdit) ProtoMessage() {}
+
+func (x *DescriptionEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionEdit.ProtoReflect.Descriptor instead.
+func (*DescriptionEdit) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DescriptionEdit) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DescriptionEdit) GetOperation() []*OperationComponent {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *DescriptionEdit) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+type DescriptionCursor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision     uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Position     int64  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	SelectionEnd int64  `protobuf:"varint,3,opt,name=selection_end,json=selectionEnd,proto3" json:"selection_end,omitempty"`
+}
+
+func (x *DescriptionCursor) Reset() {
+	*x = DescriptionCursor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptionCursor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionCursor) ProtoMessage() {}
+
+func (x *DescriptionCursor) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionCursor.ProtoReflect.Descriptor instead.
+func (*DescriptionCursor) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DescriptionCursor) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DescriptionCursor) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *DescriptionCursor) GetSelectionEnd() int64 {
+	if x != nil {
+		return x.SelectionEnd
+	}
+	return 0
+}
+
+type DescriptionPresence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID uint64             `protobuf:"varint,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Cursor *DescriptionCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Left   bool               `protobuf:"varint,3,opt,name=left,proto3" json:"left,omitempty"`
+}
+
+func (x *DescriptionPresence) Reset() {
+	*x = DescriptionPresence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptionPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionPresence) ProtoMessage() {}
+
+func (x *DescriptionPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionPresence.ProtoReflect.Descriptor instead.
+func (*DescriptionPresence) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DescriptionPresence) GetUserID() uint64 {
+	if x != nil {
+		return x.UserID
+	}
+	return 0
+}
+
+func (x *DescriptionPresence) GetCursor() *DescriptionCursor {
+	if x != nil {
+		return x.Cursor
+	}
+	return nil
+}
+
+func (x *DescriptionPresence) GetLeft() bool {
+	if x != nil {
+		return x.Left
+	}
+	return false
+}
+
+type DescriptionSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision uint64                 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Content  string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Presence []*DescriptionPresence `protobuf:"bytes,3,rep,name=presence,proto3" json:"presence,omitempty"`
+}
+
+func (x *DescriptionSnapshot) Reset() {
+	*x = DescriptionSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptionSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionSnapshot) ProtoMessage() {}
+
+func (x *DescriptionSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionSnapshot.ProtoReflect.Descriptor instead.
+func (*DescriptionSnapshot) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DescriptionSnapshot) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *DescriptionSnapshot) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *DescriptionSnapshot) GetPresence() []*DescriptionPresence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+type DescriptionAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *DescriptionAck) Reset() {
+	*x = DescriptionAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescriptionAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescriptionAck) ProtoMessage() {}
+
+func (x *DescriptionAck) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescriptionAck.ProtoReflect.Descriptor instead.
+func (*DescriptionAck) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *DescriptionAck) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type EditCardDescriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*EditCardDescriptionResponse_Snapshot
+	//	*EditCardDescriptionResponse_Ack
+	//	*EditCardDescriptionResponse_Edit
+	//	*EditCardDescriptionResponse_Presence
+	Payload isEditCardDescriptionResponse_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *EditCardDescriptionResponse) Reset() {
+	*x = EditCardDescriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_card_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditCardDescriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCardDescriptionResponse) ProtoMessage() {}
+
+func (x *EditCardDescriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_card_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCardDescriptionResponse.ProtoReflect.Descriptor instead.
+func (*EditCardDescriptionResponse) Descriptor() ([]byte, []int) {
+	return file_card_proto_rawDescGZIP(), []int{103}
+}
+
+func (m *EditCardDescriptionResponse) GetPayload() isEditCardDescriptionResponse_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *EditCardDescriptionResponse) GetSnapshot() *DescriptionSnapshot {
+	if x, ok := x.GetPayload().(*EditCardDescriptionResponse_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *EditCardDescriptionResponse) GetAck() *DescriptionAck {
+	if x, ok := x.GetPayload().(*EditCardDescriptionResponse_Ack); ok {
+		return x.Ack
+	}
+	return nil
+}
+
+func (x *EditCardDescriptionResponse) GetEdit() *DescriptionEdit {
+	if x, ok := x.GetPayload().(*EditCardDescriptionResponse_Edit); ok {
+		return x.Edit
+	}
+	return nil
+}
+
+func (x *EditCardDescriptionResponse) GetPresence() *DescriptionPresence {
+	if x, ok := x.GetPayload().(*EditCardDescriptionResponse_Presence); ok {
+		return x.Presence
+	}
+	return nil
+}
+
+type isEditCardDescriptionResponse_Payload interface {
+	isEditCardDescriptionResponse_Payload()
+}
+
+type EditCardDescriptionResponse_Snapshot struct {
+	Snapshot *DescriptionSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3,oneof"`
+}
+
+type EditCardDescriptionResponse_Ack struct {
+	Ack *DescriptionAck `protobuf:"bytes,2,opt,name=ack,proto3,oneof"`
+}
+
+type EditCardDescriptionResponse_Edit struct {
+	Edit *DescriptionEdit `protobuf:"bytes,3,opt,name=edit,proto3,oneof"`
+}
+
+type EditCardDescriptionResponse_Presence struct {
+	Presence *DescriptionPresence `protobuf:"bytes,4,opt,name=presence,proto3,oneof"`
+}
+
+func (*EditCardDescriptionResponse_Snapshot) isEditCardDescriptionResponse_Payload() {}
+
+func (*EditCardDescriptionResponse_Ack) isEditCardDescriptionResponse_Payload() {}
+
+func (*EditCardDescriptionResponse_Edit) isEditCardDescriptionResponse_Payload() {}
+
+func (*EditCardDescriptionResponse_Presence) isEditCardDescriptionResponse_Payload() {}
+
+var File_card_proto protoreflect.FileDescriptor
+
+var file_card_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfb, 0x05, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64,
+	0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0a,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x74,
+	0x6d, 0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x22, 0xf2, 0x04, 0x0a, 0x08, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x48, 0x0a, 0x13, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x72, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12,
+	0x3b, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x22, 0xe4, 0x01, 0x0a,
+	0x0e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x65,
+	0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x64,
+	0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x44,
+	0x61, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x3a, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f,
+	0x72, 0x75, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75,
+	0x6e, 0x41, 0x74, 0x22, 0x8b, 0x02, 0x0a, 0x10, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1f,
+	0x0a, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x23, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x27, 0x0a, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x62, 0x6f, 0x78, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x62, 0x6f, 0x78, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x08, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x63, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0xb4, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x22, 0x4f, 0x0a,
+	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1b, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0xe7,
+	0x03, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x12, 0x20, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x29,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x09,
+	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x48, 0x74, 0x6d, 0x6c, 0x22, 0x41, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x60, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb2, 0x01,
+	0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x50,
+	0x65, 0x72, 0x50, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x5f, 0x6d,
+	0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4d, 0x6f,
+	0x72, 0x65, 0x22, 0xef, 0x02, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x64, 0x75, 0x65,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x0f, 0x43, 0x61, 0x72, 0x64, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x65, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x65, 0x77, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x0f, 0x57, 0x49,
+	0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x69, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7b,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64,
+	0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x43, 0x0a, 0x11, 0x77, 0x69, 0x70, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x57, 0x49, 0x50, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x77, 0x69, 0x70, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x37, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x20, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x63, 0x61,
+	0x72, 0x64, 0x22, 0x6b, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x57, 0x0a, 0x0f, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x6f,
+	0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x7a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x22, 0x41, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x42, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x61,
+	0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b,
+	0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x40, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x61,
+	0x72, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0x6e, 0x0a,
+	0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x83, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x17, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6f, 0x6c, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x18, 0x4d,
+	0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x43, 0x0a, 0x11, 0x77, 0x69, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x57, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x77, 0x69, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x2b, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x22, 0x30, 0x0a, 0x14,
+	0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4a,
+	0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x22, 0x33, 0x0a, 0x17, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xc8, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x44, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12,
+	0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5f, 0x0a, 0x1a,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x37, 0x0a,
+	0x1b, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x56, 0x0a, 0x18, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72,
+	0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x35,
+	0x0a, 0x19, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x22, 0x38, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x65, 0x0a, 0x15, 0x41, 0x64,
+	0x64, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x44, 0x22, 0x50, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x22, 0x6a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
+	0x35, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2b, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x32,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x4a, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x64, 0x69,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x64, 0x69, 0x74, 0x52, 0x05, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a, 0x69, 0x22, 0x36, 0x0a,
+	0x1a, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6a, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x6f, 0x6a, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x6f, 0x6a,
+	0x69, 0x22, 0x39, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x50, 0x0a, 0x18,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x35,
+	0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x49, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x22, 0x32, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x22, 0x35, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x12, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72,
+	0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x13,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a,
+	0x17, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xca, 0x01,
+	0x0a, 0x18, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x6f, 0x6e,
+	0x74, 0x68, 0x44, 0x61, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x35, 0x0a, 0x19, 0x53, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x35, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x38, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x68, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3b, 0x0a, 0x1f,
+	0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x61, 0x0a, 0x21, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x22, 0x3e, 0x0a, 0x22,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6d, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x7d, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x43, 0x61, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x36, 0x0a,
+	0x1a, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x16, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x0e, 0x42, 0x75,
+	0x6c, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61,
+	0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x11,
+	0x77, 0x69, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x57, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x0f, 0x77, 0x69, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x22, 0x9b, 0x01, 0x0a, 0x17, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22,
+	0x36, 0x0a, 0x1c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x22, 0x53, 0x0a, 0x1d, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x22, 0x7f, 0x0a, 0x19,
+	0x4d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x95, 0x01,
+	0x0a, 0x1a, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73,
+	0x12, 0x43, 0x0a, 0x11, 0x77, 0x69, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x57, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x77, 0x69, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x8f, 0x01, 0x0a, 0x16, 0x53, 0x6f, 0x72, 0x74, 0x43, 0x61,
+	0x72, 0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
+	0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x73,
+	0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4d, 0x0a, 0x17, 0x53, 0x6f, 0x72, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x63,
+	0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x41, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6d, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x13, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x6f, 0x77, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x11, 0x77,
+	0x69, 0x70, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
+	0x57, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52,
+	0x0f, 0x77, 0x69, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x22, 0xc0, 0x01, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0xe4, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x64, 0x44, 0x75, 0x65, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x64, 0x75, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xc1, 0x01, 0x0a, 0x1a, 0x45,
+	0x64, 0x69, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x04, 0x6a, 0x6f, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12,
+	0x2d, 0x0a, 0x04, 0x65, 0x64, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x65, 0x64, 0x69, 0x74, 0x12, 0x33,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x30,
+	0x0a, 0x16, 0x4a, 0x6f, 0x69, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x72, 0x64, 0x49, 0x44,
+	0x22, 0x6a, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e,
+	0x12, 0x18, 0x0a, 0x06, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x06, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x7f, 0x0a, 0x0f,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x70, 0x0a,
+	0x11, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x64, 0x22,
+	0x74, 0x0a, 0x13, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x31,
+	0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6c, 0x65, 0x66, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x2c, 0x0a, 0x0e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x1b, 0x45,
+	0x64, 0x69, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2a, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x03, 0x61, 0x63,
+	0x6b, 0x12, 0x2d, 0x0a, 0x04, 0x65, 0x64, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x64, 0x69, 0x74, 0x48, 0x00, 0x52, 0x04, 0x65, 0x64, 0x69, 0x74,
+	0x12, 0x39, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x32, 0xdc, 0x1a, 0x0a, 0x0b, 0x43, 0x61, 0x72, 0x64, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1e, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42,
+	0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42,
+	0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x57, 0x0a, 0x10, 0x4d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x6f,
+	0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4d,
+	0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x45, 0x64, 0x69, 0x74, 0x43, 0x61, 0x72, 0x64,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0c, 0x41, 0x64,
+	0x64, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a,
+	0x0c, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x13, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x22, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11,
+	0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61,
+	0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64,
+	0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x23, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x0e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12,
+	0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x61, 0x72, 0x64, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x12, 0x19,
+	0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x6d, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x6d, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x6d,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x5a, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x14,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6c, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x26, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x75, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x64, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x72, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x43, 0x61, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x42, 0x75, 0x6c, 0x6b,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61,
+	0x72, 0x64, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66,
+	0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x64,
+	0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41, 0x6c,
+	0x6c, 0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x12, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x6c,
+	0x6c, 0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x63,
+	0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x41, 0x6c, 0x6c,
+	0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x53, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70,
+	0x62, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x49, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x72,
+	0x64, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x64, 0x70, 0x62, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x38, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6d, 0x38, 0x38, 0x38, 0x73, 0x6d, 0x2f, 0x68, 0x61, 0x6c, 0x74,
+	0x65, 0x6e, 0x2d, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x63, 0x61, 0x72, 0x64, 0x2d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x61, 0x72, 0x64, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_card_proto_rawDescOnce sync.Once
+	file_card_proto_rawDescData = file_card_proto_rawDesc
+)
+
+func file_card_proto_rawDescGZIP() []byte {
+	file_card_proto_rawDescOnce.Do(func() {
+		file_card_proto_rawDescData = protoimpl.X.CompressGZIP(file_card_proto_rawDescData)
+	})
+	return file_card_proto_rawDescData
+}
+
+var file_card_proto_msgTypes = make([]protoimpl.MessageInfo, 105)
+var file_card_proto_goTypes = []interface{}{
+	(*Card)(nil),                               // 0: cardpb.Card
+	(*CardMeta)(nil),                           // 1: cardpb.CardMeta
+	(*CardReminder)(nil),                       // 2: cardpb.CardReminder
+	(*CardRecurrence)(nil),                     // 3: cardpb.CardRecurrence
+	(*CustomFieldValue)(nil),                   // 4: cardpb.CustomFieldValue
+	(*Label)(nil),                              // 5: cardpb.Label
+	(*Attachment)(nil),                         // 6: cardpb.Attachment
+	(*User)(nil),                               // 7: cardpb.User
+	(*Comment)(nil),                            // 8: cardpb.Comment
+	(*CommentReaction)(nil),                    // 9: cardpb.CommentReaction
+	(*CommentEdit)(nil),                        // 10: cardpb.CommentEdit
+	(*Pagination)(nil),                         // 11: cardpb.Pagination
+	(*CardVersion)(nil),                        // 12: cardpb.CardVersion
+	(*CardFieldChange)(nil),                    // 13: cardpb.CardFieldChange
+	(*CreateCardRequest)(nil),                  // 14: cardpb.CreateCardRequest
+	(*WIPLimitWarning)(nil),                    // 15: cardpb.WIPLimitWarning
+	(*CreateCardResponse)(nil),                 // 16: cardpb.CreateCardResponse
+	(*GetCardByIDRequest)(nil),                 // 17: cardpb.GetCardByIDRequest
+	(*GetCardByIDResponse)(nil),                // 18: cardpb.GetCardByIDResponse
+	(*CustomFieldFilter)(nil),                  // 19: cardpb.CustomFieldFilter
+	(*CustomFieldSort)(nil),                    // 20: cardpb.CustomFieldSort
+	(*GetCardsByBoardRequest)(nil),             // 21: cardpb.GetCardsByBoardRequest
+	(*GetCardsByBoardResponse)(nil),            // 22: cardpb.GetCardsByBoardResponse
+	(*GetCardsByListRequest)(nil),              // 23: cardpb.GetCardsByListRequest
+	(*GetCardsByListResponse)(nil),             // 24: cardpb.GetCardsByListResponse
+	(*UpdateCardNameRequest)(nil),              // 25: cardpb.UpdateCardNameRequest
+	(*UpdateCardNameResponse)(nil),             // 26: cardpb.UpdateCardNameResponse
+	(*UpdateCardDescriptionRequest)(nil),       // 27: cardpb.UpdateCardDescriptionRequest
+	(*UpdateCardDescriptionResponse)(nil),      // 28: cardpb.UpdateCardDescriptionResponse
+	(*MoveCardPositionRequest)(nil),            // 29: cardpb.MoveCardPositionRequest
+	(*MoveCardPositionResponse)(nil),           // 30: cardpb.MoveCardPositionResponse
+	(*DeleteCardRequest)(nil),                  // 31: cardpb.DeleteCardRequest
+	(*DeleteCardResponse)(nil),                 // 32: cardpb.DeleteCardResponse
+	(*AddCardLabelRequest)(nil),                // 33: cardpb.AddCardLabelRequest
+	(*AddCardLabelResponse)(nil),               // 34: cardpb.AddCardLabelResponse
+	(*RemoveCardLabelRequest)(nil),             // 35: cardpb.RemoveCardLabelRequest
+	(*RemoveCardLabelResponse)(nil),            // 36: cardpb.RemoveCardLabelResponse
+	(*SetCardDatesRequest)(nil),                // 37: cardpb.SetCardDatesRequest
+	(*SetCardDatesResponse)(nil),               // 38: cardpb.SetCardDatesResponse
+	(*ToggleCardCompletedRequest)(nil),         // 39: cardpb.ToggleCardCompletedRequest
+	(*ToggleCardCompletedResponse)(nil),        // 40: cardpb.ToggleCardCompletedResponse
+	(*AddCardAttachmentRequest)(nil),           // 41: cardpb.AddCardAttachmentRequest
+	(*AddCardAttachmentResponse)(nil),          // 42: cardpb.AddCardAttachmentResponse
+	(*RemoveCardAttachmentRequest)(nil),        // 43: cardpb.RemoveCardAttachmentRequest
+	(*RemoveCardAttachmentResponse)(nil),       // 44: cardpb.RemoveCardAttachmentResponse
+	(*AddCardCommentRequest)(nil),              // 45: cardpb.AddCardCommentRequest
+	(*AddCardCommentResponse)(nil),             // 46: cardpb.AddCardCommentResponse
+	(*UpdateCardCommentRequest)(nil),           // 47: cardpb.UpdateCardCommentRequest
+	(*UpdateCardCommentResponse)(nil),          // 48: cardpb.UpdateCardCommentResponse
+	(*GetCardCommentsRequest)(nil),             // 49: cardpb.GetCardCommentsRequest
+	(*GetCardCommentsResponse)(nil),            // 50: cardpb.GetCardCommentsResponse
+	(*GetCardCommentHistoryRequest)(nil),       // 51: cardpb.GetCardCommentHistoryRequest
+	(*GetCardCommentHistoryResponse)(nil),      // 52: cardpb.GetCardCommentHistoryResponse
+	(*AddCommentReactionRequest)(nil),          // 53: cardpb.AddCommentReactionRequest
+	(*AddCommentReactionResponse)(nil),         // 54: cardpb.AddCommentReactionResponse
+	(*RemoveCommentReactionRequest)(nil),       // 55: cardpb.RemoveCommentReactionRequest
+	(*RemoveCommentReactionResponse)(nil),      // 56: cardpb.RemoveCommentReactionResponse
+	(*RemoveCardCommentRequest)(nil),           // 57: cardpb.RemoveCardCommentRequest
+	(*RemoveCardCommentResponse)(nil),          // 58: cardpb.RemoveCardCommentResponse
+	(*AddCardMembersRequest)(nil),              // 59: cardpb.AddCardMembersRequest
+	(*AddCardMembersResponse)(nil),             // 60: cardpb.AddCardMembersResponse
+	(*RemoveCardMembersRequest)(nil),           // 61: cardpb.RemoveCardMembersRequest
+	(*RemoveCardMembersResponse)(nil),          // 62: cardpb.RemoveCardMembersResponse
+	(*ArchiveCardRequest)(nil),                 // 63: cardpb.ArchiveCardRequest
+	(*ArchiveCardResponse)(nil),                // 64: cardpb.ArchiveCardResponse
+	(*RestoreCardRequest)(nil),                 // 65: cardpb.RestoreCardRequest
+	(*RestoreCardResponse)(nil),                // 66: cardpb.RestoreCardResponse
+	(*SetCardRemindersRequest)(nil),            // 67: cardpb.SetCardRemindersRequest
+	(*SetCardRemindersResponse)(nil),           // 68: cardpb.SetCardRemindersResponse
+	(*SetCardRecurrenceRequest)(nil),           // 69: cardpb.SetCardRecurrenceRequest
+	(*SetCardRecurrenceResponse)(nil),          // 70: cardpb.SetCardRecurrenceResponse
+	(*RemoveCardRecurrenceRequest)(nil),        // 71: cardpb.RemoveCardRecurrenceRequest
+	(*RemoveCardRecurrenceResponse)(nil),       // 72: cardpb.RemoveCardRecurrenceResponse
+	(*SetCardCustomFieldValueRequest)(nil),     // 73: cardpb.SetCardCustomFieldValueRequest
+	(*SetCardCustomFieldValueResponse)(nil),    // 74: cardpb.SetCardCustomFieldValueResponse
+	(*RemoveCardCustomFieldValueRequest)(nil),  // 75: cardpb.RemoveCardCustomFieldValueRequest
+	(*RemoveCardCustomFieldValueResponse)(nil), // 76: cardpb.RemoveCardCustomFieldValueResponse
+	(*GetCardHistoryRequest)(nil),              // 77: cardpb.GetCardHistoryRequest
+	(*GetCardHistoryResponse)(nil),             // 78: cardpb.GetCardHistoryResponse
+	(*RestoreCardVersionRequest)(nil),          // 79: cardpb.RestoreCardVersionRequest
+	(*RestoreCardVersionResponse)(nil),         // 80: cardpb.RestoreCardVersionResponse
+	(*BulkUpdateCardsRequest)(nil),             // 81: cardpb.BulkUpdateCardsRequest
+	(*BulkCardResult)(nil),                     // 82: cardpb.BulkCardResult
+	(*BulkUpdateCardsResponse)(nil),            // 83: cardpb.BulkUpdateCardsResponse
+	(*ArchiveAllCardsInListRequest)(nil),       // 84: cardpb.ArchiveAllCardsInListRequest
+	(*ArchiveAllCardsInListResponse)(nil),      // 85: cardpb.ArchiveAllCardsInListResponse
+	(*MoveAllCardsInListRequest)(nil),          // 86: cardpb.MoveAllCardsInListRequest
+	(*MoveAllCardsInListResponse)(nil),         // 87: cardpb.MoveAllCardsInListResponse
+	(*SortCardsInListRequest)(nil),             // 88: cardpb.SortCardsInListRequest
+	(*SortCardsInListResponse)(nil),            // 89: cardpb.SortCardsInListResponse
+	(*ImportCardsRequest)(nil),                 // 90: cardpb.ImportCardsRequest
+	(*ImportCardsRowError)(nil),                // 91: cardpb.ImportCardsRowError
+	(*ImportCardsResponse)(nil),                // 92: cardpb.ImportCardsResponse
+	(*ListCardsEvent)(nil),                     // 93: cardpb.ListCardsEvent
+	(*CardDueEvent)(nil),                       // 94: cardpb.CardDueEvent
+	(*EditCardDescriptionRequest)(nil),         // 95: cardpb.EditCardDescriptionRequest
+	(*JoinDescriptionSession)(nil),             // 96: cardpb.JoinDescriptionSession
+	(*OperationComponent)(nil),                 // 97: cardpb.OperationComponent
+	(*DescriptionEdit)(nil),                    // 98: cardpb.DescriptionEdit
+	(*DescriptionCursor)(nil),                  // 99: cardpb.DescriptionCursor
+	(*DescriptionPresence)(nil),                // 100: cardpb.DescriptionPresence
+	(*DescriptionSnapshot)(nil),                // 101: cardpb.DescriptionSnapshot
+	(*DescriptionAck)(nil),                     // 102: cardpb.DescriptionAck
+	(*EditCardDescriptionResponse)(nil),        // 103: cardpb.EditCardDescriptionResponse
+	nil,                                        // 104: cardpb.ImportCardsRequest.ColumnsEntry
+	(*timestamppb.Timestamp)(nil),              // 105: google.protobuf.Timestamp
+}
+var file_card_proto_depIdxs = []int32{
+	105, // 0: cardpb.Card.start_date:type_name -> google.protobuf.Timestamp
+	105, // 1: cardpb.Card.due_date:type_name -> google.protobuf.Timestamp
+	105, // 2: cardpb.Card.created_at:type_name -> google.protobuf.Timestamp
+	105, // 3: cardpb.Card.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 4: cardpb.Card.reminders:type_name -> cardpb.CardReminder
+	3,   // 5: cardpb.Card.recurrence:type_name -> cardpb.CardRecurrence
+	4,   // 6: cardpb.Card.custom_field_values:type_name -> cardpb.CustomFieldValue
+	105, // 7: cardpb.CardMeta.start_date:type_name -> google.protobuf.Timestamp
+	105, // 8: cardpb.CardMeta.due_date:type_name -> google.protobuf.Timestamp
+	105, // 9: cardpb.CardMeta.created_at:type_name -> google.protobuf.Timestamp
+	105, // 10: cardpb.CardMeta.updated_at:type_name -> google.protobuf.Timestamp
+	4,   // 11: cardpb.CardMeta.custom_field_values:type_name -> cardpb.CustomFieldValue
+	105, // 12: cardpb.CardReminder.notified_at:type_name -> google.protobuf.Timestamp
+	105, // 13: cardpb.CardRecurrence.next_run_at:type_name -> google.protobuf.Timestamp
+	105, // 14: cardpb.CustomFieldValue.date_value:type_name -> google.protobuf.Timestamp
+	7,   // 15: cardpb.Comment.user:type_name -> cardpb.User
+	105, // 16: cardpb.Comment.created_at:type_name -> google.protobuf.Timestamp
+	105, // 17: cardpb.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 18: cardpb.Comment.replies:type_name -> cardpb.Comment
+	9,   // 19: cardpb.Comment.reactions:type_name -> cardpb.CommentReaction
+	105, // 20: cardpb.Comment.edited_at:type_name -> google.protobuf.Timestamp
+	105, // 21: cardpb.CommentEdit.edited_at:type_name -> google.protobuf.Timestamp
+	105, // 22: cardpb.CardVersion.start_date:type_name -> google.protobuf.Timestamp
+	105, // 23: cardpb.CardVersion.due_date:type_name -> google.protobuf.Timestamp
+	105, // 24: cardpb.CardVersion.created_at:type_name -> google.protobuf.Timestamp
+	13,  // 25: cardpb.CardVersion.changes:type_name -> cardpb.CardFieldChange
+	0,   // 26: cardpb.CreateCardResponse.card:type_name -> cardpb.Card
+	15,  // 27: cardpb.CreateCardResponse.wip_limit_warning:type_name -> cardpb.WIPLimitWarning
+	0,   // 28: cardpb.GetCardByIDResponse.card:type_name -> cardpb.Card
+	19,  // 29: cardpb.GetCardsByBoardRequest.filters:type_name -> cardpb.CustomFieldFilter
+	20,  // 30: cardpb.GetCardsByBoardRequest.sort:type_name -> cardpb.CustomFieldSort
+	1,   // 31: cardpb.GetCardsByBoardResponse.cards:type_name -> cardpb.CardMeta
+	19,  // 32: cardpb.GetCardsByListRequest.filters:type_name -> cardpb.CustomFieldFilter
+	20,  // 33: cardpb.GetCardsByListRequest.sort:type_name -> cardpb.CustomFieldSort
+	1,   // 34: cardpb.GetCardsByListResponse.cards:type_name -> cardpb.CardMeta
+	15,  // 35: cardpb.MoveCardPositionResponse.wip_limit_warning:type_name -> cardpb.WIPLimitWarning
+	105, // 36: cardpb.SetCardDatesRequest.StartDate:type_name -> google.protobuf.Timestamp
+	105, // 37: cardpb.SetCardDatesRequest.DueDate:type_name -> google.protobuf.Timestamp
+	8,   // 38: cardpb.GetCardCommentsResponse.comments:type_name -> cardpb.Comment
+	11,  // 39: cardpb.GetCardCommentsResponse.pagination:type_name -> cardpb.Pagination
+	10,  // 40: cardpb.GetCardCommentHistoryResponse.edits:type_name -> cardpb.CommentEdit
+	4,   // 41: cardpb.SetCardCustomFieldValueRequest.value:type_name -> cardpb.CustomFieldValue
+	12,  // 42: cardpb.GetCardHistoryResponse.versions:type_name -> cardpb.CardVersion
+	11,  // 43: cardpb.GetCardHistoryResponse.pagination:type_name -> cardpb.Pagination
+	105, // 44: cardpb.BulkUpdateCardsRequest.due_date:type_name -> google.protobuf.Timestamp
+	15,  // 45: cardpb.BulkCardResult.wip_limit_warning:type_name -> cardpb.WIPLimitWarning
+	82,  // 46: cardpb.BulkUpdateCardsResponse.results:type_name -> cardpb.BulkCardResult
+	15,  // 47: cardpb.MoveAllCardsInListResponse.wip_limit_warning:type_name -> cardpb.WIPLimitWarning
+	104, // 48: cardpb.ImportCardsRequest.columns:type_name -> cardpb.ImportCardsRequest.ColumnsEntry
+	91,  // 49: cardpb.ImportCardsResponse.errors:type_name -> cardpb.ImportCardsRowError
+	15,  // 50: cardpb.ImportCardsResponse.wip_limit_warning:type_name -> cardpb.WIPLimitWarning
+	105, // 51: cardpb.CardDueEvent.due_date:type_name -> google.protobuf.Timestamp
+	96,  // 52: cardpb.EditCardDescriptionRequest.join:type_name -> cardpb.JoinDescriptionSession
+	98,  // 53: cardpb.EditCardDescriptionRequest.edit:type_name -> cardpb.DescriptionEdit
+	99,  // 54: cardpb.EditCardDescriptionRequest.cursor:type_name -> cardpb.DescriptionCursor
+	97,  // 55: cardpb.DescriptionEdit.operation:type_name -> cardpb.OperationComponent
+	99,  // 56: cardpb.DescriptionPresence.cursor:type_name -> cardpb.DescriptionCursor
+	100, // 57: cardpb.DescriptionSnapshot.presence:type_name -> cardpb.DescriptionPresence
+	101, // 58: cardpb.EditCardDescriptionResponse.snapshot:type_name -> cardpb.DescriptionSnapshot
+	102, // 59: cardpb.EditCardDescriptionResponse.ack:type_name -> cardpb.DescriptionAck
+	98,  // 60: cardpb.EditCardDescriptionResponse.edit:type_name -> cardpb.DescriptionEdit
+	100, // 61: cardpb.EditCardDescriptionResponse.presence:type_name -> cardpb.DescriptionPresence
+	14,  // 62: cardpb.CardService.CreateCard:input_type -> cardpb.CreateCardRequest
+	17,  // 63: cardpb.CardService.GetCardByID:input_type -> cardpb.GetCardByIDRequest
+	23,  // 64: cardpb.CardService.GetCardsByList:input_type -> cardpb.GetCardsByListRequest
+	21,  // 65: cardpb.CardService.GetCardsByBoard:input_type -> cardpb.GetCardsByBoardRequest
+	29,  // 66: cardpb.CardService.MoveCardPosition:input_type -> cardpb.MoveCardPositionRequest
+	25,  // 67: cardpb.CardService.UpdateCardName:input_type -> cardpb.UpdateCardNameRequest
+	27,  // 68: cardpb.CardService.UpdateCardDescription:input_type -> cardpb.UpdateCardDescriptionRequest
+	95,  // 69: cardpb.CardService.EditCardDescription:input_type -> cardpb.EditCardDescriptionRequest
+	33,  // 70: cardpb.CardService.AddCardLabel:input_type -> cardpb.AddCardLabelRequest
+	35,  // 71: cardpb.CardService.RemoveCardLabel:input_type -> cardpb.RemoveCardLabelRequest
+	37,  // 72: cardpb.CardService.SetCardDates:input_type -> cardpb.SetCardDatesRequest
+	39,  // 73: cardpb.CardService.ToggleCardCompleted:input_type -> cardpb.ToggleCardCompletedRequest
+	41,  // 74: cardpb.CardService.AddCardAttachment:input_type -> cardpb.AddCardAttachmentRequest
+	43,  // 75: cardpb.CardService.RemoveCardAttachment:input_type -> cardpb.RemoveCardAttachmentRequest
+	45,  // 76: cardpb.CardService.AddCardComment:input_type -> cardpb.AddCardCommentRequest
+	57,  // 77: cardpb.CardService.RemoveCardComment:input_type -> cardpb.RemoveCardCommentRequest
+	47,  // 78: cardpb.CardService.UpdateCardComment:input_type -> cardpb.UpdateCardCommentRequest
+	49,  // 79: cardpb.CardService.GetCardComments:input_type -> cardpb.GetCardCommentsRequest
+	51,  // 80: cardpb.CardService.GetCardCommentHistory:input_type -> cardpb.GetCardCommentHistoryRequest
+	53,  // 81: cardpb.CardService.AddCommentReaction:input_type -> cardpb.AddCommentReactionRequest
+	55,  // 82: cardpb.CardService.RemoveCommentReaction:input_type -> cardpb.RemoveCommentReactionRequest
+	59,  // 83: cardpb.CardService.AddCardMembers:input_type -> cardpb.AddCardMembersRequest
+	61,  // 84: cardpb.CardService.RemoveCardMembers:input_type -> cardpb.RemoveCardMembersRequest
+	63,  // 85: cardpb.CardService.ArchiveCard:input_type -> cardpb.ArchiveCardRequest
+	65,  // 86: cardpb.CardService.RestoreCard:input_type -> cardpb.RestoreCardRequest
+	31,  // 87: cardpb.CardService.DeleteCard:input_type -> cardpb.DeleteCardRequest
+	67,  // 88: cardpb.CardService.SetCardReminders:input_type -> cardpb.SetCardRemindersRequest
+	69,  // 89: cardpb.CardService.SetCardRecurrence:input_type -> cardpb.SetCardRecurrenceRequest
+	71,  // 90: cardpb.CardService.RemoveCardRecurrence:input_type -> cardpb.RemoveCardRecurrenceRequest
+	73,  // 91: cardpb.CardService.SetCardCustomFieldValue:input_type -> cardpb.SetCardCustomFieldValueRequest
+	75,  // 92: cardpb.CardService.RemoveCardCustomFieldValue:input_type -> cardpb.RemoveCardCustomFieldValueRequest
+	77,  // 93: cardpb.CardService.GetCardHistory:input_type -> cardpb.GetCardHistoryRequest
+	79,  // 94: cardpb.CardService.RestoreCardVersion:input_type -> cardpb.RestoreCardVersionRequest
+	81,  // 95: cardpb.CardService.BulkUpdateCards:input_type -> cardpb.BulkUpdateCardsRequest
+	84,  // 96: cardpb.CardService.ArchiveAllCardsInList:input_type -> cardpb.ArchiveAllCardsInListRequest
+	86,  // 97: cardpb.CardService.MoveAllCardsInList:input_type -> cardpb.MoveAllCardsInListRequest
+	88,  // 98: cardpb.CardService.SortCardsInList:input_type -> cardpb.SortCardsInListRequest
+	90,  // 99: cardpb.CardService.ImportCards:input_type -> cardpb.ImportCardsRequest
+	16,  // 100: cardpb.CardService.CreateCard:output_type -> cardpb.CreateCardResponse
+	18,  // 101: cardpb.CardService.GetCardByID:output_type -> cardpb.GetCardByIDResponse
+	24,  // 102: cardpb.CardService.GetCardsByList:output_type -> cardpb.GetCardsByListResponse
+	22,  // 103: cardpb.CardService.GetCardsByBoard:output_type -> cardpb.GetCardsByBoardResponse
+	30,  // 104: cardpb.CardService.MoveCardPosition:output_type -> cardpb.MoveCardPositionResponse
+	26,  // 105: cardpb.CardService.UpdateCardName:output_type -> cardpb.UpdateCardNameResponse
+	28,  // 106: cardpb.CardService.UpdateCardDescription:output_type -> cardpb.UpdateCardDescriptionResponse
+	103, // 107: cardpb.CardService.EditCardDescription:output_type -> cardpb.EditCardDescriptionResponse
+	34,  // 108: cardpb.CardService.AddCardLabel:output_type -> cardpb.AddCardLabelResponse
+	36,  // 109: cardpb.CardService.RemoveCardLabel:output_type -> cardpb.RemoveCardLabelResponse
+	38,  // 110: cardpb.CardService.SetCardDates:output_type -> cardpb.SetCardDatesResponse
+	40,  // 111: cardpb.CardService.ToggleCardCompleted:output_type -> cardpb.ToggleCardCompletedResponse
+	42,  // 112: cardpb.CardService.AddCardAttachment:output_type -> cardpb.AddCardAttachmentResponse
+	44,  // 113: cardpb.CardService.RemoveCardAttachment:output_type -> cardpb.RemoveCardAttachmentResponse
+	46,  // 114: cardpb.CardService.AddCardComment:output_type -> cardpb.AddCardCommentResponse
+	58,  // 115: cardpb.CardService.RemoveCardComment:output_type -> cardpb.RemoveCardCommentResponse
+	48,  // 116: cardpb.CardService.UpdateCardComment:output_type -> cardpb.UpdateCardCommentResponse
+	50,  // 117: cardpb.CardService.GetCardComments:output_type -> cardpb.GetCardCommentsResponse
+	52,  // 118: cardpb.CardService.GetCardCommentHistory:output_type -> cardpb.GetCardCommentHistoryResponse
+	54,  // 119: cardpb.CardService.AddCommentReaction:output_type -> cardpb.AddCommentReactionResponse
+	56,  // 120: cardpb.CardService.RemoveCommentReaction:output_type -> cardpb.RemoveCommentReactionResponse
+	60,  // 121: cardpb.CardService.AddCardMembers:output_type -> cardpb.AddCardMembersResponse
+	62,  // 122: cardpb.CardService.RemoveCardMembers:output_type -> cardpb.RemoveCardMembersResponse
+	64,  // 123: cardpb.CardService.ArchiveCard:output_type -> cardpb.ArchiveCardResponse
+	66,  // 124: cardpb.CardService.RestoreCard:output_type -> cardpb.RestoreCardResponse
+	32,  // 125: cardpb.CardService.DeleteCard:output_type -> cardpb.DeleteCardResponse
+	68,  // 126: cardpb.CardService.SetCardReminders:output_type -> cardpb.SetCardRemindersResponse
+	70,  // 127: cardpb.CardService.SetCardRecurrence:output_type -> cardpb.SetCardRecurrenceResponse
+	72,  // 128: cardpb.CardService.RemoveCardRecurrence:output_type -> cardpb.RemoveCardRecurrenceResponse
+	74,  // 129: cardpb.CardService.SetCardCustomFieldValue:output_type -> cardpb.SetCardCustomFieldValueResponse
+	76,  // 130: cardpb.CardService.RemoveCardCustomFieldValue:output_type -> cardpb.RemoveCardCustomFieldValueResponse
+	78,  // 131: cardpb.CardService.GetCardHistory:output_type -> cardpb.GetCardHistoryResponse
+	80,  // 132: cardpb.CardService.RestoreCardVersion:output_type -> cardpb.RestoreCardVersionResponse
+	83,  // 133: cardpb.CardService.BulkUpdateCards:output_type -> cardpb.BulkUpdateCardsResponse
+	85,  // 134: cardpb.CardService.ArchiveAllCardsInList:output_type -> cardpb.ArchiveAllCardsInListResponse
+	87,  // 135: cardpb.CardService.MoveAllCardsInList:output_type -> cardpb.MoveAllCardsInListResponse
+	89,  // 136: cardpb.CardService.SortCardsInList:output_type -> cardpb.SortCardsInListResponse
+	92,  // 137: cardpb.CardService.ImportCards:output_type -> cardpb.ImportCardsResponse
+	100, // [100:138] is the sub-list for method output_type
+	62,  // [62:100] is the sub-list for method input_type
+	62,  // [62:62] is the sub-list for extension type_name
+	62,  // [62:62] is the sub-list for extension extendee
+	0,   // [0:62] is the sub-list for field type_name
+}
+
+func init() { file_card_proto_init() }
+func file_card_proto_init() {
+	if File_card_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_card_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Card); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardReminder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardRecurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomFieldValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Label); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentReaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommentEdit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardFieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WIPLimitWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateCardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardByIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomFieldFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomFieldSort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardsByBoardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardsByBoardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardsByListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardsByListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCardNameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCardNameResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCardDescriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCardDescriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveCardPositionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveCardPositionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteCardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCardLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCardLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardDatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardDatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleCardCompletedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleCardCompletedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCardAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCardAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardAttachmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardAttachmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCardCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCardCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCardCommentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateCardCommentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardCommentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardCommentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardCommentHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardCommentHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCommentReactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCommentReactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCommentReactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3159,8 +8651,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardMeta); i {
+		file_card_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCommentReactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3171,8 +8663,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Label); i {
+		file_card_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardCommentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3183,8 +8675,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Attachment); i {
+		file_card_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardCommentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3195,8 +8687,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_card_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCardMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3207,8 +8699,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
+		file_card_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddCardMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3219,8 +8711,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCardRequest); i {
+		file_card_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardMembersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3231,8 +8723,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateCardResponse); i {
+		file_card_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardMembersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3243,8 +8735,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCardByIDRequest); i {
+		file_card_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveCardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3255,8 +8747,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCardByIDResponse); i {
+		file_card_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveCardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3267,8 +8759,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCardsByBoardRequest); i {
+		file_card_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCardRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3279,8 +8771,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCardsByBoardResponse); i {
+		file_card_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3291,8 +8783,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCardsByListRequest); i {
+		file_card_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardRemindersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3303,8 +8795,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCardsByListResponse); i {
+		file_card_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardRemindersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3315,8 +8807,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCardNameRequest); i {
+		file_card_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardRecurrenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3327,8 +8819,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCardNameResponse); i {
+		file_card_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardRecurrenceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3339,8 +8831,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCardDescriptionRequest); i {
+		file_card_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardRecurrenceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3351,8 +8843,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateCardDescriptionResponse); i {
+		file_card_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardRecurrenceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3363,8 +8855,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveCardPositionRequest); i {
+		file_card_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardCustomFieldValueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3375,8 +8867,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MoveCardPositionResponse); i {
+		file_card_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetCardCustomFieldValueResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3387,8 +8879,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCardRequest); i {
+		file_card_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardCustomFieldValueRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3399,8 +8891,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteCardResponse); i {
+		file_card_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveCardCustomFieldValueResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3411,8 +8903,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCardLabelRequest); i {
+		file_card_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3423,8 +8915,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCardLabelResponse); i {
+		file_card_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCardHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3435,8 +8927,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCardLabelRequest); i {
+		file_card_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCardVersionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3447,8 +8939,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCardLabelResponse); i {
+		file_card_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreCardVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3459,8 +8951,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetCardDatesRequest); i {
+		file_card_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkUpdateCardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3471,8 +8963,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetCardDatesResponse); i {
+		file_card_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkCardResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3483,8 +8975,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleCardCompletedRequest); i {
+		file_card_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkUpdateCardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3495,8 +8987,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleCardCompletedResponse); i {
+		file_card_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveAllCardsInListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3507,8 +8999,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCardAttachmentRequest); i {
+		file_card_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveAllCardsInListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3519,8 +9011,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCardAttachmentResponse); i {
+		file_card_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveAllCardsInListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3531,8 +9023,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCardAttachmentRequest); i {
+		file_card_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveAllCardsInListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3543,8 +9035,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCardAttachmentResponse); i {
+		file_card_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortCardsInListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3555,8 +9047,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCardCommentRequest); i {
+		file_card_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SortCardsInListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3567,8 +9059,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCardCommentResponse); i {
+		file_card_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCardsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3579,8 +9071,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCardCommentRequest); i {
+		file_card_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCardsRowError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3591,8 +9083,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCardCommentResponse); i {
+		file_card_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportCardsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3603,8 +9095,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCardMembersRequest); i {
+		file_card_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCardsEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3615,8 +9107,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddCardMembersResponse); i {
+		file_card_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardDueEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3627,8 +9119,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCardMembersRequest); i {
+		file_card_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditCardDescriptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3639,8 +9131,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveCardMembersResponse); i {
+		file_card_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinDescriptionSession); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3651,8 +9143,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveCardRequest); i {
+		file_card_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationComponent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3663,8 +9155,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveCardResponse); i {
+		file_card_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptionEdit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3675,8 +9167,8 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreCardRequest); i {
+		file_card_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptionCursor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3687,8 +9179,44 @@ func file_card_proto_init() {
 				return nil
 			}
 		}
-		file_card_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreCardResponse); i {
+		file_card_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptionPresence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptionSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescriptionAck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_card_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditCardDescriptionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3700,13 +9228,36 @@ func file_card_proto_init() {
 			}
 		}
 	}
+	file_card_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*CustomFieldValue_TextValue)(nil),
+		(*CustomFieldValue_NumberValue)(nil),
+		(*CustomFieldValue_DateValue)(nil),
+		(*CustomFieldValue_CheckboxValue)(nil),
+		(*CustomFieldValue_OptionID)(nil),
+	}
+	file_card_proto_msgTypes[95].OneofWrappers = []interface{}{
+		(*EditCardDescriptionRequest_Join)(nil),
+		(*EditCardDescriptionRequest_Edit)(nil),
+		(*EditCardDescriptionRequest_Cursor)(nil),
+	}
+	file_card_proto_msgTypes[97].OneofWrappers = []interface{}{
+		(*OperationComponent_Retain)(nil),
+		(*OperationComponent_Insert)(nil),
+		(*OperationComponent_Delete)(nil),
+	}
+	file_card_proto_msgTypes[103].OneofWrappers = []interface{}{
+		(*EditCardDescriptionResponse_Snapshot)(nil),
+		(*EditCardDescriptionResponse_Ack)(nil),
+		(*EditCardDescriptionResponse_Edit)(nil),
+		(*EditCardDescriptionResponse_Presence)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_card_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   105,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,6 +30,7 @@ type CardServiceClient interface {
 	MoveCardPosition(ctx context.Context, in *MoveCardPositionRequest, opts ...grpc.CallOption) (*MoveCardPositionResponse, error)
 	UpdateCardName(ctx context.Context, in *UpdateCardNameRequest, opts ...grpc.CallOption) (*UpdateCardNameResponse, error)
 	UpdateCardDescription(ctx context.Context, in *UpdateCardDescriptionRequest, opts ...grpc.CallOption) (*UpdateCardDescriptionResponse, error)
+	EditCardDescription(ctx context.Context, opts ...grpc.CallOption) (CardService_EditCardDescriptionClient, error)
 	AddCardLabel(ctx context.Context, in *AddCardLabelRequest, opts ...grpc.CallOption) (*AddCardLabelResponse, error)
 	RemoveCardLabel(ctx context.Context, in *RemoveCardLabelRequest, opts ...grpc.CallOption) (*RemoveCardLabelResponse, error)
 	SetCardDates(ctx context.Context, in *SetCardDatesRequest, opts ...grpc.CallOption) (*SetCardDatesResponse, error)
@@ -38,11 +39,28 @@ type CardServiceClient interface {
 	RemoveCardAttachment(ctx context.Context, in *RemoveCardAttachmentRequest, opts ...grpc.CallOption) (*RemoveCardAttachmentResponse, error)
 	AddCardComment(ctx context.Context, in *AddCardCommentRequest, opts ...grpc.CallOption) (*AddCardCommentResponse, error)
 	RemoveCardComment(ctx context.Context, in *RemoveCardCommentRequest, opts ...grpc.CallOption) (*RemoveCardCommentResponse, error)
+	UpdateCardComment(ctx context.Context, in *UpdateCardCommentRequest, opts ...grpc.CallOption) (*UpdateCardCommentResponse, error)
+	GetCardComments(ctx context.Context, in *GetCardCommentsRequest, opts ...grpc.CallOption) (*GetCardCommentsResponse, error)
+	GetCardCommentHistory(ctx context.Context, in *GetCardCommentHistoryRequest, opts ...grpc.CallOption) (*GetCardCommentHistoryResponse, error)
+	AddCommentReaction(ctx context.Context, in *AddCommentReactionRequest, opts ...grpc.CallOption) (*AddCommentReactionResponse, error)
+	RemoveCommentReaction(ctx context.Context, in *RemoveCommentReactionRequest, opts ...grpc.CallOption) (*RemoveCommentReactionResponse, error)
 	AddCardMembers(ctx context.Context, in *AddCardMembersRequest, opts ...grpc.CallOption) (*AddCardMembersResponse, error)
 	RemoveCardMembers(ctx context.Context, in *RemoveCardMembersRequest, opts ...grpc.CallOption) (*RemoveCardMembersResponse, error)
 	ArchiveCard(ctx context.Context, in *ArchiveCardRequest, opts ...grpc.CallOption) (*ArchiveCardResponse, error)
 	RestoreCard(ctx context.Context, in *RestoreCardRequest, opts ...grpc.CallOption) (*RestoreCardResponse, error)
 	DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*DeleteCardResponse, error)
+	SetCardReminders(ctx context.Context, in *SetCardRemindersRequest, opts ...grpc.CallOption) (*SetCardRemindersResponse, error)
+	SetCardRecurrence(ctx context.Context, in *SetCardRecurrenceRequest, opts ...grpc.CallOption) (*SetCardRecurrenceResponse, error)
+	RemoveCardRecurrence(ctx context.Context, in *RemoveCardRecurrenceRequest, opts ...grpc.CallOption) (*RemoveCardRecurrenceResponse, error)
+	SetCardCustomFieldValue(ctx context.Context, in *SetCardCustomFieldValueRequest, opts ...grpc.CallOption) (*SetCardCustomFieldValueResponse, error)
+	RemoveCardCustomFieldValue(ctx context.Context, in *RemoveCardCustomFieldValueRequest, opts ...grpc.CallOption) (*RemoveCardCustomFieldValueResponse, error)
+	GetCardHistory(ctx context.Context, in *GetCardHistoryRequest, opts ...grpc.CallOption) (*GetCardHistoryResponse, error)
+	RestoreCardVersion(ctx context.Context, in *RestoreCardVersionRequest, opts ...grpc.CallOption) (*RestoreCardVersionResponse, error)
+	BulkUpdateCards(ctx context.Context, in *BulkUpdateCardsRequest, opts ...grpc.CallOption) (*BulkUpdateCardsResponse, error)
+	ArchiveAllCardsInList(ctx context.Context, in *ArchiveAllCardsInListRequest, opts ...grpc.CallOption) (*ArchiveAllCardsInListResponse, error)
+	MoveAllCardsInList(ctx context.Context, in *MoveAllCardsInListRequest, opts ...grpc.CallOption) (*MoveAllCardsInListResponse, error)
+	SortCardsInList(ctx context.Context, in *SortCardsInListRequest, opts ...grpc.CallOption) (*SortCardsInListResponse, error)
+	ImportCards(ctx context.Context, in *ImportCardsRequest, opts ...grpc.CallOption) (*ImportCardsResponse, error)
 }
 
 type cardServiceClient struct {
@@ -116,6 +134,37 @@ func (c *cardServiceClient) UpdateCardDescription(ctx context.Context, in *Updat
 	return out, nil
 }
 
+func (c *cardServiceClient) EditCardDescription(ctx context.Context, opts ...grpc.CallOption) (CardService_EditCardDescriptionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CardService_ServiceDesc.Streams[0], "/cardpb.CardService/EditCardDescription", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cardServiceEditCardDescriptionClient{stream}
+	return x, nil
+}
+
+type CardService_EditCardDescriptionClient interface {
+	Send(*EditCardDescriptionRequest) error
+	Recv() (*EditCardDescriptionResponse, error)
+	grpc.ClientStream
+}
+
+type cardServiceEditCardDescriptionClient struct {
+	grpc.ClientStream
+}
+
+func (x *cardServiceEditCardDescriptionClient) Send(m *EditCardDescriptionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *cardServiceEditCardDescriptionClient) Recv() (*EditCardDescriptionResponse, error) {
+	m := new(EditCardDescriptionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *cardServiceClient) AddCardLabel(ctx context.Context, in *AddCardLabelRequest, opts ...grpc.CallOption) (*AddCardLabelResponse, error) {
 	out := new(AddCardLabelResponse)
 	err := c.cc.Invoke(ctx, "/cardpb.CardService/AddCardLabel", in, out, opts...)
@@ -188,6 +237,51 @@ func (c *cardServiceClient) RemoveCardComment(ctx context.Context, in *RemoveCar
 	return out, nil
 }
 
+func (c *cardServiceClient) UpdateCardComment(ctx context.Context, in *UpdateCardCommentRequest, opts ...grpc.CallOption) (*UpdateCardCommentResponse, error) {
+	out := new(UpdateCardCommentResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/UpdateCardComment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) GetCardComments(ctx context.Context, in *GetCardCommentsRequest, opts ...grpc.CallOption) (*GetCardCommentsResponse, error) {
+	out := new(GetCardCommentsResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/GetCardComments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) GetCardCommentHistory(ctx context.Context, in *GetCardCommentHistoryRequest, opts ...grpc.CallOption) (*GetCardCommentHistoryResponse, error) {
+	out := new(GetCardCommentHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/GetCardCommentHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) AddCommentReaction(ctx context.Context, in *AddCommentReactionRequest, opts ...grpc.CallOption) (*AddCommentReactionResponse, error) {
+	out := new(AddCommentReactionResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/AddCommentReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) RemoveCommentReaction(ctx context.Context, in *RemoveCommentReactionRequest, opts ...grpc.CallOption) (*RemoveCommentReactionResponse, error) {
+	out := new(RemoveCommentReactionResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/RemoveCommentReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cardServiceClient) AddCardMembers(ctx context.Context, in *AddCardMembersRequest, opts ...grpc.CallOption) (*AddCardMembersResponse, error) {
 	out := new(AddCardMembersResponse)
 	err := c.cc.Invoke(ctx, "/cardpb.CardService/AddCardMembers", in, out, opts...)
@@ -233,6 +327,114 @@ func (c *cardServiceClient) DeleteCard(ctx context.Context, in *DeleteCardReques
 	return out, nil
 }
 
+func (c *cardServiceClient) SetCardReminders(ctx context.Context, in *SetCardRemindersRequest, opts ...grpc.CallOption) (*SetCardRemindersResponse, error) {
+	out := new(SetCardRemindersResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/SetCardReminders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) SetCardRecurrence(ctx context.Context, in *SetCardRecurrenceRequest, opts ...grpc.CallOption) (*SetCardRecurrenceResponse, error) {
+	out := new(SetCardRecurrenceResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/SetCardRecurrence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) RemoveCardRecurrence(ctx context.Context, in *RemoveCardRecurrenceRequest, opts ...grpc.CallOption) (*RemoveCardRecurrenceResponse, error) {
+	out := new(RemoveCardRecurrenceResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/RemoveCardRecurrence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) SetCardCustomFieldValue(ctx context.Context, in *SetCardCustomFieldValueRequest, opts ...grpc.CallOption) (*SetCardCustomFieldValueResponse, error) {
+	out := new(SetCardCustomFieldValueResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/SetCardCustomFieldValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) RemoveCardCustomFieldValue(ctx context.Context, in *RemoveCardCustomFieldValueRequest, opts ...grpc.CallOption) (*RemoveCardCustomFieldValueResponse, error) {
+	out := new(RemoveCardCustomFieldValueResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/RemoveCardCustomFieldValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) GetCardHistory(ctx context.Context, in *GetCardHistoryRequest, opts ...grpc.CallOption) (*GetCardHistoryResponse, error) {
+	out := new(GetCardHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/GetCardHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) RestoreCardVersion(ctx context.Context, in *RestoreCardVersionRequest, opts ...grpc.CallOption) (*RestoreCardVersionResponse, error) {
+	out := new(RestoreCardVersionResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/RestoreCardVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) BulkUpdateCards(ctx context.Context, in *BulkUpdateCardsRequest, opts ...grpc.CallOption) (*BulkUpdateCardsResponse, error) {
+	out := new(BulkUpdateCardsResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/BulkUpdateCards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ArchiveAllCardsInList(ctx context.Context, in *ArchiveAllCardsInListRequest, opts ...grpc.CallOption) (*ArchiveAllCardsInListResponse, error) {
+	out := new(ArchiveAllCardsInListResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/ArchiveAllCardsInList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) MoveAllCardsInList(ctx context.Context, in *MoveAllCardsInListRequest, opts ...grpc.CallOption) (*MoveAllCardsInListResponse, error) {
+	out := new(MoveAllCardsInListResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/MoveAllCardsInList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) SortCardsInList(ctx context.Context, in *SortCardsInListRequest, opts ...grpc.CallOption) (*SortCardsInListResponse, error) {
+	out := new(SortCardsInListResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/SortCardsInList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cardServiceClient) ImportCards(ctx context.Context, in *ImportCardsRequest, opts ...grpc.CallOption) (*ImportCardsResponse, error) {
+	out := new(ImportCardsResponse)
+	err := c.cc.Invoke(ctx, "/cardpb.CardService/ImportCards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CardServiceServer is the server API for CardService service.
 // All implementations must embed UnimplementedCardServiceServer
 // for forward compatibility
@@ -245,6 +447,7 @@ type CardServiceServer interface {
 	MoveCardPosition(context.Context, *MoveCardPositionRequest) (*MoveCardPositionResponse, error)
 	UpdateCardName(context.Context, *UpdateCardNameRequest) (*UpdateCardNameResponse, error)
 	UpdateCardDescription(context.Context, *UpdateCardDescriptionRequest) (*UpdateCardDescriptionResponse, error)
+	EditCardDescription(CardService_EditCardDescriptionServer) error
 	AddCardLabel(context.Context, *AddCardLabelRequest) (*AddCardLabelResponse, error)
 	RemoveCardLabel(context.Context, *RemoveCardLabelRequest) (*RemoveCardLabelResponse, error)
 	SetCardDates(context.Context, *SetCardDatesRequest) (*SetCardDatesResponse, error)
@@ -253,11 +456,28 @@ type CardServiceServer interface {
 	RemoveCardAttachment(context.Context, *RemoveCardAttachmentRequest) (*RemoveCardAttachmentResponse, error)
 	AddCardComment(context.Context, *AddCardCommentRequest) (*AddCardCommentResponse, error)
 	RemoveCardComment(context.Context, *RemoveCardCommentRequest) (*RemoveCardCommentResponse, error)
+	UpdateCardComment(context.Context, *UpdateCardCommentRequest) (*UpdateCardCommentResponse, error)
+	GetCardComments(context.Context, *GetCardCommentsRequest) (*GetCardCommentsResponse, error)
+	GetCardCommentHistory(context.Context, *GetCardCommentHistoryRequest) (*GetCardCommentHistoryResponse, error)
+	AddCommentReaction(context.Context, *AddCommentReactionRequest) (*AddCommentReactionResponse, error)
+	RemoveCommentReaction(context.Context, *RemoveCommentReactionRequest) (*RemoveCommentReactionResponse, error)
 	AddCardMembers(context.Context, *AddCardMembersRequest) (*AddCardMembersResponse, error)
 	RemoveCardMembers(context.Context, *RemoveCardMembersRequest) (*RemoveCardMembersResponse, error)
 	ArchiveCard(context.Context, *ArchiveCardRequest) (*ArchiveCardResponse, error)
 	RestoreCard(context.Context, *RestoreCardRequest) (*RestoreCardResponse, error)
 	DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error)
+	SetCardReminders(context.Context, *SetCardRemindersRequest) (*SetCardRemindersResponse, error)
+	SetCardRecurrence(context.Context, *SetCardRecurrenceRequest) (*SetCardRecurrenceResponse, error)
+	RemoveCardRecurrence(context.Context, *RemoveCardRecurrenceRequest) (*RemoveCardRecurrenceResponse, error)
+	SetCardCustomFieldValue(context.Context, *SetCardCustomFieldValueRequest) (*SetCardCustomFieldValueResponse, error)
+	RemoveCardCustomFieldValue(context.Context, *RemoveCardCustomFieldValueRequest) (*RemoveCardCustomFieldValueResponse, error)
+	GetCardHistory(context.Context, *GetCardHistoryRequest) (*GetCardHistoryResponse, error)
+	RestoreCardVersion(context.Context, *RestoreCardVersionRequest) (*RestoreCardVersionResponse, error)
+	BulkUpdateCards(context.Context, *BulkUpdateCardsRequest) (*BulkUpdateCardsResponse, error)
+	ArchiveAllCardsInList(context.Context, *ArchiveAllCardsInListRequest) (*ArchiveAllCardsInListResponse, error)
+	MoveAllCardsInList(context.Context, *MoveAllCardsInListRequest) (*MoveAllCardsInListResponse, error)
+	SortCardsInList(context.Context, *SortCardsInListRequest) (*SortCardsInListResponse, error)
+	ImportCards(context.Context, *ImportCardsRequest) (*ImportCardsResponse, error)
 	mustEmbedUnimplementedCardServiceServer()
 }
 
@@ -286,6 +506,9 @@ func (UnimplementedCardServiceServer) UpdateCardName(context.Context, *UpdateCar
 func (UnimplementedCardServiceServer) UpdateCardDescription(context.Context, *UpdateCardDescriptionRequest) (*UpdateCardDescriptionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCardDescription not implemented")
 }
+func (UnimplementedCardServiceServer) EditCardDescription(CardService_EditCardDescriptionServer) error {
+	return status.Errorf(codes.Unimplemented, "method EditCardDescription not implemented")
+}
 func (UnimplementedCardServiceServer) AddCardLabel(context.Context, *AddCardLabelRequest) (*AddCardLabelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCardLabel not implemented")
 }
@@ -310,6 +533,21 @@ func (UnimplementedCardServiceServer) AddCardComment(context.Context, *AddCardCo
 func (UnimplementedCardServiceServer) RemoveCardComment(context.Context, *RemoveCardCommentRequest) (*RemoveCardCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCardComment not implemented")
 }
+func (UnimplementedCardServiceServer) UpdateCardComment(context.Context, *UpdateCardCommentRequest) (*UpdateCardCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCardComment not implemented")
+}
+func (UnimplementedCardServiceServer) GetCardComments(context.Context, *GetCardCommentsRequest) (*GetCardCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCardComments not implemented")
+}
+func (UnimplementedCardServiceServer) GetCardCommentHistory(context.Context, *GetCardCommentHistoryRequest) (*GetCardCommentHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCardCommentHistory not implemented")
+}
+func (UnimplementedCardServiceServer) AddCommentReaction(context.Context, *AddCommentReactionRequest) (*AddCommentReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCommentReaction not implemented")
+}
+func (UnimplementedCardServiceServer) RemoveCommentReaction(context.Context, *RemoveCommentReactionRequest) (*RemoveCommentReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCommentReaction not implemented")
+}
 func (UnimplementedCardServiceServer) AddCardMembers(context.Context, *AddCardMembersRequest) (*AddCardMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCardMembers not implemented")
 }
@@ -325,6 +563,42 @@ func (UnimplementedCardServiceServer) RestoreCard(context.Context, *RestoreCardR
 func (UnimplementedCardServiceServer) DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCard not implemented")
 }
+func (UnimplementedCardServiceServer) SetCardReminders(context.Context, *SetCardRemindersRequest) (*SetCardRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCardReminders not implemented")
+}
+func (UnimplementedCardServiceServer) SetCardRecurrence(context.Context, *SetCardRecurrenceRequest) (*SetCardRecurrenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCardRecurrence not implemented")
+}
+func (UnimplementedCardServiceServer) RemoveCardRecurrence(context.Context, *RemoveCardRecurrenceRequest) (*RemoveCardRecurrenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCardRecurrence not implemented")
+}
+func (UnimplementedCardServiceServer) SetCardCustomFieldValue(context.Context, *SetCardCustomFieldValueRequest) (*SetCardCustomFieldValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCardCustomFieldValue not implemented")
+}
+func (UnimplementedCardServiceServer) RemoveCardCustomFieldValue(context.Context, *RemoveCardCustomFieldValueRequest) (*RemoveCardCustomFieldValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCardCustomFieldValue not implemented")
+}
+func (UnimplementedCardServiceServer) GetCardHistory(context.Context, *GetCardHistoryRequest) (*GetCardHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCardHistory not implemented")
+}
+func (UnimplementedCardServiceServer) RestoreCardVersion(context.Context, *RestoreCardVersionRequest) (*RestoreCardVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCardVersion not implemented")
+}
+func (UnimplementedCardServiceServer) BulkUpdateCards(context.Context, *BulkUpdateCardsRequest) (*BulkUpdateCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateCards not implemented")
+}
+func (UnimplementedCardServiceServer) ArchiveAllCardsInList(context.Context, *ArchiveAllCardsInListRequest) (*ArchiveAllCardsInListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveAllCardsInList not implemented")
+}
+func (UnimplementedCardServiceServer) MoveAllCardsInList(context.Context, *MoveAllCardsInListRequest) (*MoveAllCardsInListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveAllCardsInList not implemented")
+}
+func (UnimplementedCardServiceServer) SortCardsInList(context.Context, *SortCardsInListRequest) (*SortCardsInListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SortCardsInList not implemented")
+}
+func (UnimplementedCardServiceServer) ImportCards(context.Context, *ImportCardsRequest) (*ImportCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCards not implemented")
+}
 func (UnimplementedCardServiceServer) mustEmbedUnimplementedCardServiceServer() {}
 
 // UnsafeCardServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -464,6 +738,32 @@ func _CardService_UpdateCardDescription_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardService_EditCardDescription_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CardServiceServer).EditCardDescription(&cardServiceEditCardDescriptionServer{stream})
+}
+
+type CardService_EditCardDescriptionServer interface {
+	Send(*EditCardDescriptionResponse) error
+	Recv() (*EditCardDescriptionRequest, error)
+	grpc.ServerStream
+}
+
+type cardServiceEditCardDescriptionServer struct {
+	grpc.ServerStream
+}
+
+func (x *cardServiceEditCardDescriptionServer) Send(m *EditCardDescriptionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *cardServiceEditCardDescriptionServer) Recv() (*EditCardDescriptionRequest, error) {
+	m := new(EditCardDescriptionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _CardService_AddCardLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddCardLabelRequest)
 	if err := dec(in); err != nil {
@@ -608,6 +908,96 @@ func _CardService_RemoveCardComment_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardService_UpdateCardComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCardCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).UpdateCardComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/UpdateCardComment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).UpdateCardComment(ctx, req.(*UpdateCardCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_GetCardComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCardCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetCardComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/GetCardComments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetCardComments(ctx, req.(*GetCardCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_GetCardCommentHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCardCommentHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetCardCommentHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/GetCardCommentHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetCardCommentHistory(ctx, req.(*GetCardCommentHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_AddCommentReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCommentReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).AddCommentReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/AddCommentReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).AddCommentReaction(ctx, req.(*AddCommentReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_RemoveCommentReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCommentReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).RemoveCommentReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/RemoveCommentReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).RemoveCommentReaction(ctx, req.(*RemoveCommentReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CardService_AddCardMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddCardMembersRequest)
 	if err := dec(in); err != nil {
@@ -698,6 +1088,222 @@ func _CardService_DeleteCard_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CardService_SetCardReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCardRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SetCardReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/SetCardReminders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SetCardReminders(ctx, req.(*SetCardRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_SetCardRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCardRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SetCardRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/SetCardRecurrence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SetCardRecurrence(ctx, req.(*SetCardRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_RemoveCardRecurrence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCardRecurrenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).RemoveCardRecurrence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/RemoveCardRecurrence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).RemoveCardRecurrence(ctx, req.(*RemoveCardRecurrenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_SetCardCustomFieldValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCardCustomFieldValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SetCardCustomFieldValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/SetCardCustomFieldValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SetCardCustomFieldValue(ctx, req.(*SetCardCustomFieldValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_RemoveCardCustomFieldValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCardCustomFieldValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).RemoveCardCustomFieldValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/RemoveCardCustomFieldValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).RemoveCardCustomFieldValue(ctx, req.(*RemoveCardCustomFieldValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_GetCardHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCardHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).GetCardHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/GetCardHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).GetCardHistory(ctx, req.(*GetCardHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_RestoreCardVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCardVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).RestoreCardVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/RestoreCardVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).RestoreCardVersion(ctx, req.(*RestoreCardVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_BulkUpdateCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).BulkUpdateCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/BulkUpdateCards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).BulkUpdateCards(ctx, req.(*BulkUpdateCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ArchiveAllCardsInList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveAllCardsInListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ArchiveAllCardsInList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/ArchiveAllCardsInList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ArchiveAllCardsInList(ctx, req.(*ArchiveAllCardsInListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_MoveAllCardsInList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveAllCardsInListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).MoveAllCardsInList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/MoveAllCardsInList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).MoveAllCardsInList(ctx, req.(*MoveAllCardsInListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_SortCardsInList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SortCardsInListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).SortCardsInList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/SortCardsInList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).SortCardsInList(ctx, req.(*SortCardsInListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CardService_ImportCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CardServiceServer).ImportCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cardpb.CardService/ImportCards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CardServiceServer).ImportCards(ctx, req.(*ImportCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CardService_ServiceDesc is the grpc.ServiceDesc for CardService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -765,6 +1371,26 @@ var CardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCardComment",
 			Handler:    _CardService_RemoveCardComment_Handler,
 		},
+		{
+			MethodName: "UpdateCardComment",
+			Handler:    _CardService_UpdateCardComment_Handler,
+		},
+		{
+			MethodName: "GetCardComments",
+			Handler:    _CardService_GetCardComments_Handler,
+		},
+		{
+			MethodName: "GetCardCommentHistory",
+			Handler:    _CardService_GetCardCommentHistory_Handler,
+		},
+		{
+			MethodName: "AddCommentReaction",
+			Handler:    _CardService_AddCommentReaction_Handler,
+		},
+		{
+			MethodName: "RemoveCommentReaction",
+			Handler:    _CardService_RemoveCommentReaction_Handler,
+		},
 		{
 			MethodName: "AddCardMembers",
 			Handler:    _CardService_AddCardMembers_Handler,
@@ -785,7 +1411,62 @@ var CardService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCard",
 			Handler:    _CardService_DeleteCard_Handler,
 		},
+		{
+			MethodName: "SetCardReminders",
+			Handler:    _CardService_SetCardReminders_Handler,
+		},
+		{
+			MethodName: "SetCardRecurrence",
+			Handler:    _CardService_SetCardRecurrence_Handler,
+		},
+		{
+			MethodName: "RemoveCardRecurrence",
+			Handler:    _CardService_RemoveCardRecurrence_Handler,
+		},
+		{
+			MethodName: "SetCardCustomFieldValue",
+			Handler:    _CardService_SetCardCustomFieldValue_Handler,
+		},
+		{
+			MethodName: "RemoveCardCustomFieldValue",
+			Handler:    _CardService_RemoveCardCustomFieldValue_Handler,
+		},
+		{
+			MethodName: "GetCardHistory",
+			Handler:    _CardService_GetCardHistory_Handler,
+		},
+		{
+			MethodName: "RestoreCardVersion",
+			Handler:    _CardService_RestoreCardVersion_Handler,
+		},
+		{
+			MethodName: "BulkUpdateCards",
+			Handler:    _CardService_BulkUpdateCards_Handler,
+		},
+		{
+			MethodName: "ArchiveAllCardsInList",
+			Handler:    _CardService_ArchiveAllCardsInList_Handler,
+		},
+		{
+			MethodName: "MoveAllCardsInList",
+			Handler:    _CardService_MoveAllCardsInList_Handler,
+		},
+		{
+			MethodName: "SortCardsInList",
+			Handler:    _CardService_SortCardsInList_Handler,
+		},
+		{
+			MethodName: "ImportCards",
+			Handler:    _CardService_ImportCards_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EditCardDescription",
+			Handler:       _CardService_EditCardDescription_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "card.proto",
 }
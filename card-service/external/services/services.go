@@ -49,6 +49,7 @@ func GetServices(cfg *config.ServiceConfig) *Services {
 					time.Sleep(1 * time.Second) // wait for a second before trying again
 				} else {
 					setConn(conn)
+					setClient(conn)
 					break
 				}
 			}
@@ -70,6 +71,7 @@ func GetServices(cfg *config.ServiceConfig) *Services {
 								conn.Close()                                                    // close the old connection
 								conn = newConn                                                  // replace the old connection with the new one
 								setConn(newConn)                                                // update the connection
+								setClient(newConn)                                              // rebuild the client on the new connection
 								log.Printf("Successfully reconnected to service at %s", target) // log the reconnection
 								break
 							}
@@ -83,25 +85,25 @@ func GetServices(cfg *config.ServiceConfig) *Services {
 		go connect(cfg.UserServiceAddr, func(conn *grpc.ClientConn) {
 			services.userConn = conn
 		}, func(client interface{}) {
-			services.userClient = client.(pb_user.UserServiceClient)
+			services.userClient = pb_user.NewUserServiceClient(client.(*grpc.ClientConn))
 		})
 
 		go connect(cfg.UserServiceAddr, func(conn *grpc.ClientConn) {
 			services.authConn = conn
 		}, func(client interface{}) {
-			services.authClient = client.(pb_user.AuthServiceClient)
+			services.authClient = pb_user.NewAuthServiceClient(client.(*grpc.ClientConn))
 		})
 
 		go connect(cfg.ListServiceAddr, func(conn *grpc.ClientConn) {
 			services.listConn = conn
 		}, func(client interface{}) {
-			services.listClient = client.(pb_list.ListServiceClient)
+			services.listClient = pb_list.NewListServiceClient(client.(*grpc.ClientConn))
 		})
 
 		go connect(cfg.BoardServiceAddr, func(conn *grpc.ClientConn) {
 			services.boardConn = conn
 		}, func(client interface{}) {
-			services.boardClient = client.(pbBoard.BoardServiceClient)
+			services.boardClient = pbBoard.NewBoardServiceClient(client.(*grpc.ClientConn))
 		})
 	})
 
@@ -109,28 +111,28 @@ func GetServices(cfg *config.ServiceConfig) *Services {
 }
 
 func (s *Services) GetUserClient() (pb_user.UserServiceClient, error) {
-	if s.userConn.GetState() != connectivity.Ready {
+	if s.userConn == nil || s.userConn.GetState() != connectivity.Ready {
 		return nil, errors.New("user service not available")
 	}
 	return s.userClient, nil
 }
 
 func (s *Services) GetAuthClient() (pb_user.AuthServiceClient, error) {
-	if s.authConn.GetState() != connectivity.Ready {
+	if s.authConn == nil || s.authConn.GetState() != connectivity.Ready {
 		return nil, errors.New("auth service not available")
 	}
 	return s.authClient, nil
 }
 
 func (s *Services) GetListClient() (pb_list.ListServiceClient, error) {
-	if s.listConn.GetState() != connectivity.Ready {
+	if s.listConn == nil || s.listConn.GetState() != connectivity.Ready {
 		return nil, errors.New("list service not available")
 	}
 	return s.listClient, nil
 }
 
 func (s *Services) GetBoardClient() (pbBoard.BoardServiceClient, error) {
-	if s.boardConn.GetState() != connectivity.Ready {
+	if s.boardConn == nil || s.boardConn.GetState() != connectivity.Ready {
 		return nil, errors.New("board service not available")
 	}
 	return s.boardClient, nil
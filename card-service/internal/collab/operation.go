@@ -0,0 +1,229 @@
+package collab
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	ErrBaseLengthMismatch = errors.New("operation does not match the document length")
+	ErrInvalidComponent   = errors.New("operation component must retain, insert or delete")
+)
+
+// Component is a single step of an operation. Exactly one of its fields is set. Lengths count runes,
+// not bytes, so that clients working with characters agree with the server.
+type Component struct {
+	Retain int
+	Insert string
+	Delete int
+}
+
+// Operation walks a document from start to end. It has to cover the whole document, so the sum of
+// its retains and deletes is the length of the document it applies to.
+type Operation []Component
+
+// Validate rejects empty and negative components
+func (o Operation) Validate() error {
+	for _, component := range o {
+		set := 0
+		if component.Retain != 0 {
+			set++
+		}
+		if component.Insert != "" {
+			set++
+		}
+		if component.Delete != 0 {
+			set++
+		}
+		if set != 1 || component.Retain < 0 || component.Delete < 0 {
+			return ErrInvalidComponent
+		}
+	}
+	return nil
+}
+
+// BaseLength is the length of the document the operation applies to
+func (o Operation) BaseLength() int {
+	length := 0
+	for _, component := range o {
+		length += component.Retain + component.Delete
+	}
+	return length
+}
+
+// Apply returns the document with the operation applied to it
+func (o Operation) Apply(document string) (string, error) {
+	runes := []rune(document)
+	if o.BaseLength() != len(runes) {
+		return "", ErrBaseLengthMismatch
+	}
+
+	var result strings.Builder
+	index := 0
+	for _, component := range o {
+		switch {
+		case component.Retain > 0:
+			result.WriteString(string(runes[index : index+component.Retain]))
+			index += component.Retain
+		case component.Insert != "":
+			result.WriteString(component.Insert)
+		case component.Delete > 0:
+			index += component.Delete
+		}
+	}
+
+	return result.String(), nil
+}
+
+// Transform takes two operations made concurrently on the same document and returns a' and b' such
+// that applying a then b' gives the same document as applying b then a'. When both insert at the
+// same position the text of a ends up first.
+func Transform(a, b Operation) (Operation, Operation, error) {
+	if a.BaseLength() != b.BaseLength() {
+		return nil, nil, ErrBaseLengthMismatch
+	}
+
+	var aPrime, bPrime builder
+	ai, bi := &iterator{operation: a}, &iterator{operation: b}
+
+	for {
+		ca, cb := ai.peek(), bi.peek()
+		if ca == nil && cb == nil {
+			break
+		}
+
+		// Inserts don't consume the document, so they pass through and the other side retains them
+		if ca != nil && ca.Insert != "" {
+			aPrime.insert(ca.Insert)
+			bPrime.retain(utf8.RuneCountInString(ca.Insert))
+			ai.next(0)
+			continue
+		}
+		if cb != nil && cb.Insert != "" {
+			aPrime.retain(utf8.RuneCountInString(cb.Insert))
+			bPrime.insert(cb.Insert)
+			bi.next(0)
+			continue
+		}
+
+		if ca == nil || cb == nil {
+			return nil, nil, ErrBaseLengthMismatch
+		}
+
+		length := min(ca.length(), cb.length())
+		switch {
+		case ca.Retain > 0 && cb.Retain > 0:
+			aPrime.retain(length)
+			bPrime.retain(length)
+		case ca.Delete > 0 && cb.Retain > 0:
+			aPrime.delete(length)
+		case ca.Retain > 0 && cb.Delete > 0:
+			bPrime.delete(length)
+		}
+		// Both deleting the same text leaves nothing to do on either side
+
+		ai.next(length)
+		bi.next(length)
+	}
+
+	return aPrime.operation, bPrime.operation, nil
+}
+
+// TransformIndex moves a position in the document the operation applies to, e.g. a cursor, to
+// where it ends up after the operation
+func TransformIndex(o Operation, index int) int {
+	newIndex := index
+	for _, component := range o {
+		switch {
+		case component.Retain > 0:
+			index -= component.Retain
+		case component.Insert != "":
+			newIndex += utf8.RuneCountInString(component.Insert)
+		case component.Delete > 0:
+			newIndex -= min(index, component.Delete)
+			index -= component.Delete
+		}
+		if index < 0 {
+			break
+		}
+	}
+	return newIndex
+}
+
+// iterator walks the components of an operation, splitting retains and deletes as they are consumed
+type iterator struct {
+	operation Operation
+	index     int
+	current   *Component
+}
+
+func (it *iterator) peek() *Component {
+	if it.current == nil && it.index < len(it.operation) {
+		component := it.operation[it.index]
+		it.current = &component
+		it.index++
+	}
+	return it.current
+}
+
+// next consumes length runes of the current retain or delete, or the whole current insert
+func (it *iterator) next(length int) {
+	switch {
+	case it.current.Retain > length:
+		it.current.Retain -= length
+	case it.current.Delete > length:
+		it.current.Delete -= length
+	default:
+		it.current = nil
+	}
+}
+
+func (c *Component) length() int {
+	return c.Retain + c.Delete
+}
+
+// builder appends components, merging them with the previous component of the same kind
+type builder struct {
+	operation Operation
+}
+
+func (b *builder) last() *Component {
+	if len(b.operation) == 0 {
+		return nil
+	}
+	return &b.operation[len(b.operation)-1]
+}
+
+func (b *builder) retain(length int) {
+	if length == 0 {
+		return
+	}
+	if last := b.last(); last != nil && last.Retain > 0 {
+		last.Retain += length
+		return
+	}
+	b.operation = append(b.operation, Component{Retain: length})
+}
+
+func (b *builder) insert(text string) {
+	if text == "" {
+		return
+	}
+	if last := b.last(); last != nil && last.Insert != "" {
+		last.Insert += text
+		return
+	}
+	b.operation = append(b.operation, Component{Insert: text})
+}
+
+func (b *builder) delete(length int) {
+	if length == 0 {
+		return
+	}
+	if last := b.last(); last != nil && last.Delete > 0 {
+		last.Delete += length
+		return
+	}
+	b.operation = append(b.operation, Component{Delete: length})
+}
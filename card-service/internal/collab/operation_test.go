@@ -0,0 +1,131 @@
+package collab
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTransformConverges(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		a        Operation
+		b        Operation
+		want     string
+	}{
+		{
+			name:     "inserts at the same index put a first",
+			document: "abc",
+			a:        Operation{{Retain: 1}, {Insert: "X"}, {Retain: 2}},
+			b:        Operation{{Retain: 1}, {Insert: "Y"}, {Retain: 2}},
+			want:     "aXYbc",
+		},
+		{
+			name:     "inserts at different indexes",
+			document: "abc",
+			a:        Operation{{Insert: "X"}, {Retain: 3}},
+			b:        Operation{{Retain: 3}, {Insert: "Y"}},
+			want:     "XabcY",
+		},
+		{
+			name:     "insert before a delete",
+			document: "abc",
+			a:        Operation{{Retain: 1}, {Insert: "X"}, {Retain: 2}},
+			b:        Operation{{Retain: 1}, {Delete: 1}, {Retain: 1}},
+			want:     "aXc",
+		},
+		{
+			name:     "insert inside a deleted range",
+			document: "abcd",
+			a:        Operation{{Retain: 2}, {Insert: "X"}, {Retain: 2}},
+			b:        Operation{{Retain: 1}, {Delete: 2}, {Retain: 1}},
+			want:     "aXd",
+		},
+		{
+			name:     "overlapping deletes",
+			document: "abcdef",
+			a:        Operation{{Retain: 1}, {Delete: 3}, {Retain: 2}},
+			b:        Operation{{Retain: 2}, {Delete: 3}, {Retain: 1}},
+			want:     "af",
+		},
+		{
+			name:     "the same delete",
+			document: "abc",
+			a:        Operation{{Retain: 1}, {Delete: 1}, {Retain: 1}},
+			b:        Operation{{Retain: 1}, {Delete: 1}, {Retain: 1}},
+			want:     "ac",
+		},
+		{
+			name:     "one delete containing the other",
+			document: "abcdef",
+			a:        Operation{{Delete: 6}},
+			b:        Operation{{Retain: 2}, {Delete: 2}, {Insert: "X"}, {Retain: 2}},
+			want:     "X",
+		},
+		{
+			name:     "runes rather than bytes",
+			document: "héllo",
+			a:        Operation{{Retain: 2}, {Insert: "ü"}, {Retain: 3}},
+			b:        Operation{{Retain: 1}, {Delete: 1}, {Retain: 3}},
+			want:     "hüllo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aPrime, bPrime, err := Transform(tt.a, tt.b)
+			if err != nil {
+				t.Fatalf("Error transforming: %v", err)
+			}
+
+			ab := apply(t, tt.document, tt.a, bPrime)
+			ba := apply(t, tt.document, tt.b, aPrime)
+			if ab != tt.want || ba != tt.want {
+				t.Errorf("Got %q applying a then b' and %q applying b then a', want %q", ab, ba, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransformRejectsDifferentDocuments(t *testing.T) {
+	_, _, err := Transform(Operation{{Retain: 2}}, Operation{{Retain: 3}})
+	if !errors.Is(err, ErrBaseLengthMismatch) {
+		t.Errorf("Got error %v, want %v", err, ErrBaseLengthMismatch)
+	}
+}
+
+func TestTransformIndex(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation Operation
+		index     int
+		want      int
+	}{
+		{name: "insert before", operation: Operation{{Retain: 1}, {Insert: "XY"}, {Retain: 3}}, index: 3, want: 5},
+		{name: "insert at", operation: Operation{{Retain: 3}, {Insert: "XY"}, {Retain: 1}}, index: 3, want: 5},
+		{name: "insert after", operation: Operation{{Retain: 3}, {Insert: "XY"}, {Retain: 1}}, index: 2, want: 2},
+		{name: "delete before", operation: Operation{{Delete: 2}, {Retain: 2}}, index: 3, want: 1},
+		{name: "delete around", operation: Operation{{Retain: 1}, {Delete: 2}, {Retain: 1}}, index: 2, want: 1},
+		{name: "delete after", operation: Operation{{Retain: 3}, {Delete: 1}}, index: 2, want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TransformIndex(tt.operation, tt.index); got != tt.want {
+				t.Errorf("Got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func apply(t *testing.T, document string, operations ...Operation) string {
+	t.Helper()
+
+	for _, operation := range operations {
+		var err error
+		if document, err = operation.Apply(document); err != nil {
+			t.Fatalf("Error applying %v: %v", operation, err)
+		}
+	}
+	return document
+}
@@ -0,0 +1,559 @@
+package collab
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// historySize is how many operations a session keeps to transform late edits against. Clients
+	// further behind than that have to rejoin.
+	historySize = 1000
+
+	// eventBufferSize is how many events a client can fall behind before it is dropped
+	eventBufferSize = 256
+)
+
+var (
+	ErrRevisionOutOfRange = errors.New("revision is no longer or not yet available")
+	ErrDocumentTooLarge   = errors.New("document exceeds the maximum size")
+	ErrClientClosed       = errors.New("client has left the session")
+	// ErrConflict is returned by a SaveFunc when the card changed since the expected version
+	ErrConflict = errors.New("card was modified since the session saved it")
+)
+
+// NotOwnerError is returned when joining a card whose session is held by another replica
+type NotOwnerError struct {
+	Owner string // Address of the replica holding the session
+}
+
+func (e *NotOwnerError) Error() string {
+	return "description session is held by " + e.Owner
+}
+
+// LoadFunc returns the current description of a card on the board, with the version of the card
+type LoadFunc func(cardID, boardID uint64) (string, uint64, error)
+
+// SaveFunc persists a snapshot of a description back to the card unless the card changed since the
+// expected version, and returns the version of the card once saved
+type SaveFunc func(cardID, boardID uint64, description string, expectedVersion uint64) (uint64, error)
+
+// Leases make one replica the owner of each card's session. Sessions live in the memory of their
+// owner, so everyone editing a card must be served by that replica.
+type Leases interface {
+	// Claim makes this replica the owner of the card's session and returns an empty owner, unless
+	// another replica holds it, which is returned
+	Claim(cardID uint64) (string, error)
+	// Renew keeps this replica the owner of the cards' sessions and returns the cards it still holds
+	Renew(cardIDs []uint64) ([]uint64, error)
+	Release(cardID uint64) error
+	// Owner returns the replica holding the card's session, empty when nobody is editing it
+	Owner(cardID uint64) (string, error)
+}
+
+// Cursor is a client's caret, with SelectionEnd equal to Position when nothing is selected
+type Cursor struct {
+	Position     int
+	SelectionEnd int
+}
+
+type Presence struct {
+	UserID uint64
+	Cursor *Cursor // Nil when the user hasn't placed a cursor yet or has left
+	Left   bool
+}
+
+type Edit struct {
+	Revision  uint64 // Revision the operation produced
+	UserID    uint64
+	Operation Operation
+}
+
+type Ack struct {
+	Revision uint64
+}
+
+// Event is sent to a client, with exactly one field set
+type Event struct {
+	Ack      *Ack
+	Edit     *Edit
+	Presence *Presence
+}
+
+// Snapshot is the state of a session handed to a joining client
+type Snapshot struct {
+	Document string
+	Revision uint64
+	Presence []*Presence
+}
+
+// Client is one connection taking part in a session
+type Client struct {
+	UserID  uint64
+	session *Session
+	events  chan *Event
+	cursor  *Cursor
+	closed  bool
+}
+
+// Events delivers acknowledgements and changes made by others. It is closed when the client leaves
+// or falls too far behind.
+func (c *Client) Events() <-chan *Event {
+	return c.events
+}
+
+// Session holds the shared document of one card while anyone is editing it
+type Session struct {
+	cardID  uint64
+	boardID uint64
+
+	opened  chan struct{} // Closed once the session is loaded, or failed to open with openErr
+	openErr error
+	done    chan struct{} // Closed once the ended session is saved and removed from its hub
+
+	saving sync.Mutex // Held while the document is saved, so saves don't race each other's version
+
+	mu            sync.Mutex
+	document      string
+	revision      uint64
+	history       []Operation // Operations producing the revisions after historyStart
+	historyStart  uint64
+	savedRevision uint64
+	savedDocument string // Description of the card as last loaded or saved
+	version       uint64 // Version of the card savedDocument is at
+	clients       map[*Client]struct{}
+	ended         bool // Set once the session stops taking clients
+}
+
+// Hub keeps one session per card being edited on this replica and periodically saves their
+// documents. A card's session is only opened on the replica holding its lease, joins on other
+// replicas fail with a NotOwnerError naming it. A session whose lease can't be renewed is closed
+// before the lease expires, so two replicas never accept edits to the same card.
+//
+// The hub lock only guards the sessions map, leases are claimed and documents loaded and saved
+// outside it.
+type Hub struct {
+	load             LoadFunc
+	save             SaveFunc
+	leases           Leases
+	maxDocumentSize  int
+	snapshotInterval time.Duration
+
+	mu       sync.Mutex
+	sessions map[uint64]*Session
+}
+
+func NewHub(load LoadFunc, save SaveFunc, leases Leases, maxDocumentSize int, snapshotInterval time.Duration) *Hub {
+	return &Hub{
+		load:             load,
+		save:             save,
+		leases:           leases,
+		maxDocumentSize:  maxDocumentSize,
+		snapshotInterval: snapshotInterval,
+		sessions:         make(map[uint64]*Session),
+	}
+}
+
+// Run renews the leases of the open sessions and saves changed documents every snapshot interval
+// until the context is done. Sessions whose lease couldn't be renewed are closed, after saving them
+// while the lease may still be held, and those whose lease was taken are closed without saving.
+func (h *Hub) Run(ctx context.Context) {
+	ticker := time.NewTicker(h.snapshotInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sessions := h.openSessions()
+			cardIDs := make([]uint64, 0, len(sessions))
+			for _, session := range sessions {
+				cardIDs = append(cardIDs, session.cardID)
+			}
+
+			held, err := h.leases.Renew(cardIDs)
+			if err != nil {
+				log.Printf("Failed to renew description session leases, closing the sessions: %v", err)
+			}
+
+			for _, session := range sessions {
+				if err != nil {
+					h.snapshot(session)
+					h.close(session)
+					continue
+				}
+
+				if !slices.Contains(held, session.cardID) {
+					log.Printf("Description session lease of card %d was taken by another replica, closing the session", session.cardID)
+					h.close(session)
+					continue
+				}
+
+				if !h.snapshot(session) {
+					h.close(session)
+				}
+			}
+		}
+	}
+}
+
+// openSessions returns the sessions done opening
+func (h *Hub) openSessions() []*Session {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sessions := make([]*Session, 0, len(h.sessions))
+	for _, session := range h.sessions {
+		select {
+		case <-session.opened:
+			if session.openErr == nil {
+				sessions = append(sessions, session)
+			}
+		default:
+		}
+	}
+	return sessions
+}
+
+// IsEditing reports whether a session is open for the card, on any replica
+func (h *Hub) IsEditing(cardID uint64) (bool, error) {
+	h.mu.Lock()
+	_, ok := h.sessions[cardID]
+	h.mu.Unlock()
+
+	if ok {
+		return true, nil
+	}
+
+	owner, err := h.leases.Owner(cardID)
+	if err != nil {
+		return false, err
+	}
+	return owner != "", nil
+}
+
+// Join adds a client to the card's session, opening the session if nobody is editing yet. When
+// another replica holds the session it returns a NotOwnerError.
+func (h *Hub) Join(cardID, boardID, userID uint64) (*Client, *Snapshot, error) {
+	for {
+		h.mu.Lock()
+		session, ok := h.sessions[cardID]
+		if !ok {
+			session = &Session{
+				cardID:  cardID,
+				boardID: boardID,
+				opened:  make(chan struct{}),
+				done:    make(chan struct{}),
+				clients: make(map[*Client]struct{}),
+			}
+			h.sessions[cardID] = session
+		}
+		h.mu.Unlock()
+
+		// Other joins of the card wait for it to be opened
+		if !ok {
+			h.open(session)
+		}
+		<-session.opened
+		if session.openErr != nil {
+			return nil, nil, session.openErr
+		}
+
+		if client, snapshot, ok := session.join(userID); ok {
+			return client, snapshot, nil
+		}
+
+		// The session ended meanwhile, the next one loads the document once it's saved
+		<-session.done
+	}
+}
+
+// open claims the lease of a new session and loads its document
+func (h *Hub) open(session *Session) {
+	defer close(session.opened)
+
+	owner, err := h.leases.Claim(session.cardID)
+	if err == nil && owner != "" {
+		err = &NotOwnerError{Owner: owner}
+	}
+	if err != nil {
+		session.openErr = err
+		h.remove(session, false)
+		return
+	}
+
+	document, version, err := h.load(session.cardID, session.boardID)
+	if err != nil {
+		session.openErr = err
+		h.remove(session, true)
+		return
+	}
+
+	session.mu.Lock()
+	session.document = document
+	session.savedDocument = document
+	session.version = version
+	session.mu.Unlock()
+}
+
+// Leave removes the client from its session. The last client to leave saves the document and
+// closes the session.
+func (h *Hub) Leave(client *Client) {
+	session := client.session
+
+	session.mu.Lock()
+	session.remove(client)
+	session.broadcast(nil, &Event{Presence: &Presence{UserID: client.UserID, Left: true}})
+	last := len(session.clients) == 0 && !session.ended
+	if last {
+		session.ended = true
+	}
+	session.mu.Unlock()
+
+	if last {
+		h.snapshot(session)
+		h.remove(session, true)
+	}
+}
+
+// close ends a session whose document can no longer be saved. Its clients are dropped and join
+// again from the description as saved.
+func (h *Hub) close(session *Session) {
+	session.mu.Lock()
+	ending := !session.ended
+	session.ended = true
+	for client := range session.clients {
+		session.remove(client)
+	}
+	session.mu.Unlock()
+
+	if ending {
+		h.remove(session, true)
+	}
+}
+
+// remove takes an ended session out of the hub, releasing its lease first so the card's next
+// session claims it again
+func (h *Hub) remove(session *Session, release bool) {
+	if release {
+		if err := h.leases.Release(session.cardID); err != nil {
+			log.Printf("Failed to release description session of card %d: %v", session.cardID, err)
+		}
+	}
+
+	h.mu.Lock()
+	if h.sessions[session.cardID] == session {
+		delete(h.sessions, session.cardID)
+	}
+	h.mu.Unlock()
+
+	close(session.done)
+}
+
+// Submit applies an operation the client made at the given revision. It is transformed against
+// everything applied since, acknowledged to the client and sent to everyone else.
+func (h *Hub) Submit(client *Client, revision uint64, operation Operation) error {
+	if err := operation.Validate(); err != nil {
+		return err
+	}
+
+	session := client.session
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if client.closed {
+		return ErrClientClosed
+	}
+
+	concurrent, err := session.since(revision)
+	if err != nil {
+		return err
+	}
+
+	for _, applied := range concurrent {
+		if operation, _, err = Transform(operation, applied); err != nil {
+			return err
+		}
+	}
+
+	document, err := operation.Apply(session.document)
+	if err != nil {
+		return err
+	}
+	if len(document) > h.maxDocumentSize {
+		return ErrDocumentTooLarge
+	}
+
+	session.document = document
+	session.revision++
+	session.history = append(session.history, operation)
+	if len(session.history) > historySize {
+		session.history = session.history[1:]
+		session.historyStart++
+	}
+
+	for other := range session.clients {
+		if other.cursor != nil {
+			other.cursor = &Cursor{
+				Position:     TransformIndex(operation, other.cursor.Position),
+				SelectionEnd: TransformIndex(operation, other.cursor.SelectionEnd),
+			}
+		}
+	}
+
+	session.send(client, &Event{Ack: &Ack{Revision: session.revision}})
+	session.broadcast(client, &Event{Edit: &Edit{Revision: session.revision, UserID: client.UserID, Operation: operation}})
+
+	return nil
+}
+
+// UpdateCursor moves the client's cursor, given at the revision the client has seen, and shows it
+// to everyone else
+func (h *Hub) UpdateCursor(client *Client, revision uint64, cursor Cursor) error {
+	session := client.session
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	if client.closed {
+		return ErrClientClosed
+	}
+
+	concurrent, err := session.since(revision)
+	if err != nil {
+		return err
+	}
+
+	for _, applied := range concurrent {
+		cursor.Position = TransformIndex(applied, cursor.Position)
+		cursor.SelectionEnd = TransformIndex(applied, cursor.SelectionEnd)
+	}
+
+	length := utf8.RuneCountInString(session.document)
+	cursor.Position = max(0, min(cursor.Position, length))
+	cursor.SelectionEnd = max(0, min(cursor.SelectionEnd, length))
+
+	client.cursor = &cursor
+	session.broadcast(client, &Event{Presence: &Presence{UserID: client.UserID, Cursor: &cursor}})
+
+	return nil
+}
+
+// snapshot saves the document if it changed since the last save. The save expects the card at the
+// version last saved: when the card changed since, but not its description, the save is rebased on
+// the new version. It returns false when the description was changed outside the session, whose
+// document would overwrite it.
+func (h *Hub) snapshot(session *Session) bool {
+	session.saving.Lock()
+	defer session.saving.Unlock()
+
+	session.mu.Lock()
+	document, revision := session.document, session.revision
+	savedDocument, version := session.savedDocument, session.version
+	changed := revision != session.savedRevision
+	session.mu.Unlock()
+
+	if !changed {
+		return true
+	}
+
+	version, err := h.save(session.cardID, session.boardID, document, version)
+	if errors.Is(err, ErrConflict) {
+		var current string
+		if current, version, err = h.load(session.cardID, session.boardID); err == nil {
+			if current != savedDocument {
+				log.Printf("Description of card %d was changed outside its editing session, closing the session", session.cardID)
+				return false
+			}
+			version, err = h.save(session.cardID, session.boardID, document, version)
+		}
+	}
+	if err != nil {
+		log.Printf("Failed to save description of card %d: %v", session.cardID, err)
+		return true
+	}
+
+	session.mu.Lock()
+	if revision > session.savedRevision {
+		session.savedRevision = revision
+		session.savedDocument = document
+		session.version = version
+	}
+	session.mu.Unlock()
+
+	return true
+}
+
+// join adds a client, returning false once the session has ended
+func (s *Session) join(userID uint64) (*Client, *Snapshot, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ended {
+		return nil, nil, false
+	}
+
+	client := &Client{
+		UserID:  userID,
+		session: s,
+		events:  make(chan *Event, eventBufferSize),
+	}
+
+	snapshot := &Snapshot{
+		Document: s.document,
+		Revision: s.revision,
+	}
+	for other := range s.clients {
+		snapshot.Presence = append(snapshot.Presence, &Presence{UserID: other.UserID, Cursor: other.cursor})
+	}
+
+	s.broadcast(client, &Event{Presence: &Presence{UserID: userID}})
+	s.clients[client] = struct{}{}
+
+	return client, snapshot, true
+}
+
+// since returns the operations applied after the revision
+func (s *Session) since(revision uint64) ([]Operation, error) {
+	if revision < s.historyStart || revision > s.revision {
+		return nil, ErrRevisionOutOfRange
+	}
+	return s.history[revision-s.historyStart:], nil
+}
+
+// broadcast sends the event to every client but the given one
+func (s *Session) broadcast(except *Client, event *Event) {
+	for client := range s.clients {
+		if client != except {
+			s.send(client, event)
+		}
+	}
+}
+
+// send queues the event without blocking, dropping clients that can't keep up
+func (s *Session) send(client *Client, event *Event) {
+	if client.closed {
+		return
+	}
+
+	select {
+	case client.events <- event:
+	default:
+		s.remove(client)
+	}
+}
+
+func (s *Session) remove(client *Client) {
+	if client.closed {
+		return
+	}
+
+	client.closed = true
+	close(client.events)
+	delete(s.clients, client)
+}
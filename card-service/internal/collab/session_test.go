@@ -0,0 +1,293 @@
+package collab
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testOwner = "card-service-1:50054"
+
+// fakeLeases keeps the owners of sessions in memory
+type fakeLeases struct {
+	mu       sync.Mutex
+	owners   map[uint64]string
+	renewErr error
+}
+
+func (l *fakeLeases) Claim(cardID uint64) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if owner, ok := l.owners[cardID]; ok && owner != testOwner {
+		return owner, nil
+	}
+	l.owners[cardID] = testOwner
+	return "", nil
+}
+
+func (l *fakeLeases) Renew(cardIDs []uint64) ([]uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.renewErr != nil {
+		return nil, l.renewErr
+	}
+
+	var held []uint64
+	for _, cardID := range cardIDs {
+		if l.owners[cardID] == testOwner {
+			held = append(held, cardID)
+		}
+	}
+	return held, nil
+}
+
+func (l *fakeLeases) Release(cardID uint64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.owners[cardID] == testOwner {
+		delete(l.owners, cardID)
+	}
+	return nil
+}
+
+func (l *fakeLeases) Owner(cardID uint64) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.owners[cardID], nil
+}
+
+func (l *fakeLeases) setOwner(cardID uint64, owner string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.owners[cardID] = owner
+}
+
+// fakeCard is a card's description and version as saved
+type fakeCard struct {
+	mu          sync.Mutex
+	description string
+	version     uint64
+}
+
+func (c *fakeCard) load(cardID, boardID uint64) (string, uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.description, c.version, nil
+}
+
+func (c *fakeCard) save(cardID, boardID uint64, description string, expectedVersion uint64) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if expectedVersion != c.version {
+		return 0, ErrConflict
+	}
+	c.description = description
+	c.version++
+	return c.version, nil
+}
+
+func (c *fakeCard) saved() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.description
+}
+
+func newTestHub(description string) (*Hub, *fakeLeases, *fakeCard) {
+	leases := &fakeLeases{owners: make(map[uint64]string)}
+	card := &fakeCard{description: description, version: 1}
+	return NewHub(card.load, card.save, leases, 1000, time.Millisecond), leases, card
+}
+
+func TestSubmitTransformsStaleRevision(t *testing.T) {
+	hub, _, _ := newTestHub("abc")
+
+	a, _, err := hub.Join(1, 1, 10)
+	if err != nil {
+		t.Fatalf("Error joining: %v", err)
+	}
+	b, _, err := hub.Join(1, 1, 20)
+	if err != nil {
+		t.Fatalf("Error joining: %v", err)
+	}
+
+	if err := hub.Submit(a, 0, Operation{{Insert: "X"}, {Retain: 3}}); err != nil {
+		t.Fatalf("Error submitting: %v", err)
+	}
+	// b hasn't seen a's edit yet
+	if err := hub.Submit(b, 0, Operation{{Retain: 3}, {Insert: "Y"}}); err != nil {
+		t.Fatalf("Error submitting: %v", err)
+	}
+
+	if got := a.session.document; got != "XabcY" {
+		t.Errorf("Got document %q, want %q", got, "XabcY")
+	}
+
+	// a sees b join, its own ack and then b's edit transformed past its own
+	events := drain(a)
+	want := &Edit{Revision: 2, UserID: 20, Operation: Operation{{Retain: 4}, {Insert: "Y"}}}
+	if len(events) != 3 || events[1].Ack == nil || events[1].Ack.Revision != 1 || !reflect.DeepEqual(events[2].Edit, want) {
+		t.Errorf("Got events %+v, want the join, an ack of revision 1 and edit %+v", events, want)
+	}
+
+	events = drain(b)
+	if len(events) != 2 || events[1].Ack == nil || events[1].Ack.Revision != 2 {
+		t.Errorf("Got events %+v, want a's edit and an ack of revision 2", events)
+	}
+}
+
+func TestSubmitRejectsUnknownRevision(t *testing.T) {
+	hub, _, _ := newTestHub("abc")
+
+	client, _, err := hub.Join(1, 1, 10)
+	if err != nil {
+		t.Fatalf("Error joining: %v", err)
+	}
+
+	if err := hub.Submit(client, 5, Operation{{Retain: 3}}); !errors.Is(err, ErrRevisionOutOfRange) {
+		t.Errorf("Got error %v, want %v", err, ErrRevisionOutOfRange)
+	}
+}
+
+func TestJoinHeldByAnotherReplica(t *testing.T) {
+	hub, leases, _ := newTestHub("abc")
+	leases.setOwner(1, "card-service-2:50054")
+
+	_, _, err := hub.Join(1, 1, 10)
+
+	var notOwner *NotOwnerError
+	if !errors.As(err, &notOwner) || notOwner.Owner != "card-service-2:50054" {
+		t.Errorf("Got error %v, want the session held by card-service-2:50054", err)
+	}
+}
+
+func TestLastLeaveSavesAndReleases(t *testing.T) {
+	hub, leases, card := newTestHub("abc")
+
+	client, _, err := hub.Join(1, 1, 10)
+	if err != nil {
+		t.Fatalf("Error joining: %v", err)
+	}
+	if err := hub.Submit(client, 0, Operation{{Retain: 3}, {Insert: "d"}}); err != nil {
+		t.Fatalf("Error submitting: %v", err)
+	}
+
+	hub.Leave(client)
+
+	if got := card.saved(); got != "abcd" {
+		t.Errorf("Got saved description %q, want %q", got, "abcd")
+	}
+	if owner, _ := leases.Owner(1); owner != "" {
+		t.Errorf("Got owner %q after the last client left, want none", owner)
+	}
+}
+
+func TestRunClosesSessionsWithoutLease(t *testing.T) {
+	tests := []struct {
+		name     string
+		loseTo   string
+		renewErr error
+		want     string // Description saved once the session is closed
+	}{
+		{name: "taken by another replica", loseTo: "card-service-2:50054", want: "abc"},
+		{name: "renewal failed", renewErr: errors.New("database unavailable"), want: "abcd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub, leases, card := newTestHub("abc")
+
+			client, _, err := hub.Join(1, 1, 10)
+			if err != nil {
+				t.Fatalf("Error joining: %v", err)
+			}
+			if err := hub.Submit(client, 0, Operation{{Retain: 3}, {Insert: "d"}}); err != nil {
+				t.Fatalf("Error submitting: %v", err)
+			}
+
+			leases.mu.Lock()
+			if tt.loseTo != "" {
+				leases.owners[1] = tt.loseTo
+			}
+			leases.renewErr = tt.renewErr
+			leases.mu.Unlock()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go hub.Run(ctx)
+
+			// The client is dropped once its session is closed
+			for range client.Events() {
+			}
+
+			if got := card.saved(); got != tt.want {
+				t.Errorf("Got saved description %q, want %q", got, tt.want)
+			}
+			if editing, _ := hub.IsEditing(1); editing != (tt.loseTo != "") {
+				t.Errorf("Got editing %v, want it only while the other replica holds the card", editing)
+			}
+		})
+	}
+}
+
+func TestConcurrentJoinsShareSession(t *testing.T) {
+	hub, _, card := newTestHub("")
+
+	var wg sync.WaitGroup
+	for userID := uint64(1); userID <= 20; userID++ {
+		wg.Add(1)
+		go func(userID uint64) {
+			defer wg.Done()
+
+			client, snapshot, err := hub.Join(1, 1, userID)
+			if err != nil {
+				t.Errorf("Error joining: %v", err)
+				return
+			}
+
+			// Each client appends a character at the revision it joined at
+			length := len([]rune(snapshot.Document))
+			operation := Operation{{Insert: "x"}}
+			if length > 0 {
+				operation = Operation{{Retain: length}, {Insert: "x"}}
+			}
+			if err := hub.Submit(client, snapshot.Revision, operation); err != nil {
+				t.Errorf("Error submitting: %v", err)
+			}
+
+			hub.Leave(client)
+		}(userID)
+	}
+	wg.Wait()
+
+	// Sessions opened one after another each saved their edits before the next loaded them
+	if got := card.saved(); got != "xxxxxxxxxxxxxxxxxxxx" {
+		t.Errorf("Got saved description %q, want one character per client", got)
+	}
+}
+
+// drain returns the events queued for the client
+func drain(client *Client) []*Event {
+	var events []*Event
+	for {
+		select {
+		case event, ok := <-client.Events():
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
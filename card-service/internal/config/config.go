@@ -1,15 +1,26 @@
 package config
 
 import (
+	"net"
 	"os"
 	"strconv"
+	"time"
+
+	"github.com/sm888sm/halten-backend/common/constants/messagebuses"
 )
 
 type Config struct {
-	Port     int
-	Database DatabaseConfig
-	RabbitMQ RabbitMQConfig
-	Services ServiceConfig
+	Port      int
+	Database  DatabaseConfig
+	RabbitMQ  RabbitMQConfig
+	Services  ServiceConfig
+	Scheduler SchedulerConfig
+	Markdown  MarkdownConfig
+	Collab    CollabConfig
+	Outbox    OutboxConfig
+	// AttachmentDir is the directory attachment file paths are relative to
+	AttachmentDir string
+	MetricsAddr   string // Serves the consumer metrics when set, such as :9090
 }
 
 type DatabaseConfig struct {
@@ -30,6 +41,28 @@ type ServiceConfig struct {
 
 type RabbitMQConfig struct { // Add this struct
 	URL string
+	Bus string // messagebuses.RabbitMQ, or messagebuses.Memory when every service runs in one process
+}
+
+type SchedulerConfig struct {
+	Interval time.Duration
+	LockKey  int64 // PostgreSQL advisory lock key shared by every card-service replica
+}
+
+type MarkdownConfig struct {
+	CacheSize int // Number of rendered documents kept in memory
+}
+
+type CollabConfig struct {
+	SnapshotInterval time.Duration // How often descriptions being edited together are saved
+	// AdvertiseAddr is the address other replicas relay editors to when this one holds a session
+	AdvertiseAddr string
+	LeaseTTL      time.Duration // How long a replica holds a session without renewing its lease
+}
+
+type OutboxConfig struct {
+	Interval  time.Duration // How often the relay publishes the messages waiting in the outbox
+	Retention time.Duration // How long sent messages are kept
 }
 
 func LoadConfig() (*Config, error) {
@@ -43,6 +76,55 @@ func LoadConfig() (*Config, error) {
 		dbPort = 5432 // Default PostgreSQL port
 	}
 
+	schedulerInterval, err := strconv.Atoi(os.Getenv("SCHEDULER_INTERVAL_SECONDS"))
+	if err != nil {
+		schedulerInterval = 60 // Default scheduler interval
+	}
+
+	schedulerLockKey, err := strconv.ParseInt(os.Getenv("SCHEDULER_LOCK_KEY"), 10, 64)
+	if err != nil {
+		schedulerLockKey = 54001 // Default card scheduler lock key
+	}
+
+	markdownCacheSize, err := strconv.Atoi(os.Getenv("MARKDOWN_CACHE_SIZE"))
+	if err != nil {
+		markdownCacheSize = 1000 // Default markdown cache size
+	}
+
+	collabSnapshotInterval, err := strconv.Atoi(os.Getenv("COLLAB_SNAPSHOT_INTERVAL_SECONDS"))
+	if err != nil {
+		collabSnapshotInterval = 10 // Default collaborative editing snapshot interval
+	}
+
+	collabLeaseTTL, err := strconv.Atoi(os.Getenv("COLLAB_LEASE_SECONDS"))
+	if err != nil {
+		collabLeaseTTL = 30 // Default session lease, renewed every snapshot interval
+	}
+
+	collabAdvertiseAddr := os.Getenv("COLLAB_ADVERTISE_ADDR")
+	if collabAdvertiseAddr == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		collabAdvertiseAddr = net.JoinHostPort(hostname, strconv.Itoa(port)) // Default to the replica's hostname
+	}
+
+	outboxInterval, err := strconv.Atoi(os.Getenv("OUTBOX_INTERVAL_MILLISECONDS"))
+	if err != nil {
+		outboxInterval = 500 // Default outbox relay interval
+	}
+
+	outboxRetentionHours, err := strconv.Atoi(os.Getenv("OUTBOX_RETENTION_HOURS"))
+	if err != nil {
+		outboxRetentionHours = 24 // Default outbox retention
+	}
+
+	messageBus := os.Getenv("MESSAGE_BUS")
+	if messageBus == "" {
+		messageBus = messagebuses.RabbitMQ // Default message bus
+	}
+
 	return &Config{
 		Port: port, // Or your default
 		Database: DatabaseConfig{
@@ -60,6 +142,25 @@ func LoadConfig() (*Config, error) {
 		},
 		RabbitMQ: RabbitMQConfig{ // Add this line
 			URL: os.Getenv("RABBITMQ_URL"),
+			Bus: messageBus,
+		},
+		Scheduler: SchedulerConfig{
+			Interval: time.Duration(schedulerInterval) * time.Second,
+			LockKey:  schedulerLockKey,
+		},
+		Markdown: MarkdownConfig{
+			CacheSize: markdownCacheSize,
+		},
+		Collab: CollabConfig{
+			SnapshotInterval: time.Duration(collabSnapshotInterval) * time.Second,
+			AdvertiseAddr:    collabAdvertiseAddr,
+			LeaseTTL:         time.Duration(collabLeaseTTL) * time.Second,
+		},
+		Outbox: OutboxConfig{
+			Interval:  time.Duration(outboxInterval) * time.Millisecond,
+			Retention: time.Duration(outboxRetentionHours) * time.Hour,
 		},
+		AttachmentDir: os.Getenv("ATTACHMENT_DIR"),
+		MetricsAddr:   os.Getenv("METRICS_ADDR"),
 	}, nil
 }
@@ -1,43 +1,61 @@
 package rabbitmq
 
 import (
+	"context"
+
 	"github.com/sm888sm/halten-backend/card-service/internal/config"
+	"github.com/sm888sm/halten-backend/common/constants/messagebuses"
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+	"github.com/sm888sm/halten-backend/common/messaging/bus/memorybus"
+	"github.com/sm888sm/halten-backend/common/messaging/bus/rabbitmqbus"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/connection"
 	"github.com/streadway/amqp"
 )
 
 var (
-	RabbitMQChannel *amqp.Channel
-	conn            *amqp.Connection
+	conn       *connection.Manager
+	messageBus bus.Bus
 )
 
+// Connect connects to RabbitMQ, reconnecting for as long as the service runs. The memory bus is only
+// available when every service runs in the process, and is refused otherwise.
 func Connect(cfg *config.RabbitMQConfig) error {
-	var err error
-	conn, err = amqp.Dial(cfg.URL)
-	if err != nil {
-		return err
-	}
-
-	RabbitMQChannel, err = conn.Channel()
-	if err != nil {
-		return err
+	if cfg.Bus == messagebuses.Memory {
+		shared, err := memorybus.Shared()
+		if err != nil {
+			return err
+		}
+		messageBus = shared
+		return nil
 	}
 
-	err = RabbitMQChannel.ExchangeDeclare(
-		"halten", // name
-		"topic",  // type
-		true,     // durable
-		false,    // auto-deleted
-		false,    // internal
-		false,    // no-wait
-		nil,      // arguments
-	)
+	var err error
+	conn, err = connection.Dial(context.Background(), cfg.URL, declareExchange)
 	if err != nil {
 		return err
 	}
 
+	messageBus = rabbitmqbus.New(conn)
 	return nil
 }
 
-func GetConnection() *amqp.Connection {
+// GetConnection returns the connection to RabbitMQ, nil with the memory bus
+func GetConnection() *connection.Manager {
 	return conn
 }
+
+func GetBus() bus.Bus {
+	return messageBus
+}
+
+func declareExchange(ch *amqp.Channel) error {
+	return ch.ExchangeDeclare(
+		rabbitmqbus.Exchange, // name
+		"topic",              // type
+		true,                 // durable
+		false,                // auto-deleted
+		false,                // internal
+		false,                // no-wait
+		nil,                  // arguments
+	)
+}
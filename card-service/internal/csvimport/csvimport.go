@@ -0,0 +1,249 @@
+package csvimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sm888sm/halten-backend/common/constants/customfieldtypes"
+	"github.com/sm888sm/halten-backend/models"
+)
+
+// MaxContentSize is the largest file accepted for an import
+const MaxContentSize = 2 << 20
+
+// MaxRows is the most cards one file can import
+const MaxRows = 1000
+
+// Columns of the file are mapped to these card fields. Custom fields are mapped as
+// custom_field:<name>.
+const (
+	Name              = "name"
+	Description       = "description"
+	Labels            = "labels"
+	Members           = "members"
+	DueDate           = "due_date"
+	CustomFieldPrefix = "custom_field:"
+)
+
+// headerAliases are the headers mapped without an explicit mapping, compared case-insensitively
+var headerAliases = map[string]string{
+	"name":        Name,
+	"title":       Name,
+	"card":        Name,
+	"description": Description,
+	"labels":      Labels,
+	"label":       Labels,
+	"tags":        Labels,
+	"members":     Members,
+	"member":      Members,
+	"assignees":   Members,
+	"due date":    DueDate,
+	"due_date":    DueDate,
+	"due":         DueDate,
+}
+
+// Row is a row of the file, with the cells of the mapped columns
+type Row struct {
+	Line         int // Line of the file the row starts on, the header being line 1
+	Name         string
+	Description  string
+	Labels       []string
+	Members      []string
+	DueDate      string
+	CustomFields map[string]string // Keyed by custom field name
+}
+
+// IsValidTarget reports whether a column can be mapped to the target
+func IsValidTarget(target string) bool {
+	switch target {
+	case Name, Description, Labels, Members, DueDate:
+		return true
+	}
+	return strings.HasPrefix(target, CustomFieldPrefix) && len(target) > len(CustomFieldPrefix)
+}
+
+// Parse reads the rows of a CSV file with a header line. columns maps headers to card fields;
+// without it, headers are matched to card fields and to the names of customFieldNames. Columns
+// that aren't mapped are ignored.
+func Parse(content []byte, columns map[string]string, customFieldNames []string) ([]Row, error) {
+	// Spreadsheets often save CSV with a byte order mark
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("the file is empty")
+		}
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+
+	targets := mapColumns(header, columns, customFieldNames)
+	hasName := false
+	for _, target := range targets {
+		hasName = hasName || target == Name
+	}
+	if !hasName {
+		return nil, errors.New("no column is mapped to the card name")
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+
+		if isBlank(record) {
+			continue
+		}
+		if len(rows) == MaxRows {
+			return nil, fmt.Errorf("the file has more than %d rows", MaxRows)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := Row{Line: line, CustomFields: make(map[string]string)}
+		for i, cell := range record {
+			if i >= len(targets) {
+				break
+			}
+
+			cell = strings.TrimSpace(cell)
+			switch target := targets[i]; {
+			case target == Name:
+				row.Name = cell
+			case target == Description:
+				row.Description = cell
+			case target == Labels:
+				row.Labels = splitList(cell)
+			case target == Members:
+				for _, username := range splitList(cell) {
+					row.Members = append(row.Members, strings.TrimPrefix(username, "@"))
+				}
+			case target == DueDate:
+				row.DueDate = cell
+			case strings.HasPrefix(target, CustomFieldPrefix):
+				if cell != "" {
+					row.CustomFields[strings.TrimPrefix(target, CustomFieldPrefix)] = cell
+				}
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// mapColumns returns the card field of each column, empty for columns that aren't imported
+func mapColumns(header []string, columns map[string]string, customFieldNames []string) []string {
+	targets := make([]string, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+
+		if len(columns) > 0 {
+			targets[i] = columns[name]
+			continue
+		}
+
+		if target, ok := headerAliases[strings.ToLower(name)]; ok {
+			targets[i] = target
+			continue
+		}
+		for _, customFieldName := range customFieldNames {
+			if strings.EqualFold(name, customFieldName) {
+				targets[i] = CustomFieldPrefix + customFieldName
+			}
+		}
+	}
+	return targets
+}
+
+// ParseDate reads a date cell, either RFC 3339 or a plain date taken as midnight UTC
+func ParseDate(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04", "2006-01-02"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, errors.New("invalid date, expected YYYY-MM-DD or RFC 3339")
+}
+
+// ParseCustomFieldValue reads a cell into the value of a custom field, with the same rules as
+// values set on a single card
+func ParseCustomFieldValue(customField *models.CustomField, value string) (*models.CardCustomFieldValue, error) {
+	fieldValue := &models.CardCustomFieldValue{CustomFieldID: customField.ID}
+
+	switch customField.Type {
+	case customfieldtypes.Text:
+		if utf8.RuneCountInString(value) > 255 {
+			return nil, errors.New("text values cannot be longer than 255 characters")
+		}
+		fieldValue.TextValue = &value
+	case customfieldtypes.Number:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, errors.New("invalid number")
+		}
+		fieldValue.NumberValue = &number
+	case customfieldtypes.Date:
+		date, err := ParseDate(value)
+		if err != nil {
+			return nil, err
+		}
+		fieldValue.DateValue = &date
+	case customfieldtypes.Checkbox:
+		var checked bool
+		switch strings.ToLower(value) {
+		case "true", "yes", "y", "x", "1":
+			checked = true
+		case "false", "no", "n", "0":
+		default:
+			return nil, errors.New("invalid checkbox value, expected yes or no")
+		}
+		fieldValue.CheckboxValue = &checked
+	case customfieldtypes.Select:
+		for _, option := range customField.Options {
+			if strings.EqualFold(option.Value, value) {
+				optionID := option.ID
+				fieldValue.OptionID = &optionID
+			}
+		}
+		if fieldValue.OptionID == nil {
+			return nil, fmt.Errorf("%q isn't an option of the field", value)
+		}
+	}
+
+	return fieldValue, nil
+}
+
+// splitList splits a cell holding several values separated by commas
+func splitList(cell string) []string {
+	var values []string
+	for _, value := range strings.Split(cell, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func isBlank(record []string) bool {
+	for _, cell := range record {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package customfields
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/sm888sm/halten-backend/common/constants/customfieldtypes"
+)
+
+// Filter operators
+const (
+	Equal       = "eq"
+	NotEqual    = "ne"
+	GreaterThan = "gt"
+	LessThan    = "lt"
+	Contains    = "contains"
+	Set         = "set"
+	Unset       = "unset"
+)
+
+func IsValidOperator(operator string) bool {
+	switch operator {
+	case Equal, NotEqual, GreaterThan, LessThan, Contains, Set, Unset:
+		return true
+	}
+	return false
+}
+
+// ValueColumn returns the card_custom_field_values column holding values of the given field type
+func ValueColumn(fieldType string) string {
+	switch fieldType {
+	case customfieldtypes.Number:
+		return "number_value"
+	case customfieldtypes.Date:
+		return "date_value"
+	case customfieldtypes.Checkbox:
+		return "checkbox_value"
+	case customfieldtypes.Select:
+		return "option_id"
+	default:
+		return "text_value"
+	}
+}
+
+// ParseFilterValue checks that the operator applies to the field type and parses the filter value
+// into the type stored for it. Set and unset take no value.
+func ParseFilterValue(fieldType string, operator string, value string) (interface{}, error) {
+	switch operator {
+	case Set, Unset:
+		return nil, nil
+	case GreaterThan, LessThan:
+		if fieldType != customfieldtypes.Number && fieldType != customfieldtypes.Date {
+			return nil, errors.New("gt and lt only apply to number and date fields")
+		}
+	case Contains:
+		if fieldType != customfieldtypes.Text {
+			return nil, errors.New("contains only applies to text fields")
+		}
+	}
+
+	switch fieldType {
+	case customfieldtypes.Number:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, errors.New("invalid number")
+		}
+		return number, nil
+	case customfieldtypes.Date:
+		date, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, errors.New("invalid date, expected RFC 3339")
+		}
+		return date, nil
+	case customfieldtypes.Checkbox:
+		checked, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errors.New("invalid checkbox value")
+		}
+		return checked, nil
+	case customfieldtypes.Select:
+		optionID, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid optionID")
+		}
+		return optionID, nil
+	default:
+		return value, nil
+	}
+}
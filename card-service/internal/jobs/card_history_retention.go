@@ -0,0 +1,21 @@
+package jobs
+
+import (
+	"context"
+
+	"github.com/sm888sm/halten-backend/card-service/internal/repositories"
+)
+
+type CardHistoryRetentionJob struct {
+	cardRepo repositories.CardRepository
+}
+
+func NewCardHistoryRetentionJob(cardRepo repositories.CardRepository) *CardHistoryRetentionJob {
+	return &CardHistoryRetentionJob{cardRepo: cardRepo}
+}
+
+// Run drops the card versions boards no longer keep
+func (j *CardHistoryRetentionJob) Run(ctx context.Context) error {
+	_, err := j.cardRepo.PruneCardHistory()
+	return err
+}
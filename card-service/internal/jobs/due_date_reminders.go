@@ -0,0 +1,98 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
+	internal_models "github.com/sm888sm/halten-backend/card-service/internal/models"
+	"github.com/sm888sm/halten-backend/card-service/internal/repositories"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+)
+
+// overdueBatchSize is the number of overdue cards notified per query
+const overdueBatchSize = 100
+
+type DueDateReminderJob struct {
+	cardRepo  repositories.CardRepository
+	publisher publishers.Publisher
+}
+
+func NewDueDateReminderJob(cardRepo repositories.CardRepository, publisher publishers.Publisher) *DueDateReminderJob {
+	return &DueDateReminderJob{cardRepo: cardRepo, publisher: publisher}
+}
+
+// Run emits card.due_soon for every reminder whose offset has been reached and card.overdue for
+// every card past its due date. The event is written to the outbox with the card's notification,
+// so a card is marked as notified if and only if its event is published.
+func (j *DueDateReminderJob) Run(ctx context.Context) error {
+	now := time.Now()
+
+	dueSoon, err := j.cardRepo.GetDueSoonCards(&repositories.GetDueCardsRequest{Now: now})
+	if err != nil {
+		return err
+	}
+
+	for _, card := range dueSoon.Cards {
+		if err := j.notify(card, publishers.CardDueSoon, "card.due_soon", now); err != nil {
+			log.Printf("Failed to send due soon reminder for card %d: %v", card.CardID, err)
+		}
+	}
+
+	// Overdue cards are paged by ID, so a card that failed to be notified isn't fetched again
+	// until the next run
+	var afterID uint64
+	for {
+		overdue, err := j.cardRepo.GetOverdueCards(&repositories.GetDueCardsRequest{Now: now, AfterID: afterID, Limit: overdueBatchSize})
+		if err != nil {
+			return err
+		}
+
+		for _, card := range overdue.Cards {
+			if err := j.notify(card, publishers.CardOverdue, "card.overdue", now); err != nil {
+				log.Printf("Failed to send overdue notice for card %d: %v", card.CardID, err)
+			}
+			afterID = card.CardID
+		}
+
+		if len(overdue.Cards) < overdueBatchSize {
+			return nil
+		}
+	}
+}
+
+func (j *DueDateReminderJob) notify(card *internal_models.DueCardDTO, messageType publishers.MessageType, actionType string, now time.Time) error {
+	event := &pb_card.CardDueEvent{
+		CardID:        card.CardID,
+		BoardID:       card.BoardID,
+		ListID:        card.ListID,
+		Name:          card.Name,
+		DueDate:       timestamppb.New(card.DueDate),
+		OffsetMinutes: card.OffsetMinutes,
+		Members:       card.Members,
+	}
+
+	details := fmt.Sprintf("Card \"%s\" is overdue", card.Name)
+	if messageType == publishers.CardDueSoon {
+		details = fmt.Sprintf("Card \"%s\" is due at %s", card.Name, card.DueDate.Format(time.RFC3339))
+	}
+
+	return j.cardRepo.MarkCardDueNotified(&repositories.MarkCardDueNotifiedRequest{
+		CardID:     card.CardID,
+		BoardID:    card.BoardID,
+		ReminderID: card.ReminderID,
+		ActionType: actionType,
+		Details:    details,
+		Now:        now,
+		// Triggered by the scheduler, not by a user
+		Event: publishers.NewEvent(j.publisher, messageType, events.Metadata{BoardID: card.BoardID}, func(ids []uint64) proto.Message {
+			return event
+		}),
+	})
+}
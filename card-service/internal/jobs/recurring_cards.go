@@ -0,0 +1,26 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/sm888sm/halten-backend/card-service/internal/repositories"
+)
+
+type RecurringCardJob struct {
+	cardRepo repositories.CardRepository
+}
+
+func NewRecurringCardJob(cardRepo repositories.CardRepository) *RecurringCardJob {
+	return &RecurringCardJob{cardRepo: cardRepo}
+}
+
+// Run creates the next occurrence of every recurring card whose current occurrence is due
+func (j *RecurringCardJob) Run(ctx context.Context) error {
+	res, err := j.cardRepo.CreateDueRecurringCards(&repositories.CreateDueRecurringCardsRequest{Now: time.Now()})
+	if res != nil && len(res.CardIDs) > 0 {
+		log.Printf("Created %d recurring cards", len(res.CardIDs))
+	}
+	return err
+}
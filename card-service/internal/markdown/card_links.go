@@ -0,0 +1,68 @@
+package markdown
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+const cardLinkClass = "card-link"
+
+// CardLinks turns card shortcodes like #123 into links to the card
+var CardLinks goldmark.Extender = &cardLinks{}
+
+type cardLinks struct{}
+
+func (e *cardLinks) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(&cardLinkParser{}, 999),
+	))
+}
+
+type cardLinkParser struct{}
+
+func (p *cardLinkParser) Trigger() []byte {
+	return []byte{'#'}
+}
+
+func (p *cardLinkParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	// Shortcodes stand on their own, so issue#1 or ##1 stay plain text
+	if previous := block.PrecendingCharacter(); !unicode.IsSpace(previous) && !strings.ContainsRune("([{", previous) {
+		return nil
+	}
+
+	line, segment := block.PeekLine()
+
+	digits := 0
+	for digits+1 < len(line) && line[digits+1] >= '0' && line[digits+1] <= '9' {
+		digits++
+	}
+	if digits == 0 {
+		return nil
+	}
+
+	// #123abc isn't a shortcode either
+	if next := digits + 1; next < len(line) && (unicode.IsLetter(rune(line[next])) || line[next] == '_') {
+		return nil
+	}
+
+	cardID, err := strconv.ParseUint(string(line[1:digits+1]), 10, 64)
+	if err != nil || cardID == 0 {
+		return nil
+	}
+
+	block.Advance(digits + 1)
+
+	link := ast.NewLink()
+	link.Destination = []byte("/cards/" + strconv.FormatUint(cardID, 10))
+	link.SetAttributeString("class", []byte(cardLinkClass))
+	link.AppendChild(link, ast.NewTextSegment(text.NewSegment(segment.Start, segment.Start+digits+1)))
+
+	return link
+}
@@ -0,0 +1,109 @@
+package markdown
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"regexp"
+	"sync"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// Renderer turns Markdown into sanitized HTML. Rendered output is cached by the hash of the source,
+// so reading the same description or comment again doesn't render it again.
+type Renderer struct {
+	markdown goldmark.Markdown
+	policy   *bluemonday.Policy
+
+	mu       sync.Mutex
+	capacity int
+	entries  map[[sha256.Size]byte]*list.Element
+	order    *list.List // Most recently used first
+}
+
+type cacheEntry struct {
+	key  [sha256.Size]byte
+	html string
+}
+
+func NewRenderer(cacheSize int) *Renderer {
+	return &Renderer{
+		// Raw HTML in the source is escaped by goldmark and the output is sanitized on top of it
+		markdown: goldmark.New(
+			goldmark.WithExtensions(extension.GFM, CardLinks),
+		),
+		policy:   newPolicy(),
+		capacity: cacheSize,
+		entries:  make(map[[sha256.Size]byte]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// newPolicy allows user generated content plus the markup produced for task lists and card links
+func newPolicy() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^` + cardLinkClass + `$`)).OnElements("a")
+	return policy
+}
+
+func (r *Renderer) Render(source string) (string, error) {
+	if source == "" {
+		return "", nil
+	}
+
+	key := sha256.Sum256([]byte(source))
+	if html, ok := r.get(key); ok {
+		return html, nil
+	}
+
+	var buf bytes.Buffer
+	if err := r.markdown.Convert([]byte(source), &buf); err != nil {
+		return "", err
+	}
+
+	html := r.policy.Sanitize(buf.String())
+	r.put(key, html)
+
+	return html, nil
+}
+
+func (r *Renderer) get(key [sha256.Size]byte) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	element, ok := r.entries[key]
+	if !ok {
+		return "", false
+	}
+
+	r.order.MoveToFront(element)
+	return element.Value.(*cacheEntry).html, true
+}
+
+func (r *Renderer) put(key [sha256.Size]byte, html string) {
+	if r.capacity <= 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if element, ok := r.entries[key]; ok {
+		r.order.MoveToFront(element)
+		return
+	}
+
+	r.entries[key] = r.order.PushFront(&cacheEntry{key: key, html: html})
+
+	// Evict the least recently used entry
+	if r.order.Len() > r.capacity {
+		oldest := r.order.Back()
+		r.order.Remove(oldest)
+		delete(r.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -2,90 +2,62 @@ package consumer
 
 import (
 	"context"
-	"fmt"
 
+	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
 	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
 	"github.com/sm888sm/halten-backend/card-service/internal/services"
-	"github.com/streadway/amqp"
-	"google.golang.org/protobuf/proto"
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/consumers"
+	"gorm.io/gorm"
 )
 
 type CardConsumer struct {
-	Channel     *amqp.Channel
+	Bus         bus.Bus
+	DB          *gorm.DB
 	CardService *services.CardService
 }
 
-func NewCardConsumer(ch *amqp.Channel, boardService *services.CardService) *CardConsumer {
-	return &CardConsumer{Channel: ch, CardService: boardService}
+func NewCardConsumer(b bus.Bus, db *gorm.DB, cardService *services.CardService) *CardConsumer {
+	return &CardConsumer{Bus: b, DB: db, CardService: cardService}
 }
 
 func (c *CardConsumer) ConsumeCardMessages(ctx context.Context) error {
-	ch := c.Channel
-
-	q, err := ch.QueueDeclare(
-		"",
-		false,
-		false,
-		true,
-		false,
-		nil)
-	if err != nil {
-		return err
-	}
-
-	// Bind the queue to multiple routing keys
-	err = ch.QueueBind(
-		q.Name,
-		"card.*", // Use a wildcard to match any routing key that starts with "board."
-		"halten",
-		false,
-		nil)
-	if err != nil {
-		return err
-	}
+	consumer := consumers.NewConsumer(c.DB, &consumers.Queue{
+		Name:        "card-service.card",
+		Prefetch:    10,
+		RetryDelays: consumers.DefaultRetryDelays,
+	})
+
+	consumer.Handle(events.CardDelete, func(ctx context.Context, msg *consumers.Message) error {
+		req := &pb_card.DeleteCardRequest{}
+		if err := events.Unpack(msg.Event, req); err != nil {
+			return consumers.Permanent(err)
+		}
 
-	msgs, err := ch.Consume(
-		q.Name,
-		"",
-		true,
-		false,
-		false,
-		false,
-		nil)
-	if err != nil {
+		_, err := c.CardService.DeleteCard(ctx, req)
 		return err
-	}
+	})
 
-	go func() {
-		for d := range msgs {
-			fmt.Println("Received a message: ", d.RoutingKey)
-			switch d.RoutingKey {
-			case "card.delete":
-				req := &pb_card.DeleteCardRequest{}
-				err := proto.Unmarshal(d.Body, req)
-				if err != nil {
-					// Log the error or handle it as needed
-					continue
-				}
-
-				_, err = c.CardService.DeleteCard(ctx, req)
-				if err != nil {
-					// TODO: Log the error or handle it as needed
-				}
-
-				continue
-
-			case "card.update":
-				// Handle board.update messages
-				// ...
+	return consumer.Run(ctx, c.Bus)
+}
 
-				// Add more cases as needed
-			default:
-				// Log or handle unknown routing keys
-				// ...
-			}
+// ConsumeBoardMessages deletes the cards of boards deleted for good
+func (c *CardConsumer) ConsumeBoardMessages(ctx context.Context) error {
+	consumer := consumers.NewConsumer(c.DB, &consumers.Queue{
+		Name:        "card-service.board",
+		Prefetch:    10,
+		RetryDelays: consumers.DefaultRetryDelays,
+	})
+
+	consumer.Handle(events.BoardDeleted, func(ctx context.Context, msg *consumers.Message) error {
+		event := &pb_board.BoardDeletedEvent{}
+		if err := events.Unpack(msg.Event, event); err != nil {
+			return consumers.Permanent(err)
 		}
-	}()
 
-	return nil
+		return c.CardService.PurgeBoardCards(ctx, event)
+	})
+
+	return consumer.Run(ctx, c.Bus)
 }
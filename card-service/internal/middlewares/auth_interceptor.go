@@ -26,23 +26,41 @@ var (
 	}
 
 	checkRole = map[string]string{
-		"/proto.CardService/CreateCard":            roles.MemberRole,
-		"/proto.CardService/MoveCardPosition":      roles.MemberRole,
-		"/proto.CardService/UpdateCardName":        roles.MemberRole,
-		"/proto.CardService/UpdateCardDescription": roles.MemberRole,
-		"/proto.CardService/AddCardLabel":          roles.MemberRole,
-		"/proto.CardService/RemoveCardLabel":       roles.MemberRole,
-		"/proto.CardService/SetCardDates":          roles.MemberRole,
-		"/proto.CardService/ToggleCardCompleted":   roles.MemberRole,
-		"/proto.CardService/AddCardAttachment":     roles.MemberRole,
-		"/proto.CardService/RemoveCardAttachment":  roles.MemberRole,
-		"/proto.CardService/AddCardComment":        roles.MemberRole,
-		"/proto.CardService/RemoveCardComment":     roles.MemberRole,
-		"/proto.CardService/AddCardMembers":        roles.MemberRole,
-		"/proto.CardService/RemoveCardMembers":     roles.MemberRole,
-		"/proto.CardService/ArchiveCard":           roles.MemberRole,
-		"/proto.CardService/RestoreCard":           roles.MemberRole,
-		"/proto.CardService/DeleteCard":            roles.AdminRole,
+		"/proto.CardService/CreateCard":                 roles.MemberRole,
+		"/proto.CardService/MoveCardPosition":           roles.MemberRole,
+		"/proto.CardService/UpdateCardName":             roles.MemberRole,
+		"/proto.CardService/UpdateCardDescription":      roles.MemberRole,
+		"/proto.CardService/EditCardDescription":        roles.MemberRole,
+		"/proto.CardService/AddCardLabel":               roles.MemberRole,
+		"/proto.CardService/RemoveCardLabel":            roles.MemberRole,
+		"/proto.CardService/SetCardDates":               roles.MemberRole,
+		"/proto.CardService/ToggleCardCompleted":        roles.MemberRole,
+		"/proto.CardService/AddCardAttachment":          roles.MemberRole,
+		"/proto.CardService/RemoveCardAttachment":       roles.MemberRole,
+		"/proto.CardService/AddCardComment":             roles.MemberRole,
+		"/proto.CardService/RemoveCardComment":          roles.MemberRole,
+		"/proto.CardService/UpdateCardComment":          roles.MemberRole,
+		"/proto.CardService/GetCardComments":            roles.ObserverRole,
+		"/proto.CardService/GetCardCommentHistory":      roles.ObserverRole,
+		"/proto.CardService/AddCommentReaction":         roles.MemberRole,
+		"/proto.CardService/RemoveCommentReaction":      roles.MemberRole,
+		"/proto.CardService/AddCardMembers":             roles.MemberRole,
+		"/proto.CardService/RemoveCardMembers":          roles.MemberRole,
+		"/proto.CardService/ArchiveCard":                roles.MemberRole,
+		"/proto.CardService/RestoreCard":                roles.MemberRole,
+		"/proto.CardService/DeleteCard":                 roles.AdminRole,
+		"/proto.CardService/SetCardReminders":           roles.MemberRole,
+		"/proto.CardService/SetCardRecurrence":          roles.MemberRole,
+		"/proto.CardService/RemoveCardRecurrence":       roles.MemberRole,
+		"/proto.CardService/SetCardCustomFieldValue":    roles.MemberRole,
+		"/proto.CardService/RemoveCardCustomFieldValue": roles.MemberRole,
+		"/proto.CardService/GetCardHistory":             roles.ObserverRole,
+		"/proto.CardService/RestoreCardVersion":         roles.MemberRole,
+		"/proto.CardService/BulkUpdateCards":            roles.MemberRole,
+		"/proto.CardService/ArchiveAllCardsInList":      roles.MemberRole,
+		"/proto.CardService/MoveAllCardsInList":         roles.MemberRole,
+		"/proto.CardService/SortCardsInList":            roles.MemberRole,
+		"/proto.CardService/ImportCards":                roles.MemberRole,
 		// Add other methods here...
 	}
 )
@@ -53,14 +71,42 @@ type AuthInterceptor struct {
 }
 
 func NewAuthInterceptor(db *gorm.DB, svc *external_services.Services) *AuthInterceptor {
-	return &AuthInterceptor{db: db}
+	return &AuthInterceptor{db: db, svc: svc}
 }
 
 func (v *AuthInterceptor) AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-	_, isException := checkRoleException[info.FullMethod]
+	ctx, err := v.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+func (v *AuthInterceptor) StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := v.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+}
+
+// authorizedStream carries the context holding the user and board IDs to the stream handler
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (v *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	_, isException := checkRoleException[method]
 	if !isException {
 
-		requiredRole, ok := checkRole[info.FullMethod]
+		requiredRole, ok := checkRole[method]
 		if !ok {
 			return nil, status.Errorf(codes.Unavailable, errorhandlers.NewAPIError(http.StatusNotImplemented, "Invalid method").Error())
 		}
@@ -108,5 +154,5 @@ func (v *AuthInterceptor) AuthInterceptor(ctx context.Context, req interface{},
 		// }
 	}
 
-	return handler(ctx, req)
+	return ctx, nil
 }
@@ -2,10 +2,24 @@ package middlewares
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode/utf8"
 
 	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
+	"github.com/sm888sm/halten-backend/card-service/internal/csvimport"
+	"github.com/sm888sm/halten-backend/card-service/internal/customfields"
+	"github.com/sm888sm/halten-backend/card-service/internal/recurrence"
+	"github.com/sm888sm/halten-backend/card-service/internal/repositories"
+	"github.com/sm888sm/halten-backend/common/constants/bulkcardoperations"
+	"github.com/sm888sm/halten-backend/common/constants/contextkeys"
+	"github.com/sm888sm/halten-backend/common/constants/customfieldtypes"
 	"github.com/sm888sm/halten-backend/common/constants/fielderrors"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/models"
 	"google.golang.org/grpc"
 	"gorm.io/gorm"
 )
@@ -23,7 +37,7 @@ func (v *ValidatorInterceptor) ValidationInterceptor(ctx context.Context, req in
 	// Card Service
 	case "/proto.CardService/CreateCard":
 		req := req.(*pb_card.CreateCardRequest)
-		if err := validateCreateCardRequest(req); err != nil {
+		if err := ValidateCreateCardRequest(req); err != nil {
 			return nil, err
 		}
 	case "/proto.CardService/GetCardByID":
@@ -36,11 +50,17 @@ func (v *ValidatorInterceptor) ValidationInterceptor(ctx context.Context, req in
 		if err := validateGetCardsByListRequest(req); err != nil {
 			return nil, err
 		}
+		if err := validateCustomFieldFilters(req.Filters, req.Sort); err != nil {
+			return nil, err
+		}
 	case "/proto.CardService/GetCardsByBoard":
 		req := req.(*pb_card.GetCardsByBoardRequest)
 		if err := validateGetCardsByBoardRequest(req); err != nil {
 			return nil, err
 		}
+		if err := validateCustomFieldFilters(req.Filters, req.Sort); err != nil {
+			return nil, err
+		}
 	case "/proto.CardService/MoveCardPosition":
 		req := req.(*pb_card.MoveCardPositionRequest)
 		if err := validateMoveCardPositionRequest(req); err != nil {
@@ -96,6 +116,31 @@ func (v *ValidatorInterceptor) ValidationInterceptor(ctx context.Context, req in
 		if err := validateRemoveCardCommentRequest(req); err != nil {
 			return nil, err
 		}
+	case "/proto.CardService/UpdateCardComment":
+		req := req.(*pb_card.UpdateCardCommentRequest)
+		if err := validateUpdateCardCommentRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/GetCardComments":
+		req := req.(*pb_card.GetCardCommentsRequest)
+		if err := validateGetCardCommentsRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/GetCardCommentHistory":
+		req := req.(*pb_card.GetCardCommentHistoryRequest)
+		if err := validateGetCardCommentHistoryRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/AddCommentReaction":
+		req := req.(*pb_card.AddCommentReactionRequest)
+		if err := validateCommentReaction(req.CardID, req.CommentID, req.Emoji); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/RemoveCommentReaction":
+		req := req.(*pb_card.RemoveCommentReactionRequest)
+		if err := validateCommentReaction(req.CardID, req.CommentID, req.Emoji); err != nil {
+			return nil, err
+		}
 	case "/proto.CardService/AddCardMembers":
 		req := req.(*pb_card.AddCardMembersRequest)
 		if err := validateAddCardMembersRequest(req); err != nil {
@@ -121,12 +166,73 @@ func (v *ValidatorInterceptor) ValidationInterceptor(ctx context.Context, req in
 		if err := validateDeleteCardRequest(req); err != nil {
 			return nil, err
 		}
+	case "/proto.CardService/SetCardReminders":
+		req := req.(*pb_card.SetCardRemindersRequest)
+		if err := validateSetCardRemindersRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/SetCardRecurrence":
+		req := req.(*pb_card.SetCardRecurrenceRequest)
+		if err := validateSetCardRecurrenceRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/RemoveCardRecurrence":
+		req := req.(*pb_card.RemoveCardRecurrenceRequest)
+		if err := validateRemoveCardRecurrenceRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/SetCardCustomFieldValue":
+		req := req.(*pb_card.SetCardCustomFieldValueRequest)
+		if err := v.validateSetCardCustomFieldValueRequest(ctx, req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/RemoveCardCustomFieldValue":
+		req := req.(*pb_card.RemoveCardCustomFieldValueRequest)
+		if err := validateRemoveCardCustomFieldValueRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/GetCardHistory":
+		req := req.(*pb_card.GetCardHistoryRequest)
+		if err := validateGetCardHistoryRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/RestoreCardVersion":
+		req := req.(*pb_card.RestoreCardVersionRequest)
+		if err := validateRestoreCardVersionRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/BulkUpdateCards":
+		req := req.(*pb_card.BulkUpdateCardsRequest)
+		if err := validateBulkUpdateCardsRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/ArchiveAllCardsInList":
+		req := req.(*pb_card.ArchiveAllCardsInListRequest)
+		if err := validateArchiveAllCardsInListRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/MoveAllCardsInList":
+		req := req.(*pb_card.MoveAllCardsInListRequest)
+		if err := validateMoveAllCardsInListRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/SortCardsInList":
+		req := req.(*pb_card.SortCardsInListRequest)
+		if err := validateSortCardsInListRequest(req); err != nil {
+			return nil, err
+		}
+	case "/proto.CardService/ImportCards":
+		req := req.(*pb_card.ImportCardsRequest)
+		if err := validateImportCardsRequest(req); err != nil {
+			return nil, err
+		}
 	}
 
 	return handler(ctx, req)
 }
 
-func validateCreateCardRequest(req *pb_card.CreateCardRequest) error {
+// ValidateCreateCardRequest is also applied to every row of a card import
+func ValidateCreateCardRequest(req *pb_card.CreateCardRequest) error {
 	fieldErrors := make(map[string]errorhandlers.FieldError)
 
 	if req.ListID == 0 {
@@ -142,6 +248,12 @@ func validateCreateCardRequest(req *pb_card.CreateCardRequest) error {
 			Message: "Name is required",
 			Field:   "Name",
 		}
+	} else if utf8.RuneCountInString(req.Name) > 50 {
+		fieldErrors["Name"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "Name must be at most 50 characters",
+			Field:   "Name",
+		}
 	}
 
 	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
@@ -244,6 +356,12 @@ func validateUpdateCardDescriptionRequest(req *pb_card.UpdateCardDescriptionRequ
 			Message: "Description is required",
 			Field:   "Description",
 		}
+	} else if len(req.Description) > 16384 {
+		fieldErrors["Description"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "Description must be at most 16384 bytes",
+			Field:   "Description",
+		}
 	}
 
 	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
@@ -376,12 +494,126 @@ func validateAddCardCommentRequest(req *pb_card.AddCardCommentRequest) error {
 		}
 	}
 
-	if req.Content == "" {
+	validateCommentContent(req.Content, fieldErrors)
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateUpdateCardCommentRequest(req *pb_card.UpdateCardCommentRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if req.CommentID == 0 {
+		fieldErrors["CommentID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CommentID is required",
+			Field:   "CommentID",
+		}
+	}
+
+	validateCommentContent(req.Content, fieldErrors)
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateCommentContent(content string, fieldErrors map[string]errorhandlers.FieldError) {
+	if strings.TrimSpace(content) == "" {
 		fieldErrors["Content"] = errorhandlers.FieldError{
 			Code:    fielderrors.ErrRequired,
 			Message: "Content is required",
 			Field:   "Content",
 		}
+	} else if len(content) > 1024 {
+		fieldErrors["Content"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "Content must be at most 1024 bytes",
+			Field:   "Content",
+		}
+	}
+}
+
+func validateGetCardCommentsRequest(req *pb_card.GetCardCommentsRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if req.PageSize > 100 {
+		fieldErrors["PageSize"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrOutOfRange,
+			Message: "PageSize must be at most 100",
+			Field:   "PageSize",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateGetCardCommentHistoryRequest(req *pb_card.GetCardCommentHistoryRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if req.CommentID == 0 {
+		fieldErrors["CommentID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CommentID is required",
+			Field:   "CommentID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateCommentReaction(cardID uint64, commentID uint64, emoji string) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if cardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if commentID == 0 {
+		fieldErrors["CommentID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CommentID is required",
+			Field:   "CommentID",
+		}
+	}
+
+	if emoji == "" {
+		fieldErrors["Emoji"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "Emoji is required",
+			Field:   "Emoji",
+		}
+	} else if len(emoji) > 32 || !utf8.ValidString(emoji) || strings.ContainsAny(emoji, " \t\r\n") {
+		fieldErrors["Emoji"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "Emoji must be a single emoji of at most 32 bytes",
+			Field:   "Emoji",
+		}
 	}
 
 	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
@@ -494,3 +726,537 @@ func validateDeleteCardRequest(req *pb_card.DeleteCardRequest) error {
 
 	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
 }
+
+const (
+	maxCardReminders     = 5
+	maxReminderOffsetMin = 30 * 24 * 60 // 30 days
+)
+
+func validateSetCardRemindersRequest(req *pb_card.SetCardRemindersRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if len(req.OffsetMinutes) > maxCardReminders {
+		fieldErrors["OffsetMinutes"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "A card cannot have more than 5 reminders",
+			Field:   "OffsetMinutes",
+		}
+	}
+
+	seen := make(map[int64]bool)
+	for _, offset := range req.OffsetMinutes {
+		if offset <= 0 || offset > maxReminderOffsetMin {
+			fieldErrors["OffsetMinutes"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrOutOfRange,
+				Message: "Reminder offsets must be between 1 minute and 30 days",
+				Field:   "OffsetMinutes",
+			}
+			break
+		}
+		if seen[offset] {
+			fieldErrors["OffsetMinutes"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrExists,
+				Message: "Reminder offsets must be unique",
+				Field:   "OffsetMinutes",
+			}
+			break
+		}
+		seen[offset] = true
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateSetCardRecurrenceRequest(req *pb_card.SetCardRecurrenceRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if req.Interval < 1 || req.Interval > 365 {
+		fieldErrors["Interval"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrOutOfRange,
+			Message: "Interval must be between 1 and 365",
+			Field:   "Interval",
+		}
+	}
+
+	switch req.Frequency {
+	case recurrence.Daily:
+	case recurrence.Weekly:
+		if len(req.Weekdays) == 0 {
+			fieldErrors["Weekdays"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrRequired,
+				Message: "Weekdays are required for weekly recurrence",
+				Field:   "Weekdays",
+			}
+		} else if _, err := recurrence.ParseWeekdays(req.Weekdays); err != nil {
+			fieldErrors["Weekdays"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrInvalid,
+				Message: "Weekdays must be one of MO, TU, WE, TH, FR, SA, SU",
+				Field:   "Weekdays",
+			}
+		}
+	case recurrence.Monthly:
+		if req.MonthDay < 1 || req.MonthDay > 31 {
+			fieldErrors["MonthDay"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrOutOfRange,
+				Message: "MonthDay must be between 1 and 31",
+				Field:   "MonthDay",
+			}
+		}
+	default:
+		fieldErrors["Frequency"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "Frequency must be daily, weekly or monthly",
+			Field:   "Frequency",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateRemoveCardRecurrenceRequest(req *pb_card.RemoveCardRecurrenceRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+const maxCustomFieldFilters = 10
+
+func validateCustomFieldFilters(filters []*pb_card.CustomFieldFilter, sort *pb_card.CustomFieldSort) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if len(filters) > maxCustomFieldFilters {
+		fieldErrors["Filters"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "Cards cannot be filtered by more than 10 custom fields",
+			Field:   "Filters",
+		}
+	}
+
+	for _, filter := range filters {
+		if filter.CustomFieldID == 0 {
+			fieldErrors["Filters"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrRequired,
+				Message: "CustomFieldID is required for every filter",
+				Field:   "Filters",
+			}
+			break
+		}
+		if !customfields.IsValidOperator(filter.Operator) {
+			fieldErrors["Filters"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrInvalid,
+				Message: "Operator must be one of eq, ne, gt, lt, contains, set, unset",
+				Field:   "Filters",
+			}
+			break
+		}
+	}
+
+	if sort != nil && sort.CustomFieldID == 0 {
+		fieldErrors["Sort"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CustomFieldID is required to sort",
+			Field:   "Sort",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+// validateSetCardCustomFieldValueRequest checks the value against the field definition, so it needs
+// the board the request was authorized for
+func (v *ValidatorInterceptor) validateSetCardCustomFieldValueRequest(ctx context.Context, req *pb_card.SetCardCustomFieldValueRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if req.Value == nil || req.Value.CustomFieldID == 0 {
+		fieldErrors["CustomFieldID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CustomFieldID is required",
+			Field:   "CustomFieldID",
+		}
+		return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+	}
+
+	if req.Value.Value == nil {
+		fieldErrors["Value"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "Value is required",
+			Field:   "Value",
+		}
+		return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+	}
+
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	var customField models.CustomField
+	if err := v.db.Where("id = ? AND board_id = ?", req.Value.CustomFieldID, boardID).First(&customField).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			fieldErrors["CustomFieldID"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrDoesntExist,
+				Message: "Custom field does not exist on this board",
+				Field:   "CustomFieldID",
+			}
+			return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	mismatch := errorhandlers.FieldError{
+		Code:    fielderrors.ErrMismatch,
+		Message: "Value does not match the custom field type " + customField.Type,
+		Field:   "Value",
+	}
+
+	switch value := req.Value.Value.(type) {
+	case *pb_card.CustomFieldValue_TextValue:
+		if customField.Type != customfieldtypes.Text {
+			fieldErrors["Value"] = mismatch
+		} else if len(value.TextValue) > 255 {
+			fieldErrors["Value"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrMaxLength,
+				Message: "Text values cannot be longer than 255 characters",
+				Field:   "Value",
+			}
+		}
+	case *pb_card.CustomFieldValue_NumberValue:
+		if customField.Type != customfieldtypes.Number {
+			fieldErrors["Value"] = mismatch
+		} else if math.IsNaN(value.NumberValue) || math.IsInf(value.NumberValue, 0) {
+			fieldErrors["Value"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrInvalid,
+				Message: "Number values must be finite",
+				Field:   "Value",
+			}
+		}
+	case *pb_card.CustomFieldValue_DateValue:
+		if customField.Type != customfieldtypes.Date {
+			fieldErrors["Value"] = mismatch
+		} else if !value.DateValue.IsValid() {
+			fieldErrors["Value"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrInvalid,
+				Message: "Invalid date",
+				Field:   "Value",
+			}
+		}
+	case *pb_card.CustomFieldValue_CheckboxValue:
+		if customField.Type != customfieldtypes.Checkbox {
+			fieldErrors["Value"] = mismatch
+		}
+	case *pb_card.CustomFieldValue_OptionID:
+		if customField.Type != customfieldtypes.Select {
+			fieldErrors["Value"] = mismatch
+			break
+		}
+
+		var count int64
+		if err := v.db.Model(&models.CustomFieldOption{}).Where("id = ? AND custom_field_id = ?", value.OptionID, customField.ID).Count(&count).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if count == 0 {
+			fieldErrors["Value"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrDoesntExist,
+				Message: "Option does not exist on this custom field",
+				Field:   "Value",
+			}
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateRemoveCardCustomFieldValueRequest(req *pb_card.RemoveCardCustomFieldValueRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if req.CustomFieldID == 0 {
+		fieldErrors["CustomFieldID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CustomFieldID is required",
+			Field:   "CustomFieldID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateGetCardHistoryRequest(req *pb_card.GetCardHistoryRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if req.PageSize > 100 {
+		fieldErrors["PageSize"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrOutOfRange,
+			Message: "PageSize must be at most 100",
+			Field:   "PageSize",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateRestoreCardVersionRequest(req *pb_card.RestoreCardVersionRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.CardID == 0 {
+		fieldErrors["CardID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardID is required",
+			Field:   "CardID",
+		}
+	}
+
+	if req.Version == 0 {
+		fieldErrors["Version"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "Version is required",
+			Field:   "Version",
+		}
+	}
+
+	for _, field := range req.Fields {
+		if !slices.Contains(repositories.CardVersionFields, field) {
+			fieldErrors["Fields"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrInvalid,
+				Message: "Fields must be name, description, dates, labels or members",
+				Field:   "Fields",
+			}
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+// maxBulkCards bounds how many cards a bulk update holds locks on in one transaction
+const maxBulkCards = 100
+
+func validateBulkUpdateCardsRequest(req *pb_card.BulkUpdateCardsRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if len(req.CardIDs) == 0 {
+		fieldErrors["CardIDs"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CardIDs is required",
+			Field:   "CardIDs",
+		}
+	} else if len(req.CardIDs) > maxBulkCards {
+		fieldErrors["CardIDs"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrOutOfRange,
+			Message: fmt.Sprintf("CardIDs cannot contain more than %d cards", maxBulkCards),
+			Field:   "CardIDs",
+		}
+	}
+
+	seen := make(map[uint64]bool, len(req.CardIDs))
+	for _, cardID := range req.CardIDs {
+		if cardID == 0 || seen[cardID] {
+			fieldErrors["CardIDs"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrInvalid,
+				Message: "CardIDs must be distinct and non-zero",
+				Field:   "CardIDs",
+			}
+			break
+		}
+		seen[cardID] = true
+	}
+
+	switch req.Operation {
+	case bulkcardoperations.Move:
+		if req.ListID == 0 {
+			fieldErrors["ListID"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrRequired,
+				Message: "ListID is required",
+				Field:   "ListID",
+			}
+		}
+	case bulkcardoperations.AddLabel, bulkcardoperations.RemoveLabel:
+		if req.LabelID == 0 {
+			fieldErrors["LabelID"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrRequired,
+				Message: "LabelID is required",
+				Field:   "LabelID",
+			}
+		}
+	case bulkcardoperations.AddMember, bulkcardoperations.RemoveMember:
+		if req.UserID == 0 {
+			fieldErrors["UserID"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrRequired,
+				Message: "UserID is required",
+				Field:   "UserID",
+			}
+		}
+	case bulkcardoperations.SetDueDate:
+		if req.DueDate == nil {
+			fieldErrors["DueDate"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrRequired,
+				Message: "DueDate is required",
+				Field:   "DueDate",
+			}
+		}
+	case bulkcardoperations.Archive, bulkcardoperations.Restore, bulkcardoperations.Complete:
+	default:
+		fieldErrors["Operation"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "Operation must be move, archive, restore, add_label, remove_label, add_member, remove_member, set_due_date or complete",
+			Field:   "Operation",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateArchiveAllCardsInListRequest(req *pb_card.ArchiveAllCardsInListRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.ListID == 0 {
+		fieldErrors["ListID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "ListID is required",
+			Field:   "ListID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateMoveAllCardsInListRequest(req *pb_card.MoveAllCardsInListRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.ListID == 0 {
+		fieldErrors["ListID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "ListID is required",
+			Field:   "ListID",
+		}
+	}
+
+	if req.TargetListID == 0 {
+		fieldErrors["TargetListID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "TargetListID is required",
+			Field:   "TargetListID",
+		}
+	} else if req.TargetListID == req.ListID {
+		fieldErrors["TargetListID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "TargetListID must differ from ListID",
+			Field:   "TargetListID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateSortCardsInListRequest(req *pb_card.SortCardsInListRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.ListID == 0 {
+		fieldErrors["ListID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "ListID is required",
+			Field:   "ListID",
+		}
+	}
+
+	if !slices.Contains(repositories.CardSortFields, req.SortBy) {
+		fieldErrors["SortBy"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrInvalid,
+			Message: "SortBy must be due_date, created_at, name or custom_field",
+			Field:   "SortBy",
+		}
+	} else if req.SortBy == repositories.CardSortCustomField && req.CustomFieldID == 0 {
+		fieldErrors["CustomFieldID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "CustomFieldID is required when sorting by a custom field",
+			Field:   "CustomFieldID",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
+func validateImportCardsRequest(req *pb_card.ImportCardsRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.ListID == 0 {
+		fieldErrors["ListID"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "ListID is required",
+			Field:   "ListID",
+		}
+	}
+
+	if len(req.Content) == 0 {
+		fieldErrors["Content"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrRequired,
+			Message: "Content is required",
+			Field:   "Content",
+		}
+	} else if len(req.Content) > csvimport.MaxContentSize {
+		fieldErrors["Content"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrMaxLength,
+			Message: "Content must be at most 2 MB",
+			Field:   "Content",
+		}
+	}
+
+	for header, target := range req.Columns {
+		if !csvimport.IsValidTarget(target) {
+			fieldErrors["Columns"] = errorhandlers.FieldError{
+				Code:    fielderrors.ErrInvalid,
+				Message: fmt.Sprintf("Column %q must be mapped to name, description, labels, members, due_date or custom_field:<name>", header),
+				Field:   "Columns",
+			}
+			break
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
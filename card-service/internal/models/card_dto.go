@@ -5,34 +5,150 @@ import (
 )
 
 type CardDTO struct {
-	ID          uint64
-	ListID      uint64
-	BoardID     uint64
+	ID                uint64
+	ListID            uint64
+	BoardID           uint64
+	Name              string
+	Description       string
+	Position          int64
+	Version           uint64
+	Labels            []uint64
+	Members           []uint64
+	Attachments       []uint64
+	IsCompleted       bool
+	StartDate         *time.Time
+	DueDate           *time.Time
+	Reminders         []*CardReminderDTO
+	Recurrence        *CardRecurrenceDTO
+	CustomFieldValues []*CardCustomFieldValueDTO
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type CardReminderDTO struct {
+	ID            uint64
+	OffsetMinutes int64
+	NotifiedAt    *time.Time
+}
+
+type CardRecurrenceDTO struct {
+	Frequency    string
+	Interval     int
+	Weekdays     []string
+	MonthDay     int
+	TargetListID uint64
+	NextRunAt    time.Time
+}
+
+// CardCustomFieldValueDTO holds exactly one value, matching the custom field's type
+type CardCustomFieldValueDTO struct {
+	CustomFieldID uint64
+	TextValue     *string
+	NumberValue   *float64
+	DateValue     *time.Time
+	CheckboxValue *bool
+	OptionID      *uint64
+}
+
+// DueCardDTO describes a card whose due date reminder or overdue notice is pending
+type DueCardDTO struct {
+	CardID        uint64
+	BoardID       uint64
+	ListID        uint64
+	Name          string
+	DueDate       time.Time
+	ReminderID    uint64
+	OffsetMinutes int64
+	Members       []uint64
+}
+
+type CardMetaDTO struct {
+	ID                uint64
+	ListID            uint64
+	BoardID           uint64
+	Name              string
+	Position          int64
+	Version           uint64
+	Labels            []uint64
+	Members           []uint64
+	TotalAttachment   uint64
+	TotalComment      uint64
+	IsCompleted       bool
+	StartDate         *time.Time
+	DueDate           *time.Time
+	CustomFieldValues []*CardCustomFieldValueDTO
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type CommentDTO struct {
+	ID        uint64
+	CardID    uint64
+	ParentID  *uint64
+	UserID    uint64
+	Username  string
+	Content   string
+	Replies   []*CommentDTO
+	Reactions []*CommentReactionDTO
+	Mentions  []uint64
+	EditedAt  *time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CommentReactionDTO struct {
+	Emoji   string
+	UserIDs []uint64
+}
+
+type CommentEditDTO struct {
+	Content  string
+	EditedAt time.Time
+}
+
+type Pagination struct {
+	CurrentPage  uint64
+	TotalPages   uint64
+	ItemsPerPage uint64
+	TotalItems   uint64
+	HasMore      bool
+}
+
+// CardVersionDTO is a card as it was at a version, with the changes from the version before it
+type CardVersionDTO struct {
+	Version     uint64
 	Name        string
-	Position    int64
-	Labels      []uint64
-	Members     []uint64
-	Attachments []uint64
-	IsCompleted bool
+	Description string
 	StartDate   *time.Time
 	DueDate     *time.Time
+	Labels      []uint64
+	Members     []uint64
 	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	Changes     []*CardFieldChangeDTO // Empty for the oldest version kept
 }
 
-type CardMetaDTO struct {
-	ID              uint64
-	ListID          uint64
-	BoardID         uint64
-	Name            string
-	Position        int64
-	Labels          []uint64
-	Members         []uint64
-	TotalAttachment uint64
-	TotalComment    uint64
-	IsCompleted     bool
-	StartDate       *time.Time
-	DueDate         *time.Time
-	CreatedAt       time.Time
-	UpdatedAt       time.Time
+// CardFieldChangeDTO describes how a field changed. Name, description and dates have their old and
+// new values, labels and members the IDs added and removed.
+type CardFieldChangeDTO struct {
+	Field    string
+	OldValue string
+	NewValue string
+	Added    []uint64
+	Removed  []uint64
+}
+
+// WIPLimitWarningDTO reports a card added to a list already at its WIP limit on a board that only
+// warns about it
+type WIPLimitWarningDTO struct {
+	ListID    uint64
+	ListName  string
+	WIPLimit  int
+	CardCount int64 // Cards in the list including the ones added
+}
+
+// BulkCardResultDTO reports how a bulk operation went for one of its cards
+type BulkCardResultDTO struct {
+	CardID          uint64
+	Error           error // Nil when the card was updated
+	WIPLimitWarning *WIPLimitWarningDTO
 }
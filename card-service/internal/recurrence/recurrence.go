@@ -0,0 +1,148 @@
+package recurrence
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	Daily   = "daily"
+	Weekly  = "weekly"
+	Monthly = "monthly"
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Rule is the supported RRULE subset: every N days, every N weeks on the given weekdays, or every
+// N months on day N of the month. Days past the end of a shorter month fall on its last day.
+type Rule struct {
+	Frequency string
+	Interval  int
+	Weekdays  []time.Weekday
+	MonthDay  int
+}
+
+// ParseWeekdays converts RRULE weekday codes (MO, TU, ...) into weekdays
+func ParseWeekdays(codes []string) ([]time.Weekday, error) {
+	var weekdays []time.Weekday
+	for _, code := range codes {
+		weekday, ok := weekdayCodes[strings.ToUpper(strings.TrimSpace(code))]
+		if !ok {
+			return nil, errors.New("invalid weekday " + code)
+		}
+		weekdays = append(weekdays, weekday)
+	}
+	return weekdays, nil
+}
+
+// NewRule builds a rule from its stored form, weekdays being a comma separated list of codes
+func NewRule(frequency string, interval int, weekdays string, monthDay int) (*Rule, error) {
+	rule := &Rule{
+		Frequency: frequency,
+		Interval:  interval,
+		MonthDay:  monthDay,
+	}
+
+	if weekdays != "" {
+		parsed, err := ParseWeekdays(strings.Split(weekdays, ","))
+		if err != nil {
+			return nil, err
+		}
+		rule.Weekdays = parsed
+	}
+
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func (r *Rule) Validate() error {
+	if r.Interval < 1 || r.Interval > 365 {
+		return errors.New("interval must be between 1 and 365")
+	}
+
+	switch r.Frequency {
+	case Daily:
+	case Weekly:
+		if len(r.Weekdays) == 0 {
+			return errors.New("weekly recurrence requires at least one weekday")
+		}
+	case Monthly:
+		if r.MonthDay < 1 || r.MonthDay > 31 {
+			return errors.New("monthly recurrence requires a day between 1 and 31")
+		}
+	default:
+		return errors.New("frequency must be daily, weekly or monthly")
+	}
+
+	return nil
+}
+
+// Next returns the first occurrence strictly after the given time, keeping its time of day
+func (r *Rule) Next(after time.Time) time.Time {
+	switch r.Frequency {
+	case Weekly:
+		return r.nextWeekly(after)
+	case Monthly:
+		return r.nextMonthly(after)
+	default:
+		return after.AddDate(0, 0, r.Interval)
+	}
+}
+
+func (r *Rule) nextWeekly(after time.Time) time.Time {
+	days := make(map[time.Weekday]bool)
+	for _, weekday := range r.Weekdays {
+		days[weekday] = true
+	}
+
+	weekStart := startOfWeek(after)
+
+	// Within at most Interval+1 weeks one of the weekdays is reached
+	for i := 1; i <= 7*(r.Interval+1); i++ {
+		candidate := after.AddDate(0, 0, i)
+		weeks := int(startOfWeek(candidate).Sub(weekStart).Hours()/24+0.5) / 7
+		if weeks%r.Interval == 0 && days[candidate.Weekday()] {
+			return candidate
+		}
+	}
+
+	return after.AddDate(0, 0, 7*r.Interval)
+}
+
+func (r *Rule) nextMonthly(after time.Time) time.Time {
+	// The day may still be ahead in the current month
+	if candidate := r.monthDayOf(after, 0); candidate.After(after) {
+		return candidate
+	}
+	return r.monthDayOf(after, r.Interval)
+}
+
+func (r *Rule) monthDayOf(t time.Time, monthOffset int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(monthOffset), 1, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	day := r.MonthDay
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+// startOfWeek returns the Monday starting the week of t
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
@@ -2,13 +2,21 @@ package repositories
 
 import (
 	"errors"
-	"sort"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/common/positions"
+	"github.com/sm888sm/halten-backend/common/transactions"
+	"github.com/sm888sm/halten-backend/common/undo"
 
 	internal_models "github.com/sm888sm/halten-backend/card-service/internal/models"
+	"github.com/sm888sm/halten-backend/card-service/internal/recurrence"
 	models "github.com/sm888sm/halten-backend/models"
 )
 
@@ -23,22 +31,32 @@ func NewCardRepository(db *gorm.DB) *GormCardRepository {
 func (r *GormCardRepository) CreateCard(req *CreateCardRequest) (*CreateCardResponse, error) {
 	var res CreateCardResponse
 
-	var maxPosition int64
-	if err := r.db.Model(&models.Card{}).Where("list_id = ?", req.Card.ListID).Select("max(position)").Row().Scan(&maxPosition); err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errorhandlers.NewGrpcInternalError()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := r.lockList(tx, req.Card.ListID); err != nil {
+			return err
 		}
-		// If no other card exists, set maxPosition to 0
-		maxPosition = 0
-	}
 
-	req.Card.Position = maxPosition + 1
+		warning, err := r.checkWIPLimit(tx, req.Card.ListID, 1)
+		if err != nil {
+			return err
+		}
+		res.WIPLimitWarning = warning
+
+		sequence := &positions.Sequence{Table: "cards", Column: "list_id", ParentID: req.Card.ListID}
+		position, err := sequence.Append(tx)
+		if err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		req.Card.Position = position
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Create(req.Card).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
 
+		if err := r.recordCardVersion(tx, req.Card.ID); err != nil {
+			return err
+		}
+
 		res.Card = req.Card
 		return nil
 	})
@@ -69,20 +87,55 @@ func (r *GormCardRepository) GetCardByID(req *GetCardByIDRequest) (*GetCardByIDR
 	var attachmentIDs []uint64
 	r.db.Model(&models.Attachment{}).Where("card_id = ?", card.ID).Pluck("id", &attachmentIDs)
 
+	var reminders []*models.CardReminder
+	r.db.Where("card_id = ?", card.ID).Order("offset_minutes").Find(&reminders)
+
+	var reminderDTOs []*internal_models.CardReminderDTO
+	for _, reminder := range reminders {
+		reminderDTOs = append(reminderDTOs, &internal_models.CardReminderDTO{
+			ID:            reminder.ID,
+			OffsetMinutes: reminder.OffsetMinutes,
+			NotifiedAt:    reminder.NotifiedAt,
+		})
+	}
+
+	customFieldValueDTOs, err := r.getCardCustomFieldValues(r.db, card.ID)
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	var recurrenceDTO *internal_models.CardRecurrenceDTO
+	var cardRecurrence models.CardRecurrence
+	if err := r.db.Where("card_id = ?", card.ID).First(&cardRecurrence).Error; err == nil {
+		recurrenceDTO = &internal_models.CardRecurrenceDTO{
+			Frequency:    cardRecurrence.Frequency,
+			Interval:     cardRecurrence.Interval,
+			Weekdays:     splitWeekdays(cardRecurrence.Weekdays),
+			MonthDay:     cardRecurrence.MonthDay,
+			TargetListID: cardRecurrence.TargetListID,
+			NextRunAt:    cardRecurrence.NextRunAt,
+		}
+	}
+
 	cardDTO := &internal_models.CardDTO{
-		ID:          card.ID,
-		ListID:      card.ListID,
-		BoardID:     card.BoardID,
-		Name:        card.Name,
-		Position:    card.Position,
-		Labels:      labelIDs,
-		Members:     memberIDs,
-		Attachments: attachmentIDs,
-		IsCompleted: card.IsCompleted,
-		StartDate:   card.StartDate,
-		DueDate:     card.DueDate,
-		CreatedAt:   card.CreatedAt,
-		UpdatedAt:   card.UpdatedAt,
+		ID:                card.ID,
+		ListID:            card.ListID,
+		BoardID:           card.BoardID,
+		Name:              card.Name,
+		Description:       card.Description,
+		Position:          card.Position,
+		Version:           card.Version,
+		Labels:            labelIDs,
+		Members:           memberIDs,
+		Attachments:       attachmentIDs,
+		IsCompleted:       card.IsCompleted,
+		StartDate:         card.StartDate,
+		DueDate:           card.DueDate,
+		Reminders:         reminderDTOs,
+		Recurrence:        recurrenceDTO,
+		CustomFieldValues: customFieldValueDTOs,
+		CreatedAt:         card.CreatedAt,
+		UpdatedAt:         card.UpdatedAt,
 	}
 
 	return &GetCardByIDResponse{Card: cardDTO}, nil
@@ -91,9 +144,22 @@ func (r *GormCardRepository) GetCardByID(req *GetCardByIDRequest) (*GetCardByIDR
 func (r *GormCardRepository) GetCardsByList(req *GetCardsByListRequest) (*GetCardsByListResponse, error) {
 	var cardDTOs []*internal_models.CardMetaDTO
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
+	var list models.List
+	if err := r.db.Select("id", "board_id").First(&list, req.ListID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorhandlers.NewGrpcNotFoundError("List not found")
+		}
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	customFieldScope, err := r.customFieldScope(list.BoardID, req.Filters, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
 		var cards []*models.Card
-		if err := tx.Where("list_id = ? AND archived = false", req.ListID).Find(&cards).Error; err != nil {
+		if err := tx.Select("cards.*").Where("cards.list_id = ? AND cards.is_archived = false", req.ListID).Scopes(customFieldScope).Find(&cards).Error; err != nil {
 			return err
 		}
 
@@ -118,21 +184,28 @@ func (r *GormCardRepository) GetCardsByList(req *GetCardsByListRequest) (*GetCar
 				return err
 			}
 
+			customFieldValueDTOs, err := r.getCardCustomFieldValues(tx, card.ID)
+			if err != nil {
+				return err
+			}
+
 			cardDTO := &internal_models.CardMetaDTO{
-				ID:              card.ID,
-				ListID:          card.ListID,
-				BoardID:         card.BoardID,
-				Name:            card.Name,
-				Position:        card.Position,
-				IsCompleted:     card.IsCompleted,
-				StartDate:       card.StartDate,
-				DueDate:         card.DueDate,
-				CreatedAt:       card.CreatedAt,
-				UpdatedAt:       card.UpdatedAt,
-				Labels:          labelIDs,
-				Members:         memberIDs,
-				TotalAttachment: uint64(totalAttachment),
-				TotalComment:    uint64(totalComment),
+				ID:                card.ID,
+				ListID:            card.ListID,
+				BoardID:           card.BoardID,
+				Name:              card.Name,
+				Position:          card.Position,
+				Version:           card.Version,
+				IsCompleted:       card.IsCompleted,
+				StartDate:         card.StartDate,
+				DueDate:           card.DueDate,
+				CreatedAt:         card.CreatedAt,
+				UpdatedAt:         card.UpdatedAt,
+				Labels:            labelIDs,
+				Members:           memberIDs,
+				TotalAttachment:   uint64(totalAttachment),
+				TotalComment:      uint64(totalComment),
+				CustomFieldValues: customFieldValueDTOs,
 			}
 			cardDTOs = append(cardDTOs, cardDTO)
 		}
@@ -150,9 +223,14 @@ func (r *GormCardRepository) GetCardsByList(req *GetCardsByListRequest) (*GetCar
 func (r *GormCardRepository) GetCardsByBoard(req *GetCardsByBoardRequest) (*GetCardsByBoardResponse, error) {
 	var cardDTOs []*internal_models.CardMetaDTO
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
+	customFieldScope, err := r.customFieldScope(req.BoardID, req.Filters, req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
 		var cards []*models.Card
-		err := tx.Where("list_id = ? AND archived = false", req.BoardID).Find(&cards).Error
+		err := tx.Select("cards.*").Where("cards.board_id = ? AND cards.is_archived = false", req.BoardID).Scopes(customFieldScope).Find(&cards).Error
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				// Handle not found error
@@ -184,21 +262,28 @@ func (r *GormCardRepository) GetCardsByBoard(req *GetCardsByBoardRequest) (*GetC
 				return err
 			}
 
+			customFieldValueDTOs, err := r.getCardCustomFieldValues(tx, card.ID)
+			if err != nil {
+				return err
+			}
+
 			cardDTO := &internal_models.CardMetaDTO{
-				ID:              card.ID,
-				ListID:          card.ListID,
-				BoardID:         card.BoardID,
-				Name:            card.Name,
-				Position:        card.Position,
-				IsCompleted:     card.IsCompleted,
-				StartDate:       card.StartDate,
-				DueDate:         card.DueDate,
-				CreatedAt:       card.CreatedAt,
-				UpdatedAt:       card.UpdatedAt,
-				Labels:          labelIDs,
-				Members:         memberIDs,
-				TotalAttachment: uint64(totalAttachment),
-				TotalComment:    uint64(totalComment),
+				ID:                card.ID,
+				ListID:            card.ListID,
+				BoardID:           card.BoardID,
+				Name:              card.Name,
+				Position:          card.Position,
+				Version:           card.Version,
+				IsCompleted:       card.IsCompleted,
+				StartDate:         card.StartDate,
+				DueDate:           card.DueDate,
+				CreatedAt:         card.CreatedAt,
+				UpdatedAt:         card.UpdatedAt,
+				Labels:            labelIDs,
+				Members:           memberIDs,
+				TotalAttachment:   uint64(totalAttachment),
+				TotalComment:      uint64(totalComment),
+				CustomFieldValues: customFieldValueDTOs,
 			}
 			cardDTOs = append(cardDTOs, cardDTO)
 		}
@@ -213,86 +298,76 @@ func (r *GormCardRepository) GetCardsByBoard(req *GetCardsByBoardRequest) (*GetC
 	return &GetCardsByBoardResponse{Cards: cardDTOs}, nil
 }
 
-func (r *GormCardRepository) MoveCardPosition(req *MoveCardPositionRequest) error {
-	var count int64
-	r.db.Model(&models.Card{}).Where("id = ? AND list_id = ? AND board_id = ?", req.CardID, req.OldListID, req.BoardID).Count(&count)
-	if count == 0 {
-		return errorhandlers.NewGrpcNotFoundError("Card not found")
-	}
+func (r *GormCardRepository) MoveCardPosition(req *MoveCardPositionRequest) (*MoveCardPositionResponse, error) {
+	var res MoveCardPositionResponse
 
-	return r.db.Transaction(func(tx *gorm.DB) error {
-		// Get all cards in the old list
-		var oldCards []*models.Card
-		if err := tx.Where("list_id = ?", req.OldListID).Find(&oldCards).Error; err != nil {
-			return errorhandlers.NewGrpcInternalError()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.lockCard(tx, req.CardID, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
 		}
-
-		// Sort cards by position
-		sort.Slice(oldCards, func(i, j int) bool {
-			return oldCards[i].Position < oldCards[j].Position
-		})
-
-		// Find the card to be moved
-		var movingCard *models.Card
-		for i, c := range oldCards {
-			if c.ID == req.CardID {
-				movingCard = c
-				oldCards = append(oldCards[:i], oldCards[i+1:]...)
-				break
-			}
+		if card.ListID != req.OldListID {
+			return errorhandlers.NewGrpcNotFoundError("Card not found")
 		}
 
-		// Update the positions of the remaining cards in the old list
-		for i, c := range oldCards {
-			c.Position = int64(i + 1)
-			if err := tx.Save(&c).Error; err != nil {
+		if req.NewListID != req.OldListID {
+			if err := r.checkListExistsAndBelongsToBoard(tx, req.NewListID, req.BoardID); err != nil {
 				return err
 			}
 		}
 
-		// If the card is moving to a different list, update the positions in the new list
-		if req.OldListID != req.NewListID {
-			var newCards []*models.Card
-			if err := tx.Where("list_id = ?", req.NewListID).Find(&newCards).Error; err != nil {
-				return errorhandlers.NewGrpcInternalError()
-			}
-
-			// Sort cards by position
-			sort.Slice(newCards, func(i, j int) bool {
-				return newCards[i].Position < newCards[j].Position
-			})
+		// Moves into the same list are serialized on the list row; only the moved card is written
+		if err := r.lockList(tx, req.NewListID); err != nil {
+			return err
+		}
 
-			// Insert the card at the new position and update the positions of the other cards
-			newCards = append(newCards, nil)
-			copy(newCards[req.Position+1:], newCards[req.Position:])
-			newCards[req.Position] = movingCard
-			for i, c := range newCards {
-				c.Position = int64(i + 1)
-				if err := tx.Save(&c).Error; err != nil {
-					return err
-				}
-			}
-		} else { // If the card is moving within the same list, just update its position
-			movingCard.Position = req.Position
-			if err := tx.Save(&movingCard).Error; err != nil {
+		// Reordering within a list doesn't change how many cards it holds
+		if req.NewListID != card.ListID {
+			warning, err := r.checkWIPLimit(tx, req.NewListID, 1)
+			if err != nil {
 				return err
 			}
+			res.WIPLimitWarning = warning
 		}
 
-		return nil
+		sequence := &positions.Sequence{Table: "cards", Column: "list_id", ParentID: req.NewListID}
+		position, err := sequence.Place(tx, card.ID, req.Position)
+		if err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		previous, version := undo.Move{ListID: card.ListID, Position: card.Position}, card.Version+1
+		if err := tx.Model(card).Updates(map[string]interface{}{"list_id": req.NewListID, "position": position, "version": version}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    req.BoardID,
+			EntityType: undo.EntityCard,
+			EntityID:   card.ID,
+			Action:     undo.ActionMove,
+			Version:    version,
+			Inverse:    &previous,
+		})
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
 }
 
 func (r *GormCardRepository) UpdateCardName(req *UpdateCardNameRequest) error {
 
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		card, err := r.lockCard(tx, req.CardID, req.BoardID, req.ExpectedVersion)
 		if err != nil {
 			return err
 		}
 
 		if card.Name != req.Name {
-			db := tx.Model(card).Update("Name", req.Name)
+			db := tx.Model(card).Updates(map[string]interface{}{"name": req.Name, "version": card.Version + 1})
 			if db.Error != nil {
 				return errorhandlers.NewGrpcInternalError()
 			}
@@ -300,25 +375,29 @@ func (r *GormCardRepository) UpdateCardName(req *UpdateCardNameRequest) error {
 			if db.RowsAffected == 0 {
 				return errorhandlers.NewGrpcNotFoundError("No card found to update")
 			}
+
+			if err := r.recordCardVersion(tx, card.ID); err != nil {
+				return err
+			}
 		}
 
 		return nil
 	})
 }
 
-func (r *GormCardRepository) UpdateCardDescription(req *UpdateCardDescriptionRequest) error {
+func (r *GormCardRepository) UpdateCardDescription(req *UpdateCardDescriptionRequest) (*UpdateCardDescriptionResponse, error) {
+	res := &UpdateCardDescriptionResponse{}
 
-	return r.db.Transaction(func(tx *gorm.DB) error {
-		card := &models.Card{BaseModel: models.BaseModel{ID: req.CardID}, BoardID: req.BoardID}
-		if err := tx.First(card).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errorhandlers.NewGrpcNotFoundError("Card not found")
-			}
-			return errorhandlers.NewGrpcInternalError()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.lockCard(tx, req.CardID, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
 		}
 
+		res.Version = card.Version
 		if card.Description != req.Description {
-			db := tx.Model(card).Update("Description", req.Description)
+			res.Version++
+			db := tx.Model(card).Updates(map[string]interface{}{"description": req.Description, "version": res.Version})
 			if db.Error != nil {
 				return errorhandlers.NewGrpcInternalError()
 			}
@@ -326,10 +405,20 @@ func (r *GormCardRepository) UpdateCardDescription(req *UpdateCardDescriptionReq
 			if db.RowsAffected == 0 {
 				return errorhandlers.NewGrpcNotFoundError("No card found to update")
 			}
+
+			if err := r.recordCardVersion(tx, card.ID); err != nil {
+				return err
+			}
 		}
 
 		return nil
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
 }
 
 func (r *GormCardRepository) AddCardLabel(req *AddCardLabelRequest) error {
@@ -344,7 +433,11 @@ func (r *GormCardRepository) AddCardLabel(req *AddCardLabelRequest) error {
 			return errorhandlers.NewGrpcInternalError()
 		}
 
-		return nil
+		if err := r.bumpCardVersion(tx, req.CardID); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return r.recordCardVersion(tx, req.CardID)
 	})
 }
 
@@ -374,44 +467,66 @@ func (r *GormCardRepository) RemoveCardLabel(req *RemoveCardLabelRequest) error
 			return errorhandlers.NewGrpcInternalError()
 		}
 
-		return nil
+		if err := r.bumpCardVersion(tx, req.CardID); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return r.recordCardVersion(tx, req.CardID)
 	})
 }
 
 func (r *GormCardRepository) SetCardDates(req *SetCardDatesRequest) error {
 	// Ensure startDate is no later than dueDate
-	if req.StartDate == nil && req.DueDate == nil && req.StartDate.After(*req.DueDate) {
+	if req.StartDate != nil && req.DueDate != nil && req.StartDate.After(*req.DueDate) {
 		return errorhandlers.NewGrpcBadRequestError("Start date cannot be later than due date")
 	}
 
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		card, err := r.lockCard(tx, req.CardID, req.BoardID, req.ExpectedVersion)
 		if err != nil {
 			return err
 		}
 
 		changes := false
 
-		if card.StartDate != req.StartDate {
+		if !sameTime(card.StartDate, req.StartDate) {
 			card.StartDate = req.StartDate
 			changes = true
 		}
 
-		if card.DueDate != req.DueDate {
+		if !sameTime(card.DueDate, req.DueDate) {
 			card.DueDate = req.DueDate
 			changes = true
+
+			// A new due date re-arms the reminders and the overdue notice
+			card.OverdueNotifiedAt = nil
+			if err := tx.Model(&models.CardReminder{}).Where("card_id = ?", card.ID).Update("notified_at", nil).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+
+			// The next copy of a recurring card is created once its new due date passes
+			if card.DueDate != nil {
+				if err := tx.Model(&models.CardRecurrence{}).Where("card_id = ?", card.ID).Update("next_run_at", *card.DueDate).Error; err != nil {
+					return errorhandlers.NewGrpcInternalError()
+				}
+			}
 		}
 
 		// If both startDate and dueDate are unset, unmark the card as complete
-		if req.StartDate.IsZero() && req.DueDate.IsZero() && card.IsCompleted {
+		if (req.StartDate == nil || req.StartDate.IsZero()) && (req.DueDate == nil || req.DueDate.IsZero()) && card.IsCompleted {
 			card.IsCompleted = false
 			changes = true
 		}
 
 		if changes {
+			card.Version++
 			if err := tx.Save(card).Error; err != nil {
 				return errorhandlers.NewGrpcInternalError()
 			}
+
+			if err := r.recordCardVersion(tx, card.ID); err != nil {
+				return err
+			}
 		}
 
 		return nil
@@ -420,7 +535,7 @@ func (r *GormCardRepository) SetCardDates(req *SetCardDatesRequest) error {
 
 func (r *GormCardRepository) ToggleCardCompleted(req *ToggleCardCompletedRequest) error {
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		card, err := r.lockCard(tx, req.CardID, req.BoardID, req.ExpectedVersion)
 		if err != nil {
 			return err
 		}
@@ -431,11 +546,27 @@ func (r *GormCardRepository) ToggleCardCompleted(req *ToggleCardCompletedRequest
 		}
 
 		card.IsCompleted = !card.IsCompleted
+		card.Version++
 
 		if err := tx.Save(card).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
 
+		// Completing a recurring card creates its next occurrence right away
+		if card.IsCompleted {
+			var cardRecurrence models.CardRecurrence
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("card_id = ?", card.ID).First(&cardRecurrence).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil
+				}
+				return errorhandlers.NewGrpcInternalError()
+			}
+
+			if _, err := r.createRecurringCardCopy(tx, card, &cardRecurrence, time.Now()); err != nil {
+				return err
+			}
+		}
+
 		return nil
 	})
 }
@@ -507,20 +638,39 @@ func (r *GormCardRepository) RemoveCardAttachment(req *RemoveCardAttachmentReque
 	})
 }
 
-func (r *GormCardRepository) AddCardComment(req *AddCardCommentRequest) error {
-	return r.db.Transaction(func(tx *gorm.DB) error {
-		_, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+func (r *GormCardRepository) AddCardComment(req *AddCardCommentRequest) (*AddCardCommentResponse, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
 		if err != nil {
 			return err
 		}
 
 		req.Comment.CardID = req.CardID
 		req.Comment.UserID = req.UserID
+
+		// Threads are one level deep, so replies to a reply join the top-level comment
+		if req.Comment.ParentID != nil {
+			parent, err := r.checkCommentExistsAndBelongsToCard(tx, *req.Comment.ParentID, card.ID)
+			if err != nil {
+				return err
+			}
+			if parent.ParentID != nil {
+				req.Comment.ParentID = parent.ParentID
+			}
+		}
+
 		if err := tx.Create(&req.Comment).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
-		return nil
+
+		return r.syncCommentMentions(tx, card, &req.Comment, req.MentionedUserIDs)
 	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &AddCardCommentResponse{CommentID: req.Comment.ID}, nil
 }
 
 func (r *GormCardRepository) RemoveCardComment(req *RemoveCardCommentRequest) error {
@@ -544,6 +694,11 @@ func (r *GormCardRepository) RemoveCardComment(req *RemoveCardCommentRequest) er
 		// 	return errorhandlers.NewAPIError(httpcodes.ErrUnauthorized, "You are not authorized to delete this comment")
 		// }
 
+		// Replies go along with the comment they belong to
+		if err := tx.Where("parent_id = ?", comment.ID).Delete(&models.Comment{}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
 		if err := tx.Delete(comment).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
@@ -572,10 +727,16 @@ func (r *GormCardRepository) AddCardMembers(req *AddCardMembersRequest) error {
 			}
 
 			// Add user to card
-			tx.Model(&card).Association("Members").Append(&user)
+			if err := tx.Where(models.CardMember{CardID: card.ID, UserID: user.ID}).FirstOrCreate(&models.CardMember{}).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
 		}
 
-		return nil
+		if err := r.bumpCardVersion(tx, card.ID); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return r.recordCardVersion(tx, card.ID)
 	})
 }
 
@@ -599,56 +760,1398 @@ func (r *GormCardRepository) RemoveCardMembers(req *RemoveCardMembersRequest) er
 			}
 
 			// Remove user from card
-			tx.Model(&card).Association("Members").Delete(&user)
+			if err := tx.Where("card_id = ? AND user_id = ?", card.ID, user.ID).Delete(&models.CardMember{}).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
 		}
 
-		return nil
+		if err := r.bumpCardVersion(tx, card.ID); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return r.recordCardVersion(tx, card.ID)
 	})
 }
 
 func (r *GormCardRepository) ArchiveCard(req *ArchiveCardRequest) error {
 
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		card, err := r.lockCard(tx, req.CardID, req.BoardID, req.ExpectedVersion)
 		if err != nil {
 			return err
 		}
 
 		card.IsArchived = true
+		card.Version++
 		if err := tx.Save(card).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
 
-		return nil
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    req.BoardID,
+			EntityType: undo.EntityCard,
+			EntityID:   card.ID,
+			Action:     undo.ActionArchive,
+			Version:    card.Version,
+		})
 	})
 }
 
 func (r *GormCardRepository) RestoreCard(req *RestoreCardRequest) error {
 
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		card, err := r.lockCard(tx, req.CardID, req.BoardID, req.ExpectedVersion)
 		if err != nil {
 			return err
 		}
 
 		card.IsArchived = false
+		card.Version++
 		if err := tx.Save(card).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
 
-		return nil
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    req.BoardID,
+			EntityType: undo.EntityCard,
+			EntityID:   card.ID,
+			Action:     undo.ActionRestore,
+			Version:    card.Version,
+		})
 	})
 }
 
 func (r *GormCardRepository) DeleteCard(req *DeleteCardRequest) error {
 
+	return transactions.Join(r.db, req.Tx, func(tx *gorm.DB) error {
+		now := undo.Timestamp()
+		result := tx.Model(&models.Card{}).Where("id = ? AND board_id = ? AND is_archived = ?", req.CardID, req.BoardID, true).UpdateColumn("deleted_at", now)
+		if result.Error != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if result.RowsAffected == 0 {
+			return errorhandlers.NewGrpcNotFoundError("Card not found or not archived")
+		}
+
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    req.BoardID,
+			EntityType: undo.EntityCard,
+			EntityID:   req.CardID,
+			Action:     undo.ActionDelete,
+			Inverse:    &undo.Delete{DeletedAt: now},
+		})
+	})
+}
+
+func (r *GormCardRepository) SetCardReminders(req *SetCardRemindersRequest) error {
+
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Where("id = ? AND board_id = ? archived = true", req.CardID, req.BoardID).Delete(&models.Card{}).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errorhandlers.NewGrpcNotFoundError("Card not found")
-			}
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		// Replace the card's reminder settings as a whole
+		if err := tx.Unscoped().Where("card_id = ?", card.ID).Delete(&models.CardReminder{}).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
+
+		for _, offset := range req.OffsetMinutes {
+			reminder := models.CardReminder{
+				CardID:        card.ID,
+				OffsetMinutes: offset,
+			}
+			if err := tx.Create(&reminder).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+		}
+
+		return nil
+	})
+}
+
+func (r *GormCardRepository) GetDueSoonCards(req *GetDueCardsRequest) (*GetDueCardsResponse, error) {
+	var dueCards []*internal_models.DueCardDTO
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table("card_reminders").
+			Select("card_reminders.id AS reminder_id, card_reminders.offset_minutes, cards.id AS card_id, cards.board_id, cards.list_id, cards.name, cards.due_date").
+			Joins("JOIN cards ON cards.id = card_reminders.card_id AND cards.deleted_at IS NULL").
+			Where("card_reminders.deleted_at IS NULL AND card_reminders.notified_at IS NULL").
+			Where("cards.due_date IS NOT NULL AND cards.is_completed = false AND cards.is_archived = false").
+			Where("cards.due_date > ? AND cards.due_date - card_reminders.offset_minutes * interval '1 minute' <= ?", req.Now, req.Now).
+			Scan(&dueCards).Error; err != nil {
+			return err
+		}
+
+		for _, dueCard := range dueCards {
+			memberIDs, err := r.getCardMemberUserIDs(tx, dueCard.CardID)
+			if err != nil {
+				return err
+			}
+			dueCard.Members = memberIDs
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return &GetDueCardsResponse{Cards: dueCards}, nil
+}
+
+func (r *GormCardRepository) GetOverdueCards(req *GetDueCardsRequest) (*GetDueCardsResponse, error) {
+	var dueCards []*internal_models.DueCardDTO
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var cards []*models.Card
+		query := tx.Where("due_date IS NOT NULL AND due_date <= ? AND overdue_notified_at IS NULL AND is_completed = false AND is_archived = false", req.Now).
+			Where("id > ?", req.AfterID).
+			Order("id")
+		if req.Limit > 0 {
+			query = query.Limit(req.Limit)
+		}
+		if err := query.Find(&cards).Error; err != nil {
+			return err
+		}
+
+		for _, card := range cards {
+			memberIDs, err := r.getCardMemberUserIDs(tx, card.ID)
+			if err != nil {
+				return err
+			}
+
+			dueCards = append(dueCards, &internal_models.DueCardDTO{
+				CardID:  card.ID,
+				BoardID: card.BoardID,
+				ListID:  card.ListID,
+				Name:    card.Name,
+				DueDate: *card.DueDate,
+				Members: memberIDs,
+			})
+		}
+
 		return nil
 	})
+
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return &GetDueCardsResponse{Cards: dueCards}, nil
+}
+
+func (r *GormCardRepository) MarkCardDueNotified(req *MarkCardDueNotifiedRequest) error {
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var result *gorm.DB
+		if req.ReminderID != 0 {
+			result = tx.Model(&models.CardReminder{}).
+				Where("id = ? AND card_id = ? AND notified_at IS NULL", req.ReminderID, req.CardID).
+				Update("notified_at", req.Now)
+		} else {
+			result = tx.Model(&models.Card{}).
+				Where("id = ? AND overdue_notified_at IS NULL", req.CardID).
+				Update("overdue_notified_at", req.Now)
+		}
+
+		if result.Error != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		// Already handled, don't notify the members twice
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		activityLog := models.ActivityLog{
+			BoardID:    req.BoardID,
+			ActionType: req.ActionType,
+			Details:    req.Details,
+		}
+		if err := tx.Create(&activityLog).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		memberIDs, err := r.getCardMemberUserIDs(tx, req.CardID)
+		if err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		for _, userID := range memberIDs {
+			notification := models.Notification{
+				ActivityLogID: activityLog.ID,
+				UserID:        userID,
+			}
+			if err := tx.Create(&notification).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+		}
+
+		if err := req.Event.Write(tx, req.CardID); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+}
+
+func (r *GormCardRepository) SetCardRecurrence(req *SetCardRecurrenceRequest) error {
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		targetListID := req.TargetListID
+		if targetListID == 0 {
+			targetListID = card.ListID
+		}
+		if err := r.checkListExistsAndBelongsToBoard(tx, targetListID, card.BoardID); err != nil {
+			return err
+		}
+
+		weekdays := make([]string, len(req.Weekdays))
+		for i, weekday := range req.Weekdays {
+			weekdays[i] = strings.ToUpper(strings.TrimSpace(weekday))
+		}
+
+		rule, err := recurrence.NewRule(req.Frequency, req.Interval, strings.Join(weekdays, ","), req.MonthDay)
+		if err != nil {
+			return errorhandlers.NewGrpcBadRequestError(err.Error())
+		}
+
+		var cardRecurrence models.CardRecurrence
+		if err := tx.Where("card_id = ?", card.ID).First(&cardRecurrence).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorhandlers.NewGrpcInternalError()
+			}
+			cardRecurrence.CardID = card.ID
+		}
+
+		cardRecurrence.Frequency = rule.Frequency
+		cardRecurrence.Interval = rule.Interval
+		cardRecurrence.Weekdays = strings.Join(weekdays, ",")
+		cardRecurrence.MonthDay = rule.MonthDay
+		cardRecurrence.TargetListID = targetListID
+
+		// Dated cards recur once their due date passes, undated ones on the rule alone
+		if anchor := recurrenceAnchor(card); anchor != nil {
+			cardRecurrence.NextRunAt = *anchor
+		} else {
+			cardRecurrence.NextRunAt = rule.Next(time.Now())
+		}
+
+		if err := tx.Save(&cardRecurrence).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+}
+
+func (r *GormCardRepository) RemoveCardRecurrence(req *RemoveCardRecurrenceRequest) error {
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("card_id = ?", card.ID).Delete(&models.CardRecurrence{})
+		if result.Error != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if result.RowsAffected == 0 {
+			return errorhandlers.NewGrpcNotFoundError("Card recurrence not found")
+		}
+
+		return nil
+	})
+}
+
+func (r *GormCardRepository) CreateDueRecurringCards(req *CreateDueRecurringCardsRequest) (*CreateDueRecurringCardsResponse, error) {
+	var recurrenceIDs []uint64
+	if err := r.db.Model(&models.CardRecurrence{}).Where("next_run_at <= ?", req.Now).Pluck("id", &recurrenceIDs).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	var res CreateDueRecurringCardsResponse
+
+	// One transaction per series so a broken one doesn't hold back the others
+	for _, recurrenceID := range recurrenceIDs {
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			var cardRecurrence models.CardRecurrence
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("id = ? AND next_run_at <= ?", recurrenceID, req.Now).
+				First(&cardRecurrence).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					// Handled meanwhile by a completion
+					return nil
+				}
+				return err
+			}
+
+			var card models.Card
+			if err := tx.First(&card, cardRecurrence.CardID).Error; err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					// The series ends with its card
+					return tx.Unscoped().Delete(&cardRecurrence).Error
+				}
+				return err
+			}
+
+			cardCopy, err := r.createRecurringCardCopy(tx, &card, &cardRecurrence, req.Now)
+			if err != nil {
+				return err
+			}
+
+			res.CardIDs = append(res.CardIDs, cardCopy.ID)
+			return nil
+		})
+
+		if err != nil {
+			return &res, errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	return &res, nil
+}
+
+// createRecurringCardCopy creates the next occurrence of a recurring card with its dates shifted
+// to the next occurrence after now, and moves the recurrence over to the copy. Checklists are not
+// modelled yet, so only labels, members and reminders are carried over.
+func (r *GormCardRepository) createRecurringCardCopy(tx *gorm.DB, card *models.Card, cardRecurrence *models.CardRecurrence, now time.Time) (*models.Card, error) {
+	rule, err := recurrence.NewRule(cardRecurrence.Frequency, cardRecurrence.Interval, cardRecurrence.Weekdays, cardRecurrence.MonthDay)
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	// Fall back to the card's list when the target list is gone
+	listID := cardRecurrence.TargetListID
+	if err := r.checkListExistsAndBelongsToBoard(tx, listID, card.BoardID); err != nil {
+		listID = card.ListID
+	}
+
+	if err := r.lockList(tx, listID); err != nil {
+		return nil, err
+	}
+
+	sequence := &positions.Sequence{Table: "cards", Column: "list_id", ParentID: listID}
+	position, err := sequence.Append(tx)
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	cardCopy := &models.Card{
+		BoardID:     card.BoardID,
+		ListID:      listID,
+		Name:        card.Name,
+		Description: card.Description,
+		Position:    position,
+	}
+
+	if anchor := recurrenceAnchor(card); anchor != nil {
+		// Skip occurrences missed while the card was open
+		next := rule.Next(*anchor)
+		for !next.After(now) {
+			next = rule.Next(next)
+		}
+
+		shift := next.Sub(*anchor)
+		if card.StartDate != nil {
+			startDate := card.StartDate.Add(shift)
+			cardCopy.StartDate = &startDate
+		}
+		if card.DueDate != nil {
+			dueDate := card.DueDate.Add(shift)
+			cardCopy.DueDate = &dueDate
+		}
+
+		cardRecurrence.NextRunAt = next
+	} else {
+		next := rule.Next(cardRecurrence.NextRunAt)
+		for !next.After(now) {
+			next = rule.Next(next)
+		}
+
+		cardRecurrence.NextRunAt = next
+	}
+
+	if err := tx.Create(cardCopy).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	var labels []models.Label
+	if err := tx.Model(card).Association("Labels").Find(&labels); err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+	if len(labels) > 0 {
+		if err := tx.Model(cardCopy).Association("Labels").Append(&labels); err != nil {
+			return nil, errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	memberIDs, err := r.getCardMemberUserIDs(tx, card.ID)
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+	for _, userID := range memberIDs {
+		if err := tx.Create(&models.CardMember{CardID: cardCopy.ID, UserID: userID}).Error; err != nil {
+			return nil, errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	if err := r.recordCardVersion(tx, cardCopy.ID); err != nil {
+		return nil, err
+	}
+
+	var reminders []models.CardReminder
+	if err := tx.Where("card_id = ?", card.ID).Find(&reminders).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+	for _, reminder := range reminders {
+		if err := tx.Create(&models.CardReminder{CardID: cardCopy.ID, OffsetMinutes: reminder.OffsetMinutes}).Error; err != nil {
+			return nil, errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	cardRecurrence.CardID = cardCopy.ID
+	if err := tx.Save(cardRecurrence).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return cardCopy, nil
+}
+
+func (r *GormCardRepository) SetCardCustomFieldValue(req *SetCardCustomFieldValueRequest) error {
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := r.checkCustomFieldExistsAndBelongsToBoard(tx, req.Value.CustomFieldID, card.BoardID); err != nil {
+			return err
+		}
+
+		var value models.CardCustomFieldValue
+		if err := tx.Where("card_id = ? AND custom_field_id = ?", card.ID, req.Value.CustomFieldID).First(&value).Error; err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorhandlers.NewGrpcInternalError()
+			}
+			value.CardID = card.ID
+			value.CustomFieldID = req.Value.CustomFieldID
+		}
+
+		// The validator made sure only the column matching the field type is set
+		value.TextValue = req.Value.TextValue
+		value.NumberValue = req.Value.NumberValue
+		value.DateValue = req.Value.DateValue
+		value.CheckboxValue = req.Value.CheckboxValue
+		value.OptionID = req.Value.OptionID
+
+		if err := tx.Save(&value).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+}
+
+func (r *GormCardRepository) RemoveCardCustomFieldValue(req *RemoveCardCustomFieldValueRequest) error {
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("card_id = ? AND custom_field_id = ?", card.ID, req.CustomFieldID).Delete(&models.CardCustomFieldValue{})
+		if result.Error != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if result.RowsAffected == 0 {
+			return errorhandlers.NewGrpcNotFoundError("Custom field value not found")
+		}
+
+		return nil
+	})
+}
+
+func (r *GormCardRepository) UpdateCardComment(req *UpdateCardCommentRequest) error {
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		comment, err := r.checkCommentExistsAndBelongsToCard(tx, req.CommentID, card.ID)
+		if err != nil {
+			return err
+		}
+
+		if comment.UserID != req.UserID {
+			return errorhandlers.NewGrpcForbiddenError("Only the author can edit a comment")
+		}
+
+		if comment.Content == req.Content {
+			return nil
+		}
+
+		// Keep the previous content as edit history
+		edit := models.CommentEdit{
+			CommentID: comment.ID,
+			Content:   comment.Content,
+		}
+		if err := tx.Create(&edit).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		now := time.Now()
+		comment.Content = req.Content
+		comment.EditedAt = &now
+		if err := tx.Save(comment).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return r.syncCommentMentions(tx, card, comment, req.MentionedUserIDs)
+	})
+}
+
+func (r *GormCardRepository) GetCardComments(req *GetCardCommentsRequest) (*GetCardCommentsResponse, error) {
+	var commentDTOs []*internal_models.CommentDTO
+	var totalItems int64
+
+	offset := int((req.PageNumber - 1) * req.PageSize)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		// Pages hold top-level comments, newest first, each with its whole thread
+		if err := tx.Model(&models.Comment{}).Where("card_id = ? AND parent_id IS NULL", card.ID).Count(&totalItems).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		var comments []*models.Comment
+		if err := tx.Preload("User").
+			Preload("Replies", func(db *gorm.DB) *gorm.DB {
+				return db.Order("created_at")
+			}).
+			Preload("Replies.User").
+			Preload("Replies.Reactions").
+			Preload("Replies.Mentions").
+			Preload("Reactions").
+			Preload("Mentions").
+			Where("card_id = ? AND parent_id IS NULL", card.ID).
+			Order("created_at DESC").
+			Offset(offset).Limit(int(req.PageSize)).
+			Find(&comments).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		for _, comment := range comments {
+			commentDTO := convertCommentToDTO(comment)
+			for i := range comment.Replies {
+				commentDTO.Replies = append(commentDTO.Replies, convertCommentToDTO(&comment.Replies[i]))
+			}
+			commentDTOs = append(commentDTOs, commentDTO)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := &internal_models.Pagination{
+		CurrentPage:  req.PageNumber,
+		TotalPages:   (uint64(totalItems) + req.PageSize - 1) / req.PageSize,
+		ItemsPerPage: req.PageSize,
+		TotalItems:   uint64(totalItems),
+		HasMore:      req.PageNumber*req.PageSize < uint64(totalItems),
+	}
+
+	return &GetCardCommentsResponse{Comments: commentDTOs, Pagination: pagination}, nil
+}
+
+func (r *GormCardRepository) GetCardCommentHistory(req *GetCardCommentHistoryRequest) (*GetCardCommentHistoryResponse, error) {
+	var editDTOs []*internal_models.CommentEditDTO
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		comment, err := r.checkCommentExistsAndBelongsToCard(tx, req.CommentID, card.ID)
+		if err != nil {
+			return err
+		}
+
+		var edits []*models.CommentEdit
+		if err := tx.Where("comment_id = ?", comment.ID).Order("created_at DESC").Find(&edits).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		for _, edit := range edits {
+			editDTOs = append(editDTOs, &internal_models.CommentEditDTO{
+				Content:  edit.Content,
+				EditedAt: edit.CreatedAt,
+			})
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetCardCommentHistoryResponse{Edits: editDTOs}, nil
+}
+
+func (r *GormCardRepository) AddCommentReaction(req *AddCommentReactionRequest) error {
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		comment, err := r.checkCommentExistsAndBelongsToCard(tx, req.CommentID, card.ID)
+		if err != nil {
+			return err
+		}
+
+		reaction := models.CommentReaction{
+			CommentID: comment.ID,
+			UserID:    req.UserID,
+			Emoji:     req.Emoji,
+		}
+
+		// Reacting twice with the same emoji is a no-op
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&reaction).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+}
+
+func (r *GormCardRepository) RemoveCommentReaction(req *RemoveCommentReactionRequest) error {
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		comment, err := r.checkCommentExistsAndBelongsToCard(tx, req.CommentID, card.ID)
+		if err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("comment_id = ? AND user_id = ? AND emoji = ?", comment.ID, req.UserID, req.Emoji).Delete(&models.CommentReaction{})
+		if result.Error != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if result.RowsAffected == 0 {
+			return errorhandlers.NewGrpcNotFoundError("Reaction not found")
+		}
+
+		return nil
+	})
+}
+
+// syncCommentMentions stores the board members mentioned in a comment and notifies the ones that
+// weren't mentioned before, so editing a comment doesn't notify the same users again. Users who
+// aren't members of the board are ignored.
+func (r *GormCardRepository) syncCommentMentions(tx *gorm.DB, card *models.Card, comment *models.Comment, mentionedUserIDs []uint64) error {
+	var memberIDs []uint64
+	if len(mentionedUserIDs) > 0 {
+		if err := tx.Model(&models.BoardMember{}).Where("board_id = ? AND user_id IN ?", card.BoardID, mentionedUserIDs).Pluck("user_id", &memberIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	var existingIDs []uint64
+	if err := tx.Model(&models.CommentMention{}).Where("comment_id = ?", comment.ID).Pluck("user_id", &existingIDs).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	mentioned := make(map[uint64]bool)
+	for _, userID := range memberIDs {
+		mentioned[userID] = true
+	}
+
+	existing := make(map[uint64]bool)
+	for _, userID := range existingIDs {
+		existing[userID] = true
+		if !mentioned[userID] {
+			if err := tx.Unscoped().Where("comment_id = ? AND user_id = ?", comment.ID, userID).Delete(&models.CommentMention{}).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+		}
+	}
+
+	var newIDs []uint64
+	for _, userID := range memberIDs {
+		if existing[userID] {
+			continue
+		}
+		if err := tx.Create(&models.CommentMention{CommentID: comment.ID, UserID: userID}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		// Mentioning yourself doesn't notify
+		if userID != comment.UserID {
+			newIDs = append(newIDs, userID)
+		}
+	}
+
+	if len(newIDs) == 0 {
+		return nil
+	}
+
+	activityLog := models.ActivityLog{
+		BoardID:    card.BoardID,
+		UserID:     comment.UserID,
+		ActionType: "comment.mention",
+		Details:    fmt.Sprintf("Mentioned in a comment on card \"%s\"", card.Name),
+	}
+	if err := tx.Create(&activityLog).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	for _, userID := range newIDs {
+		notification := models.Notification{
+			ActivityLogID: activityLog.ID,
+			UserID:        userID,
+		}
+		if err := tx.Create(&notification).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	return nil
+}
+
+func (r *GormCardRepository) GetCardHistory(req *GetCardHistoryRequest) (*GetCardHistoryResponse, error) {
+	var versionDTOs []*internal_models.CardVersionDTO
+	var totalItems int64
+
+	offset := int((req.PageNumber - 1) * req.PageSize)
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.checkCardExistsAndBelongsToBoard(tx, req.CardID, req.BoardID)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.CardVersion{}).Where("card_id = ?", card.ID).Count(&totalItems).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		// One version past the page is loaded to diff the last version of the page against
+		var cardVersions []*models.CardVersion
+		if err := tx.Where("card_id = ?", card.ID).
+			Order("version DESC").
+			Offset(offset).Limit(int(req.PageSize) + 1).
+			Find(&cardVersions).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		for i, cardVersion := range cardVersions {
+			if i == int(req.PageSize) {
+				break
+			}
+
+			var previous *models.CardVersion
+			if i+1 < len(cardVersions) {
+				previous = cardVersions[i+1]
+			}
+
+			versionDTOs = append(versionDTOs, convertCardVersionToDTO(cardVersion, previous))
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := &internal_models.Pagination{
+		CurrentPage:  req.PageNumber,
+		TotalPages:   (uint64(totalItems) + req.PageSize - 1) / req.PageSize,
+		ItemsPerPage: req.PageSize,
+		TotalItems:   uint64(totalItems),
+		HasMore:      req.PageNumber*req.PageSize < uint64(totalItems),
+	}
+
+	return &GetCardHistoryResponse{Versions: versionDTOs, Pagination: pagination}, nil
+}
+
+// RestoreCardVersion rolls fields of the card back to a version of its history. The rollback is a
+// change like any other, so it creates a new version rather than rewriting the history. Labels
+// deleted from the board and users no longer on the board are left out.
+func (r *GormCardRepository) RestoreCardVersion(req *RestoreCardVersionRequest) error {
+	restore := func(field string) bool {
+		return len(req.Fields) == 0 || slices.Contains(req.Fields, field)
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		card, err := r.lockCard(tx, req.CardID, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		var cardVersion models.CardVersion
+		if err := tx.Where("card_id = ? AND version = ?", card.ID, req.Version).First(&cardVersion).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorhandlers.NewGrpcNotFoundError("Card version not found")
+			}
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		updates := map[string]interface{}{"version": card.Version + 1}
+
+		if restore(CardVersionFieldName) {
+			updates["name"] = cardVersion.Name
+		}
+
+		if restore(CardVersionFieldDescription) {
+			updates["description"] = cardVersion.Description
+		}
+
+		if restore(CardVersionFieldDates) {
+			updates["start_date"] = cardVersion.StartDate
+			updates["due_date"] = cardVersion.DueDate
+
+			// A different due date re-arms the reminders and the overdue notice, as when it is set
+			if !sameTime(card.DueDate, cardVersion.DueDate) {
+				updates["overdue_notified_at"] = nil
+				if err := tx.Model(&models.CardReminder{}).Where("card_id = ?", card.ID).Update("notified_at", nil).Error; err != nil {
+					return errorhandlers.NewGrpcInternalError()
+				}
+			}
+		}
+
+		if restore(CardVersionFieldLabels) {
+			var labels []models.Label
+			if labelIDs := splitIDs(cardVersion.LabelIDs); len(labelIDs) > 0 {
+				if err := tx.Where("id IN ? AND board_id = ?", labelIDs, card.BoardID).Find(&labels).Error; err != nil {
+					return errorhandlers.NewGrpcInternalError()
+				}
+			}
+
+			if err := tx.Model(card).Association("Labels").Replace(labels); err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+		}
+
+		if restore(CardVersionFieldMembers) {
+			var memberIDs []uint64
+			if userIDs := splitIDs(cardVersion.MemberIDs); len(userIDs) > 0 {
+				if err := tx.Model(&models.BoardMember{}).Where("board_id = ? AND user_id IN ?", card.BoardID, userIDs).Pluck("user_id", &memberIDs).Error; err != nil {
+					return errorhandlers.NewGrpcInternalError()
+				}
+			}
+
+			currentIDs, err := r.getCardMemberUserIDs(tx, card.ID)
+			if err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+
+			added, removed := diffIDs(currentIDs, memberIDs)
+			if len(removed) > 0 {
+				if err := tx.Where("card_id = ? AND user_id IN ?", card.ID, removed).Delete(&models.CardMember{}).Error; err != nil {
+					return errorhandlers.NewGrpcInternalError()
+				}
+			}
+			for _, userID := range added {
+				if err := tx.Create(&models.CardMember{CardID: card.ID, UserID: userID}).Error; err != nil {
+					return errorhandlers.NewGrpcInternalError()
+				}
+			}
+		}
+
+		if err := tx.Model(card).Updates(updates).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return r.recordCardVersion(tx, card.ID)
+	})
+}
+
+// PruneCardHistory applies the retention of every board limiting its card history. Changes to a
+// card already prune its own history, this catches versions expiring and retention being lowered.
+func (r *GormCardRepository) PruneCardHistory() (*PruneCardHistoryResponse, error) {
+	var boards []models.Board
+	if err := r.db.Select("id", "card_history_limit", "card_history_days").
+		Where("card_history_limit > 0 OR card_history_days > 0").
+		Find(&boards).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	for _, board := range boards {
+		cardIDs := r.db.Model(&models.Card{}).Unscoped().Select("id").Where("board_id = ?", board.ID)
+		if err := pruneCardVersions(r.db.Where("card_id IN (?)", cardIDs), board.CardHistoryLimit, board.CardHistoryDays); err != nil {
+			return nil, errorhandlers.NewGrpcInternalError()
+		}
+	}
+
+	return &PruneCardHistoryResponse{BoardCount: len(boards)}, nil
+}
+
+// BulkUpdateCards applies one operation to many cards in a single transaction. Every card is updated
+// in a savepoint of its own, so a card that fails is rolled back and reported without undoing the
+// others. Bulk changes aren't recorded in the undo log.
+func (r *GormCardRepository) BulkUpdateCards(req *BulkUpdateCardsRequest) (*BulkUpdateCardsResponse, error) {
+	var res BulkUpdateCardsResponse
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Transactions opened on tx are nested as savepoints
+		txRepo := &GormCardRepository{db: tx}
+
+		for _, cardID := range req.CardIDs {
+			warning, err := txRepo.bulkUpdateCard(req, cardID)
+			res.Results = append(res.Results, &internal_models.BulkCardResultDTO{
+				CardID:          cardID,
+				Error:           err,
+				WIPLimitWarning: warning,
+			})
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return &res, nil
+}
+
+// ArchiveAllCardsInList archives every card of the list with one statement. Like other bulk
+// changes it isn't recorded in the undo log.
+func (r *GormCardRepository) ArchiveAllCardsInList(req *ArchiveAllCardsInListRequest) (*ArchiveAllCardsInListResponse, error) {
+	var res ArchiveAllCardsInListResponse
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := r.checkListExistsAndBelongsToBoard(tx, req.ListID, req.BoardID); err != nil {
+			return err
+		}
+
+		if err := r.lockList(tx, req.ListID); err != nil {
+			return err
+		}
+
+		var cards []*models.Card
+		if err := tx.Model(&cards).Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+			Where("list_id = ? AND board_id = ? AND is_archived = ?", req.ListID, req.BoardID, false).
+			Updates(map[string]interface{}{"is_archived": true, "version": gorm.Expr("version + 1")}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		for _, card := range cards {
+			res.CardIDs = append(res.CardIDs, card.ID)
+		}
+
+		if err := req.Event.Write(tx, res.CardIDs...); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// MoveAllCardsInList appends the unarchived cards of the list to the target list, keeping their
+// order. Cards moved to another board lose the labels, custom field values and members that belong
+// to their old board, and recurring copies are created in the target list.
+func (r *GormCardRepository) MoveAllCardsInList(req *MoveAllCardsInListRequest) (*MoveAllCardsInListResponse, error) {
+	var res MoveAllCardsInListResponse
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := r.checkListExistsAndBelongsToBoard(tx, req.ListID, req.BoardID); err != nil {
+			return err
+		}
+
+		if err := r.checkListExistsAndBelongsToBoard(tx, req.TargetListID, req.TargetBoardID); err != nil {
+			return err
+		}
+
+		// Lock both lists in the same order as any concurrent move the other way
+		listIDs := []uint64{req.ListID, req.TargetListID}
+		slices.Sort(listIDs)
+		for _, listID := range listIDs {
+			if err := r.lockList(tx, listID); err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Model(&models.Card{}).
+			Where("list_id = ? AND is_archived = ?", req.ListID, false).
+			Order("position, id").
+			Pluck("id", &res.CardIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if len(res.CardIDs) == 0 {
+			return nil
+		}
+
+		warning, err := r.checkWIPLimit(tx, req.TargetListID, int64(len(res.CardIDs)))
+		if err != nil {
+			return err
+		}
+		res.WIPLimitWarning = warning
+
+		sequence := &positions.Sequence{Table: "cards", Column: "list_id", ParentID: req.TargetListID}
+		next, err := sequence.Append(tx)
+		if err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := tx.Exec(`UPDATE cards SET list_id = ?, board_id = ?, position = ? + (ranked.rank - 1) * ?, version = cards.version + 1
+			FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY position, id) AS rank FROM cards WHERE id IN ?) ranked
+			WHERE cards.id = ranked.id`,
+			req.TargetListID, req.TargetBoardID, next, positions.Gap, res.CardIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := req.Event.Write(tx, res.CardIDs...); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if req.TargetBoardID == req.BoardID {
+			return nil
+		}
+
+		if err := tx.Exec("DELETE FROM card_labels WHERE card_id IN ?", res.CardIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := tx.Unscoped().Where("card_id IN ?", res.CardIDs).Delete(&models.CardCustomFieldValue{}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		targetMembers := tx.Model(&models.BoardMember{}).Select("user_id").Where("board_id = ?", req.TargetBoardID)
+		if err := tx.Where("card_id IN ? AND user_id NOT IN (?)", res.CardIDs, targetMembers).Delete(&models.CardMember{}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := tx.Model(&models.CardRecurrence{}).Where("card_id IN ?", res.CardIDs).Update("target_list_id", req.TargetListID).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// SortCardsInList reorders the cards of the list, archived ones included, so that they keep their
+// place when restored
+func (r *GormCardRepository) SortCardsInList(req *SortCardsInListRequest) (*SortCardsInListResponse, error) {
+	var res SortCardsInListResponse
+
+	sortScope, err := r.cardSortScope(req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if err := r.checkListExistsAndBelongsToBoard(tx, req.ListID, req.BoardID); err != nil {
+			return err
+		}
+
+		if err := r.lockList(tx, req.ListID); err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Card{}).Where("cards.list_id = ?", req.ListID).Scopes(sortScope).Pluck("cards.id", &res.CardIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := rankCards(tx, res.CardIDs); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := req.Event.Write(tx, res.CardIDs...); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetCardImportTargets returns what the rows of an import can refer to on the board
+func (r *GormCardRepository) GetCardImportTargets(req *GetCardImportTargetsRequest) (*GetCardImportTargetsResponse, error) {
+	var res GetCardImportTargetsResponse
+
+	if err := r.db.Select("id", "name", "color").Where("board_id = ?", req.BoardID).Find(&res.Labels).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	memberIDs := r.db.Model(&models.BoardMember{}).Select("user_id").Where("board_id = ?", req.BoardID)
+	if err := r.db.Select("id", "username").Where("id IN (?)", memberIDs).Find(&res.Members).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	if err := r.db.Preload("Options").Where("board_id = ?", req.BoardID).Order("position").Find(&res.CustomFields).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return &res, nil
+}
+
+// ImportCards adds the cards at the end of the list with their labels, members and custom field
+// values, creating the labels they name that don't exist yet. Either every card is added or none.
+func (r *GormCardRepository) ImportCards(req *ImportCardsRequest) (*ImportCardsResponse, error) {
+	var res ImportCardsResponse
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := r.checkListExistsAndBelongsToBoard(tx, req.ListID, req.BoardID); err != nil {
+			return err
+		}
+
+		if err := r.lockList(tx, req.ListID); err != nil {
+			return err
+		}
+
+		warning, err := r.checkWIPLimit(tx, req.ListID, int64(len(req.Cards)))
+		if err != nil {
+			return err
+		}
+		res.WIPLimitWarning = warning
+
+		// Rows naming the same new label share it
+		newLabelIDs := make(map[string]uint64)
+		for _, importedCard := range req.Cards {
+			for _, name := range importedCard.NewLabels {
+				if _, ok := newLabelIDs[strings.ToLower(name)]; ok {
+					continue
+				}
+
+				label := &models.Label{BoardID: req.BoardID, Name: name, Color: req.LabelColor}
+				if err := tx.Create(label).Error; err != nil {
+					return errorhandlers.NewGrpcInternalError()
+				}
+				newLabelIDs[strings.ToLower(name)] = label.ID
+			}
+		}
+		res.LabelsCreated = len(newLabelIDs)
+
+		sequence := &positions.Sequence{Table: "cards", Column: "list_id", ParentID: req.ListID}
+		position, err := sequence.Append(tx)
+		if err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		for _, importedCard := range req.Cards {
+			card := importedCard.Card
+			card.BoardID = req.BoardID
+			card.ListID = req.ListID
+			card.Position = position
+			position += positions.Gap
+
+			for _, userID := range importedCard.MemberUserIDs {
+				card.Members = append(card.Members, models.CardMember{UserID: userID})
+			}
+			card.CustomFieldValues = importedCard.CustomFieldValues
+
+			if err := tx.Create(card).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+
+			labelIDs := importedCard.LabelIDs
+			for _, name := range importedCard.NewLabels {
+				labelIDs = append(labelIDs, newLabelIDs[strings.ToLower(name)])
+			}
+			for _, labelID := range labelIDs {
+				if err := tx.Exec("INSERT INTO card_labels (card_id, label_id) VALUES (?, ?)", card.ID, labelID).Error; err != nil {
+					return errorhandlers.NewGrpcInternalError()
+				}
+			}
+
+			if err := r.recordCardVersion(tx, card.ID); err != nil {
+				return err
+			}
+
+			res.CardIDs = append(res.CardIDs, card.ID)
+		}
+
+		if err := req.Event.Write(tx, res.CardIDs...); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// PurgeBoardCards hard-deletes every card of a board being deleted for good, with everything attached
+// to them. A board whose cards are already gone is confirmed again.
+func (r *GormCardRepository) PurgeBoardCards(req *PurgeBoardCardsRequest) (*PurgeBoardCardsResponse, error) {
+	res := &PurgeBoardCardsResponse{}
+
+	err := transactions.Join(r.db, req.Tx, func(tx *gorm.DB) error {
+		cardIDs := tx.Unscoped().Model(&models.Card{}).Select("id").Where("board_id = ?", req.BoardID)
+		commentIDs := tx.Unscoped().Model(&models.Comment{}).Select("id").Where("card_id IN (?)", cardIDs)
+
+		if err := tx.Unscoped().Model(&models.Attachment{}).Where("card_id IN (?)", cardIDs).Pluck("file_path", &res.AttachmentPaths).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		deletes := []struct {
+			model interface{}
+			query string
+			ids   *gorm.DB
+		}{
+			{&models.CommentEdit{}, "comment_id IN (?)", commentIDs},
+			{&models.CommentReaction{}, "comment_id IN (?)", commentIDs},
+			{&models.CommentMention{}, "comment_id IN (?)", commentIDs},
+			{&models.Comment{}, "card_id IN (?)", cardIDs},
+			{&models.Attachment{}, "card_id IN (?)", cardIDs},
+			{&models.CardMember{}, "card_id IN (?)", cardIDs},
+			{&models.CardReminder{}, "card_id IN (?)", cardIDs},
+			{&models.CardRecurrence{}, "card_id IN (?)", cardIDs},
+			{&models.CardCustomFieldValue{}, "card_id IN (?)", cardIDs},
+			{&models.CardVersion{}, "card_id IN (?)", cardIDs},
+			{&models.Watch{}, "card_id IN (?)", cardIDs},
+		}
+
+		if err := tx.Exec("DELETE FROM card_labels WHERE card_id IN (?)", cardIDs).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		for _, d := range deletes {
+			if err := tx.Unscoped().Where(d.query, d.ids).Delete(d.model).Error; err != nil {
+				return errorhandlers.NewGrpcInternalError()
+			}
+		}
+		if err := tx.Unscoped().Where("board_id = ?", req.BoardID).Delete(&models.Card{}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := req.Completed.Write(tx, req.BoardID); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ClaimDescriptionSession makes the replica the owner of the card's editing session, unless another
+// replica holds a lease that hasn't expired. It returns the owner of the session.
+func (r *GormCardRepository) ClaimDescriptionSession(req *ClaimDescriptionSessionRequest) (string, error) {
+	var session models.DescriptionSession
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "card_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"owner", "expires_at"}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				gorm.Expr("description_sessions.expires_at < ? OR description_sessions.owner = ?", now, req.Owner),
+			}},
+		}).Create(&models.DescriptionSession{
+			CardID:    req.CardID,
+			Owner:     req.Owner,
+			ExpiresAt: now.Add(req.TTL),
+		}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := tx.First(&session, req.CardID).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		return nil
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return session.Owner, nil
+}
+
+// RenewDescriptionSessions extends the leases the replica holds on the cards' sessions and returns
+// the cards whose lease it still holds
+func (r *GormCardRepository) RenewDescriptionSessions(req *RenewDescriptionSessionsRequest) (*RenewDescriptionSessionsResponse, error) {
+	res := &RenewDescriptionSessionsResponse{}
+	if len(req.CardIDs) == 0 {
+		return res, nil
+	}
+
+	var sessions []models.DescriptionSession
+	if err := r.db.Model(&sessions).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "card_id"}}}).
+		Where("card_id IN ? AND owner = ?", req.CardIDs, req.Owner).
+		Update("expires_at", time.Now().Add(req.TTL)).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	for _, session := range sessions {
+		res.CardIDs = append(res.CardIDs, session.CardID)
+	}
+	return res, nil
+}
+
+// ReleaseDescriptionSession gives up the replica's lease on the card's session
+func (r *GormCardRepository) ReleaseDescriptionSession(req *ReleaseDescriptionSessionRequest) error {
+	if err := r.db.Where("card_id = ? AND owner = ?", req.CardID, req.Owner).Delete(&models.DescriptionSession{}).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	return nil
+}
+
+// GetDescriptionSessionOwner returns the replica holding the card's editing session, empty when
+// nobody is editing it
+func (r *GormCardRepository) GetDescriptionSessionOwner(cardID uint64) (string, error) {
+	var session models.DescriptionSession
+	if err := r.db.Where("card_id = ? AND expires_at >= ?", cardID, time.Now()).First(&session).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", errorhandlers.NewGrpcInternalError()
+	}
+
+	return session.Owner, nil
 }
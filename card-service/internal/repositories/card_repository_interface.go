@@ -4,7 +4,11 @@ import (
 	"time"
 
 	internal_models "github.com/sm888sm/halten-backend/card-service/internal/models"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+	"github.com/sm888sm/halten-backend/common/undo"
 	models "github.com/sm888sm/halten-backend/models"
+
+	"gorm.io/gorm"
 )
 
 type CreateCardRequest struct {
@@ -12,7 +16,8 @@ type CreateCardRequest struct {
 }
 
 type CreateCardResponse struct {
-	Card *models.Card
+	Card            *models.Card
+	WIPLimitWarning *internal_models.WIPLimitWarningDTO // Set when the card was added to a full list
 }
 
 type GetCardByIDRequest struct {
@@ -24,7 +29,9 @@ type GetCardByIDResponse struct {
 }
 
 type GetCardsByListRequest struct {
-	ListID uint64
+	ListID  uint64
+	Filters []*CustomFieldFilter
+	Sort    *CustomFieldSort
 }
 
 type GetCardsByListResponse struct {
@@ -33,6 +40,8 @@ type GetCardsByListResponse struct {
 
 type GetCardsByBoardRequest struct {
 	BoardID uint64
+	Filters []*CustomFieldFilter
+	Sort    *CustomFieldSort
 }
 
 type GetCardsByBoardResponse struct {
@@ -42,26 +51,40 @@ type GetCardsByBoardResponse struct {
 type DeleteCardRequest struct {
 	CardID  uint64
 	BoardID uint64
+	Actor   *undo.Actor // Records the action in the actor's undo log when set
+	Tx      *gorm.DB    // Transaction to write in, a new one when nil
 }
 
 type MoveCardPositionRequest struct {
-	CardID    uint64
-	Position  int64
-	BoardID   uint64
-	OldListID uint64
-	NewListID uint64
+	CardID          uint64
+	Position        int64
+	BoardID         uint64
+	OldListID       uint64
+	NewListID       uint64
+	ExpectedVersion uint64      // Zero skips the version check
+	Actor           *undo.Actor // Records the action in the actor's undo log when set
+}
+
+type MoveCardPositionResponse struct {
+	WIPLimitWarning *internal_models.WIPLimitWarningDTO // Set when the card was moved into a full list
 }
 
 type UpdateCardNameRequest struct {
-	CardID  uint64
-	Name    string
-	BoardID uint64
+	CardID          uint64
+	Name            string
+	BoardID         uint64
+	ExpectedVersion uint64 // Zero skips the version check
 }
 
 type UpdateCardDescriptionRequest struct {
-	CardID      uint64
-	Description string
-	BoardID     uint64
+	CardID          uint64
+	Description     string
+	BoardID         uint64
+	ExpectedVersion uint64 // Zero skips the version check
+}
+
+type UpdateCardDescriptionResponse struct {
+	Version uint64 // Version of the card once updated
 }
 
 type AddCardLabelRequest struct {
@@ -77,15 +100,17 @@ type RemoveCardLabelRequest struct {
 }
 
 type SetCardDatesRequest struct {
-	StartDate *time.Time
-	DueDate   *time.Time
-	CardID    uint64
-	BoardID   uint64
+	StartDate       *time.Time
+	DueDate         *time.Time
+	CardID          uint64
+	BoardID         uint64
+	ExpectedVersion uint64 // Zero skips the version check
 }
 
 type ToggleCardCompletedRequest struct {
-	CardID  uint64
-	BoardID uint64
+	CardID          uint64
+	BoardID         uint64
+	ExpectedVersion uint64 // Zero skips the version check
 }
 
 type AddCardAttachmentRequest struct {
@@ -101,10 +126,62 @@ type RemoveCardAttachmentRequest struct {
 }
 
 type AddCardCommentRequest struct {
-	Comment models.Comment
-	CardID  uint64
-	BoardID uint64
-	UserID  uint64
+	Comment          models.Comment
+	CardID           uint64
+	BoardID          uint64
+	UserID           uint64
+	MentionedUserIDs []uint64
+}
+
+type AddCardCommentResponse struct {
+	CommentID uint64
+}
+
+type UpdateCardCommentRequest struct {
+	CommentID        uint64
+	CardID           uint64
+	BoardID          uint64
+	UserID           uint64
+	Content          string
+	MentionedUserIDs []uint64
+}
+
+type GetCardCommentsRequest struct {
+	CardID     uint64
+	BoardID    uint64
+	PageNumber uint64
+	PageSize   uint64
+}
+
+type GetCardCommentsResponse struct {
+	Comments   []*internal_models.CommentDTO
+	Pagination *internal_models.Pagination
+}
+
+type GetCardCommentHistoryRequest struct {
+	CommentID uint64
+	CardID    uint64
+	BoardID   uint64
+}
+
+type GetCardCommentHistoryResponse struct {
+	Edits []*internal_models.CommentEditDTO
+}
+
+type AddCommentReactionRequest struct {
+	CommentID uint64
+	CardID    uint64
+	BoardID   uint64
+	UserID    uint64
+	Emoji     string
+}
+
+type RemoveCommentReactionRequest struct {
+	CommentID uint64
+	CardID    uint64
+	BoardID   uint64
+	UserID    uint64
+	Emoji     string
 }
 
 type RemoveCardCommentRequest struct {
@@ -127,34 +204,275 @@ type RemoveCardMembersRequest struct {
 }
 
 type ArchiveCardRequest struct {
+	CardID          uint64
+	BoardID         uint64
+	ExpectedVersion uint64      // Zero skips the version check
+	Actor           *undo.Actor // Records the action in the actor's undo log when set
+}
+
+type RestoreCardRequest struct {
+	CardID          uint64
+	BoardID         uint64
+	ExpectedVersion uint64      // Zero skips the version check
+	Actor           *undo.Actor // Records the action in the actor's undo log when set
+}
+
+type SetCardRemindersRequest struct {
+	OffsetMinutes []int64
+	CardID        uint64
+	BoardID       uint64
+}
+
+type GetDueCardsRequest struct {
+	Now     time.Time
+	AfterID uint64 // Cards with a greater ID are returned, for the next page
+	Limit   int    // Zero returns every card
+}
+
+type GetDueCardsResponse struct {
+	Cards []*internal_models.DueCardDTO
+}
+
+type MarkCardDueNotifiedRequest struct {
+	CardID     uint64
+	BoardID    uint64
+	ReminderID uint64 // Zero marks the card's overdue notice instead of a reminder
+	ActionType string
+	Details    string
+	Now        time.Time
+	Event      publishers.Event // Announces the notice, written with the ID of the card
+}
+
+type SetCardRecurrenceRequest struct {
+	Frequency    string
+	Interval     int
+	Weekdays     []string
+	MonthDay     int
+	TargetListID uint64 // Zero keeps copies in the card's current list
+	CardID       uint64
+	BoardID      uint64
+}
+
+type RemoveCardRecurrenceRequest struct {
 	CardID  uint64
 	BoardID uint64
 }
 
-type RestoreCardRequest struct {
+type CreateDueRecurringCardsRequest struct {
+	Now time.Time
+}
+
+type CreateDueRecurringCardsResponse struct {
+	CardIDs []uint64
+}
+
+type CustomFieldFilter struct {
+	CustomFieldID uint64
+	Operator      string
+	Value         string
+}
+
+type CustomFieldSort struct {
+	CustomFieldID uint64
+	Descending    bool
+}
+
+type SetCardCustomFieldValueRequest struct {
+	Value   *internal_models.CardCustomFieldValueDTO
 	CardID  uint64
 	BoardID uint64
 }
 
+type RemoveCardCustomFieldValueRequest struct {
+	CustomFieldID uint64
+	CardID        uint64
+	BoardID       uint64
+}
+
+type GetCardHistoryRequest struct {
+	CardID     uint64
+	BoardID    uint64
+	PageNumber uint64
+	PageSize   uint64
+}
+
+type GetCardHistoryResponse struct {
+	Versions   []*internal_models.CardVersionDTO
+	Pagination *internal_models.Pagination
+}
+
+type RestoreCardVersionRequest struct {
+	CardID          uint64
+	BoardID         uint64
+	Version         uint64
+	Fields          []string // Fields to roll back, empty restores all of them
+	ExpectedVersion uint64   // Zero skips the version check
+}
+
+type BulkUpdateCardsRequest struct {
+	CardIDs   []uint64
+	BoardID   uint64
+	Operation string
+	ListID    uint64 // List cards are moved to
+	LabelID   uint64 // Label added or removed
+	UserID    uint64 // Member added or removed
+	DueDate   *time.Time
+}
+
+type BulkUpdateCardsResponse struct {
+	Results []*internal_models.BulkCardResultDTO
+}
+
+type ArchiveAllCardsInListRequest struct {
+	ListID  uint64
+	BoardID uint64
+	Event   publishers.Event // Written with the IDs of the archived cards
+}
+
+type ArchiveAllCardsInListResponse struct {
+	CardIDs []uint64
+}
+
+type MoveAllCardsInListRequest struct {
+	ListID        uint64
+	BoardID       uint64
+	TargetListID  uint64
+	TargetBoardID uint64           // Board of the target list, the board of the list unless cards change board
+	Event         publishers.Event // Written with the IDs of the moved cards
+}
+
+type MoveAllCardsInListResponse struct {
+	CardIDs         []uint64 // Moved cards in their new order
+	WIPLimitWarning *internal_models.WIPLimitWarningDTO
+}
+
+type SortCardsInListRequest struct {
+	ListID        uint64
+	BoardID       uint64
+	SortBy        string
+	CustomFieldID uint64 // Custom field sorted by when SortBy is custom_field
+	Descending    bool
+	Event         publishers.Event // Written with the IDs of the sorted cards
+}
+
+type SortCardsInListResponse struct {
+	CardIDs []uint64 // Cards of the list in their new order
+}
+
+type PruneCardHistoryResponse struct {
+	BoardCount int
+}
+
+type GetCardImportTargetsRequest struct {
+	BoardID uint64
+}
+
+type GetCardImportTargetsResponse struct {
+	Labels       []models.Label
+	Members      []models.User        // Board members, with their usernames
+	CustomFields []models.CustomField // With their options
+}
+
+type ImportedCard struct {
+	Card              *models.Card
+	LabelIDs          []uint64
+	NewLabels         []string // Names of labels that don't exist yet and are created
+	MemberUserIDs     []uint64
+	CustomFieldValues []models.CardCustomFieldValue
+}
+
+type ImportCardsRequest struct {
+	BoardID    uint64
+	ListID     uint64
+	Cards      []*ImportedCard  // In the order they're added at the end of the list
+	LabelColor string           // Color of the labels created by the import
+	Event      publishers.Event // Written with the IDs of the imported cards
+}
+
+type ImportCardsResponse struct {
+	CardIDs         []uint64
+	LabelsCreated   int
+	WIPLimitWarning *internal_models.WIPLimitWarningDTO // Set when the cards took the list past its WIP limit
+}
+
+type PurgeBoardCardsRequest struct {
+	BoardID   uint64
+	Completed publishers.Event // Written with the board ID, confirming the cards are gone
+	Tx        *gorm.DB         // Transaction to write in, a new one when nil
+}
+
+type PurgeBoardCardsResponse struct {
+	AttachmentPaths []string // Removed by the caller once the cards are deleted
+}
+
+type ClaimDescriptionSessionRequest struct {
+	CardID uint64
+	Owner  string // Address of the replica claiming the session
+	TTL    time.Duration
+}
+
+type RenewDescriptionSessionsRequest struct {
+	CardIDs []uint64
+	Owner   string
+	TTL     time.Duration
+}
+
+type RenewDescriptionSessionsResponse struct {
+	CardIDs []uint64 // Cards whose lease the replica still holds
+}
+
+type ReleaseDescriptionSessionRequest struct {
+	CardID uint64
+	Owner  string
+}
+
 type CardRepository interface {
 	CreateCard(req *CreateCardRequest) (*CreateCardResponse, error)
 	GetCardByID(req *GetCardByIDRequest) (*GetCardByIDResponse, error)
 	GetCardsByList(req *GetCardsByListRequest) (*GetCardsByListResponse, error)
 	GetCardsByBoard(req *GetCardsByBoardRequest) (*GetCardsByBoardResponse, error)
-	MoveCardPosition(req *MoveCardPositionRequest) error
+	MoveCardPosition(req *MoveCardPositionRequest) (*MoveCardPositionResponse, error)
 	UpdateCardName(req *UpdateCardNameRequest) error
-	UpdateCardDescription(req *UpdateCardDescriptionRequest) error
+	UpdateCardDescription(req *UpdateCardDescriptionRequest) (*UpdateCardDescriptionResponse, error)
 	AddCardLabel(req *AddCardLabelRequest) error
 	RemoveCardLabel(req *RemoveCardLabelRequest) error
 	SetCardDates(req *SetCardDatesRequest) error
 	ToggleCardCompleted(req *ToggleCardCompletedRequest) error
 	AddCardAttachment(req *AddCardAttachmentRequest) error
 	RemoveCardAttachment(req *RemoveCardAttachmentRequest) error
-	AddCardComment(req *AddCardCommentRequest) error
+	AddCardComment(req *AddCardCommentRequest) (*AddCardCommentResponse, error)
 	RemoveCardComment(req *RemoveCardCommentRequest) error
+	UpdateCardComment(req *UpdateCardCommentRequest) error
+	GetCardComments(req *GetCardCommentsRequest) (*GetCardCommentsResponse, error)
+	GetCardCommentHistory(req *GetCardCommentHistoryRequest) (*GetCardCommentHistoryResponse, error)
+	AddCommentReaction(req *AddCommentReactionRequest) error
+	RemoveCommentReaction(req *RemoveCommentReactionRequest) error
 	AddCardMembers(req *AddCardMembersRequest) error
 	RemoveCardMembers(req *RemoveCardMembersRequest) error
 	ArchiveCard(req *ArchiveCardRequest) error
 	RestoreCard(req *RestoreCardRequest) error
 	DeleteCard(req *DeleteCardRequest) error
+	SetCardReminders(req *SetCardRemindersRequest) error
+	GetDueSoonCards(req *GetDueCardsRequest) (*GetDueCardsResponse, error)
+	GetOverdueCards(req *GetDueCardsRequest) (*GetDueCardsResponse, error)
+	MarkCardDueNotified(req *MarkCardDueNotifiedRequest) error
+	SetCardRecurrence(req *SetCardRecurrenceRequest) error
+	RemoveCardRecurrence(req *RemoveCardRecurrenceRequest) error
+	CreateDueRecurringCards(req *CreateDueRecurringCardsRequest) (*CreateDueRecurringCardsResponse, error)
+	SetCardCustomFieldValue(req *SetCardCustomFieldValueRequest) error
+	RemoveCardCustomFieldValue(req *RemoveCardCustomFieldValueRequest) error
+	GetCardHistory(req *GetCardHistoryRequest) (*GetCardHistoryResponse, error)
+	RestoreCardVersion(req *RestoreCardVersionRequest) error
+	PruneCardHistory() (*PruneCardHistoryResponse, error)
+	BulkUpdateCards(req *BulkUpdateCardsRequest) (*BulkUpdateCardsResponse, error)
+	ArchiveAllCardsInList(req *ArchiveAllCardsInListRequest) (*ArchiveAllCardsInListResponse, error)
+	MoveAllCardsInList(req *MoveAllCardsInListRequest) (*MoveAllCardsInListResponse, error)
+	SortCardsInList(req *SortCardsInListRequest) (*SortCardsInListResponse, error)
+	GetCardImportTargets(req *GetCardImportTargetsRequest) (*GetCardImportTargetsResponse, error)
+	ImportCards(req *ImportCardsRequest) (*ImportCardsResponse, error)
+	ClaimDescriptionSession(req *ClaimDescriptionSessionRequest) (string, error)
+	RenewDescriptionSessions(req *RenewDescriptionSessionsRequest) (*RenewDescriptionSessionsResponse, error)
+	ReleaseDescriptionSession(req *ReleaseDescriptionSessionRequest) error
+	GetDescriptionSessionOwner(cardID uint64) (string, error)
+	PurgeBoardCards(req *PurgeBoardCardsRequest) (*PurgeBoardCardsResponse, error)
 }
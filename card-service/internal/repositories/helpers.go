@@ -2,10 +2,23 @@ package repositories
 
 import (
 	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/sm888sm/halten-backend/card-service/internal/customfields"
+	internal_models "github.com/sm888sm/halten-backend/card-service/internal/models"
+	"github.com/sm888sm/halten-backend/common/constants/bulkcardoperations"
+	"github.com/sm888sm/halten-backend/common/constants/customfieldtypes"
+	"github.com/sm888sm/halten-backend/common/constants/wiplimitpolicies"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/common/positions"
 
 	models "github.com/sm888sm/halten-backend/models"
 )
@@ -37,3 +50,618 @@ func (r *GormCardRepository) checkCardExistsAndBelongsToBoard(tx *gorm.DB, cardI
 
 	return card, nil
 }
+
+// lockCard loads the card and locks its row until the end of the transaction. When an expected
+// version is given, a card changed since the client read it is rejected as a conflict.
+func (r *GormCardRepository) lockCard(tx *gorm.DB, cardID uint64, boardID uint64, expectedVersion uint64) (*models.Card, error) {
+	var card models.Card
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND board_id = ?", cardID, boardID).First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorhandlers.NewGrpcNotFoundError("Card not found")
+		}
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	if expectedVersion != 0 && card.Version != expectedVersion {
+		return nil, errorhandlers.NewGrpcConflictError(fmt.Sprintf("Card has been modified, current version is %d", card.Version))
+	}
+
+	return &card, nil
+}
+
+func (r *GormCardRepository) checkListExistsAndBelongsToBoard(tx *gorm.DB, listID uint64, boardID uint64) error {
+	var list models.List
+	if err := tx.First(&list, listID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorhandlers.NewGrpcNotFoundError("List not found")
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	if list.BoardID != boardID {
+		return errorhandlers.NewGrpcNotFoundError("List does not belong to the board")
+	}
+
+	return nil
+}
+
+func (r *GormCardRepository) checkCustomFieldExistsAndBelongsToBoard(tx *gorm.DB, customFieldID uint64, boardID uint64) (*models.CustomField, error) {
+	var customField models.CustomField
+	if err := tx.First(&customField, customFieldID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorhandlers.NewGrpcNotFoundError("Custom field not found")
+		}
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	if customField.BoardID != boardID {
+		return nil, errorhandlers.NewGrpcNotFoundError("Custom field does not belong to the board")
+	}
+
+	return &customField, nil
+}
+
+func (r *GormCardRepository) getCardCustomFieldValues(tx *gorm.DB, cardID uint64) ([]*internal_models.CardCustomFieldValueDTO, error) {
+	var values []*models.CardCustomFieldValue
+	if err := tx.Where("card_id = ?", cardID).Find(&values).Error; err != nil {
+		return nil, err
+	}
+
+	var valueDTOs []*internal_models.CardCustomFieldValueDTO
+	for _, value := range values {
+		valueDTOs = append(valueDTOs, &internal_models.CardCustomFieldValueDTO{
+			CustomFieldID: value.CustomFieldID,
+			TextValue:     value.TextValue,
+			NumberValue:   value.NumberValue,
+			DateValue:     value.DateValue,
+			CheckboxValue: value.CheckboxValue,
+			OptionID:      value.OptionID,
+		})
+	}
+
+	return valueDTOs, nil
+}
+
+// customFieldScope resolves the custom field filters and sort of a card query into a scope that
+// joins the matching values. Cards without a value are sorted last.
+func (r *GormCardRepository) customFieldScope(boardID uint64, filters []*CustomFieldFilter, sort *CustomFieldSort) (func(*gorm.DB) *gorm.DB, error) {
+	type condition struct {
+		sql  string
+		args []interface{}
+	}
+
+	var joins []condition
+	var wheres []condition
+	var order string
+
+	for i, filter := range filters {
+		customField, err := r.checkCustomFieldExistsAndBelongsToBoard(r.db, filter.CustomFieldID, boardID)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := customfields.ParseFilterValue(customField.Type, filter.Operator, filter.Value)
+		if err != nil {
+			return nil, errorhandlers.NewGrpcBadRequestError(err.Error())
+		}
+
+		alias := fmt.Sprintf("cf_filter_%d", i)
+		column := alias + "." + customfields.ValueColumn(customField.Type)
+
+		joins = append(joins, condition{
+			sql:  fmt.Sprintf("LEFT JOIN card_custom_field_values %[1]s ON %[1]s.card_id = cards.id AND %[1]s.custom_field_id = ? AND %[1]s.deleted_at IS NULL", alias),
+			args: []interface{}{customField.ID},
+		})
+
+		switch filter.Operator {
+		case customfields.Set:
+			wheres = append(wheres, condition{sql: column + " IS NOT NULL"})
+		case customfields.Unset:
+			wheres = append(wheres, condition{sql: column + " IS NULL"})
+		case customfields.NotEqual:
+			wheres = append(wheres, condition{sql: "(" + column + " IS NULL OR " + column + " <> ?)", args: []interface{}{value}})
+		case customfields.GreaterThan:
+			wheres = append(wheres, condition{sql: column + " > ?", args: []interface{}{value}})
+		case customfields.LessThan:
+			wheres = append(wheres, condition{sql: column + " < ?", args: []interface{}{value}})
+		case customfields.Contains:
+			pattern := "%" + likeEscaper.Replace(value.(string)) + "%"
+			wheres = append(wheres, condition{sql: column + " ILIKE ?", args: []interface{}{pattern}})
+		default:
+			wheres = append(wheres, condition{sql: column + " = ?", args: []interface{}{value}})
+		}
+	}
+
+	if sort != nil && sort.CustomFieldID != 0 {
+		customField, err := r.checkCustomFieldExistsAndBelongsToBoard(r.db, sort.CustomFieldID, boardID)
+		if err != nil {
+			return nil, err
+		}
+
+		joins = append(joins, condition{
+			sql:  "LEFT JOIN card_custom_field_values cf_sort ON cf_sort.card_id = cards.id AND cf_sort.custom_field_id = ? AND cf_sort.deleted_at IS NULL",
+			args: []interface{}{customField.ID},
+		})
+
+		column := "cf_sort." + customfields.ValueColumn(customField.Type)
+
+		// Select values sort in the order their options are defined
+		if customField.Type == customfieldtypes.Select {
+			joins = append(joins, condition{sql: "LEFT JOIN custom_field_options cf_sort_option ON cf_sort_option.id = cf_sort.option_id"})
+			column = "cf_sort_option.position"
+		}
+
+		direction := "ASC"
+		if sort.Descending {
+			direction = "DESC"
+		}
+		order = column + " " + direction + " NULLS LAST, cards.position"
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		for _, join := range joins {
+			db = db.Joins(join.sql, join.args...)
+		}
+		for _, where := range wheres {
+			db = db.Where(where.sql, where.args...)
+		}
+		if order != "" {
+			db = db.Order(order)
+		}
+		return db
+	}, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// lockList locks the list row until the end of the transaction, serializing position changes in it
+func (r *GormCardRepository) lockList(tx *gorm.DB, listID uint64) error {
+	var list models.List
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", listID).First(&list).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorhandlers.NewGrpcNotFoundError("List not found")
+		}
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	return nil
+}
+
+// checkWIPLimit is called with the list locked, before cards are added to it. A list the cards
+// would take past its WIP limit refuses them, or lets them in with a warning when the board only
+// warns.
+func (r *GormCardRepository) checkWIPLimit(tx *gorm.DB, listID uint64, added int64) (*internal_models.WIPLimitWarningDTO, error) {
+	var list models.List
+	if err := tx.Select("id", "board_id", "name", "wip_limit").First(&list, listID).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	if list.WIPLimit == 0 {
+		return nil, nil
+	}
+
+	var cardCount int64
+	if err := tx.Model(&models.Card{}).Where("list_id = ? AND is_archived = ?", listID, false).Count(&cardCount).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	if cardCount+added <= int64(list.WIPLimit) {
+		return nil, nil
+	}
+
+	var board models.Board
+	if err := tx.Select("id", "wip_limit_policy").First(&board, list.BoardID).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	if board.WIPLimitPolicy != wiplimitpolicies.Warn {
+		return nil, errorhandlers.NewGrpcFailedPreconditionError(fmt.Sprintf("List %q would exceed its WIP limit of %d cards", list.Name, list.WIPLimit))
+	}
+
+	return &internal_models.WIPLimitWarningDTO{
+		ListID:    list.ID,
+		ListName:  list.Name,
+		WIPLimit:  list.WIPLimit,
+		CardCount: cardCount + added,
+	}, nil
+}
+
+func (r *GormCardRepository) checkCommentExistsAndBelongsToCard(tx *gorm.DB, commentID uint64, cardID uint64) (*models.Comment, error) {
+	var comment models.Comment
+	if err := tx.Where("id = ? AND card_id = ?", commentID, cardID).First(&comment).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorhandlers.NewGrpcNotFoundError("Comment not found")
+		}
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	return &comment, nil
+}
+
+func convertCommentToDTO(comment *models.Comment) *internal_models.CommentDTO {
+	commentDTO := &internal_models.CommentDTO{
+		ID:        comment.ID,
+		CardID:    comment.CardID,
+		ParentID:  comment.ParentID,
+		UserID:    comment.UserID,
+		Username:  comment.User.Username,
+		Content:   comment.Content,
+		EditedAt:  comment.EditedAt,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+
+	// Group the reactions by emoji, keeping the order they were first used in
+	reactions := make(map[string]*internal_models.CommentReactionDTO)
+	for _, reaction := range comment.Reactions {
+		reactionDTO, ok := reactions[reaction.Emoji]
+		if !ok {
+			reactionDTO = &internal_models.CommentReactionDTO{Emoji: reaction.Emoji}
+			reactions[reaction.Emoji] = reactionDTO
+			commentDTO.Reactions = append(commentDTO.Reactions, reactionDTO)
+		}
+		reactionDTO.UserIDs = append(reactionDTO.UserIDs, reaction.UserID)
+	}
+
+	for _, mention := range comment.Mentions {
+		commentDTO.Mentions = append(commentDTO.Mentions, mention.UserID)
+	}
+
+	return commentDTO
+}
+
+func (r *GormCardRepository) getCardMemberUserIDs(tx *gorm.DB, cardID uint64) ([]uint64, error) {
+	var userIDs []uint64
+	if err := tx.Model(&models.CardMember{}).Where("card_id = ?", cardID).Pluck("user_id", &userIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
+}
+
+// recurrenceAnchor returns the date a recurring card's occurrences are computed from
+func recurrenceAnchor(card *models.Card) *time.Time {
+	if card.DueDate != nil {
+		return card.DueDate
+	}
+	return card.StartDate
+}
+
+func splitWeekdays(weekdays string) []string {
+	if weekdays == "" {
+		return nil
+	}
+	return strings.Split(weekdays, ",")
+}
+
+// Fields of a card kept in its history
+const (
+	CardVersionFieldName        = "name"
+	CardVersionFieldDescription = "description"
+	CardVersionFieldDates       = "dates"
+	CardVersionFieldLabels      = "labels"
+	CardVersionFieldMembers     = "members"
+)
+
+var CardVersionFields = []string{
+	CardVersionFieldName,
+	CardVersionFieldDescription,
+	CardVersionFieldDates,
+	CardVersionFieldLabels,
+	CardVersionFieldMembers,
+}
+
+// Orders cards of a list can be sorted in
+const (
+	CardSortDueDate     = "due_date"
+	CardSortCreatedAt   = "created_at"
+	CardSortName        = "name"
+	CardSortCustomField = "custom_field"
+)
+
+var CardSortFields = []string{
+	CardSortDueDate,
+	CardSortCreatedAt,
+	CardSortName,
+	CardSortCustomField,
+}
+
+// bumpCardVersion increments the version of a card changed without loading it, e.g. by adding a label
+func (r *GormCardRepository) bumpCardVersion(tx *gorm.DB, cardID uint64) error {
+	return tx.Model(&models.Card{}).Where("id = ?", cardID).UpdateColumn("version", gorm.Expr("version + 1")).Error
+}
+
+// recordCardVersion adds the current state of the card to its history and drops the versions the
+// board no longer keeps. It runs in the transaction that changed the card.
+func (r *GormCardRepository) recordCardVersion(tx *gorm.DB, cardID uint64) error {
+	var card models.Card
+	if err := tx.First(&card, cardID).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	labelIDs, err := r.getCardLabelIDs(tx, cardID)
+	if err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	memberIDs, err := r.getCardMemberUserIDs(tx, cardID)
+	if err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	cardVersion := &models.CardVersion{
+		CardID:      card.ID,
+		Version:     card.Version,
+		Name:        card.Name,
+		Description: card.Description,
+		StartDate:   card.StartDate,
+		DueDate:     card.DueDate,
+		LabelIDs:    joinIDs(labelIDs),
+		MemberIDs:   joinIDs(memberIDs),
+	}
+
+	// Several changes in one transaction share a version, the last one wins
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "card_id"}, {Name: "version"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name", "description", "start_date", "due_date", "label_ids", "member_ids", "updated_at"}),
+	}).Create(cardVersion).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	var board models.Board
+	if err := tx.Select("id", "card_history_limit", "card_history_days").First(&board, card.BoardID).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	if err := pruneCardVersions(tx.Where("card_id = ?", card.ID), board.CardHistoryLimit, board.CardHistoryDays); err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	return nil
+}
+
+// pruneCardVersions deletes the versions beyond the retention of the board from the cards matched
+// by scope. The latest version of a card is always kept.
+func pruneCardVersions(scope *gorm.DB, limit int, days int) error {
+	if limit > 0 {
+		ranked := scope.Session(&gorm.Session{}).Model(&models.CardVersion{}).
+			Select("id, ROW_NUMBER() OVER (PARTITION BY card_id ORDER BY version DESC) AS rank")
+		if err := scope.Session(&gorm.Session{}).Unscoped().
+			Where("id IN (?)", scope.Session(&gorm.Session{NewDB: true}).Table("(?) AS ranked", ranked).Select("id").Where("rank > ?", limit)).
+			Delete(&models.CardVersion{}).Error; err != nil {
+			return err
+		}
+	}
+
+	if days > 0 {
+		cutoff := time.Now().AddDate(0, 0, -days)
+		latest := scope.Session(&gorm.Session{NewDB: true}).Table("card_versions AS latest").
+			Select("MAX(latest.version)").Where("latest.card_id = card_versions.card_id")
+		if err := scope.Session(&gorm.Session{}).Unscoped().
+			Where("created_at < ? AND version < (?)", cutoff, latest).
+			Delete(&models.CardVersion{}).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *GormCardRepository) getCardLabelIDs(tx *gorm.DB, cardID uint64) ([]uint64, error) {
+	var labelIDs []uint64
+	if err := tx.Table("card_labels").Where("card_id = ?", cardID).Order("label_id").Pluck("label_id", &labelIDs).Error; err != nil {
+		return nil, err
+	}
+
+	return labelIDs, nil
+}
+
+func convertCardVersionToDTO(cardVersion *models.CardVersion, previous *models.CardVersion) *internal_models.CardVersionDTO {
+	cardVersionDTO := &internal_models.CardVersionDTO{
+		Version:     cardVersion.Version,
+		Name:        cardVersion.Name,
+		Description: cardVersion.Description,
+		StartDate:   cardVersion.StartDate,
+		DueDate:     cardVersion.DueDate,
+		Labels:      splitIDs(cardVersion.LabelIDs),
+		Members:     splitIDs(cardVersion.MemberIDs),
+		CreatedAt:   cardVersion.CreatedAt,
+	}
+
+	if previous != nil {
+		cardVersionDTO.Changes = diffCardVersions(previous, cardVersion)
+	}
+
+	return cardVersionDTO
+}
+
+func diffCardVersions(previous, current *models.CardVersion) []*internal_models.CardFieldChangeDTO {
+	var changes []*internal_models.CardFieldChangeDTO
+
+	if previous.Name != current.Name {
+		changes = append(changes, &internal_models.CardFieldChangeDTO{Field: CardVersionFieldName, OldValue: previous.Name, NewValue: current.Name})
+	}
+
+	if previous.Description != current.Description {
+		changes = append(changes, &internal_models.CardFieldChangeDTO{Field: CardVersionFieldDescription, OldValue: previous.Description, NewValue: current.Description})
+	}
+
+	if !sameTime(previous.StartDate, current.StartDate) {
+		changes = append(changes, &internal_models.CardFieldChangeDTO{Field: "start_date", OldValue: formatDate(previous.StartDate), NewValue: formatDate(current.StartDate)})
+	}
+
+	if !sameTime(previous.DueDate, current.DueDate) {
+		changes = append(changes, &internal_models.CardFieldChangeDTO{Field: "due_date", OldValue: formatDate(previous.DueDate), NewValue: formatDate(current.DueDate)})
+	}
+
+	if added, removed := diffIDs(splitIDs(previous.LabelIDs), splitIDs(current.LabelIDs)); len(added) > 0 || len(removed) > 0 {
+		changes = append(changes, &internal_models.CardFieldChangeDTO{Field: CardVersionFieldLabels, Added: added, Removed: removed})
+	}
+
+	if added, removed := diffIDs(splitIDs(previous.MemberIDs), splitIDs(current.MemberIDs)); len(added) > 0 || len(removed) > 0 {
+		changes = append(changes, &internal_models.CardFieldChangeDTO{Field: CardVersionFieldMembers, Added: added, Removed: removed})
+	}
+
+	return changes
+}
+
+func diffIDs(previous, current []uint64) (added, removed []uint64) {
+	previousSet := make(map[uint64]bool, len(previous))
+	for _, id := range previous {
+		previousSet[id] = true
+	}
+
+	currentSet := make(map[uint64]bool, len(current))
+	for _, id := range current {
+		currentSet[id] = true
+		if !previousSet[id] {
+			added = append(added, id)
+		}
+	}
+
+	for _, id := range previous {
+		if !currentSet[id] {
+			removed = append(removed, id)
+		}
+	}
+
+	return added, removed
+}
+
+func formatDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+	return date.UTC().Format(time.RFC3339)
+}
+
+func joinIDs(ids []uint64) string {
+	sorted := append([]uint64(nil), ids...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	parts := make([]string, len(sorted))
+	for i, id := range sorted {
+		parts[i] = strconv.FormatUint(id, 10)
+	}
+	return strings.Join(parts, ",")
+}
+
+func splitIDs(ids string) []uint64 {
+	if ids == "" {
+		return nil
+	}
+
+	var result []uint64
+	for _, part := range strings.Split(ids, ",") {
+		if id, err := strconv.ParseUint(part, 10, 64); err == nil {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// bulkUpdateCard applies the operation of a bulk update to one card through the method that handles
+// it on its own. Cards of other boards are reported as not found.
+func (r *GormCardRepository) bulkUpdateCard(req *BulkUpdateCardsRequest, cardID uint64) (*internal_models.WIPLimitWarningDTO, error) {
+	var card models.Card
+	if err := r.db.Where("id = ? AND board_id = ?", cardID, req.BoardID).First(&card).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorhandlers.NewGrpcNotFoundError("Card not found")
+		}
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	switch req.Operation {
+	case bulkcardoperations.Move:
+		// Cards are appended to the list in the order they were given
+		res, err := r.MoveCardPosition(&MoveCardPositionRequest{
+			CardID:    card.ID,
+			Position:  math.MaxInt64,
+			BoardID:   req.BoardID,
+			OldListID: card.ListID,
+			NewListID: req.ListID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.WIPLimitWarning, nil
+	case bulkcardoperations.Archive:
+		return nil, r.ArchiveCard(&ArchiveCardRequest{CardID: card.ID, BoardID: req.BoardID})
+	case bulkcardoperations.Restore:
+		return nil, r.RestoreCard(&RestoreCardRequest{CardID: card.ID, BoardID: req.BoardID})
+	case bulkcardoperations.AddLabel:
+		return nil, r.AddCardLabel(&AddCardLabelRequest{LabelID: req.LabelID, CardID: card.ID, BoardID: req.BoardID})
+	case bulkcardoperations.RemoveLabel:
+		return nil, r.RemoveCardLabel(&RemoveCardLabelRequest{LabelID: req.LabelID, CardID: card.ID, BoardID: req.BoardID})
+	case bulkcardoperations.AddMember:
+		return nil, r.AddCardMembers(&AddCardMembersRequest{UserIDs: []uint64{req.UserID}, CardID: card.ID, BoardID: req.BoardID})
+	case bulkcardoperations.RemoveMember:
+		return nil, r.RemoveCardMembers(&RemoveCardMembersRequest{UserIDs: []uint64{req.UserID}, CardID: card.ID, BoardID: req.BoardID})
+	case bulkcardoperations.SetDueDate:
+		return nil, r.SetCardDates(&SetCardDatesRequest{StartDate: card.StartDate, DueDate: req.DueDate, CardID: card.ID, BoardID: req.BoardID})
+	case bulkcardoperations.Complete:
+		if card.IsCompleted {
+			return nil, nil
+		}
+		return nil, r.ToggleCardCompleted(&ToggleCardCompletedRequest{CardID: card.ID, BoardID: req.BoardID})
+	}
+
+	return nil, errorhandlers.NewGrpcBadRequestError("Unknown bulk operation")
+}
+
+// cardSortScope orders a card query by one of the CardSortFields. Cards without a value sort last
+// and ties keep their current order.
+func (r *GormCardRepository) cardSortScope(req *SortCardsInListRequest) (func(*gorm.DB) *gorm.DB, error) {
+	if req.SortBy == CardSortCustomField {
+		return r.customFieldScope(req.BoardID, nil, &CustomFieldSort{CustomFieldID: req.CustomFieldID, Descending: req.Descending})
+	}
+
+	var column string
+	switch req.SortBy {
+	case CardSortDueDate:
+		column = "cards.due_date"
+	case CardSortCreatedAt:
+		column = "cards.created_at"
+	case CardSortName:
+		column = "LOWER(cards.name)"
+	default:
+		return nil, errorhandlers.NewGrpcBadRequestError("Unknown sort field")
+	}
+
+	direction := "ASC"
+	if req.Descending {
+		direction = "DESC"
+	}
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Order(column + " " + direction + " NULLS LAST, cards.position")
+	}, nil
+}
+
+// rankCards gives the cards positions Gap apart in the order of cardIDs with a single statement
+func rankCards(tx *gorm.DB, cardIDs []uint64) error {
+	if len(cardIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]string, 0, len(cardIDs))
+	vars := []interface{}{positions.Gap}
+	for i, cardID := range cardIDs {
+		rows = append(rows, "(?::bigint, ?::bigint)")
+		vars = append(vars, cardID, i+1)
+	}
+
+	query := `UPDATE cards SET position = ranked.rank * ?, version = cards.version + 1
+		FROM (VALUES ` + strings.Join(rows, ", ") + `) AS ranked(id, rank)
+		WHERE cards.id = ranked.id`
+
+	return tx.Exec(query, vars...).Error
+}
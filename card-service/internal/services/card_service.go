@@ -2,22 +2,115 @@ package services
 
 import (
 	"context"
-
+	"errors"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
 	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
+	external_services "github.com/sm888sm/halten-backend/card-service/external/services"
+	"github.com/sm888sm/halten-backend/card-service/internal/collab"
+	"github.com/sm888sm/halten-backend/card-service/internal/csvimport"
+	"github.com/sm888sm/halten-backend/card-service/internal/markdown"
 	"github.com/sm888sm/halten-backend/card-service/internal/repositories"
 	"github.com/sm888sm/halten-backend/common/constants/contextkeys"
+	"github.com/sm888sm/halten-backend/common/constants/deletionsteps"
+	"github.com/sm888sm/halten-backend/common/constants/roles"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+	"github.com/sm888sm/halten-backend/common/transactions"
+	"github.com/sm888sm/halten-backend/common/undo"
 	"github.com/sm888sm/halten-backend/models"
+	pb_user "github.com/sm888sm/halten-backend/user-service/api/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const (
+	defaultCommentPageSize = 20
+	defaultHistoryPageSize = 20
+	maxDescriptionSize     = 16384
+	maxLabelNameLength     = 50
+
+	// defaultImportLabelColor is given to the labels a card import creates
+	defaultImportLabelColor = "#b3bac5"
+)
+
 type CardService struct {
-	cardRepo repositories.CardRepository
+	cardRepo      repositories.CardRepository
+	svc           *external_services.Services
+	markdown      *markdown.Renderer
+	collab        *collab.Hub
+	peers         *descriptionPeers // Replicas holding the description sessions relayed to them
+	publishers    *publishers.Publishers
+	attachmentDir string // Directory attachment file paths are relative to
 	pb_card.UnimplementedCardServiceServer
 }
 
-func NewCardService(repo repositories.CardRepository) *CardService {
-	return &CardService{cardRepo: repo}
+func NewCardService(repo repositories.CardRepository, svc *external_services.Services, renderer *markdown.Renderer, hub *collab.Hub, publishers *publishers.Publishers, attachmentDir string) *CardService {
+	return &CardService{cardRepo: repo, svc: svc, markdown: renderer, collab: hub, peers: newDescriptionPeers(), publishers: publishers, attachmentDir: attachmentDir}
+}
+
+// Close closes the connections to the replicas description sessions are relayed to
+func (s *CardService) Close() {
+	s.peers.close()
+}
+
+// NewDescriptionHub creates the hub for collaborative description editing, saving snapshots back
+// to the cards every snapshot interval. Each card's session is held by the replica whose lease on
+// it is live, owner being the address other replicas relay its editors to.
+func NewDescriptionHub(repo repositories.CardRepository, owner string, leaseTTL, snapshotInterval time.Duration) *collab.Hub {
+	load := func(cardID, boardID uint64) (string, uint64, error) {
+		repoRes, err := repo.GetCardByID(&repositories.GetCardByIDRequest{CardID: cardID})
+		if err != nil {
+			return "", 0, err
+		}
+		if repoRes.Card.BoardID != boardID {
+			return "", 0, errorhandlers.NewGrpcNotFoundError("Card not found")
+		}
+		return repoRes.Card.Description, repoRes.Card.Version, nil
+	}
+
+	save := func(cardID, boardID uint64, description string, expectedVersion uint64) (uint64, error) {
+		repoRes, err := repo.UpdateCardDescription(&repositories.UpdateCardDescriptionRequest{
+			CardID:          cardID,
+			Description:     description,
+			BoardID:         boardID,
+			ExpectedVersion: expectedVersion,
+		})
+		if status.Code(err) == codes.Aborted {
+			return 0, collab.ErrConflict
+		}
+		if err != nil {
+			return 0, err
+		}
+		return repoRes.Version, nil
+	}
+
+	leases := &descriptionLeases{repo: repo, owner: owner, ttl: leaseTTL}
+
+	return collab.NewHub(load, save, leases, maxDescriptionSize, snapshotInterval)
+}
+
+// checkNotEditing rejects changing the description of a card being edited collaboratively, which
+// the next snapshot of the session would overwrite
+func (s *CardService) checkNotEditing(cardID uint64) error {
+	editing, err := s.collab.IsEditing(cardID)
+	if err != nil {
+		return err
+	}
+	if editing {
+		return errorhandlers.NewGrpcConflictError("Description is being edited collaboratively")
+	}
+	return nil
 }
 
 func (s *CardService) CreateCard(ctx context.Context, req *pb_card.CreateCardRequest) (*pb_card.CreateCardResponse, error) {
@@ -42,7 +135,9 @@ func (s *CardService) CreateCard(ctx context.Context, req *pb_card.CreateCardReq
 			Name:     repoRes.Card.Name,
 			ListID:   repoRes.Card.ListID,
 			Position: repoRes.Card.Position,
+			Version:  repoRes.Card.Version,
 		},
+		WipLimitWarning: convertWIPLimitWarningToProto(repoRes.WIPLimitWarning),
 	}, nil
 }
 
@@ -56,26 +151,45 @@ func (s *CardService) GetCardByID(ctx context.Context, req *pb_card.GetCardByIDR
 
 	card := repoRes.Card
 
-	return &pb_card.GetCardByIDResponse{
-		Card: &pb_card.Card{
-			CardID:      uint64(card.ID),
-			ListID:      uint64(card.ListID),
-			Name:        card.Name,
-			Position:    int64(card.Position),
-			StartDate:   timestamppb.New(*card.StartDate),
-			DueDate:     timestamppb.New(*card.DueDate),
-			Attachments: card.Attachments,
-			Labels:      card.Labels,
-			Members:     card.Members,
-			CreatedAt:   timestamppb.New(card.CreatedAt),
-			UpdatedAt:   timestamppb.New(card.UpdatedAt),
-		},
-	}, nil
+	descriptionHTML, err := s.markdown.Render(card.Description)
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	protoCard := &pb_card.Card{
+		CardID:            uint64(card.ID),
+		BoardID:           card.BoardID,
+		ListID:            uint64(card.ListID),
+		Name:              card.Name,
+		Description:       card.Description,
+		DescriptionHtml:   descriptionHTML,
+		Position:          int64(card.Position),
+		Version:           card.Version,
+		IsCompleted:       card.IsCompleted,
+		Attachments:       card.Attachments,
+		Labels:            card.Labels,
+		Members:           card.Members,
+		Reminders:         convertRemindersToProto(card.Reminders),
+		Recurrence:        convertRecurrenceToProto(card.Recurrence),
+		CustomFieldValues: convertCustomFieldValuesToProto(card.CustomFieldValues),
+		CreatedAt:         timestamppb.New(card.CreatedAt),
+		UpdatedAt:         timestamppb.New(card.UpdatedAt),
+	}
+	if card.StartDate != nil {
+		protoCard.StartDate = timestamppb.New(*card.StartDate)
+	}
+	if card.DueDate != nil {
+		protoCard.DueDate = timestamppb.New(*card.DueDate)
+	}
+
+	return &pb_card.GetCardByIDResponse{Card: protoCard}, nil
 }
 
 func (s *CardService) GetCardsByList(ctx context.Context, req *pb_card.GetCardsByListRequest) (*pb_card.GetCardsByListResponse, error) {
 	repoRes, err := s.cardRepo.GetCardsByList(&repositories.GetCardsByListRequest{
-		ListID: req.ListID,
+		ListID:  req.ListID,
+		Filters: convertCustomFieldFiltersFromProto(req.Filters),
+		Sort:    convertCustomFieldSortFromProto(req.Sort),
 	})
 	if err != nil {
 		return nil, err
@@ -84,19 +198,21 @@ func (s *CardService) GetCardsByList(ctx context.Context, req *pb_card.GetCardsB
 	var pb_cardCards []*pb_card.CardMeta
 	for _, c := range repoRes.Cards {
 		pb_cardCards = append(pb_cardCards, &pb_card.CardMeta{
-			CardID:          c.ID,
-			ListID:          c.ListID,
-			BoardID:         c.BoardID,
-			Name:            c.Name,
-			Position:        c.Position,
-			StartDate:       timestamppb.New(*c.StartDate),
-			DueDate:         timestamppb.New(*c.DueDate),
-			Labels:          c.Labels,
-			Members:         c.Members,
-			TotalAttachment: c.TotalAttachment,
-			TotalComment:    c.TotalComment,
-			CreatedAt:       timestamppb.New(c.CreatedAt),
-			UpdatedAt:       timestamppb.New(c.UpdatedAt),
+			CardID:            c.ID,
+			ListID:            c.ListID,
+			BoardID:           c.BoardID,
+			Name:              c.Name,
+			Position:          c.Position,
+			Version:           c.Version,
+			StartDate:         timestamppb.New(*c.StartDate),
+			DueDate:           timestamppb.New(*c.DueDate),
+			Labels:            c.Labels,
+			Members:           c.Members,
+			TotalAttachment:   c.TotalAttachment,
+			TotalComment:      c.TotalComment,
+			CustomFieldValues: convertCustomFieldValuesToProto(c.CustomFieldValues),
+			CreatedAt:         timestamppb.New(c.CreatedAt),
+			UpdatedAt:         timestamppb.New(c.UpdatedAt),
 		})
 	}
 
@@ -113,6 +229,8 @@ func (s *CardService) GetCardsByBoard(ctx context.Context, req *pb_card.GetCards
 
 	repoRes, err := s.cardRepo.GetCardsByBoard(&repositories.GetCardsByBoardRequest{
 		BoardID: boardID,
+		Filters: convertCustomFieldFiltersFromProto(req.Filters),
+		Sort:    convertCustomFieldSortFromProto(req.Sort),
 	})
 
 	if err != nil {
@@ -122,19 +240,21 @@ func (s *CardService) GetCardsByBoard(ctx context.Context, req *pb_card.GetCards
 	var pb_cardCards []*pb_card.CardMeta
 	for _, c := range repoRes.Cards {
 		pb_cardCards = append(pb_cardCards, &pb_card.CardMeta{
-			CardID:          c.ID,
-			ListID:          c.ListID,
-			BoardID:         c.BoardID,
-			Name:            c.Name,
-			Position:        c.Position,
-			StartDate:       timestamppb.New(*c.StartDate),
-			DueDate:         timestamppb.New(*c.DueDate),
-			Labels:          c.Labels,
-			Members:         c.Members,
-			TotalAttachment: c.TotalAttachment,
-			TotalComment:    c.TotalComment,
-			CreatedAt:       timestamppb.New(c.CreatedAt),
-			UpdatedAt:       timestamppb.New(c.UpdatedAt),
+			CardID:            c.ID,
+			ListID:            c.ListID,
+			BoardID:           c.BoardID,
+			Name:              c.Name,
+			Position:          c.Position,
+			Version:           c.Version,
+			StartDate:         timestamppb.New(*c.StartDate),
+			DueDate:           timestamppb.New(*c.DueDate),
+			Labels:            c.Labels,
+			Members:           c.Members,
+			TotalAttachment:   c.TotalAttachment,
+			TotalComment:      c.TotalComment,
+			CustomFieldValues: convertCustomFieldValuesToProto(c.CustomFieldValues),
+			CreatedAt:         timestamppb.New(c.CreatedAt),
+			UpdatedAt:         timestamppb.New(c.UpdatedAt),
 		})
 	}
 
@@ -150,18 +270,23 @@ func (s *CardService) MoveCardPosition(ctx context.Context, req *pb_card.MoveCar
 	}
 
 	repoReq := &repositories.MoveCardPositionRequest{
-		CardID:    req.CardID,
-		Position:  req.Position,
-		BoardID:   boardID,
-		OldListID: req.OldListID,
-		NewListID: req.NewListID,
+		CardID:          req.CardID,
+		Position:        req.Position,
+		BoardID:         boardID,
+		OldListID:       req.OldListID,
+		NewListID:       req.NewListID,
+		ExpectedVersion: req.ExpectedVersion,
+		Actor:           undo.ActorFromContext(ctx),
 	}
 
-	err := s.cardRepo.MoveCardPosition(repoReq)
+	repoRes, err := s.cardRepo.MoveCardPosition(repoReq)
 	if err != nil {
 		return nil, err
 	}
-	return &pb_card.MoveCardPositionResponse{}, nil
+	return &pb_card.MoveCardPositionResponse{
+		Message:         "Card moved successfully",
+		WipLimitWarning: convertWIPLimitWarningToProto(repoRes.WIPLimitWarning),
+	}, nil
 }
 
 func (s *CardService) UpdateCardName(ctx context.Context, req *pb_card.UpdateCardNameRequest) (*pb_card.UpdateCardNameResponse, error) {
@@ -171,9 +296,10 @@ func (s *CardService) UpdateCardName(ctx context.Context, req *pb_card.UpdateCar
 	}
 
 	repoReq := &repositories.UpdateCardNameRequest{
-		CardID:  req.CardID,
-		Name:    req.Name,
-		BoardID: boardID,
+		CardID:          req.CardID,
+		Name:            req.Name,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
 	}
 	err := s.cardRepo.UpdateCardName(repoReq)
 	if err != nil {
@@ -188,12 +314,17 @@ func (s *CardService) UpdateCardDescription(ctx context.Context, req *pb_card.Up
 		return nil, errorhandlers.NewGrpcInternalError()
 	}
 
+	if err := s.checkNotEditing(req.CardID); err != nil {
+		return nil, err
+	}
+
 	repoReq := &repositories.UpdateCardDescriptionRequest{
-		CardID:      req.CardID,
-		Description: req.Description,
-		BoardID:     boardID,
+		CardID:          req.CardID,
+		Description:     req.Description,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
 	}
-	err := s.cardRepo.UpdateCardDescription(repoReq)
+	_, err := s.cardRepo.UpdateCardDescription(repoReq)
 	if err != nil {
 		return nil, err
 	}
@@ -202,6 +333,105 @@ func (s *CardService) UpdateCardDescription(ctx context.Context, req *pb_card.Up
 	}, nil
 }
 
+// EditCardDescription runs a collaborative editing session. Edits are merged with operational
+// transformation and the description is saved back to the card periodically and when the last
+// editor leaves. A session held by another replica is relayed to it.
+func (s *CardService) EditCardDescription(stream pb_card.CardService_EditCardDescriptionServer) error {
+	ctx := stream.Context()
+
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	join := req.GetJoin()
+	if join == nil {
+		return errorhandlers.NewGrpcBadRequestError("The first message must join a card")
+	}
+
+	client, snapshot, err := s.collab.Join(join.CardID, boardID, userID)
+	var notOwner *collab.NotOwnerError
+	if errors.As(err, &notOwner) {
+		return s.proxyDescriptionSession(stream, notOwner.Owner, req)
+	}
+	if err != nil {
+		return err
+	}
+	defer s.collab.Leave(client)
+
+	protoSnapshot := &pb_card.DescriptionSnapshot{
+		Revision: snapshot.Revision,
+		Content:  snapshot.Document,
+	}
+	for _, presence := range snapshot.Presence {
+		protoSnapshot.Presence = append(protoSnapshot.Presence, convertPresenceToProto(presence))
+	}
+
+	if err := stream.Send(&pb_card.EditCardDescriptionResponse{
+		Payload: &pb_card.EditCardDescriptionResponse_Snapshot{Snapshot: protoSnapshot},
+	}); err != nil {
+		return err
+	}
+
+	// Messages are received on their own goroutine so that only this one sends on the stream
+	recvErr := make(chan error, 1)
+	go func() {
+		recvErr <- s.receiveDescriptionEdits(stream, client)
+	}()
+
+	for {
+		select {
+		case event, ok := <-client.Events():
+			if !ok {
+				return errorhandlers.NewGrpcConflictError("Description session is out of sync, join again")
+			}
+			if err := stream.Send(convertDescriptionEventToProto(event)); err != nil {
+				return err
+			}
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func (s *CardService) receiveDescriptionEdits(stream pb_card.CardService_EditCardDescriptionServer, client *collab.Client) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		switch payload := req.Payload.(type) {
+		case *pb_card.EditCardDescriptionRequest_Edit:
+			err = s.collab.Submit(client, payload.Edit.Revision, convertOperationFromProto(payload.Edit.Operation))
+		case *pb_card.EditCardDescriptionRequest_Cursor:
+			err = s.collab.UpdateCursor(client, payload.Cursor.Revision, collab.Cursor{
+				Position:     int(payload.Cursor.Position),
+				SelectionEnd: int(payload.Cursor.SelectionEnd),
+			})
+		default:
+			err = errorhandlers.NewGrpcBadRequestError("Already joined a card")
+		}
+
+		if err != nil {
+			return convertCollabError(err)
+		}
+	}
+}
+
 func (s *CardService) AddCardLabel(ctx context.Context, req *pb_card.AddCardLabelRequest) (*pb_card.AddCardLabelResponse, error) {
 	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
 	if !ok {
@@ -252,10 +482,11 @@ func (s *CardService) SetCardDates(ctx context.Context, req *pb_card.SetCardDate
 	dueDate := req.DueDate.AsTime()
 
 	repoReq := &repositories.SetCardDatesRequest{
-		StartDate: &startDate,
-		DueDate:   &dueDate,
-		CardID:    req.CardID,
-		BoardID:   boardID,
+		StartDate:       &startDate,
+		DueDate:         &dueDate,
+		CardID:          req.CardID,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
 	}
 	err := s.cardRepo.SetCardDates(repoReq)
 	if err != nil {
@@ -273,8 +504,9 @@ func (s *CardService) ToggleCardCompleted(ctx context.Context, req *pb_card.Togg
 	}
 
 	repoReq := &repositories.ToggleCardCompletedRequest{
-		CardID:  req.CardID,
-		BoardID: boardID,
+		CardID:          req.CardID,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
 	}
 
 	err := s.cardRepo.ToggleCardCompleted(repoReq)
@@ -343,20 +575,189 @@ func (s *CardService) AddCardComment(ctx context.Context, req *pb_card.AddCardCo
 	comment := models.Comment{
 		Content: req.Content,
 	}
+	if req.ParentID != 0 {
+		comment.ParentID = &req.ParentID
+	}
+
+	mentionedUserIDs, err := s.resolveMentions(ctx, req.Content)
+	if err != nil {
+		return nil, err
+	}
 
 	repoReq := &repositories.AddCardCommentRequest{
-		Comment: comment,
-		CardID:  req.CardID,
-		BoardID: boardID,
-		UserID:  userID,
+		Comment:          comment,
+		CardID:           req.CardID,
+		BoardID:          boardID,
+		UserID:           userID,
+		MentionedUserIDs: mentionedUserIDs,
 	}
 
-	err := s.cardRepo.AddCardComment(repoReq)
+	repoRes, err := s.cardRepo.AddCardComment(repoReq)
 	if err != nil {
 		return nil, err
 	}
 	return &pb_card.AddCardCommentResponse{
-		Message: "Comment added to card",
+		Message:   "Comment added to card",
+		CommentID: repoRes.CommentID,
+	}, nil
+}
+
+func (s *CardService) UpdateCardComment(ctx context.Context, req *pb_card.UpdateCardCommentRequest) (*pb_card.UpdateCardCommentResponse, error) {
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	mentionedUserIDs, err := s.resolveMentions(ctx, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	repoReq := &repositories.UpdateCardCommentRequest{
+		CommentID:        req.CommentID,
+		CardID:           req.CardID,
+		BoardID:          boardID,
+		UserID:           userID,
+		Content:          req.Content,
+		MentionedUserIDs: mentionedUserIDs,
+	}
+
+	err = s.cardRepo.UpdateCardComment(repoReq)
+	if err != nil {
+		return nil, err
+	}
+	return &pb_card.UpdateCardCommentResponse{
+		Message: "Comment updated",
+	}, nil
+}
+
+func (s *CardService) GetCardComments(ctx context.Context, req *pb_card.GetCardCommentsRequest) (*pb_card.GetCardCommentsResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	pageNumber := req.PageNumber
+	if pageNumber == 0 {
+		pageNumber = 1
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultCommentPageSize
+	}
+
+	repoRes, err := s.cardRepo.GetCardComments(&repositories.GetCardCommentsRequest{
+		CardID:     req.CardID,
+		BoardID:    boardID,
+		PageNumber: pageNumber,
+		PageSize:   pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []*pb_card.Comment
+	for _, comment := range repoRes.Comments {
+		protoComment, err := s.convertCommentToProto(comment)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, protoComment)
+	}
+
+	return &pb_card.GetCardCommentsResponse{
+		Comments: comments,
+		Pagination: &pb_card.Pagination{
+			CurrentPage:  repoRes.Pagination.CurrentPage,
+			TotalPages:   repoRes.Pagination.TotalPages,
+			ItemsPerPage: repoRes.Pagination.ItemsPerPage,
+			TotalItems:   repoRes.Pagination.TotalItems,
+			HasMore:      repoRes.Pagination.HasMore,
+		},
+	}, nil
+}
+
+func (s *CardService) GetCardCommentHistory(ctx context.Context, req *pb_card.GetCardCommentHistoryRequest) (*pb_card.GetCardCommentHistoryResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	repoRes, err := s.cardRepo.GetCardCommentHistory(&repositories.GetCardCommentHistoryRequest{
+		CommentID: req.CommentID,
+		CardID:    req.CardID,
+		BoardID:   boardID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var edits []*pb_card.CommentEdit
+	for _, edit := range repoRes.Edits {
+		edits = append(edits, &pb_card.CommentEdit{
+			Content:  edit.Content,
+			EditedAt: timestamppb.New(edit.EditedAt),
+		})
+	}
+
+	return &pb_card.GetCardCommentHistoryResponse{Edits: edits}, nil
+}
+
+func (s *CardService) AddCommentReaction(ctx context.Context, req *pb_card.AddCommentReactionRequest) (*pb_card.AddCommentReactionResponse, error) {
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	err := s.cardRepo.AddCommentReaction(&repositories.AddCommentReactionRequest{
+		CommentID: req.CommentID,
+		CardID:    req.CardID,
+		BoardID:   boardID,
+		UserID:    userID,
+		Emoji:     req.Emoji,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb_card.AddCommentReactionResponse{
+		Message: "Reaction added to comment",
+	}, nil
+}
+
+func (s *CardService) RemoveCommentReaction(ctx context.Context, req *pb_card.RemoveCommentReactionRequest) (*pb_card.RemoveCommentReactionResponse, error) {
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	err := s.cardRepo.RemoveCommentReaction(&repositories.RemoveCommentReactionRequest{
+		CommentID: req.CommentID,
+		CardID:    req.CardID,
+		BoardID:   boardID,
+		UserID:    userID,
+		Emoji:     req.Emoji,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb_card.RemoveCommentReactionResponse{
+		Message: "Reaction removed from comment",
 	}, nil
 }
 
@@ -437,8 +838,10 @@ func (s *CardService) ArchiveCard(ctx context.Context, req *pb_card.ArchiveCardR
 	}
 
 	repoReq := &repositories.ArchiveCardRequest{
-		CardID:  req.CardID,
-		BoardID: boardID,
+		CardID:          req.CardID,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
+		Actor:           undo.ActorFromContext(ctx),
 	}
 
 	err := s.cardRepo.ArchiveCard(repoReq)
@@ -457,8 +860,10 @@ func (s *CardService) RestoreCard(ctx context.Context, req *pb_card.RestoreCardR
 	}
 
 	repoReq := &repositories.RestoreCardRequest{
-		CardID:  req.CardID,
-		BoardID: boardID,
+		CardID:          req.CardID,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
+		Actor:           undo.ActorFromContext(ctx),
 	}
 
 	err := s.cardRepo.RestoreCard(repoReq)
@@ -480,6 +885,8 @@ func (s *CardService) DeleteCard(ctx context.Context, req *pb_card.DeleteCardReq
 	repoReq := &repositories.DeleteCardRequest{
 		CardID:  req.CardID,
 		BoardID: boardID,
+		Actor:   undo.ActorFromContext(ctx),
+		Tx:      transactions.FromContext(ctx),
 	}
 
 	err := s.cardRepo.DeleteCard(repoReq)
@@ -490,3 +897,482 @@ func (s *CardService) DeleteCard(ctx context.Context, req *pb_card.DeleteCardReq
 		Message: "Card deleted",
 	}, nil
 }
+
+func (s *CardService) SetCardReminders(ctx context.Context, req *pb_card.SetCardRemindersRequest) (*pb_card.SetCardRemindersResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	repoReq := &repositories.SetCardRemindersRequest{
+		OffsetMinutes: req.OffsetMinutes,
+		CardID:        req.CardID,
+		BoardID:       boardID,
+	}
+
+	err := s.cardRepo.SetCardReminders(repoReq)
+	if err != nil {
+		return nil, err
+	}
+	return &pb_card.SetCardRemindersResponse{
+		Message: "Card reminders updated",
+	}, nil
+}
+
+func (s *CardService) SetCardRecurrence(ctx context.Context, req *pb_card.SetCardRecurrenceRequest) (*pb_card.SetCardRecurrenceResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	repoReq := &repositories.SetCardRecurrenceRequest{
+		Frequency:    req.Frequency,
+		Interval:     int(req.Interval),
+		Weekdays:     req.Weekdays,
+		MonthDay:     int(req.MonthDay),
+		TargetListID: req.TargetListID,
+		CardID:       req.CardID,
+		BoardID:      boardID,
+	}
+
+	err := s.cardRepo.SetCardRecurrence(repoReq)
+	if err != nil {
+		return nil, err
+	}
+	return &pb_card.SetCardRecurrenceResponse{
+		Message: "Card recurrence updated",
+	}, nil
+}
+
+func (s *CardService) RemoveCardRecurrence(ctx context.Context, req *pb_card.RemoveCardRecurrenceRequest) (*pb_card.RemoveCardRecurrenceResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	repoReq := &repositories.RemoveCardRecurrenceRequest{
+		CardID:  req.CardID,
+		BoardID: boardID,
+	}
+
+	err := s.cardRepo.RemoveCardRecurrence(repoReq)
+	if err != nil {
+		return nil, err
+	}
+	return &pb_card.RemoveCardRecurrenceResponse{
+		Message: "Card recurrence removed",
+	}, nil
+}
+
+func (s *CardService) SetCardCustomFieldValue(ctx context.Context, req *pb_card.SetCardCustomFieldValueRequest) (*pb_card.SetCardCustomFieldValueResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	repoReq := &repositories.SetCardCustomFieldValueRequest{
+		Value:   convertCustomFieldValueFromProto(req.Value),
+		CardID:  req.CardID,
+		BoardID: boardID,
+	}
+
+	err := s.cardRepo.SetCardCustomFieldValue(repoReq)
+	if err != nil {
+		return nil, err
+	}
+	return &pb_card.SetCardCustomFieldValueResponse{
+		Message: "Card custom field value updated",
+	}, nil
+}
+
+func (s *CardService) RemoveCardCustomFieldValue(ctx context.Context, req *pb_card.RemoveCardCustomFieldValueRequest) (*pb_card.RemoveCardCustomFieldValueResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	repoReq := &repositories.RemoveCardCustomFieldValueRequest{
+		CustomFieldID: req.CustomFieldID,
+		CardID:        req.CardID,
+		BoardID:       boardID,
+	}
+
+	err := s.cardRepo.RemoveCardCustomFieldValue(repoReq)
+	if err != nil {
+		return nil, err
+	}
+	return &pb_card.RemoveCardCustomFieldValueResponse{
+		Message: "Card custom field value removed",
+	}, nil
+}
+
+func (s *CardService) GetCardHistory(ctx context.Context, req *pb_card.GetCardHistoryRequest) (*pb_card.GetCardHistoryResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	pageNumber := req.PageNumber
+	if pageNumber == 0 {
+		pageNumber = 1
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultHistoryPageSize
+	}
+
+	repoRes, err := s.cardRepo.GetCardHistory(&repositories.GetCardHistoryRequest{
+		CardID:     req.CardID,
+		BoardID:    boardID,
+		PageNumber: pageNumber,
+		PageSize:   pageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []*pb_card.CardVersion
+	for _, cardVersion := range repoRes.Versions {
+		versions = append(versions, convertCardVersionToProto(cardVersion))
+	}
+
+	return &pb_card.GetCardHistoryResponse{
+		Versions: versions,
+		Pagination: &pb_card.Pagination{
+			CurrentPage:  repoRes.Pagination.CurrentPage,
+			TotalPages:   repoRes.Pagination.TotalPages,
+			ItemsPerPage: repoRes.Pagination.ItemsPerPage,
+			TotalItems:   repoRes.Pagination.TotalItems,
+			HasMore:      repoRes.Pagination.HasMore,
+		},
+	}, nil
+}
+
+func (s *CardService) RestoreCardVersion(ctx context.Context, req *pb_card.RestoreCardVersionRequest) (*pb_card.RestoreCardVersionResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	if len(req.Fields) == 0 || slices.Contains(req.Fields, repositories.CardVersionFieldDescription) {
+		if err := s.checkNotEditing(req.CardID); err != nil {
+			return nil, err
+		}
+	}
+
+	err := s.cardRepo.RestoreCardVersion(&repositories.RestoreCardVersionRequest{
+		CardID:          req.CardID,
+		BoardID:         boardID,
+		Version:         req.Version,
+		Fields:          req.Fields,
+		ExpectedVersion: req.ExpectedVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_card.RestoreCardVersionResponse{
+		Message: "Card version restored",
+	}, nil
+}
+
+func (s *CardService) BulkUpdateCards(ctx context.Context, req *pb_card.BulkUpdateCardsRequest) (*pb_card.BulkUpdateCardsResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	repoReq := &repositories.BulkUpdateCardsRequest{
+		CardIDs:   req.CardIDs,
+		BoardID:   boardID,
+		Operation: req.Operation,
+		ListID:    req.ListID,
+		LabelID:   req.LabelID,
+		UserID:    req.UserID,
+	}
+	if req.DueDate != nil {
+		dueDate := req.DueDate.AsTime()
+		repoReq.DueDate = &dueDate
+	}
+
+	repoRes, err := s.cardRepo.BulkUpdateCards(repoReq)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb_card.BulkUpdateCardsResponse{
+		Message: "Cards updated",
+		Results: convertBulkCardResultsToProto(repoRes.Results),
+	}
+	for _, result := range res.Results {
+		if result.Success {
+			res.Succeeded++
+		} else {
+			res.Failed++
+		}
+	}
+
+	return res, nil
+}
+
+func (s *CardService) ArchiveAllCardsInList(ctx context.Context, req *pb_card.ArchiveAllCardsInListRequest) (*pb_card.ArchiveAllCardsInListResponse, error) {
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	repoRes, err := s.cardRepo.ArchiveAllCardsInList(&repositories.ArchiveAllCardsInListRequest{
+		ListID:  req.ListID,
+		BoardID: boardID,
+		Event: s.listCardsEvent(ctx, publishers.ListCardsArchived, &pb_card.ListCardsEvent{
+			BoardID: boardID,
+			ListID:  req.ListID,
+			UserID:  userID,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_card.ArchiveAllCardsInListResponse{
+		Message: "Cards archived",
+		CardIDs: repoRes.CardIDs,
+	}, nil
+}
+
+func (s *CardService) MoveAllCardsInList(ctx context.Context, req *pb_card.MoveAllCardsInListRequest) (*pb_card.MoveAllCardsInListResponse, error) {
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	// The interceptor only checked the board of the list, cards are also added to the target board
+	targetBoardID := req.TargetBoardID
+	if targetBoardID == 0 {
+		targetBoardID = boardID
+	}
+	if targetBoardID != boardID {
+		authClient, err := s.svc.GetAuthClient()
+		if err != nil {
+			return nil, errorhandlers.NewGrpcInternalError()
+		}
+
+		if _, err := authClient.CheckBoardUserRole(ctx, &pb_user.CheckBoardUserRoleRequest{
+			UserID:       userID,
+			BoardID:      targetBoardID,
+			RequiredRole: roles.MemberRole,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	repoRes, err := s.cardRepo.MoveAllCardsInList(&repositories.MoveAllCardsInListRequest{
+		ListID:        req.ListID,
+		BoardID:       boardID,
+		TargetListID:  req.TargetListID,
+		TargetBoardID: targetBoardID,
+		Event: s.listCardsEvent(ctx, publishers.ListCardsMoved, &pb_card.ListCardsEvent{
+			BoardID:       boardID,
+			ListID:        req.ListID,
+			TargetBoardID: targetBoardID,
+			TargetListID:  req.TargetListID,
+			UserID:        userID,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_card.MoveAllCardsInListResponse{
+		Message:         "Cards moved",
+		CardIDs:         repoRes.CardIDs,
+		WipLimitWarning: convertWIPLimitWarningToProto(repoRes.WIPLimitWarning),
+	}, nil
+}
+
+func (s *CardService) SortCardsInList(ctx context.Context, req *pb_card.SortCardsInListRequest) (*pb_card.SortCardsInListResponse, error) {
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	repoRes, err := s.cardRepo.SortCardsInList(&repositories.SortCardsInListRequest{
+		ListID:        req.ListID,
+		BoardID:       boardID,
+		SortBy:        req.SortBy,
+		CustomFieldID: req.CustomFieldID,
+		Descending:    req.Descending,
+		Event: s.listCardsEvent(ctx, publishers.ListCardsSorted, &pb_card.ListCardsEvent{
+			BoardID: boardID,
+			ListID:  req.ListID,
+			UserID:  userID,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb_card.SortCardsInListResponse{
+		Message: "Cards sorted",
+		CardIDs: repoRes.CardIDs,
+	}, nil
+}
+
+// ImportCards adds a card to the end of the list for each row of a CSV file. Rows are checked
+// before anything is saved, so a file with errors imports nothing unless the request is partial.
+func (s *CardService) ImportCards(ctx context.Context, req *pb_card.ImportCardsRequest) (*pb_card.ImportCardsResponse, error) {
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	targetsRes, err := s.cardRepo.GetCardImportTargets(&repositories.GetCardImportTargetsRequest{BoardID: boardID})
+	if err != nil {
+		return nil, err
+	}
+	targets := newCardImportTargets(targetsRes)
+
+	var customFieldNames []string
+	for _, customField := range targetsRes.CustomFields {
+		customFieldNames = append(customFieldNames, customField.Name)
+	}
+
+	rows, err := csvimport.Parse(req.Content, req.Columns, customFieldNames)
+	if err != nil {
+		return nil, errorhandlers.NewGrpcBadRequestError("Invalid CSV file: " + err.Error())
+	}
+	if len(rows) == 0 {
+		return nil, errorhandlers.NewGrpcBadRequestError("The file has no cards to import")
+	}
+
+	var cards []*repositories.ImportedCard
+	var rowErrors []*pb_card.ImportCardsRowError
+	for i := range rows {
+		card, errs := targets.resolve(req.ListID, &rows[i])
+		if len(errs) > 0 {
+			rowErrors = append(rowErrors, errs...)
+			continue
+		}
+		cards = append(cards, card)
+	}
+
+	if len(rowErrors) > 0 && (!req.Partial || len(cards) == 0) {
+		return &pb_card.ImportCardsResponse{
+			Message: "No cards imported, the file has errors",
+			Errors:  rowErrors,
+		}, nil
+	}
+
+	repoRes, err := s.cardRepo.ImportCards(&repositories.ImportCardsRequest{
+		BoardID:    boardID,
+		ListID:     req.ListID,
+		Cards:      cards,
+		LabelColor: defaultImportLabelColor,
+		Event: s.listCardsEvent(ctx, publishers.ListCardsImported, &pb_card.ListCardsEvent{
+			BoardID: boardID,
+			ListID:  req.ListID,
+			UserID:  userID,
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	message := "Cards imported"
+	if len(rowErrors) > 0 {
+		message = "Cards imported, rows with errors were skipped"
+	}
+
+	return &pb_card.ImportCardsResponse{
+		Message:         message,
+		Imported:        int32(len(repoRes.CardIDs)),
+		CardIDs:         repoRes.CardIDs,
+		LabelsCreated:   int32(repoRes.LabelsCreated),
+		Errors:          rowErrors,
+		WipLimitWarning: convertWIPLimitWarningToProto(repoRes.WIPLimitWarning),
+	}, nil
+}
+
+// PurgeBoardCards deletes the cards of a board being deleted for good, then confirms it to
+// board-service
+func (s *CardService) PurgeBoardCards(ctx context.Context, event *pb_board.BoardDeletedEvent) error {
+	repoRes, err := s.cardRepo.PurgeBoardCards(&repositories.PurgeBoardCardsRequest{
+		BoardID: event.BoardID,
+		Completed: publishers.NewEvent(s.publishers.BoardPublisher, publishers.BoardCleanupCompleted, events.MetadataFromContext(ctx), func(ids []uint64) proto.Message {
+			return &pb_board.BoardCleanupEvent{BoardID: ids[0], Step: deletionsteps.Cards}
+		}),
+		Tx: transactions.FromContext(ctx),
+	})
+	if err != nil {
+		return err
+	}
+
+	// The rows are gone once committed, so a file that can't be removed is only logged
+	transactions.AfterCommit(ctx, func() {
+		for _, path := range repoRes.AttachmentPaths {
+			if err := os.Remove(filepath.Join(s.attachmentDir, path)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				log.Printf("Failed to remove attachment file %s: %v", path, err)
+			}
+		}
+	})
+
+	return nil
+}
+
+// listCardsEvent tells live clients about cards changed together. The repository gives it the IDs
+// of the changed cards and writes it in the transaction of the change.
+func (s *CardService) listCardsEvent(ctx context.Context, messageType publishers.MessageType, event *pb_card.ListCardsEvent) publishers.Event {
+	return publishers.NewEvent(s.publishers.CardPublisher, messageType, events.MetadataFromContext(ctx), func(ids []uint64) proto.Message {
+		event.CardIDs = ids
+		return event
+	})
+}
+
+// resolveMentions looks up the users mentioned as @username in a comment. Usernames that don't
+// belong to any user are left as plain text.
+func (s *CardService) resolveMentions(ctx context.Context, content string) ([]uint64, error) {
+	usernames := parseMentions(content)
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	userClient, err := s.svc.GetUserClient()
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	var userIDs []uint64
+	for _, username := range usernames {
+		res, err := userClient.GetUserByUsername(ctx, &pb_user.GetUserByUsernameRequest{Username: username})
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return nil, err
+		}
+		userIDs = append(userIDs, res.User.UserID)
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,187 @@
+package services
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
+	"github.com/sm888sm/halten-backend/card-service/internal/repositories"
+	"github.com/sm888sm/halten-backend/common/errorhandlers"
+)
+
+// proxiedMetadataKey marks a description session relayed by another replica to the owner of the
+// session, which serves it rather than relaying it again
+const proxiedMetadataKey = "collabProxied"
+
+// descriptionLeases keeps the replica owning each description session in the database
+type descriptionLeases struct {
+	repo  repositories.CardRepository
+	owner string // Address other replicas reach this one at
+	ttl   time.Duration
+}
+
+func (l *descriptionLeases) Claim(cardID uint64) (string, error) {
+	owner, err := l.repo.ClaimDescriptionSession(&repositories.ClaimDescriptionSessionRequest{
+		CardID: cardID,
+		Owner:  l.owner,
+		TTL:    l.ttl,
+	})
+	if err != nil || owner == l.owner {
+		return "", err
+	}
+	return owner, nil
+}
+
+func (l *descriptionLeases) Renew(cardIDs []uint64) ([]uint64, error) {
+	res, err := l.repo.RenewDescriptionSessions(&repositories.RenewDescriptionSessionsRequest{
+		CardIDs: cardIDs,
+		Owner:   l.owner,
+		TTL:     l.ttl,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.CardIDs, nil
+}
+
+func (l *descriptionLeases) Release(cardID uint64) error {
+	return l.repo.ReleaseDescriptionSession(&repositories.ReleaseDescriptionSessionRequest{
+		CardID: cardID,
+		Owner:  l.owner,
+	})
+}
+
+func (l *descriptionLeases) Owner(cardID uint64) (string, error) {
+	return l.repo.GetDescriptionSessionOwner(cardID)
+}
+
+// descriptionPeers keeps a connection to each replica description sessions are relayed to, for as
+// long as any are. A peer's connection is closed once nothing is relayed to it, such as when the
+// leases of its sessions moved to another replica.
+type descriptionPeers struct {
+	mu    sync.Mutex
+	peers map[string]*descriptionPeer
+}
+
+type descriptionPeer struct {
+	conn   *grpc.ClientConn
+	client pb_card.CardServiceClient
+	relays int // Sessions being relayed to the peer
+}
+
+func newDescriptionPeers() *descriptionPeers {
+	return &descriptionPeers{peers: make(map[string]*descriptionPeer)}
+}
+
+// acquire returns a client of the replica at addr, which is released once the relay is done
+func (p *descriptionPeers) acquire(addr string) (pb_card.CardServiceClient, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	peer, ok := p.peers[addr]
+	if !ok {
+		conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, err
+		}
+
+		peer = &descriptionPeer{conn: conn, client: pb_card.NewCardServiceClient(conn)}
+		p.peers[addr] = peer
+	}
+
+	peer.relays++
+	return peer.client, nil
+}
+
+func (p *descriptionPeers) release(addr string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	peer, ok := p.peers[addr]
+	if !ok {
+		return
+	}
+
+	peer.relays--
+	if peer.relays == 0 {
+		peer.conn.Close()
+		delete(p.peers, addr)
+	}
+}
+
+// close closes the connections to every peer, ending the sessions relayed to them
+func (p *descriptionPeers) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for addr, peer := range p.peers {
+		peer.conn.Close()
+		delete(p.peers, addr)
+	}
+}
+
+// proxyDescriptionSession relays the stream to the replica holding the card's session, so the
+// client edits the same document as everyone else
+func (s *CardService) proxyDescriptionSession(stream pb_card.CardService_EditCardDescriptionServer, owner string, join *pb_card.EditCardDescriptionRequest) error {
+	ctx := stream.Context()
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get(proxiedMetadataKey)) > 0 {
+		// The lease moved while the session was relayed here
+		return status.Errorf(codes.Unavailable, errorhandlers.NewAPIError(http.StatusServiceUnavailable, "Description session is moving, join again").Error())
+	}
+
+	client, err := s.peers.acquire(owner)
+	if err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+	defer s.peers.release(owner)
+
+	md = md.Copy()
+	md.Set(proxiedMetadataKey, "true")
+	upstream, err := client.EditCardDescription(metadata.NewOutgoingContext(ctx, md))
+	if err != nil {
+		return err
+	}
+
+	if err := upstream.Send(join); err != nil {
+		return err
+	}
+
+	// Messages from the client are relayed on their own goroutine so that only this one sends on
+	// the stream
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				upstream.CloseSend()
+				return
+			}
+			if err := upstream.Send(req); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		res, err := upstream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,447 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
+	"github.com/sm888sm/halten-backend/card-service/internal/collab"
+	"github.com/sm888sm/halten-backend/card-service/internal/csvimport"
+	"github.com/sm888sm/halten-backend/card-service/internal/middlewares"
+	internal_models "github.com/sm888sm/halten-backend/card-service/internal/models"
+	"github.com/sm888sm/halten-backend/card-service/internal/repositories"
+	"github.com/sm888sm/halten-backend/common/constants/fielderrors"
+	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/models"
+)
+
+func convertRemindersToProto(reminders []*internal_models.CardReminderDTO) []*pb_card.CardReminder {
+	var protoReminders []*pb_card.CardReminder
+	for _, reminder := range reminders {
+		protoReminder := &pb_card.CardReminder{
+			OffsetMinutes: reminder.OffsetMinutes,
+		}
+		if reminder.NotifiedAt != nil {
+			protoReminder.NotifiedAt = timestamppb.New(*reminder.NotifiedAt)
+		}
+		protoReminders = append(protoReminders, protoReminder)
+	}
+	return protoReminders
+}
+
+func convertRecurrenceToProto(recurrence *internal_models.CardRecurrenceDTO) *pb_card.CardRecurrence {
+	if recurrence == nil {
+		return nil
+	}
+
+	return &pb_card.CardRecurrence{
+		Frequency:    recurrence.Frequency,
+		Interval:     int32(recurrence.Interval),
+		Weekdays:     recurrence.Weekdays,
+		MonthDay:     int32(recurrence.MonthDay),
+		TargetListID: recurrence.TargetListID,
+		NextRunAt:    timestamppb.New(recurrence.NextRunAt),
+	}
+}
+
+func convertCustomFieldValuesToProto(values []*internal_models.CardCustomFieldValueDTO) []*pb_card.CustomFieldValue {
+	var protoValues []*pb_card.CustomFieldValue
+	for _, value := range values {
+		protoValue := &pb_card.CustomFieldValue{
+			CustomFieldID: value.CustomFieldID,
+		}
+
+		switch {
+		case value.TextValue != nil:
+			protoValue.Value = &pb_card.CustomFieldValue_TextValue{TextValue: *value.TextValue}
+		case value.NumberValue != nil:
+			protoValue.Value = &pb_card.CustomFieldValue_NumberValue{NumberValue: *value.NumberValue}
+		case value.DateValue != nil:
+			protoValue.Value = &pb_card.CustomFieldValue_DateValue{DateValue: timestamppb.New(*value.DateValue)}
+		case value.CheckboxValue != nil:
+			protoValue.Value = &pb_card.CustomFieldValue_CheckboxValue{CheckboxValue: *value.CheckboxValue}
+		case value.OptionID != nil:
+			protoValue.Value = &pb_card.CustomFieldValue_OptionID{OptionID: *value.OptionID}
+		}
+
+		protoValues = append(protoValues, protoValue)
+	}
+	return protoValues
+}
+
+func convertCustomFieldValueFromProto(value *pb_card.CustomFieldValue) *internal_models.CardCustomFieldValueDTO {
+	valueDTO := &internal_models.CardCustomFieldValueDTO{
+		CustomFieldID: value.CustomFieldID,
+	}
+
+	switch v := value.Value.(type) {
+	case *pb_card.CustomFieldValue_TextValue:
+		valueDTO.TextValue = &v.TextValue
+	case *pb_card.CustomFieldValue_NumberValue:
+		valueDTO.NumberValue = &v.NumberValue
+	case *pb_card.CustomFieldValue_DateValue:
+		dateValue := v.DateValue.AsTime()
+		valueDTO.DateValue = &dateValue
+	case *pb_card.CustomFieldValue_CheckboxValue:
+		valueDTO.CheckboxValue = &v.CheckboxValue
+	case *pb_card.CustomFieldValue_OptionID:
+		valueDTO.OptionID = &v.OptionID
+	}
+
+	return valueDTO
+}
+
+func convertCustomFieldFiltersFromProto(filters []*pb_card.CustomFieldFilter) []*repositories.CustomFieldFilter {
+	var repoFilters []*repositories.CustomFieldFilter
+	for _, filter := range filters {
+		repoFilters = append(repoFilters, &repositories.CustomFieldFilter{
+			CustomFieldID: filter.CustomFieldID,
+			Operator:      filter.Operator,
+			Value:         filter.Value,
+		})
+	}
+	return repoFilters
+}
+
+func convertCustomFieldSortFromProto(sort *pb_card.CustomFieldSort) *repositories.CustomFieldSort {
+	if sort == nil {
+		return nil
+	}
+
+	return &repositories.CustomFieldSort{
+		CustomFieldID: sort.CustomFieldID,
+		Descending:    sort.Descending,
+	}
+}
+
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@(\w+)`)
+
+// parseMentions returns the distinct usernames mentioned as @username in a comment
+func parseMentions(content string) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			usernames = append(usernames, match[1])
+		}
+	}
+	return usernames
+}
+
+func (s *CardService) convertCommentToProto(comment *internal_models.CommentDTO) (*pb_card.Comment, error) {
+	contentHTML, err := s.markdown.Render(comment.Content)
+	if err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	protoComment := &pb_card.Comment{
+		CommentID: comment.ID,
+		CardID:    comment.CardID,
+		User: &pb_card.User{
+			UserID:   comment.UserID,
+			UserName: comment.Username,
+		},
+		Content:     comment.Content,
+		ContentHtml: contentHTML,
+		Mentions:    comment.Mentions,
+		CreatedAt:   timestamppb.New(comment.CreatedAt),
+		UpdatedAt:   timestamppb.New(comment.UpdatedAt),
+	}
+	if comment.ParentID != nil {
+		protoComment.ParentID = *comment.ParentID
+	}
+	if comment.EditedAt != nil {
+		protoComment.EditedAt = timestamppb.New(*comment.EditedAt)
+	}
+
+	for _, reaction := range comment.Reactions {
+		protoComment.Reactions = append(protoComment.Reactions, &pb_card.CommentReaction{
+			Emoji:   reaction.Emoji,
+			UserIDs: reaction.UserIDs,
+		})
+	}
+
+	for _, reply := range comment.Replies {
+		protoReply, err := s.convertCommentToProto(reply)
+		if err != nil {
+			return nil, err
+		}
+		protoComment.Replies = append(protoComment.Replies, protoReply)
+	}
+
+	return protoComment, nil
+}
+
+func convertOperationFromProto(components []*pb_card.OperationComponent) collab.Operation {
+	operation := make(collab.Operation, 0, len(components))
+	for _, component := range components {
+		switch kind := component.Kind.(type) {
+		case *pb_card.OperationComponent_Retain:
+			operation = append(operation, collab.Component{Retain: int(kind.Retain)})
+		case *pb_card.OperationComponent_Insert:
+			operation = append(operation, collab.Component{Insert: kind.Insert})
+		case *pb_card.OperationComponent_Delete:
+			operation = append(operation, collab.Component{Delete: int(kind.Delete)})
+		default:
+			// Left empty so that validation rejects it
+			operation = append(operation, collab.Component{})
+		}
+	}
+	return operation
+}
+
+func convertOperationToProto(operation collab.Operation) []*pb_card.OperationComponent {
+	components := make([]*pb_card.OperationComponent, 0, len(operation))
+	for _, component := range operation {
+		switch {
+		case component.Retain > 0:
+			components = append(components, &pb_card.OperationComponent{Kind: &pb_card.OperationComponent_Retain{Retain: int64(component.Retain)}})
+		case component.Insert != "":
+			components = append(components, &pb_card.OperationComponent{Kind: &pb_card.OperationComponent_Insert{Insert: component.Insert}})
+		case component.Delete > 0:
+			components = append(components, &pb_card.OperationComponent{Kind: &pb_card.OperationComponent_Delete{Delete: int64(component.Delete)}})
+		}
+	}
+	return components
+}
+
+func convertPresenceToProto(presence *collab.Presence) *pb_card.DescriptionPresence {
+	protoPresence := &pb_card.DescriptionPresence{
+		UserID: presence.UserID,
+		Left:   presence.Left,
+	}
+	if presence.Cursor != nil {
+		protoPresence.Cursor = &pb_card.DescriptionCursor{
+			Position:     int64(presence.Cursor.Position),
+			SelectionEnd: int64(presence.Cursor.SelectionEnd),
+		}
+	}
+	return protoPresence
+}
+
+func convertDescriptionEventToProto(event *collab.Event) *pb_card.EditCardDescriptionResponse {
+	switch {
+	case event.Ack != nil:
+		return &pb_card.EditCardDescriptionResponse{Payload: &pb_card.EditCardDescriptionResponse_Ack{
+			Ack: &pb_card.DescriptionAck{Revision: event.Ack.Revision},
+		}}
+	case event.Edit != nil:
+		return &pb_card.EditCardDescriptionResponse{Payload: &pb_card.EditCardDescriptionResponse_Edit{
+			Edit: &pb_card.DescriptionEdit{
+				Revision:  event.Edit.Revision,
+				UserID:    event.Edit.UserID,
+				Operation: convertOperationToProto(event.Edit.Operation),
+			},
+		}}
+	default:
+		return &pb_card.EditCardDescriptionResponse{Payload: &pb_card.EditCardDescriptionResponse_Presence{
+			Presence: convertPresenceToProto(event.Presence),
+		}}
+	}
+}
+
+// convertCollabError maps editing session errors to gRPC errors. Revision errors are conflicts the
+// client resolves by joining again.
+func convertCollabError(err error) error {
+	switch {
+	case errors.Is(err, collab.ErrRevisionOutOfRange), errors.Is(err, collab.ErrClientClosed):
+		return errorhandlers.NewGrpcConflictError("Description session is out of sync, join again")
+	case errors.Is(err, collab.ErrDocumentTooLarge):
+		return errorhandlers.NewGrpcBadRequestError("Description must be at most 16384 bytes")
+	case errors.Is(err, collab.ErrBaseLengthMismatch), errors.Is(err, collab.ErrInvalidComponent):
+		return errorhandlers.NewGrpcBadRequestError("Invalid operation")
+	default:
+		return err
+	}
+}
+
+func convertCardVersionToProto(cardVersion *internal_models.CardVersionDTO) *pb_card.CardVersion {
+	protoVersion := &pb_card.CardVersion{
+		Version:     cardVersion.Version,
+		Name:        cardVersion.Name,
+		Description: cardVersion.Description,
+		Labels:      cardVersion.Labels,
+		Members:     cardVersion.Members,
+		CreatedAt:   timestamppb.New(cardVersion.CreatedAt),
+	}
+	if cardVersion.StartDate != nil {
+		protoVersion.StartDate = timestamppb.New(*cardVersion.StartDate)
+	}
+	if cardVersion.DueDate != nil {
+		protoVersion.DueDate = timestamppb.New(*cardVersion.DueDate)
+	}
+
+	for _, change := range cardVersion.Changes {
+		protoVersion.Changes = append(protoVersion.Changes, &pb_card.CardFieldChange{
+			Field:    change.Field,
+			OldValue: change.OldValue,
+			NewValue: change.NewValue,
+			Added:    change.Added,
+			Removed:  change.Removed,
+		})
+	}
+
+	return protoVersion
+}
+
+func convertWIPLimitWarningToProto(warning *internal_models.WIPLimitWarningDTO) *pb_card.WIPLimitWarning {
+	if warning == nil {
+		return nil
+	}
+
+	return &pb_card.WIPLimitWarning{
+		ListID:    warning.ListID,
+		ListName:  warning.ListName,
+		WipLimit:  int32(warning.WIPLimit),
+		CardCount: warning.CardCount,
+	}
+}
+
+func convertBulkCardResultsToProto(results []*internal_models.BulkCardResultDTO) []*pb_card.BulkCardResult {
+	var protoResults []*pb_card.BulkCardResult
+	for _, result := range results {
+		protoResult := &pb_card.BulkCardResult{
+			CardID:          result.CardID,
+			Success:         result.Error == nil,
+			WipLimitWarning: convertWIPLimitWarningToProto(result.WIPLimitWarning),
+		}
+		if result.Error != nil {
+			apiError := errorhandlers.APIErrorFromGrpc(result.Error)
+			protoResult.Status = int32(apiError.Meta.Status)
+			protoResult.Error = apiError.Meta.Message
+		}
+		protoResults = append(protoResults, protoResult)
+	}
+
+	return protoResults
+}
+
+// cardImportTargets indexes what the rows of a card import refer to by name, compared
+// case-insensitively
+type cardImportTargets struct {
+	labels       map[string]uint64
+	members      map[string]uint64
+	customFields map[string]*models.CustomField
+}
+
+func newCardImportTargets(res *repositories.GetCardImportTargetsResponse) *cardImportTargets {
+	targets := &cardImportTargets{
+		labels:       make(map[string]uint64),
+		members:      make(map[string]uint64),
+		customFields: make(map[string]*models.CustomField),
+	}
+
+	for _, label := range res.Labels {
+		if _, ok := targets.labels[strings.ToLower(label.Name)]; !ok {
+			targets.labels[strings.ToLower(label.Name)] = label.ID
+		}
+	}
+	for _, user := range res.Members {
+		targets.members[strings.ToLower(user.Username)] = user.ID
+	}
+	for i := range res.CustomFields {
+		targets.customFields[strings.ToLower(res.CustomFields[i].Name)] = &res.CustomFields[i]
+	}
+
+	return targets
+}
+
+// resolve turns a row into the card it imports, or into the errors that keep it from being imported
+func (t *cardImportTargets) resolve(listID uint64, row *csvimport.Row) (*repositories.ImportedCard, []*pb_card.ImportCardsRowError) {
+	var rowErrors []*pb_card.ImportCardsRowError
+	addError := func(field, code, message string) {
+		rowErrors = append(rowErrors, &pb_card.ImportCardsRowError{
+			Line:    int32(row.Line),
+			Field:   field,
+			Code:    code,
+			Message: message,
+		})
+	}
+
+	if err := middlewares.ValidateCreateCardRequest(&pb_card.CreateCardRequest{ListID: listID, Name: row.Name}); err != nil {
+		for _, fieldError := range errorhandlers.APIErrorFromGrpc(err).Errors {
+			addError(fieldError.Field, fieldError.Code, fieldError.Message)
+		}
+	}
+
+	if len(row.Description) > maxDescriptionSize {
+		addError("Description", fielderrors.ErrMaxLength, fmt.Sprintf("Description must be at most %d bytes", maxDescriptionSize))
+	}
+
+	card := &repositories.ImportedCard{
+		Card: &models.Card{Name: row.Name, Description: row.Description},
+	}
+
+	if row.DueDate != "" {
+		dueDate, err := csvimport.ParseDate(row.DueDate)
+		if err != nil {
+			addError("DueDate", fielderrors.ErrInvalid, "DueDate: "+err.Error())
+		} else {
+			card.Card.DueDate = &dueDate
+		}
+	}
+
+	seenLabels := make(map[string]bool)
+	for _, name := range row.Labels {
+		key := strings.ToLower(name)
+		if seenLabels[key] {
+			continue
+		}
+		seenLabels[key] = true
+
+		if labelID, ok := t.labels[key]; ok {
+			card.LabelIDs = append(card.LabelIDs, labelID)
+		} else if utf8.RuneCountInString(name) > maxLabelNameLength {
+			addError("Labels", fielderrors.ErrMaxLength, fmt.Sprintf("Label %q must be at most %d characters", name, maxLabelNameLength))
+		} else {
+			card.NewLabels = append(card.NewLabels, name)
+		}
+	}
+
+	seenMembers := make(map[uint64]bool)
+	for _, username := range row.Members {
+		userID, ok := t.members[strings.ToLower(username)]
+		if !ok {
+			addError("Members", fielderrors.ErrDoesntExist, fmt.Sprintf("%q isn't a member of the board", username))
+			continue
+		}
+		if !seenMembers[userID] {
+			seenMembers[userID] = true
+			card.MemberUserIDs = append(card.MemberUserIDs, userID)
+		}
+	}
+
+	// Sorted so errors come in the same order on every import of the file
+	names := make([]string, 0, len(row.CustomFields))
+	for name := range row.CustomFields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		customField, ok := t.customFields[strings.ToLower(name)]
+		if !ok {
+			addError("CustomFields", fielderrors.ErrDoesntExist, fmt.Sprintf("Custom field %q doesn't exist on the board", name))
+			continue
+		}
+
+		value, err := csvimport.ParseCustomFieldValue(customField, row.CustomFields[name])
+		if err != nil {
+			addError("CustomFields", fielderrors.ErrInvalid, fmt.Sprintf("%s: %v", customField.Name, err))
+			continue
+		}
+		card.CustomFieldValues = append(card.CustomFieldValues, *value)
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+	return card, nil
+}
@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
+	external_services "github.com/sm888sm/halten-backend/card-service/external/services"
+	"github.com/sm888sm/halten-backend/card-service/internal/jobs"
+	"github.com/sm888sm/halten-backend/card-service/internal/markdown"
+	consumer "github.com/sm888sm/halten-backend/card-service/internal/messaging/rabbitmq/consumer"
+	"github.com/sm888sm/halten-backend/card-service/internal/middlewares"
+	"github.com/sm888sm/halten-backend/card-service/internal/services"
+
+	"github.com/sm888sm/halten-backend/common/messaging/outbox"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/consumers"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+	"github.com/sm888sm/halten-backend/common/scheduler"
+
+	"github.com/sm888sm/halten-backend/card-service/internal/config"
+	"github.com/sm888sm/halten-backend/card-service/internal/connections/db"
+	"github.com/sm888sm/halten-backend/card-service/internal/connections/rabbitmq"
+	"github.com/sm888sm/halten-backend/card-service/internal/repositories"
+)
+
+// Run serves card-service on addr, :<PORT> when empty, with the configuration of the environment. It
+// blocks, stopping the process when the service can't start or stops serving.
+func Run(addr string) {
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Error loading configuration: %v", err)
+	}
+
+	// Connect to database
+	err = db.Connect(&cfg.Database)
+	if err != nil {
+		log.Fatalf("Error connecting to database: %v", err)
+	} else {
+		log.Printf("Successfully connected to the database.")
+	}
+
+	sqlDB, err := db.SQLConn.DB()
+	if err != nil {
+		log.Fatalf("Error getting underlying sql.DB: %v", err)
+	}
+	defer sqlDB.Close()
+
+	// Connect to RabbitMQ
+	err = rabbitmq.Connect(&cfg.RabbitMQ)
+	if err != nil {
+		log.Fatalf("Error connecting to RabbitMQ: %v", err)
+	} else {
+		log.Printf("Successfully connected to RabbitMQ.")
+	}
+
+	// Initialize repositories
+	cardRepo := repositories.NewCardRepository(db.SQLConn)
+
+	// Initialize external services
+	svc := external_services.GetServices(&cfg.Services)
+	defer svc.Close()
+
+	// Initialize publishers
+	publishers := &publishers.Publishers{
+		BoardPublisher: publishers.NewBoardPublisher(),
+		CardPublisher:  publishers.NewCardPublisher(),
+	}
+
+	// Publish the messages written to the outbox
+	relay := outbox.NewRelay(db.SQLConn, rabbitmq.GetBus(), cfg.Outbox.Interval, cfg.Outbox.Retention)
+	go relay.Run(context.Background())
+
+	// Run collaborative description editing, saving snapshots in the background
+	descriptionHub := services.NewDescriptionHub(cardRepo, cfg.Collab.AdvertiseAddr, cfg.Collab.LeaseTTL, cfg.Collab.SnapshotInterval)
+	go descriptionHub.Run(context.Background())
+
+	// Initialize services
+	cardService := services.NewCardService(cardRepo, svc, markdown.NewRenderer(cfg.Markdown.CacheSize), descriptionHub, publishers, cfg.AttachmentDir)
+	defer cardService.Close()
+
+	// Run the due date and recurring card scheduler
+	runScheduler(&cfg.Scheduler, cardRepo, publishers)
+
+	// Create gRPC server with validation interceptor
+	AuthInterceptor := middlewares.NewAuthInterceptor(db.SQLConn, svc)
+	validatorInterceptor := middlewares.NewValidatorInterceptor(db.SQLConn)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			AuthInterceptor.AuthInterceptor,
+			validatorInterceptor.ValidationInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			AuthInterceptor.StreamAuthInterceptor,
+		),
+	)
+
+	// Register services
+	pb_card.RegisterCardServiceServer(grpcServer, cardService)
+
+	// Run RabbitMQ Consumer
+	runCardConsumer(cardService)
+
+	// Expose the consumer metrics
+	if cfg.MetricsAddr != "" {
+		go consumers.ServeMetrics(cfg.MetricsAddr)
+	}
+
+	// Start listening
+	if addr == "" {
+		addr = fmt.Sprintf(":%d", cfg.Port)
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+
+	log.Printf("Service listening on %s", addr)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+}
+
+func runCardConsumer(cardService *services.CardService) {
+	c := consumer.NewCardConsumer(rabbitmq.GetBus(), db.SQLConn, cardService)
+
+	// Deleting the cards of a board is a step of deleting the board for good
+	go func() {
+		err := c.ConsumeBoardMessages(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to consume board messages: %v", err)
+		}
+	}()
+}
+
+func runScheduler(cfg *config.SchedulerConfig, cardRepo repositories.CardRepository, publishers *publishers.Publishers) {
+	s := scheduler.NewScheduler(db.SQLConn, cfg.LockKey, cfg.Interval)
+	s.AddJob("due_date_reminders", jobs.NewDueDateReminderJob(cardRepo, publishers.CardPublisher).Run)
+	s.AddJob("recurring_cards", jobs.NewRecurringCardJob(cardRepo).Run)
+	s.AddJob("card_history_retention", jobs.NewCardHistoryRetentionJob(cardRepo).Run)
+
+	// Only the replica holding the advisory lock runs the jobs
+	go s.Run(context.Background())
+}
@@ -0,0 +1,58 @@
+// Command halten runs every service in one process, for development and integration tests. The
+// services listen on the ports of USER_SERVICE_ADDR, BOARD_SERVICE_ADDR, LIST_SERVICE_ADDR and
+// CARD_SERVICE_ADDR, which they reach each other at, and the gateway on PORT. With
+// MESSAGE_BUS=memory their events are carried in memory rather than through RabbitMQ.
+//
+// SCHEDULER_LOCK_KEY would be shared by the board and card schedulers, leave it unset.
+package main
+
+import (
+	"log"
+	"net"
+	"os"
+
+	"github.com/joho/godotenv"
+
+	board_server "github.com/sm888sm/halten-backend/board-service/server"
+	card_server "github.com/sm888sm/halten-backend/card-service/server"
+	"github.com/sm888sm/halten-backend/common/messaging/bus/memorybus"
+	gateway_server "github.com/sm888sm/halten-backend/gateway-service/server"
+	list_server "github.com/sm888sm/halten-backend/list-service/server"
+	user_server "github.com/sm888sm/halten-backend/user-service/server"
+)
+
+func main() {
+	err := godotenv.Load()
+	if err != nil {
+		log.Fatal("Error loading .env file")
+	}
+
+	// Every service runs here, so they can share the memory bus
+	memorybus.EnableShared()
+
+	// Other replicas relay description sessions to the card service, not to the gateway's PORT
+	if os.Getenv("COLLAB_ADVERTISE_ADDR") == "" {
+		os.Setenv("COLLAB_ADVERTISE_ADDR", os.Getenv("CARD_SERVICE_ADDR"))
+	}
+
+	userAddr := listenAddr("USER_SERVICE_ADDR")
+	boardAddr := listenAddr("BOARD_SERVICE_ADDR")
+	listAddr := listenAddr("LIST_SERVICE_ADDR")
+	cardAddr := listenAddr("CARD_SERVICE_ADDR")
+
+	go user_server.Run(userAddr)
+	go board_server.Run(boardAddr)
+	go list_server.Run(listAddr)
+	go card_server.Run(cardAddr)
+
+	gateway_server.Run("")
+}
+
+// listenAddr returns the address a service listens on, the port other services reach it at
+func listenAddr(key string) string {
+	_, port, err := net.SplitHostPort(os.Getenv(key))
+	if err != nil {
+		log.Fatalf("Error reading %s: %v", key, err)
+	}
+	return ":" + port
+}
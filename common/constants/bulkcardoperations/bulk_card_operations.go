@@ -0,0 +1,21 @@
+package bulkcardoperations
+
+const (
+	Move         = "move"
+	Archive      = "archive"
+	Restore      = "restore"
+	AddLabel     = "add_label"
+	RemoveLabel  = "remove_label"
+	AddMember    = "add_member"
+	RemoveMember = "remove_member"
+	SetDueDate   = "set_due_date"
+	Complete     = "complete"
+)
+
+func IsValid(operation string) bool {
+	switch operation {
+	case Move, Archive, Restore, AddLabel, RemoveLabel, AddMember, RemoveMember, SetDueDate, Complete:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+package customfieldtypes
+
+const (
+	Text     = "text"
+	Number   = "number"
+	Date     = "date"
+	Checkbox = "checkbox"
+	Select   = "select"
+)
+
+func IsValid(fieldType string) bool {
+	switch fieldType {
+	case Text, Number, Date, Checkbox, Select:
+		return true
+	}
+	return false
+}
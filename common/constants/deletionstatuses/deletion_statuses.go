@@ -0,0 +1,6 @@
+package deletionstatuses
+
+const (
+	Deleting = "deleting"
+	Failed   = "failed" // A step wasn't confirmed after every attempt
+)
@@ -0,0 +1,8 @@
+package deletionsteps
+
+// Steps of deleting a board for good, in order. Each service removes its part of the board, the
+// board's own rows go once every step is confirmed.
+const (
+	Cards = "cards" // card-service removes the cards with their comments, attachments and watches
+	Lists = "lists" // list-service removes the lists with their watches
+)
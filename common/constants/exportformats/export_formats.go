@@ -0,0 +1,15 @@
+package exportformats
+
+const (
+	JSON     = "json"
+	CSV      = "csv"
+	Markdown = "markdown"
+)
+
+func IsValid(format string) bool {
+	switch format {
+	case JSON, CSV, Markdown:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,8 @@
+package exportstatuses
+
+const (
+	Pending   = "pending"
+	Running   = "running"
+	Completed = "completed"
+	Failed    = "failed"
+)
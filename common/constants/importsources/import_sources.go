@@ -0,0 +1,13 @@
+package importsources
+
+const (
+	Trello = "trello"
+)
+
+func IsValid(source string) bool {
+	switch source {
+	case Trello:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,8 @@
+package importstatuses
+
+const (
+	Pending   = "pending"
+	Running   = "running"
+	Completed = "completed"
+	Failed    = "failed"
+)
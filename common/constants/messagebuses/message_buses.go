@@ -0,0 +1,6 @@
+package messagebuses
+
+const (
+	RabbitMQ = "rabbitmq"
+	Memory   = "memory" // Within the process, only when every service runs in it with cmd/halten
+)
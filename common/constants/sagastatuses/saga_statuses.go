@@ -0,0 +1,7 @@
+package sagastatuses
+
+const (
+	Running      = "running"
+	Compensating = "compensating" // A step failed, the steps done are being undone
+	Failed       = "failed"       // A compensation failed after every attempt
+)
@@ -0,0 +1,15 @@
+package trashitemtypes
+
+const (
+	Board = "board"
+	List  = "list"
+	Card  = "card"
+)
+
+func IsValid(itemType string) bool {
+	switch itemType {
+	case Board, List, Card:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,14 @@
+package wiplimitpolicies
+
+const (
+	Refuse = "refuse" // Cards can't be added to a list at its WIP limit
+	Warn   = "warn"   // Cards are added anyway and the response carries a warning
+)
+
+func IsValid(policy string) bool {
+	switch policy {
+	case Refuse, Warn:
+		return true
+	}
+	return false
+}
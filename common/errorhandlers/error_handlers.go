@@ -35,18 +35,29 @@ func (v *APIError) Error() string {
 }
 
 func HandleError(c *gin.Context, err error) {
+	// Errors raised by the gateway itself carry their HTTP status directly
+	if apiError, ok := err.(*APIError); ok {
+		c.JSON(apiError.Meta.Status, apiError)
+		return
+	}
+
+	apiError := APIErrorFromGrpc(err)
+	c.JSON(apiError.Meta.Status, apiError)
+}
+
+// APIErrorFromGrpc recovers the API error carried by a gRPC error. Errors that don't carry one are
+// reported as internal errors.
+func APIErrorFromGrpc(err error) *APIError {
 	st, ok := status.FromError(err)
 	if !ok {
-		c.JSON(http.StatusInternalServerError, NewHttpInternalError())
-		return
+		return NewHttpInternalError()
 	}
 
 	var apiError APIError
 	if err := json.Unmarshal([]byte(st.Message()), &apiError); err != nil {
-		c.JSON(http.StatusInternalServerError, NewHttpInternalError())
-		return
+		return NewHttpInternalError()
 	}
-	c.JSON(apiError.Meta.Status, apiError)
+	return &apiError
 }
 
 func NewAPIError(status int, message string, errors ...FieldError) *APIError {
@@ -82,6 +93,20 @@ func NewGrpcNotFoundError(message string) error {
 	return status.Errorf(codes.NotFound, NewAPIError(http.StatusNotFound, message).Error())
 }
 
+func NewGrpcForbiddenError(message string) error {
+	return status.Errorf(codes.PermissionDenied, NewAPIError(http.StatusForbidden, message).Error())
+}
+
+// NewGrpcConflictError reports a write based on a stale version of a resource
+func NewGrpcConflictError(message string) error {
+	return status.Errorf(codes.Aborted, NewAPIError(http.StatusConflict, message).Error())
+}
+
+// NewGrpcFailedPreconditionError reports a change the current state of a resource doesn't allow
+func NewGrpcFailedPreconditionError(message string) error {
+	return status.Errorf(codes.FailedPrecondition, NewAPIError(http.StatusConflict, message).Error())
+}
+
 func NewHttpInternalError() *APIError {
 	return NewAPIError(http.StatusInternalServerError, "Internal server error")
 }
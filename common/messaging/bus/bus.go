@@ -0,0 +1,51 @@
+package bus
+
+import (
+	"context"
+	"time"
+)
+
+// Message is a message carried by the bus
+type Message struct {
+	ID          string // Set by the outbox, consumers deduplicate on it
+	RoutingKey  string // The event type, which queues are bound to
+	ContentType string
+	Timestamp   time.Time
+	Body        []byte
+}
+
+// Queue is a durable queue of a service. Replicas of the service share it, so each message is
+// handled by one of them and messages published while every replica is down wait for them.
+type Queue struct {
+	Name string // Named <service>.<purpose>
+	// RoutingKeys the queue is bound to. A pattern's words are separated by dots, * matches one word
+	// and # any number of them, such as board.* or #.
+	RoutingKeys []string
+	Prefetch    int // Unsettled messages each replica holds at a time
+	// RetryDelays is the wait before each retry of a failed message
+	RetryDelays []time.Duration
+}
+
+// Delivery is a message delivered from a queue. It's settled once, with Ack, Retry or DeadLetter.
+type Delivery interface {
+	Message() *Message
+	Attempt() int // 1 for the first delivery, incremented by every retry
+	// Ack removes the message from the queue
+	Ack() error
+	// Retry delivers the message again after the delay of its attempt
+	Retry(err error) error
+	// DeadLetter sets the message aside with its error, to be looked into
+	DeadLetter(err error) error
+}
+
+// Handler handles the deliveries of a queue. ctx is the one the queue was subscribed with.
+type Handler func(ctx context.Context, d Delivery)
+
+// Bus carries messages from the services publishing them to the queues bound to their routing key
+type Bus interface {
+	// Publish publishes messages in order and reports, for each one the bus answered for, whether it
+	// was accepted. An error means the bus didn't answer for the rest, which may be published again.
+	Publish(ctx context.Context, messages []*Message) ([]bool, error)
+	// Subscribe declares the queue and hands its messages to handle until ctx is cancelled
+	Subscribe(ctx context.Context, queue *Queue, handle Handler) error
+}
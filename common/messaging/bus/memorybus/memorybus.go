@@ -0,0 +1,274 @@
+package memorybus
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+)
+
+// Bus carries messages between the services running in the process, with the routing, retries and
+// dead letters of the RabbitMQ bus. Nothing survives the process: it's meant for integration tests
+// and for running every service in a single process.
+type Bus struct {
+	mu          sync.Mutex
+	queues      map[string]*queue
+	deadLetters []*DeadLetter
+}
+
+// DeadLetter is a message that failed every attempt
+type DeadLetter struct {
+	Message  *bus.Message
+	Queue    string
+	Attempts int
+	Error    string
+	FailedAt time.Time
+}
+
+// ErrNotShared is returned by Shared in a process that doesn't run every service, whose messages
+// wouldn't reach the services they're meant for
+var ErrNotShared = errors.New("the memory bus only carries messages between services running in the same process")
+
+var (
+	shared        = New()
+	sharedEnabled atomic.Bool
+)
+
+func New() *Bus {
+	return &Bus{
+		queues: make(map[string]*queue),
+	}
+}
+
+// EnableShared lets the services of the process select the shared bus. It's called by cmd/halten,
+// which runs every service in one process, before they connect.
+func EnableShared() {
+	sharedEnabled.Store(true)
+}
+
+// Shared returns the bus of the process, which services running in the same process use to reach
+// each other. It returns ErrNotShared unless EnableShared was called.
+func Shared() (*Bus, error) {
+	if !sharedEnabled.Load() {
+		return nil, ErrNotShared
+	}
+	return shared, nil
+}
+
+// Publish hands a copy of each message to the queues bound to its routing key. Every message is
+// accepted, and one no queue is bound to is dropped, as the RabbitMQ bus does: events nothing
+// consumes would otherwise stay in the outbox ahead of the others.
+func (b *Bus) Publish(ctx context.Context, messages []*bus.Message) ([]bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	accepted := make([]bool, 0, len(messages))
+	for _, message := range messages {
+		for _, q := range b.queues {
+			if q.bound(message.RoutingKey) {
+				q.push(&delivery{bus: b, queue: q, message: copyMessage(message), attempt: 1})
+			}
+		}
+		accepted = append(accepted, true)
+	}
+
+	return accepted, nil
+}
+
+// Subscribe declares the queue and hands its messages to handle, Prefetch of them at a time, until
+// ctx is cancelled. Subscribing to a queue twice shares its messages between the subscriptions, as
+// replicas do.
+func (b *Bus) Subscribe(ctx context.Context, q *bus.Queue, handle bus.Handler) error {
+	b.mu.Lock()
+	declared, ok := b.queues[q.Name]
+	if !ok {
+		declared = newQueue(q)
+		b.queues[q.Name] = declared
+	}
+	declared.bind(q.RoutingKeys)
+	b.mu.Unlock()
+
+	prefetch := q.Prefetch
+	if prefetch == 0 {
+		prefetch = 1
+	}
+	for i := 0; i < prefetch; i++ {
+		go declared.consume(ctx, handle)
+	}
+
+	return nil
+}
+
+// DeadLetters returns the messages that failed every attempt, oldest first
+func (b *Bus) DeadLetters() []*DeadLetter {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]*DeadLetter(nil), b.deadLetters...)
+}
+
+type queue struct {
+	def *bus.Queue
+
+	mu          sync.Mutex
+	routingKeys []string
+	pending     []*delivery
+	wake        chan struct{} // Signalled when messages may be waiting
+}
+
+func newQueue(def *bus.Queue) *queue {
+	return &queue{def: def, wake: make(chan struct{}, 1)}
+}
+
+func (q *queue) bind(routingKeys []string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, routingKey := range routingKeys {
+		if !slices.Contains(q.routingKeys, routingKey) {
+			q.routingKeys = append(q.routingKeys, routingKey)
+		}
+	}
+}
+
+func (q *queue) bound(routingKey string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, pattern := range q.routingKeys {
+		if Matches(pattern, routingKey) {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *queue) push(d *delivery) {
+	q.mu.Lock()
+	q.pending = append(q.pending, d)
+	q.mu.Unlock()
+
+	q.signal()
+}
+
+func (q *queue) signal() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (q *queue) pop() *delivery {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.pending) == 0 {
+		return nil
+	}
+
+	d := q.pending[0]
+	q.pending = q.pending[1:]
+	return d
+}
+
+// consume hands the messages of the queue to handle one at a time until ctx is cancelled
+func (q *queue) consume(ctx context.Context, handle bus.Handler) {
+	for {
+		d := q.pop()
+		if d == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-q.wake:
+			}
+			continue
+		}
+
+		// Another consumer may take the messages left meanwhile
+		q.signal()
+
+		handle(ctx, d)
+	}
+}
+
+type delivery struct {
+	bus     *Bus
+	queue   *queue
+	message *bus.Message
+	attempt int
+}
+
+func (d *delivery) Message() *bus.Message { return d.message }
+
+func (d *delivery) Attempt() int { return d.attempt }
+
+func (d *delivery) Ack() error { return nil }
+
+// Retry pushes the message back to its queue after the delay of its attempt
+func (d *delivery) Retry(err error) error {
+	delays := d.queue.def.RetryDelays
+	if d.attempt > len(delays) {
+		return d.DeadLetter(err)
+	}
+
+	retry := &delivery{bus: d.bus, queue: d.queue, message: d.message, attempt: d.attempt + 1}
+	time.AfterFunc(delays[d.attempt-1], func() {
+		d.queue.push(retry)
+	})
+
+	return nil
+}
+
+func (d *delivery) DeadLetter(err error) error {
+	d.bus.mu.Lock()
+	defer d.bus.mu.Unlock()
+
+	d.bus.deadLetters = append(d.bus.deadLetters, &DeadLetter{
+		Message:  d.message,
+		Queue:    d.queue.def.Name,
+		Attempts: d.attempt,
+		Error:    err.Error(),
+		FailedAt: time.Now(),
+	})
+	log.Printf("Dead-lettered %s message %s from %s: %v", d.message.RoutingKey, d.message.ID, d.queue.def.Name, err)
+
+	return nil
+}
+
+// Matches reports whether a routing key matches a binding pattern. Words are separated by dots, *
+// matches exactly one word and # any number of them, including none, as in RabbitMQ topic exchanges.
+func Matches(pattern, routingKey string) bool {
+	return matchWords(strings.Split(pattern, "."), strings.Split(routingKey, "."))
+}
+
+func matchWords(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if matchWords(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(words) > 0 && matchWords(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && matchWords(pattern[1:], words[1:])
+	}
+}
+
+func copyMessage(message *bus.Message) *bus.Message {
+	copied := *message
+	copied.Body = append([]byte(nil), message.Body...)
+	return &copied
+}
@@ -0,0 +1,168 @@
+package memorybus_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+	"github.com/sm888sm/halten-backend/common/messaging/bus/memorybus"
+)
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		routingKey string
+		want       bool
+	}{
+		{pattern: "board.created", routingKey: "board.created", want: true},
+		{pattern: "board.created", routingKey: "board.deleted", want: false},
+		{pattern: "board.created", routingKey: "board.created.v2", want: false},
+		{pattern: "board.*", routingKey: "board.created", want: true},
+		{pattern: "board.*", routingKey: "board", want: false},
+		{pattern: "board.*", routingKey: "board.card.moved", want: false},
+		{pattern: "*.created", routingKey: "created", want: false},
+		{pattern: "board.*.moved", routingKey: "board.card.moved", want: true},
+		{pattern: "board.#", routingKey: "board", want: true},
+		{pattern: "board.#", routingKey: "board.created", want: true},
+		{pattern: "board.#", routingKey: "board.card.moved", want: true},
+		{pattern: "board.#", routingKey: "card.created", want: false},
+		{pattern: "#.created", routingKey: "created", want: true},
+		{pattern: "#.created", routingKey: "board.card.created", want: true},
+		{pattern: "#.created", routingKey: "board.created.v2", want: false},
+		{pattern: "board.#.moved", routingKey: "board.moved", want: true},
+		{pattern: "board.#.moved", routingKey: "board.list.card.moved", want: true},
+		{pattern: "board.#.moved", routingKey: "board.list.card", want: false},
+		{pattern: "#", routingKey: "board.card.moved", want: true},
+		{pattern: "#.#", routingKey: "board", want: true},
+		{pattern: "*.#", routingKey: "board", want: true},
+		{pattern: "#.*", routingKey: "board.created", want: true},
+	}
+
+	for _, tt := range tests {
+		if got := memorybus.Matches(tt.pattern, tt.routingKey); got != tt.want {
+			t.Errorf("Matches(%q, %q) = %v, want %v", tt.pattern, tt.routingKey, got, tt.want)
+		}
+	}
+}
+
+func TestRetriesThenDeadLetters(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := memorybus.New()
+
+	var mu sync.Mutex
+	var attempts []int
+	queue := &bus.Queue{
+		Name:        "test.retries",
+		RoutingKeys: []string{"board.*"},
+		RetryDelays: []time.Duration{time.Millisecond, time.Millisecond},
+	}
+	if err := b.Subscribe(ctx, queue, func(ctx context.Context, d bus.Delivery) {
+		mu.Lock()
+		attempts = append(attempts, d.Attempt())
+		mu.Unlock()
+
+		if err := d.Retry(errors.New("handler failed")); err != nil {
+			t.Errorf("Error retrying: %v", err)
+		}
+	}); err != nil {
+		t.Fatalf("Error subscribing: %v", err)
+	}
+
+	accepted, err := b.Publish(ctx, []*bus.Message{{ID: "1", RoutingKey: "board.created", Body: []byte("body")}})
+	if err != nil {
+		t.Fatalf("Error publishing: %v", err)
+	}
+	if len(accepted) != 1 || !accepted[0] {
+		t.Fatalf("Publish accepted %v, want [true]", accepted)
+	}
+
+	deadLetters := waitForDeadLetters(t, b)
+	if len(deadLetters) != 1 {
+		t.Fatalf("Got %d dead letters, want 1", len(deadLetters))
+	}
+
+	deadLetter := deadLetters[0]
+	if deadLetter.Message.ID != "1" || deadLetter.Queue != queue.Name || deadLetter.Attempts != 3 || deadLetter.Error != "handler failed" {
+		t.Errorf("Got dead letter of message %s from %s after %d attempts with %q, want message 1 from %s after 3 attempts with %q",
+			deadLetter.Message.ID, deadLetter.Queue, deadLetter.Attempts, deadLetter.Error, queue.Name, "handler failed")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(attempts) != 3 || attempts[0] != 1 || attempts[1] != 2 || attempts[2] != 3 {
+		t.Errorf("Got attempts %v, want [1 2 3]", attempts)
+	}
+}
+
+func TestDeliversToEveryBoundQueue(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := memorybus.New()
+
+	received := make(chan string, 4)
+	for _, name := range []string{"test.boards", "test.all"} {
+		name := name
+		routingKeys := []string{"board.*"}
+		if name == "test.all" {
+			routingKeys = []string{"#"}
+		}
+
+		if err := b.Subscribe(ctx, &bus.Queue{Name: name, RoutingKeys: routingKeys}, func(ctx context.Context, d bus.Delivery) {
+			received <- name + ":" + d.Message().RoutingKey
+			d.Ack()
+		}); err != nil {
+			t.Fatalf("Error subscribing: %v", err)
+		}
+	}
+
+	if _, err := b.Publish(ctx, []*bus.Message{{ID: "1", RoutingKey: "board.created"}, {ID: "2", RoutingKey: "card.created"}}); err != nil {
+		t.Fatalf("Error publishing: %v", err)
+	}
+
+	want := map[string]bool{"test.boards:board.created": true, "test.all:board.created": true, "test.all:card.created": true}
+	for n := len(want); n > 0; n-- {
+		select {
+		case got := <-received:
+			if !want[got] {
+				t.Errorf("Got unexpected delivery %s", got)
+			}
+			delete(want, got)
+		case <-time.After(time.Second):
+			t.Fatalf("Missing deliveries %v", want)
+		}
+	}
+}
+
+func TestAcceptsUnroutedMessages(t *testing.T) {
+	b := memorybus.New()
+
+	accepted, err := b.Publish(context.Background(), []*bus.Message{{ID: "1", RoutingKey: "card.overdue"}})
+	if err != nil {
+		t.Fatalf("Error publishing: %v", err)
+	}
+	if len(accepted) != 1 || !accepted[0] {
+		t.Errorf("Publish accepted %v, want [true]", accepted)
+	}
+}
+
+// waitForDeadLetters waits for the bus to dead-letter a message
+func waitForDeadLetters(t *testing.T, b *memorybus.Bus) []*memorybus.DeadLetter {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if deadLetters := b.DeadLetters(); len(deadLetters) > 0 {
+			return deadLetters
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatal("No message was dead-lettered")
+	return nil
+}
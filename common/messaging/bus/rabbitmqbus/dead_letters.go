@@ -0,0 +1,188 @@
+package rabbitmqbus
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/streadway/amqp"
+
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/connection"
+)
+
+const (
+	// DeadLetterExchange receives the messages that failed every attempt in any queue
+	DeadLetterExchange = "halten.dlx"
+	// DeadLetterQueue keeps the dead letters of every queue until they're replayed or purged
+	DeadLetterQueue = "halten.dead_letters"
+)
+
+// DeadLetter is a message that failed every attempt
+type DeadLetter struct {
+	MessageID   string    `json:"messageID"`
+	Queue       string    `json:"queue"` // Queue it failed in, where a replay sends it back
+	RoutingKey  string    `json:"routingKey"`
+	Attempts    int       `json:"attempts"`
+	Error       string    `json:"error"`
+	PublishedAt time.Time `json:"publishedAt"`
+	FailedAt    time.Time `json:"failedAt"`
+	ContentType string    `json:"contentType"`
+	Body        []byte    `json:"body"`
+}
+
+func declareDeadLetters(ch *amqp.Channel) error {
+	if err := ch.ExchangeDeclare(
+		DeadLetterExchange,
+		"fanout",
+		true,
+		false,
+		false,
+		false,
+		nil); err != nil {
+		return err
+	}
+
+	if _, err := ch.QueueDeclare(
+		DeadLetterQueue,
+		true,
+		false,
+		false,
+		false,
+		nil); err != nil {
+		return err
+	}
+
+	return ch.QueueBind(
+		DeadLetterQueue,
+		"",
+		DeadLetterExchange,
+		false,
+		nil)
+}
+
+// PeekDeadLetters returns up to limit dead letters, oldest first, leaving them in the queue
+func PeekDeadLetters(conn *connection.Manager, limit int) ([]DeadLetter, error) {
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+	// Closing the channel puts back the messages that weren't acknowledged
+	defer ch.Close()
+
+	if err := declareDeadLetters(ch); err != nil {
+		return nil, err
+	}
+
+	deadLetters := []DeadLetter{}
+	for len(deadLetters) < limit {
+		d, ok, err := ch.Get(DeadLetterQueue, false)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		deadLetters = append(deadLetters, convertDeadLetter(&d))
+	}
+
+	return deadLetters, nil
+}
+
+// ReplayDeadLetters sends dead letters back to the queue they failed in, with their attempts reset.
+// Only the messages with the given IDs are replayed, or every one when no ID is given. It returns the
+// IDs of the replayed messages.
+func ReplayDeadLetters(conn *connection.Manager, messageIDs []string) ([]string, error) {
+	ch, err := conn.Channel()
+	if err != nil {
+		return nil, err
+	}
+	// Closing the channel puts back the messages that weren't replayed
+	defer ch.Close()
+
+	if err := declareDeadLetters(ch); err != nil {
+		return nil, err
+	}
+
+	// A message is only removed from the dead letters once the broker has it back in its queue
+	if err := ch.Confirm(false); err != nil {
+		return nil, err
+	}
+	confirms := ch.NotifyPublish(make(chan amqp.Confirmation, 1))
+
+	wanted := make(map[string]bool, len(messageIDs))
+	for _, messageID := range messageIDs {
+		wanted[messageID] = true
+	}
+
+	replayed := []string{}
+	for {
+		d, ok, err := ch.Get(DeadLetterQueue, false)
+		if err != nil {
+			return replayed, err
+		}
+		if !ok {
+			break
+		}
+
+		queue, _ := d.Headers[headerOriginalQueue].(string)
+		if queue == "" || (len(wanted) > 0 && !wanted[d.MessageId]) {
+			continue
+		}
+
+		headers := amqp.Table{}
+		for key, value := range d.Headers {
+			headers[key] = value
+		}
+		delete(headers, headerAttempts)
+		delete(headers, headerOriginalQueue)
+		delete(headers, headerError)
+		delete(headers, headerFailedAt)
+
+		if err := ch.Publish(
+			"",
+			queue,
+			false,
+			false,
+			amqp.Publishing{
+				Headers:      headers,
+				ContentType:  d.ContentType,
+				DeliveryMode: amqp.Persistent,
+				MessageId:    d.MessageId,
+				Timestamp:    d.Timestamp,
+				Body:         d.Body,
+			}); err != nil {
+			return replayed, err
+		}
+
+		confirmation, ok := <-confirms
+		if !ok {
+			return replayed, errors.New("channel closed before the broker confirmed")
+		}
+		if !confirmation.Ack {
+			return replayed, fmt.Errorf("broker refused message %s", d.MessageId)
+		}
+
+		if err := d.Ack(false); err != nil {
+			return replayed, err
+		}
+		replayed = append(replayed, d.MessageId)
+	}
+
+	return replayed, nil
+}
+
+func convertDeadLetter(d *amqp.Delivery) DeadLetter {
+	deadLetter := DeadLetter{
+		MessageID:   d.MessageId,
+		RoutingKey:  originalRoutingKey(d),
+		Attempts:    attemptsOf(d),
+		PublishedAt: d.Timestamp,
+		ContentType: d.ContentType,
+		Body:        d.Body,
+	}
+	deadLetter.Queue, _ = d.Headers[headerOriginalQueue].(string)
+	deadLetter.Error, _ = d.Headers[headerError].(string)
+	deadLetter.FailedAt, _ = d.Headers[headerFailedAt].(time.Time)
+
+	return deadLetter
+}
@@ -0,0 +1,331 @@
+package rabbitmqbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/connection"
+)
+
+// Exchange is the exchange every service publishes its events to
+const Exchange = "halten"
+
+// Headers kept on a message that failed, so its retries and dead letter tell where it came from
+const (
+	headerAttempts           = "x-attempts" // Failed deliveries so far
+	headerOriginalRoutingKey = "x-original-routing-key"
+	headerOriginalQueue      = "x-original-queue"
+	headerError              = "x-error"
+	headerFailedAt           = "x-failed-at"
+)
+
+// maxErrorLength keeps the error of a failed message to a readable size
+const maxErrorLength = 1000
+
+const (
+	confirmTimeout = 10 * time.Second // How long the broker has to confirm a published message
+	// confirmBatchSize is how many messages are published before waiting for their confirmations,
+	// which wait in a buffer of that size
+	confirmBatchSize = 100
+)
+
+// Bus carries messages through RabbitMQ. Messages are published on the topic exchange halten, in
+// confirm mode. A queue's failed messages wait in a retry queue per attempt until their delay
+// expires, and go to the dead-letter exchange once they have no retries left.
+type Bus struct {
+	conn *connection.Manager
+
+	// The channel is in confirm mode, so publishes take turns on it
+	mu       sync.Mutex
+	ch       *amqp.Channel
+	confirms chan amqp.Confirmation
+}
+
+func New(conn *connection.Manager) *Bus {
+	return &Bus{conn: conn}
+}
+
+// Publish publishes the messages and waits for the broker to confirm them. While the connection is
+// being restored it returns connection.ErrDisconnected.
+func (b *Bus) Publish(ctx context.Context, messages []*bus.Message) ([]bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ch == nil {
+		if err := b.openChannel(); err != nil {
+			return nil, err
+		}
+	}
+
+	var accepted []bool
+	for start := 0; start < len(messages); start += confirmBatchSize {
+		batchAccepted, err := b.publish(messages[start:min(start+confirmBatchSize, len(messages))])
+		accepted = append(accepted, batchAccepted...)
+		if err != nil {
+			// The confirmations of the channel no longer match the messages published
+			b.ch.Close()
+			b.ch = nil
+			return accepted, err
+		}
+	}
+
+	return accepted, nil
+}
+
+func (b *Bus) openChannel() error {
+	ch, err := b.conn.Channel()
+	if err != nil {
+		return err
+	}
+
+	if err := ch.Confirm(false); err != nil {
+		ch.Close()
+		return err
+	}
+
+	b.ch = ch
+	b.confirms = ch.NotifyPublish(make(chan amqp.Confirmation, confirmBatchSize))
+	return nil
+}
+
+func (b *Bus) publish(messages []*bus.Message) ([]bool, error) {
+	published := 0
+	var publishErr error
+	for _, message := range messages {
+		if publishErr = b.ch.Publish(
+			Exchange,
+			message.RoutingKey,
+			false,
+			false,
+			amqp.Publishing{
+				ContentType:  message.ContentType,
+				DeliveryMode: amqp.Persistent,
+				MessageId:    message.ID,
+				Timestamp:    message.Timestamp,
+				Body:         message.Body,
+			}); publishErr != nil {
+			break
+		}
+		published++
+	}
+
+	// Confirmations arrive in publishing order
+	accepted := make([]bool, 0, published)
+	for i := 0; i < published; i++ {
+		confirmed, err := waitConfirm(b.confirms)
+		if err != nil {
+			return accepted, err
+		}
+		accepted = append(accepted, confirmed)
+	}
+
+	return accepted, publishErr
+}
+
+// waitConfirm waits for the broker to confirm the next message published on the channel
+func waitConfirm(confirms chan amqp.Confirmation) (bool, error) {
+	select {
+	case confirmation, ok := <-confirms:
+		if !ok {
+			return false, errors.New("channel closed before the broker confirmed")
+		}
+		return confirmation.Ack, nil
+	case <-time.After(confirmTimeout):
+		return false, fmt.Errorf("broker didn't confirm within %s", confirmTimeout)
+	}
+}
+
+// Subscribe declares the queue with its retry queues and consumes it on a channel of its own,
+// subscribing again whenever the connection is restored
+func (b *Bus) Subscribe(ctx context.Context, queue *bus.Queue, handle bus.Handler) error {
+	return b.conn.Subscribe(ctx, func(ch *amqp.Channel) error {
+		if err := declare(ch, queue); err != nil {
+			return err
+		}
+
+		prefetch := queue.Prefetch
+		if prefetch == 0 {
+			prefetch = 1
+		}
+		if err := ch.Qos(prefetch, 0, false); err != nil {
+			return err
+		}
+
+		msgs, err := ch.Consume(
+			queue.Name,
+			"",
+			false,
+			false,
+			false,
+			false,
+			nil)
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			for d := range msgs {
+				handle(ctx, &delivery{ch: ch, queue: queue, d: d})
+			}
+		}()
+
+		return nil
+	})
+}
+
+func declare(ch *amqp.Channel, queue *bus.Queue) error {
+	if err := declareDeadLetters(ch); err != nil {
+		return err
+	}
+
+	if _, err := ch.QueueDeclare(
+		queue.Name,
+		true,
+		false,
+		false,
+		false,
+		nil); err != nil {
+		return err
+	}
+
+	for _, routingKey := range queue.RoutingKeys {
+		if err := ch.QueueBind(
+			queue.Name,
+			routingKey,
+			Exchange,
+			false,
+			nil); err != nil {
+			return err
+		}
+	}
+
+	// Messages wait in a retry queue until they expire, then are dead-lettered back to the queue
+	for i, delay := range queue.RetryDelays {
+		if _, err := ch.QueueDeclare(
+			retryQueueName(queue, i+1),
+			true,
+			false,
+			false,
+			false,
+			amqp.Table{
+				"x-message-ttl":             delay.Milliseconds(),
+				"x-dead-letter-exchange":    "",
+				"x-dead-letter-routing-key": queue.Name,
+			}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delivery is a message delivered on the channel of a queue's subscription
+type delivery struct {
+	ch    *amqp.Channel
+	queue *bus.Queue
+	d     amqp.Delivery
+}
+
+func (d *delivery) Message() *bus.Message {
+	return &bus.Message{
+		ID:          d.d.MessageId,
+		RoutingKey:  originalRoutingKey(&d.d),
+		ContentType: d.d.ContentType,
+		Timestamp:   d.d.Timestamp,
+		Body:        d.d.Body,
+	}
+}
+
+func (d *delivery) Attempt() int {
+	return attemptsOf(&d.d) + 1
+}
+
+func (d *delivery) Ack() error {
+	return d.d.Ack(false)
+}
+
+// Retry moves the message to the retry queue of its attempt, which sends it back after the delay
+func (d *delivery) Retry(err error) error {
+	return d.move("", retryQueueName(d.queue, d.Attempt()), d.failedHeaders(err))
+}
+
+// DeadLetter moves the message to the dead-letter exchange, recording the queue it failed in
+func (d *delivery) DeadLetter(err error) error {
+	headers := d.failedHeaders(err)
+	headers[headerOriginalQueue] = d.queue.Name
+	headers[headerFailedAt] = time.Now().UTC()
+
+	return d.move(DeadLetterExchange, originalRoutingKey(&d.d), headers)
+}
+
+// move publishes the message elsewhere and removes it from the queue. When it can't be published
+// it's put back in the queue for another delivery rather than lost.
+func (d *delivery) move(exchange, routingKey string, headers amqp.Table) error {
+	if err := d.ch.Publish(
+		exchange,
+		routingKey,
+		false,
+		false,
+		amqp.Publishing{
+			Headers:      headers,
+			ContentType:  d.d.ContentType,
+			DeliveryMode: amqp.Persistent,
+			MessageId:    d.d.MessageId,
+			Timestamp:    d.d.Timestamp,
+			Body:         d.d.Body,
+		}); err != nil {
+		d.d.Nack(false, true)
+		return err
+	}
+
+	return d.d.Ack(false)
+}
+
+func (d *delivery) failedHeaders(err error) amqp.Table {
+	headers := amqp.Table{}
+	for key, value := range d.d.Headers {
+		headers[key] = value
+	}
+	headers[headerAttempts] = int32(d.Attempt())
+	headers[headerOriginalRoutingKey] = originalRoutingKey(&d.d)
+	headers[headerError] = truncate(err.Error(), maxErrorLength)
+
+	return headers
+}
+
+func retryQueueName(queue *bus.Queue, attempt int) string {
+	return fmt.Sprintf("%s.retry.%d", queue.Name, attempt)
+}
+
+// originalRoutingKey returns the routing key the message was published with. Retried messages come
+// back routed to the queue name.
+func originalRoutingKey(d *amqp.Delivery) string {
+	if routingKey, ok := d.Headers[headerOriginalRoutingKey].(string); ok {
+		return routingKey
+	}
+	return d.RoutingKey
+}
+
+func attemptsOf(d *amqp.Delivery) int {
+	switch attempts := d.Headers[headerAttempts].(type) {
+	case int32:
+		return int(attempts)
+	case int64:
+		return int(attempts)
+	}
+	return 0
+}
+
+func truncate(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	return strings.ToValidUTF8(text[:maxLength], "")
+}
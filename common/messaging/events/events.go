@@ -0,0 +1,157 @@
+package events
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_event "github.com/sm888sm/halten-backend/common/api/pb"
+	"github.com/sm888sm/halten-backend/common/constants/contextkeys"
+)
+
+// CorrelationIDHeader is the gRPC metadata carrying the correlation ID of a request
+const CorrelationIDHeader = "x-correlation-id"
+
+// Schema is the payload of an event type. A consumer reads every version of it: payloads only gain
+// fields, each change raising the version, and a change older consumers can't read needs a new
+// event type instead. TestSchemasCompatible checks the registered payloads against the last
+// released ones.
+type Schema struct {
+	Type    string // Also the routing key
+	Version uint32
+	Payload proto.Message // Zero value of the payload
+}
+
+var registry = make(map[string]Schema)
+
+func register(eventType string, version uint32, payload proto.Message) {
+	registry[eventType] = Schema{Type: eventType, Version: version, Payload: payload}
+}
+
+// Lookup returns the schema of an event type
+func Lookup(eventType string) (Schema, bool) {
+	schema, ok := registry[eventType]
+	return schema, ok
+}
+
+// Schemas returns the schemas of every event type, by type
+func Schemas() []Schema {
+	schemas := make([]Schema, 0, len(registry))
+	for _, schema := range registry {
+		schemas = append(schemas, schema)
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Type < schemas[j].Type })
+	return schemas
+}
+
+// Metadata tells who triggered an event and what it's about
+type Metadata struct {
+	ActorUserID   uint64
+	BoardID       uint64
+	CorrelationID string // A new one is started when empty
+}
+
+// MetadataFromContext returns the metadata of an event triggered by the request or event ctx belongs
+// to. Events following from an event handled in ctx keep its correlation ID, actor and board.
+func MetadataFromContext(ctx context.Context) Metadata {
+	var md Metadata
+	if event, ok := FromContext(ctx); ok {
+		md = Metadata{
+			ActorUserID:   event.ActorUserID,
+			BoardID:       event.BoardID,
+			CorrelationID: event.CorrelationID,
+		}
+	}
+
+	if userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64); ok {
+		md.ActorUserID = userID
+	}
+	if boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64); ok {
+		md.BoardID = boardID
+	}
+	if incoming, ok := metadata.FromIncomingContext(ctx); ok {
+		if correlationIDs := incoming.Get(CorrelationIDHeader); len(correlationIDs) > 0 && correlationIDs[0] != "" {
+			md.CorrelationID = correlationIDs[0]
+		}
+	}
+
+	return md
+}
+
+// New wraps the payload of an event in its envelope. The payload must be the registered one for the
+// event type.
+func New(eventType string, payload proto.Message, md Metadata) (*pb_event.Event, error) {
+	schema, ok := registry[eventType]
+	if !ok {
+		return nil, fmt.Errorf("event type %s isn't registered", eventType)
+	}
+
+	if got, want := payload.ProtoReflect().Descriptor().FullName(), schema.Payload.ProtoReflect().Descriptor().FullName(); got != want {
+		return nil, fmt.Errorf("event type %s carries %s, not %s", eventType, want, got)
+	}
+
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+
+	packed, err := anypb.New(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	correlationID := md.CorrelationID
+	if correlationID == "" {
+		correlationID = id
+	}
+
+	return &pb_event.Event{
+		Id:            id,
+		Type:          eventType,
+		Version:       schema.Version,
+		OccurredAt:    timestamppb.Now(),
+		ActorUserID:   md.ActorUserID,
+		BoardID:       md.BoardID,
+		CorrelationID: correlationID,
+		Payload:       packed,
+	}, nil
+}
+
+// Unpack reads the payload of an event into payload
+func Unpack(event *pb_event.Event, payload proto.Message) error {
+	if event.Payload == nil {
+		return fmt.Errorf("event %s has no payload", event.Id)
+	}
+	return event.Payload.UnmarshalTo(payload)
+}
+
+type eventKey struct{}
+
+// NewContext returns a context for handling the event, so the events it triggers follow from it
+func NewContext(ctx context.Context, event *pb_event.Event) context.Context {
+	return context.WithValue(ctx, eventKey{}, event)
+}
+
+// FromContext returns the event handled in ctx
+func FromContext(ctx context.Context) (*pb_event.Event, bool) {
+	event, ok := ctx.Value(eventKey{}).(*pb_event.Event)
+	return event, ok && event != nil
+}
+
+// newID returns a random UUID, unique across every service publishing events
+func newID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+
+	b[6] = b[6]&0x0f | 0x40 // Version 4
+	b[8] = b[8]&0x3f | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
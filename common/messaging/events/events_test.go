@@ -0,0 +1,256 @@
+package events_test
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+)
+
+// schemaSnapshot is an event type as consumers know it
+type schemaSnapshot struct {
+	Version  uint32                     `json:"version"`
+	Payload  string                     `json:"payload"`
+	Messages map[string]messageSnapshot `json:"messages"` // The payload and the messages nested in it
+}
+
+// messageSnapshot holds the fields of a message by number, which is what identifies them on the wire
+type messageSnapshot map[protoreflect.FieldNumber]fieldSnapshot
+
+type fieldSnapshot struct {
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`
+	Cardinality string `json:"cardinality"`
+	Type        string `json:"type,omitempty"` // Full name of a message or enum
+}
+
+// wireGroups are the kinds a field can change between without older consumers misreading it
+var wireGroups = [][]protoreflect.Kind{
+	{protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.BoolKind},
+	{protoreflect.Sint32Kind, protoreflect.Sint64Kind},
+	{protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind},
+	{protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind},
+	{protoreflect.StringKind, protoreflect.BytesKind},
+}
+
+// snapshotPath records the payloads of the last release, which consumers may still be built against
+const snapshotPath = "schemas.json"
+
+var update = flag.Bool("update", false, "record the current payloads as the released ones once they're compatible")
+
+// TestSchemasCompatible checks that the registered event payloads can still be read by consumers
+// built against the last released ones. It fails when a payload loses a field or changes one's type,
+// when an event type is removed or carries another message, and when a payload gains fields without
+// its version being raised. Once it passes, -update records the current payloads:
+//
+//	go test ./common/messaging/events -update
+func TestSchemasCompatible(t *testing.T) {
+	released, err := readSnapshot(snapshotPath)
+	if err != nil {
+		t.Fatalf("Error reading snapshot: %v", err)
+	}
+
+	current := make(map[string]schemaSnapshot)
+	for _, schema := range events.Schemas() {
+		current[schema.Type] = snapshotSchema(schema)
+	}
+
+	problems, unreleased := compare(released, current)
+	if !*update {
+		for _, eventType := range unreleased {
+			problems = append(problems, fmt.Sprintf("%s: not in the snapshot yet, record it with -update", eventType))
+		}
+	}
+
+	for _, problem := range problems {
+		t.Error(problem)
+	}
+	if len(problems) > 0 || !*update {
+		return
+	}
+
+	if err := writeSnapshot(snapshotPath, current); err != nil {
+		t.Fatalf("Error writing snapshot: %v", err)
+	}
+	t.Logf("Recorded %d event types in %s", len(current), snapshotPath)
+}
+
+// compare returns what keeps consumers of the released payloads from reading the current ones, and
+// the event types that weren't released yet
+func compare(released, current map[string]schemaSnapshot) (problems, unreleased []string) {
+	for _, eventType := range sortedKeys(released) {
+		before := released[eventType]
+		after, ok := current[eventType]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s: removed, consumers may still wait for it", eventType))
+			continue
+		case after.Payload != before.Payload:
+			problems = append(problems, fmt.Sprintf("%s: carries %s instead of %s, register a new event type", eventType, after.Payload, before.Payload))
+			continue
+		case after.Version < before.Version:
+			problems = append(problems, fmt.Sprintf("%s: version lowered from %d to %d", eventType, before.Version, after.Version))
+			continue
+		}
+
+		for _, name := range sortedKeys(before.Messages) {
+			// A message no longer nested shows as the field that referenced it changing type
+			afterFields, ok := after.Messages[name]
+			if !ok {
+				continue
+			}
+
+			beforeFields := before.Messages[name]
+			for _, number := range sortedKeys(beforeFields) {
+				if problem := compareField(beforeFields[number], afterFields[number]); problem != "" {
+					problems = append(problems, fmt.Sprintf("%s: %s field %d %s", eventType, name, number, problem))
+				}
+			}
+		}
+
+		added := false
+		for name, afterFields := range after.Messages {
+			beforeFields, ok := before.Messages[name]
+			if !ok {
+				added = true
+				continue
+			}
+			for number := range afterFields {
+				if _, ok := beforeFields[number]; !ok {
+					added = true
+				}
+			}
+		}
+
+		if added && after.Version == before.Version {
+			problems = append(problems, fmt.Sprintf("%s: payload gained fields, raise its version above %d", eventType, before.Version))
+		}
+	}
+
+	for _, eventType := range sortedKeys(current) {
+		if _, ok := released[eventType]; !ok {
+			unreleased = append(unreleased, eventType)
+		}
+	}
+
+	return problems, unreleased
+}
+
+func compareField(before, after fieldSnapshot) string {
+	switch {
+	case after == (fieldSnapshot{}):
+		return fmt.Sprintf("(%s) removed, consumers reading it would get its zero value", before.Name)
+	case after.Cardinality != before.Cardinality:
+		return fmt.Sprintf("(%s) changed from %s to %s", before.Name, before.Cardinality, after.Cardinality)
+	case after.Type != before.Type:
+		return fmt.Sprintf("(%s) changed from %s to %s", before.Name, before.Type, after.Type)
+	case !wireCompatible(before.Kind, after.Kind):
+		return fmt.Sprintf("(%s) changed from %s to %s", before.Name, before.Kind, after.Kind)
+	}
+	return ""
+}
+
+func wireCompatible(before, after string) bool {
+	if before == after {
+		return true
+	}
+
+	for _, group := range wireGroups {
+		hasBefore, hasAfter := false, false
+		for _, kind := range group {
+			hasBefore = hasBefore || kind.String() == before
+			hasAfter = hasAfter || kind.String() == after
+		}
+		if hasBefore && hasAfter {
+			return true
+		}
+	}
+	return false
+}
+
+func snapshotSchema(schema events.Schema) schemaSnapshot {
+	payload := schema.Payload.ProtoReflect().Descriptor()
+
+	snapshot := schemaSnapshot{
+		Version:  schema.Version,
+		Payload:  string(payload.FullName()),
+		Messages: make(map[string]messageSnapshot),
+	}
+	snapshotMessage(payload, snapshot.Messages)
+
+	return snapshot
+}
+
+// snapshotMessage adds the message and the messages nested in it. Well-known types never change, so
+// they're left out.
+func snapshotMessage(message protoreflect.MessageDescriptor, messages map[string]messageSnapshot) {
+	name := string(message.FullName())
+	if _, ok := messages[name]; ok || strings.HasPrefix(name, "google.protobuf.") {
+		return
+	}
+
+	fields := make(messageSnapshot)
+	messages[name] = fields
+
+	for i := 0; i < message.Fields().Len(); i++ {
+		field := message.Fields().Get(i)
+		snapshot := fieldSnapshot{
+			Name:        string(field.Name()),
+			Kind:        field.Kind().String(),
+			Cardinality: field.Cardinality().String(),
+		}
+
+		switch {
+		case field.Message() != nil:
+			snapshot.Type = string(field.Message().FullName())
+			snapshotMessage(field.Message(), messages)
+		case field.Enum() != nil:
+			snapshot.Type = string(field.Enum().FullName())
+		}
+
+		fields[field.Number()] = snapshot
+	}
+}
+
+func readSnapshot(path string) (map[string]schemaSnapshot, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		// Nothing was released yet
+		return map[string]schemaSnapshot{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot map[string]schemaSnapshot
+	if err := json.Unmarshal(content, &snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+func writeSnapshot(path string, snapshot map[string]schemaSnapshot) error {
+	content, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(content, '\n'), 0644)
+}
+
+func sortedKeys[K ~string | ~int32, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
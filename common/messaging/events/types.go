@@ -0,0 +1,48 @@
+package events
+
+import (
+	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
+	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
+	pb_list "github.com/sm888sm/halten-backend/list-service/api/pb"
+)
+
+// Event types, which are also their routing keys
+const (
+	BoardDelete = "board.delete"
+	BoardExport = "board.export"
+	BoardImport = "board.import"
+
+	// Deleting a board for good, see deletionsteps
+	BoardDeleted               = "board.deleted"
+	BoardListsCleanupRequested = "board.lists_cleanup_requested"
+	BoardCleanupCompleted      = "board.cleanup_completed"
+
+	ListDelete        = "list.delete"
+	ListCardsArchived = "list.cards_archived"
+	ListCardsMoved    = "list.cards_moved"
+	ListCardsSorted   = "list.cards_sorted"
+	ListCardsImported = "list.cards_imported"
+
+	CardDelete  = "card.delete"
+	CardDueSoon = "card.due_soon"
+	CardOverdue = "card.overdue"
+)
+
+func init() {
+	register(BoardDelete, 1, &pb_board.DeleteBoardRequest{})
+	register(BoardExport, 1, &pb_board.BoardExportRequestedEvent{})
+	register(BoardImport, 1, &pb_board.BoardImportRequestedEvent{})
+	register(BoardDeleted, 1, &pb_board.BoardDeletedEvent{})
+	register(BoardListsCleanupRequested, 1, &pb_board.BoardCleanupEvent{})
+	register(BoardCleanupCompleted, 1, &pb_board.BoardCleanupEvent{})
+
+	register(ListDelete, 1, &pb_list.DeleteListRequest{})
+	register(ListCardsArchived, 1, &pb_card.ListCardsEvent{})
+	register(ListCardsMoved, 1, &pb_card.ListCardsEvent{})
+	register(ListCardsSorted, 1, &pb_card.ListCardsEvent{})
+	register(ListCardsImported, 1, &pb_card.ListCardsEvent{})
+
+	register(CardDelete, 1, &pb_card.DeleteCardRequest{})
+	register(CardDueSoon, 1, &pb_card.CardDueEvent{})
+	register(CardOverdue, 1, &pb_card.CardDueEvent{})
+}
@@ -0,0 +1,169 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pb_event "github.com/sm888sm/halten-backend/common/api/pb"
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/connection"
+	"github.com/sm888sm/halten-backend/models"
+)
+
+// ContentType is the content type of the events the publishers write, protobuf event envelopes
+const ContentType = "application/protobuf; proto=eventpb.Event"
+
+const (
+	batchSize     = 100
+	pruneInterval = time.Hour
+)
+
+// Write adds an event to the outbox, published with its type as routing key and its ID as message
+// ID. tx is the transaction of the change the event announces, so the event is published if and only
+// if the change is committed.
+func Write(tx *gorm.DB, event *pb_event.Event) error {
+	body, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&models.OutboxMessage{
+		MessageID:   event.Id,
+		RoutingKey:  event.Type,
+		ContentType: ContentType,
+		Body:        body,
+	}).Error
+}
+
+// Relay publishes the messages of the outbox in the order they were written and marks them sent
+// once the bus accepted them. A message is published at least once: it's published again when
+// the relay stops between the confirmation and marking it sent, so consumers must tolerate
+// duplicates. Several replicas can relay at the same time, each locks the rows it publishes.
+type Relay struct {
+	db        *gorm.DB
+	bus       bus.Bus
+	interval  time.Duration
+	retention time.Duration
+}
+
+// NewRelay returns a relay polling the outbox every interval. Sent messages are deleted after
+// retention.
+func NewRelay(db *gorm.DB, b bus.Bus, interval, retention time.Duration) *Relay {
+	return &Relay{
+		db:        db,
+		bus:       b,
+		interval:  interval,
+		retention: retention,
+	}
+}
+
+// Run blocks until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	var lastPrune time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// A full batch means more messages are likely waiting
+		for {
+			sent, err := r.relayBatch(ctx)
+			if err != nil {
+				// Messages wait in the outbox until the connection is restored
+				if !errors.Is(err, connection.ErrDisconnected) {
+					log.Printf("Outbox relay failed: %v", err)
+				}
+				break
+			}
+			if sent < batchSize || ctx.Err() != nil {
+				break
+			}
+		}
+
+		if time.Since(lastPrune) >= pruneInterval {
+			if err := r.prune(); err != nil {
+				log.Printf("Outbox relay failed to delete sent messages: %v", err)
+			} else {
+				lastPrune = time.Now()
+			}
+		}
+	}
+}
+
+// relayBatch publishes the oldest pending messages and returns how many were sent. Messages the bus
+// didn't accept, or didn't get to before failing, are published again by the next batch.
+func (r *Relay) relayBatch(ctx context.Context) (int, error) {
+	sent := 0
+	var publishErr error
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var messages []models.OutboxMessage
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("sent_at IS NULL").
+			Order("id").
+			Limit(batchSize).
+			Find(&messages).Error; err != nil {
+			return err
+		}
+		if len(messages) == 0 {
+			return nil
+		}
+
+		msgs := make([]*bus.Message, 0, len(messages))
+		for _, message := range messages {
+			msgs = append(msgs, &bus.Message{
+				ID:          message.MessageID,
+				RoutingKey:  message.RoutingKey,
+				ContentType: message.ContentType,
+				Timestamp:   message.CreatedAt,
+				Body:        message.Body,
+			})
+		}
+
+		var accepted []bool
+		accepted, publishErr = r.bus.Publish(ctx, msgs)
+
+		var sentIDs []uint64
+		for i, ok := range accepted {
+			if ok {
+				sentIDs = append(sentIDs, messages[i].ID)
+			} else if err := tx.Model(&messages[i]).Updates(map[string]interface{}{
+				"attempts":   gorm.Expr("attempts + 1"),
+				"last_error": "Not accepted by the message bus",
+			}).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(sentIDs) > 0 {
+			if err := tx.Model(&models.OutboxMessage{}).Where("id IN ?", sentIDs).Update("sent_at", time.Now()).Error; err != nil {
+				return err
+			}
+		}
+		sent = len(sentIDs)
+
+		// What was accepted is still marked sent, the rest is retried
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sent, publishErr
+}
+
+// prune deletes the messages sent before the retention period
+func (r *Relay) prune() error {
+	return r.db.Where("sent_at < ?", time.Now().Add(-r.retention)).Delete(&models.OutboxMessage{}).Error
+}
@@ -0,0 +1,228 @@
+package connection
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/streadway/amqp"
+)
+
+const (
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// ErrDisconnected is returned while the connection to the broker is being restored
+var ErrDisconnected = errors.New("not connected to RabbitMQ")
+
+// Topology declares exchanges, queues and bindings. It's run on every connection, so what a broker
+// restart lost is declared again.
+type Topology func(ch *amqp.Channel) error
+
+// Manager keeps a connection to RabbitMQ, dialing again with a growing delay whenever it's lost.
+// Subscriptions get a channel of their own and are subscribed again once the connection is restored.
+// Publishers open a channel of their own with Channel, as the RabbitMQ bus does to wait for confirms.
+type Manager struct {
+	url      string
+	topology []Topology
+
+	mu    sync.RWMutex
+	conn  *amqp.Connection
+	ready chan struct{} // Closed while connected
+}
+
+// Dial connects to the broker at url and declares the topology, then keeps the connection up until
+// ctx is cancelled. The first connection is made before it returns, so a broker that can't be
+// reached at startup is reported.
+func Dial(ctx context.Context, url string, topology ...Topology) (*Manager, error) {
+	m := &Manager{
+		url:      url,
+		topology: topology,
+		ready:    make(chan struct{}),
+	}
+
+	conn, err := m.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	go m.keepAlive(ctx, conn)
+
+	return m, nil
+}
+
+// Channel opens a channel the caller owns and closes, for work that holds state on it such as
+// consuming or publishing in confirm mode
+func (m *Manager) Channel() (*amqp.Channel, error) {
+	m.mu.RLock()
+	conn := m.conn
+	m.mu.RUnlock()
+
+	if conn == nil {
+		return nil, ErrDisconnected
+	}
+
+	ch, err := conn.Channel()
+	if errors.Is(err, amqp.ErrClosed) {
+		return nil, ErrDisconnected
+	}
+	return ch, err
+}
+
+// Subscribe runs subscribe on a channel of its own, and again on a new channel whenever that one is
+// closed, until ctx is cancelled. subscribe declares what it consumes from and starts consuming,
+// its deliveries end when the channel is closed. The error of the first subscription is returned,
+// later ones are retried.
+func (m *Manager) Subscribe(ctx context.Context, subscribe func(ch *amqp.Channel) error) error {
+	ch, err := m.Channel()
+	if err != nil {
+		return err
+	}
+
+	if err := subscribe(ch); err != nil {
+		ch.Close()
+		return err
+	}
+
+	go m.resubscribe(ctx, ch, subscribe)
+
+	return nil
+}
+
+func (m *Manager) resubscribe(ctx context.Context, ch *amqp.Channel, subscribe func(ch *amqp.Channel) error) {
+	for {
+		closed := ch.NotifyClose(make(chan *amqp.Error, 1))
+
+		select {
+		case <-ctx.Done():
+			ch.Close()
+			return
+		case <-closed:
+		}
+
+		delay := minReconnectDelay
+		for {
+			if err := m.wait(ctx); err != nil {
+				return
+			}
+
+			var err error
+			if ch, err = m.Channel(); err == nil {
+				if err = subscribe(ch); err == nil {
+					break
+				}
+				ch.Close()
+			}
+
+			log.Printf("Failed to subscribe again, retrying in %s: %v", delay, err)
+			if !sleep(ctx, delay) {
+				return
+			}
+			delay = nextDelay(delay)
+		}
+	}
+}
+
+// connect dials the broker and declares the topology
+func (m *Manager) connect() (*amqp.Connection, error) {
+	conn, err := amqp.Dial(m.url)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	defer ch.Close()
+
+	for _, declare := range m.topology {
+		if err := declare(ch); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	m.mu.Lock()
+	m.conn = conn
+	close(m.ready)
+	m.mu.Unlock()
+
+	return conn, nil
+}
+
+// keepAlive dials again each time the connection is lost, until ctx is cancelled
+func (m *Manager) keepAlive(ctx context.Context, conn *amqp.Connection) {
+	for {
+		closed := conn.NotifyClose(make(chan *amqp.Error, 1))
+
+		select {
+		case <-ctx.Done():
+			conn.Close()
+			return
+		case err := <-closed:
+			log.Printf("Lost the connection to RabbitMQ, reconnecting: %v", err)
+		}
+
+		m.disconnected()
+
+		delay := minReconnectDelay
+		for {
+			var err error
+			if conn, err = m.connect(); err == nil {
+				break
+			}
+
+			log.Printf("Failed to reconnect to RabbitMQ, retrying in %s: %v", delay, err)
+			if !sleep(ctx, delay) {
+				return
+			}
+			delay = nextDelay(delay)
+		}
+
+		log.Printf("Reconnected to RabbitMQ")
+	}
+}
+
+// disconnected makes callers wait for the next connection
+func (m *Manager) disconnected() {
+	m.mu.Lock()
+	m.conn = nil
+	m.ready = make(chan struct{})
+	m.mu.Unlock()
+}
+
+// wait waits until the manager is connected or ctx is done
+func (m *Manager) wait(ctx context.Context) error {
+	m.mu.RLock()
+	ready := m.ready
+	m.mu.RUnlock()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// sleep waits for delay unless ctx is done first, reporting whether it waited
+func sleep(ctx context.Context, delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+func nextDelay(delay time.Duration) time.Duration {
+	return min(delay*2, maxReconnectDelay)
+}
@@ -0,0 +1,213 @@
+package consumers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	pb_event "github.com/sm888sm/halten-backend/common/api/pb"
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/transactions"
+	"github.com/sm888sm/halten-backend/models"
+)
+
+const (
+	// processedRetention is how long handled messages are remembered. A message redelivered later
+	// than that, which the broker never does on its own, is handled again.
+	processedRetention = 7 * 24 * time.Hour
+	pruneInterval      = time.Hour
+)
+
+// DefaultRetryDelays waits longer before each retry, giving a dependency that's down time to recover
+var DefaultRetryDelays = []time.Duration{5 * time.Second, 30 * time.Second, 5 * time.Minute}
+
+// Queue is a durable queue of a service, declared on the bus the consumer runs on
+type Queue = bus.Queue
+
+// Message is a message delivered to a handler
+type Message struct {
+	ID         string // Set by the outbox, empty for messages published without it
+	RoutingKey string // Routing key it was published with, retries included
+	Attempt    int    // 1 for the first delivery, incremented by every retry
+	Body       []byte
+	Event      *pb_event.Event // Envelope read from the body, events.Unpack reads its payload
+}
+
+// Handler handles a message. ctx carries the event, so the events the handler triggers follow from
+// it, and the transaction recording the message as processed, which the handler writes in through
+// transactions.FromContext. A returned error retries the message later, unless it's permanent.
+type Handler func(ctx context.Context, msg *Message) error
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks an error retrying can't fix, such as a message that can't be decoded. The message
+// is dead-lettered right away.
+func Permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// Consumer routes the messages of a queue to the handler of their routing key. Each message is
+// handled once per queue: it's recorded as processed in the transaction its handler writes in, so
+// the record and what the handler changed are committed together. A duplicate delivered meanwhile
+// waits for the outcome and is skipped once the first one succeeded. The record is rolled back with
+// the handler's changes when it fails, leaving the message to its retry.
+type Consumer struct {
+	db         *gorm.DB
+	queue      *Queue
+	handlers   map[string]Handler
+	idempotent map[string]bool // Routing keys whose handlers run outside a transaction
+}
+
+func NewConsumer(db *gorm.DB, queue *Queue) *Consumer {
+	return &Consumer{
+		db:         db,
+		queue:      queue,
+		handlers:   make(map[string]Handler),
+		idempotent: make(map[string]bool),
+	}
+}
+
+// Handle registers the handler of the messages published with the routing key
+func (c *Consumer) Handle(routingKey string, handler Handler) {
+	c.handlers[routingKey] = handler
+}
+
+// HandleIdempotent registers the handler of a message that's safe to handle more than once, such as
+// a job claiming its work by status. It runs outside a transaction, so what it writes is seen while
+// it runs, and its messages aren't recorded as processed.
+func (c *Consumer) HandleIdempotent(routingKey string, handler Handler) {
+	c.handlers[routingKey] = handler
+	c.idempotent[routingKey] = true
+}
+
+// Run subscribes to the queue on b, bound to the routing keys with a handler unless the queue lists
+// its own. A message is acknowledged once handled. When the handler fails it's retried after the
+// delay of its attempt, and dead-lettered once it has no retries left or the error is permanent.
+// Handlers are given ctx.
+func (c *Consumer) Run(ctx context.Context, b bus.Bus) error {
+	queue := *c.queue
+	if len(queue.RoutingKeys) == 0 {
+		for routingKey := range c.handlers {
+			queue.RoutingKeys = append(queue.RoutingKeys, routingKey)
+		}
+	}
+
+	if err := b.Subscribe(ctx, &queue, c.deliver); err != nil {
+		return err
+	}
+
+	go c.pruneProcessed(ctx)
+
+	return nil
+}
+
+func (c *Consumer) deliver(ctx context.Context, d bus.Delivery) {
+	delivered := d.Message()
+	msg := &Message{
+		ID:         delivered.ID,
+		RoutingKey: delivered.RoutingKey,
+		Attempt:    d.Attempt(),
+		Body:       delivered.Body,
+	}
+
+	start := time.Now()
+	outcome, err := c.handle(ctx, msg)
+	duration := time.Since(start)
+
+	if err == nil {
+		recordOutcome(c.queue.Name, msg.RoutingKey, outcome, duration)
+		if err := d.Ack(); err != nil {
+			// Redelivered and skipped as a duplicate
+			log.Printf("Failed to acknowledge message %s from %s: %v", msg.ID, c.queue.Name, err)
+		}
+		return
+	}
+
+	var settleErr error
+	var permanent *permanentError
+	if errors.As(err, &permanent) || msg.Attempt > len(c.queue.RetryDelays) {
+		outcome, settleErr = outcomeDeadLettered, d.DeadLetter(err)
+	} else {
+		outcome, settleErr = outcomeRetried, d.Retry(err)
+	}
+	recordOutcome(c.queue.Name, msg.RoutingKey, outcome, duration)
+	log.Printf("Failed to handle %s message %s from %s (attempt %d, %s): %v", msg.RoutingKey, msg.ID, c.queue.Name, msg.Attempt, outcome, err)
+
+	if settleErr != nil {
+		// The bus keeps it for another delivery rather than losing it
+		log.Printf("Failed to move message %s out of %s: %v", msg.ID, c.queue.Name, settleErr)
+	}
+}
+
+// handle runs the handler of the message unless the message was already processed
+func (c *Consumer) handle(ctx context.Context, msg *Message) (string, error) {
+	handler, ok := c.handlers[msg.RoutingKey]
+	if !ok {
+		return outcomeIgnored, nil
+	}
+
+	msg.Event = &pb_event.Event{}
+	if err := proto.Unmarshal(msg.Body, msg.Event); err != nil {
+		return outcomeHandled, Permanent(fmt.Errorf("invalid event envelope: %w", err))
+	}
+	ctx = events.NewContext(ctx, msg.Event)
+
+	// Without an ID duplicates can't be told apart
+	if msg.ID == "" || c.idempotent[msg.RoutingKey] {
+		return outcomeHandled, handler(ctx, msg)
+	}
+
+	outcome := outcomeHandled
+	err := transactions.Run(ctx, c.db, func(ctx context.Context) error {
+		tx := transactions.FromContext(ctx)
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models.ProcessedMessage{
+			Consumer:    c.queue.Name,
+			MessageID:   msg.ID,
+			ProcessedAt: time.Now(),
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			outcome = outcomeDuplicate
+			return nil
+		}
+
+		return handler(ctx, msg)
+	})
+
+	return outcome, err
+}
+
+// pruneProcessed forgets the messages handled before the retention period, until ctx is cancelled
+func (c *Consumer) pruneProcessed(ctx context.Context) {
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := c.db.Where("consumer = ? AND processed_at < ?", c.queue.Name, time.Now().Add(-processedRetention)).
+			Delete(&models.ProcessedMessage{}).Error; err != nil {
+			log.Printf("Failed to delete processed messages of %s: %v", c.queue.Name, err)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package consumers_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+	"github.com/sm888sm/halten-backend/common/messaging/bus/memorybus"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/consumers"
+)
+
+func TestRetriesFailedMessagesThenDeadLetters(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		wantAttempts []int
+	}{
+		{name: "retried", err: errors.New("dependency down"), wantAttempts: []int{1, 2, 3}},
+		{name: "permanent", err: consumers.Permanent(errors.New("invalid payload")), wantAttempts: []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			b := memorybus.New()
+
+			var mu sync.Mutex
+			var attempts []int
+			// Messages without an ID are handled without recording them, so no database is needed
+			c := consumers.NewConsumer(nil, &consumers.Queue{
+				Name:        "test.consumer",
+				RetryDelays: []time.Duration{time.Millisecond, time.Millisecond},
+			})
+			c.Handle("board.created", func(ctx context.Context, msg *consumers.Message) error {
+				mu.Lock()
+				attempts = append(attempts, msg.Attempt)
+				mu.Unlock()
+				return tt.err
+			})
+			if err := c.Run(ctx, b); err != nil {
+				t.Fatalf("Error running consumer: %v", err)
+			}
+
+			if _, err := b.Publish(ctx, []*bus.Message{{RoutingKey: "board.created"}}); err != nil {
+				t.Fatalf("Error publishing: %v", err)
+			}
+
+			deadLetters := waitForDeadLetters(t, b)
+			if len(deadLetters) != 1 || deadLetters[0].Error != tt.err.Error() {
+				t.Fatalf("Got dead letters %v, want one failed with %q", deadLetters, tt.err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if len(attempts) != len(tt.wantAttempts) {
+				t.Fatalf("Got attempts %v, want %v", attempts, tt.wantAttempts)
+			}
+			for i := range attempts {
+				if attempts[i] != tt.wantAttempts[i] {
+					t.Fatalf("Got attempts %v, want %v", attempts, tt.wantAttempts)
+				}
+			}
+		})
+	}
+}
+
+// waitForDeadLetters waits for the bus to dead-letter a message
+func waitForDeadLetters(t *testing.T, b *memorybus.Bus) []*memorybus.DeadLetter {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if deadLetters := b.DeadLetters(); len(deadLetters) > 0 {
+			return deadLetters
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	t.Fatal("No message was dead-lettered")
+	return nil
+}
@@ -0,0 +1,70 @@
+package consumers
+
+import (
+	"expvar"
+	"log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Outcomes of a delivery, counted per queue and routing key
+const (
+	outcomeHandled      = "handled"
+	outcomeDuplicate    = "duplicate"     // Already processed, skipped
+	outcomeIgnored      = "ignored"       // No handler for its routing key
+	outcomeRetried      = "retried"       // Failed, moved to a retry queue
+	outcomeDeadLettered = "dead_lettered" // Failed with no retries left or permanently
+)
+
+// Metrics are published with expvar, served at /debug/vars when the service exposes them. Each is a
+// map keyed by <queue>:<routing key>, then by outcome.
+var (
+	deliveries       = expvar.NewMap("consumer_deliveries")
+	handlingDuration = expvar.NewMap("consumer_handling_milliseconds") // Summed, divide by deliveries
+
+	countersMu sync.Mutex
+
+	servingMetrics atomic.Bool
+)
+
+func recordOutcome(queue, routingKey, outcome string, duration time.Duration) {
+	key := queue + ":" + routingKey
+	counterOf(deliveries, key).Add(outcome, 1)
+	counterOf(handlingDuration, key).Add(outcome, duration.Milliseconds())
+}
+
+func counterOf(metric *expvar.Map, key string) *expvar.Map {
+	if counter, ok := metric.Get(key).(*expvar.Map); ok {
+		return counter
+	}
+
+	// Queues handle their deliveries concurrently, only one of them adds the counter
+	countersMu.Lock()
+	defer countersMu.Unlock()
+
+	if counter, ok := metric.Get(key).(*expvar.Map); ok {
+		return counter
+	}
+	counter := new(expvar.Map)
+	metric.Set(key, counter)
+	return counter
+}
+
+// ServeMetrics serves the metrics of the process at /debug/vars on addr. It blocks, logging why it
+// stopped. The services running in the same process share the metrics, so only the first call
+// serves them and the others return right away.
+func ServeMetrics(addr string) {
+	if !servingMetrics.CompareAndSwap(false, true) {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	log.Printf("Serving metrics on %s", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Printf("Stopped serving metrics: %v", err)
+	}
+}
@@ -4,28 +4,88 @@ import (
 	"fmt"
 
 	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
-	"github.com/streadway/amqp"
+	"github.com/sm888sm/halten-backend/common/constants/deletionsteps"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/outbox"
 	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
-type BoardPublisher struct {
-	Channel *amqp.Channel
-}
+const (
+	DeleteBoard MessageType = iota
+	ExportBoard
+	ImportBoard
+	BoardDeleted
+	BoardListsCleanupRequested
+	BoardCleanupCompleted
+	// Add other message types here...
+)
+
+type BoardPublisher struct{}
 
-func NewBoardPublisher(ch *amqp.Channel) *BoardPublisher {
-	return &BoardPublisher{Channel: ch}
+func NewBoardPublisher() *BoardPublisher {
+	return &BoardPublisher{}
 }
 
-func (p *BoardPublisher) Publish(messageType MessageType, message []byte) error {
+func (p *BoardPublisher) Publish(tx *gorm.DB, md events.Metadata, messageType MessageType, message []byte) error {
 	switch messageType {
-	case DeleteCard:
+	case DeleteBoard:
 		var msg pb_board.DeleteBoardRequest
 		err := proto.Unmarshal(message, &msg)
 		if err != nil {
 			return err
 		}
 
-		err = p.publishDeleteBoardMessage(&msg)
+		err = p.publishDeleteBoardMessage(tx, md, &msg)
+		if err != nil {
+			return err
+		}
+	case ExportBoard:
+		var msg pb_board.BoardExportRequestedEvent
+		err := proto.Unmarshal(message, &msg)
+		if err != nil {
+			return err
+		}
+
+		err = p.publishExportBoardMessage(tx, md, &msg)
+		if err != nil {
+			return err
+		}
+	case ImportBoard:
+		var msg pb_board.BoardImportRequestedEvent
+		err := proto.Unmarshal(message, &msg)
+		if err != nil {
+			return err
+		}
+
+		err = p.publishImportBoardMessage(tx, md, &msg)
+		if err != nil {
+			return err
+		}
+	case BoardDeleted:
+		var msg pb_board.BoardDeletedEvent
+		err := proto.Unmarshal(message, &msg)
+		if err != nil {
+			return err
+		}
+
+		err = p.publishBoardDeletedMessage(tx, md, &msg)
+		if err != nil {
+			return err
+		}
+	case BoardListsCleanupRequested, BoardCleanupCompleted:
+		var msg pb_board.BoardCleanupEvent
+		err := proto.Unmarshal(message, &msg)
+		if err != nil {
+			return err
+		}
+
+		eventType := events.BoardListsCleanupRequested
+		if messageType == BoardCleanupCompleted {
+			eventType = events.BoardCleanupCompleted
+		}
+
+		err = p.publishBoardCleanupMessage(tx, md, eventType, &msg)
 		if err != nil {
 			return err
 		}
@@ -37,23 +97,74 @@ func (p *BoardPublisher) Publish(messageType MessageType, message []byte) error
 	return nil
 }
 
-func (p *BoardPublisher) publishDeleteBoardMessage(req *pb_board.DeleteBoardRequest) error {
-	ch := p.Channel
-	defer ch.Close()
+func (p *BoardPublisher) publishDeleteBoardMessage(tx *gorm.DB, md events.Metadata, req *pb_board.DeleteBoardRequest) error {
+	event, err := events.New(events.BoardDelete, req, md)
+	if err != nil {
+		return err
+	}
+
+	return outbox.Write(tx, event)
+}
 
-	message, err := proto.Marshal(req)
+func (p *BoardPublisher) publishExportBoardMessage(tx *gorm.DB, md events.Metadata, payload *pb_board.BoardExportRequestedEvent) error {
+	event, err := events.New(events.BoardExport, payload, md)
 	if err != nil {
 		return err
 	}
 
-	err = ch.Publish(
-		"halten",
-		"board.delete",
-		false,
-		false,
-		amqp.Publishing{
-			ContentType: "application/protobuf",
-			Body:        message,
-		})
-	return err
+	return outbox.Write(tx, event)
+}
+
+func (p *BoardPublisher) publishImportBoardMessage(tx *gorm.DB, md events.Metadata, payload *pb_board.BoardImportRequestedEvent) error {
+	event, err := events.New(events.BoardImport, payload, md)
+	if err != nil {
+		return err
+	}
+
+	return outbox.Write(tx, event)
+}
+
+func (p *BoardPublisher) publishBoardDeletedMessage(tx *gorm.DB, md events.Metadata, payload *pb_board.BoardDeletedEvent) error {
+	event, err := events.New(events.BoardDeleted, payload, md)
+	if err != nil {
+		return err
+	}
+
+	return outbox.Write(tx, event)
+}
+
+func (p *BoardPublisher) publishBoardCleanupMessage(tx *gorm.DB, md events.Metadata, eventType string, payload *pb_board.BoardCleanupEvent) error {
+	event, err := events.New(eventType, payload, md)
+	if err != nil {
+		return err
+	}
+
+	return outbox.Write(tx, event)
+}
+
+// NewBoardCleanupEvent returns the Event asking for a step of deleting boards for good, one message
+// per board it's written with: board.deleted for the cards, which go first, then
+// board.lists_cleanup_requested
+func NewBoardCleanupEvent(publisher Publisher, md events.Metadata, step string) Event {
+	messageType := BoardListsCleanupRequested
+	if step == deletionsteps.Cards {
+		messageType = BoardDeleted
+	}
+
+	return func(tx *gorm.DB, ids []uint64) error {
+		for _, boardID := range ids {
+			md.BoardID = boardID
+			event := NewEvent(publisher, messageType, md, func(ids []uint64) proto.Message {
+				if messageType == BoardDeleted {
+					return &pb_board.BoardDeletedEvent{BoardID: boardID}
+				}
+				return &pb_board.BoardCleanupEvent{BoardID: boardID, Step: step}
+			})
+
+			if err := event.Write(tx, boardID); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
 }
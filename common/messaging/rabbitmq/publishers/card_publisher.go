@@ -4,20 +4,26 @@ import (
 	"fmt"
 
 	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
-	"github.com/streadway/amqp"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/outbox"
 	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
 const (
 	DeleteCard MessageType = iota
+	CardDueSoon
+	CardOverdue
+	ListCardsArchived
+	ListCardsMoved
+	ListCardsSorted
+	ListCardsImported
 	// Add other message types here...
 )
 
-type CardPublisher struct {
-	Channel *amqp.Channel
-}
+type CardPublisher struct{}
 
-func (p *CardPublisher) Publish(messageType MessageType, message []byte) error {
+func (p *CardPublisher) Publish(tx *gorm.DB, md events.Metadata, messageType MessageType, message []byte) error {
 	switch messageType {
 	case DeleteCard:
 		var msg pb_card.DeleteCardRequest
@@ -26,7 +32,44 @@ func (p *CardPublisher) Publish(messageType MessageType, message []byte) error {
 			return err
 		}
 
-		err = p.publishDeleteCardMessage(&msg)
+		err = p.publishDeleteCardMessage(tx, md, &msg)
+		if err != nil {
+			return err
+		}
+	case CardDueSoon, CardOverdue:
+		var msg pb_card.CardDueEvent
+		err := proto.Unmarshal(message, &msg)
+		if err != nil {
+			return err
+		}
+
+		eventType := events.CardDueSoon
+		if messageType == CardOverdue {
+			eventType = events.CardOverdue
+		}
+
+		err = p.publishCardDueMessage(tx, md, eventType, &msg)
+		if err != nil {
+			return err
+		}
+	case ListCardsArchived, ListCardsMoved, ListCardsSorted, ListCardsImported:
+		var msg pb_card.ListCardsEvent
+		err := proto.Unmarshal(message, &msg)
+		if err != nil {
+			return err
+		}
+
+		eventType := events.ListCardsArchived
+		switch messageType {
+		case ListCardsMoved:
+			eventType = events.ListCardsMoved
+		case ListCardsSorted:
+			eventType = events.ListCardsSorted
+		case ListCardsImported:
+			eventType = events.ListCardsImported
+		}
+
+		err = p.publishListCardsMessage(tx, md, eventType, &msg)
 		if err != nil {
 			return err
 		}
@@ -38,26 +81,33 @@ func (p *CardPublisher) Publish(messageType MessageType, message []byte) error {
 	return nil
 }
 
-func NewCardPublisher(ch *amqp.Channel) *CardPublisher {
-	return &CardPublisher{Channel: ch}
+func NewCardPublisher() *CardPublisher {
+	return &CardPublisher{}
+}
+
+func (p *CardPublisher) publishDeleteCardMessage(tx *gorm.DB, md events.Metadata, req *pb_card.DeleteCardRequest) error {
+	event, err := events.New(events.CardDelete, req, md)
+	if err != nil {
+		return err
+	}
+
+	return outbox.Write(tx, event)
 }
 
-func (p *CardPublisher) publishDeleteCardMessage(req *pb_card.DeleteCardRequest) error {
-	ch := p.Channel
-	defer ch.Close()
+func (p *CardPublisher) publishCardDueMessage(tx *gorm.DB, md events.Metadata, eventType string, payload *pb_card.CardDueEvent) error {
+	event, err := events.New(eventType, payload, md)
+	if err != nil {
+		return err
+	}
+
+	return outbox.Write(tx, event)
+}
 
-	message, err := proto.Marshal(req)
+func (p *CardPublisher) publishListCardsMessage(tx *gorm.DB, md events.Metadata, eventType string, payload *pb_card.ListCardsEvent) error {
+	event, err := events.New(eventType, payload, md)
 	if err != nil {
 		return err
 	}
 
-	err = ch.Publish("halten",
-		"card.delete",
-		false,
-		false,
-		amqp.Publishing{
-			ContentType: "application/protobuf",
-			Body:        message,
-		})
-	return err
+	return outbox.Write(tx, event)
 }
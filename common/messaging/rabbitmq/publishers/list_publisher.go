@@ -3,9 +3,11 @@ package publishers
 import (
 	"fmt"
 
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/outbox"
 	pb_list "github.com/sm888sm/halten-backend/list-service/api/pb"
-	"github.com/streadway/amqp"
 	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
 const (
@@ -13,11 +15,9 @@ const (
 	// Add other message types here...
 )
 
-type ListPublisher struct {
-	Channel *amqp.Channel
-}
+type ListPublisher struct{}
 
-func (p *ListPublisher) Publish(messageType MessageType, message []byte) error {
+func (p *ListPublisher) Publish(tx *gorm.DB, md events.Metadata, messageType MessageType, message []byte) error {
 	switch messageType {
 	case DeleteList:
 		var msg pb_list.DeleteListRequest
@@ -26,7 +26,7 @@ func (p *ListPublisher) Publish(messageType MessageType, message []byte) error {
 			return err
 		}
 
-		err = p.publishDeleteListMessage(&msg)
+		err = p.publishDeleteListMessage(tx, md, &msg)
 		if err != nil {
 			return err
 		}
@@ -38,27 +38,15 @@ func (p *ListPublisher) Publish(messageType MessageType, message []byte) error {
 	return nil
 }
 
-func NewListPublisher(ch *amqp.Channel) *ListPublisher {
-	return &ListPublisher{Channel: ch}
+func NewListPublisher() *ListPublisher {
+	return &ListPublisher{}
 }
 
-func (p *ListPublisher) publishDeleteListMessage(req *pb_list.DeleteListRequest) error {
-	ch := p.Channel
-	defer ch.Close()
-
-	message, err := proto.Marshal(req)
+func (p *ListPublisher) publishDeleteListMessage(tx *gorm.DB, md events.Metadata, req *pb_list.DeleteListRequest) error {
+	event, err := events.New(events.ListDelete, req, md)
 	if err != nil {
 		return err
 	}
 
-	err = ch.Publish(
-		"halten",
-		"list.delete",
-		false,
-		false,
-		amqp.Publishing{
-			ContentType: "application/protobuf",
-			Body:        message,
-		})
-	return err
+	return outbox.Write(tx, event)
 }
@@ -1,5 +1,11 @@
 package publishers
 
+import (
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
 type MessageType int
 
 type Publishers struct {
@@ -9,6 +15,39 @@ type Publishers struct {
 	// Add other publishers here...
 }
 
+// Publisher writes messages to the outbox, from which the relay publishes them to the halten exchange
 type Publisher interface {
-	Publish(messageType MessageType, message []byte) error
+	// Publish writes the message in tx, the transaction of the change it announces, as the payload
+	// of an event with the metadata
+	Publish(tx *gorm.DB, md events.Metadata, messageType MessageType, message []byte) error
+}
+
+// Event writes the message announcing a change, given the IDs of what the change affected.
+// Repositories call it in the transaction of the change, so the message is only published when the
+// change is committed.
+type Event func(tx *gorm.DB, ids []uint64) error
+
+// NewEvent returns the Event publishing the message build makes from the affected IDs. Nothing is
+// published when the change affected nothing.
+func NewEvent(publisher Publisher, messageType MessageType, md events.Metadata, build func(ids []uint64) proto.Message) Event {
+	return func(tx *gorm.DB, ids []uint64) error {
+		if len(ids) == 0 {
+			return nil
+		}
+
+		message, err := proto.Marshal(build(ids))
+		if err != nil {
+			return err
+		}
+
+		return publisher.Publish(tx, md, messageType, message)
+	}
+}
+
+// Write calls the event, if the change has one
+func (e Event) Write(tx *gorm.DB, ids ...uint64) error {
+	if e == nil {
+		return nil
+	}
+	return e(tx, ids)
 }
@@ -0,0 +1,111 @@
+package positions
+
+import (
+	"database/sql"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Gap is the distance between neighbouring positions after an append or a rebalance. Items moved
+// between two neighbours take the midpoint, so about 16 moves into the same slot fit before the
+// sequence has to be rebalanced.
+const Gap int64 = 1 << 16
+
+// Sequence is the ordered set of rows sharing a parent, e.g. the cards of a list. Positions are
+// sparse so that a move only rewrites the moved row. Callers must hold a lock on the parent row
+// for the rest of their transaction, which serializes concurrent moves within the sequence.
+type Sequence struct {
+	Table    string // e.g. "cards"
+	Column   string // Column referencing the parent, e.g. "list_id"
+	ParentID uint64
+}
+
+// Append returns the position after the last row of the sequence
+func (s *Sequence) Append(tx *gorm.DB) (int64, error) {
+	var maxPosition sql.NullInt64
+	if err := tx.Table(s.Table).Where(s.Column+" = ? AND deleted_at IS NULL", s.ParentID).Select("max(position)").Row().Scan(&maxPosition); err != nil {
+		return 0, err
+	}
+	return maxPosition.Int64 + Gap, nil
+}
+
+// Place returns the position for the row with the given ID moved to the 1-based index of the
+// sequence. When its new neighbours have no room left between them the sequence is rebalanced.
+func (s *Sequence) Place(tx *gorm.DB, id uint64, index int64) (int64, error) {
+	position, ok, err := s.between(tx, id, index)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		return position, nil
+	}
+
+	if err := s.Rebalance(tx); err != nil {
+		return 0, err
+	}
+
+	position, ok, err = s.between(tx, id, index)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("no room at index %d of %s %d", index, s.Column, s.ParentID)
+	}
+
+	return position, nil
+}
+
+// Rebalance spreads the positions of the sequence Gap apart, keeping their order. Versions are left
+// alone: the order of the rows doesn't change, so edits made against their versions still apply.
+func (s *Sequence) Rebalance(tx *gorm.DB) error {
+	query := fmt.Sprintf(`UPDATE %[1]s SET position = ranked.rank * ?
+		FROM (SELECT id, ROW_NUMBER() OVER (ORDER BY position, id) AS rank FROM %[1]s WHERE %[2]s = ? AND deleted_at IS NULL) ranked
+		WHERE %[1]s.id = ranked.id`, s.Table, s.Column)
+
+	return tx.Exec(query, Gap, s.ParentID).Error
+}
+
+// between finds the position between the neighbours the row would have at the given index
+func (s *Sequence) between(tx *gorm.DB, id uint64, index int64) (int64, bool, error) {
+	if index < 1 {
+		index = 1
+	}
+
+	offset, limit := index-2, 2
+	if index == 1 {
+		offset, limit = 0, 1
+	}
+
+	var neighbours []int64
+	if err := tx.Table(s.Table).
+		Where(s.Column+" = ? AND id <> ? AND deleted_at IS NULL", s.ParentID, id).
+		Order("position, id").
+		Offset(int(offset)).Limit(limit).
+		Pluck("position", &neighbours).Error; err != nil {
+		return 0, false, err
+	}
+
+	var previous, next int64
+	switch {
+	case index == 1 && len(neighbours) == 0:
+		return Gap, true, nil
+	case index == 1:
+		next = neighbours[0]
+	case len(neighbours) == 2:
+		previous, next = neighbours[0], neighbours[1]
+	default:
+		// The index is past the end of the sequence
+		var maxPosition sql.NullInt64
+		if err := tx.Table(s.Table).Where(s.Column+" = ? AND id <> ? AND deleted_at IS NULL", s.ParentID, id).Select("max(position)").Row().Scan(&maxPosition); err != nil {
+			return 0, false, err
+		}
+		return maxPosition.Int64 + Gap, true, nil
+	}
+
+	if next-previous < 2 {
+		return 0, false, nil
+	}
+
+	return previous + (next-previous)/2, true, nil
+}
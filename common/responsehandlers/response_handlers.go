@@ -8,6 +8,7 @@ type Meta struct {
 	Status     int         `json:"status"`
 	Message    string      `json:"message"`
 	Pagination interface{} `json:"pagination,omitempty"`
+	Warnings   []string    `json:"warnings,omitempty"`
 }
 
 type Pagination struct {
@@ -38,3 +39,14 @@ func SuccessWithPagination(c *gin.Context, status int, message string, data inte
 		"data": data,
 	})
 }
+
+func SuccessWithWarnings(c *gin.Context, status int, message string, data interface{}, warnings []string) {
+	c.JSON(status, gin.H{
+		"meta": Meta{
+			Status:   status,
+			Message:  message,
+			Warnings: warnings,
+		},
+		"data": data,
+	})
+}
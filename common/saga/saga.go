@@ -0,0 +1,361 @@
+package saga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/sm888sm/halten-backend/common/constants/sagastatuses"
+	"github.com/sm888sm/halten-backend/models"
+)
+
+// maxErrorLength fits the error of a saga in its column
+const maxErrorLength = 255
+
+// resumeBatchSize is how many sagas are picked up at a time
+const resumeBatchSize = 100
+
+// DefaultRetryDelays waits longer before each retry of a failed compensation. A compensation that
+// fails once more marks the saga as failed.
+var DefaultRetryDelays = []time.Duration{time.Minute, 5 * time.Minute, 30 * time.Minute}
+
+// errLost stops a runner whose saga was updated by another one, such as Resume taking over a saga
+// whose step didn't finish in time
+var errLost = errors.New("saga was taken over by another runner")
+
+// Commit records the step being run as done, with the data of the saga. A step changing the
+// service's own database calls it in the transaction of the change, so the change is never made
+// without being recorded. Steps that don't call it are recorded once they return.
+type Commit func(tx *gorm.DB) error
+
+type Step struct {
+	Name string
+	// Action does the step, filling in data what later steps and compensations need. A step that
+	// returns an error must have changed nothing, or leave it to the compensation of an earlier step.
+	Action func(ctx context.Context, data any, commit Commit) error
+	// Compensate undoes the step, nil when there's nothing to undo. It's run again until it
+	// succeeds, so it must be safe to repeat.
+	Compensate func(ctx context.Context, data any) error
+	// Retryable steps are run again by Resume when their runner stopped during them, which carries
+	// the saga on rather than compensating it. Their action must be safe to repeat.
+	Retryable bool
+}
+
+// Definition is a kind of saga. Services sharing the database register definitions of their own
+// types only, a saga is resumed by the service that started it.
+type Definition struct {
+	Type    string // Named <entity>.<flow>
+	NewData func() any
+	Steps   []Step
+}
+
+// Orchestrator runs sagas, recording each step as it's done. When a step fails the steps done are
+// compensated in reverse order. A saga whose runner stopped in the middle of it is carried on by
+// Resume when the step it stopped at is retryable and compensated otherwise, as are the sagas whose
+// compensation failed.
+type Orchestrator struct {
+	db          *gorm.DB
+	definitions map[string]*Definition
+	stepTimeout time.Duration // How long a step may run before its saga is taken over
+	retryDelays []time.Duration
+}
+
+func NewOrchestrator(db *gorm.DB, stepTimeout time.Duration) *Orchestrator {
+	return &Orchestrator{
+		db:          db,
+		definitions: make(map[string]*Definition),
+		stepTimeout: stepTimeout,
+		retryDelays: DefaultRetryDelays,
+	}
+}
+
+// Register adds a kind of saga the orchestrator starts and resumes
+func (o *Orchestrator) Register(def *Definition) {
+	o.definitions[def.Type] = def
+}
+
+// Start runs a saga of a registered type. data is a pointer of the type its definition's NewData
+// returns. When a step fails its error is returned once the steps done are compensated, or left to
+// Resume if compensating fails too.
+func (o *Orchestrator) Start(ctx context.Context, sagaType string, data any) error {
+	def, ok := o.definitions[sagaType]
+	if !ok {
+		return fmt.Errorf("unknown saga type %q", sagaType)
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	saga := &models.Saga{
+		Type:          sagaType,
+		Status:        sagastatuses.Running,
+		Data:          body,
+		NextAttemptAt: time.Now().Add(o.stepTimeout),
+	}
+	if err := o.db.Create(saga).Error; err != nil {
+		return err
+	}
+
+	return o.newRun(def, saga, data).forward(ctx)
+}
+
+// Resume takes over the sagas of the registered types whose runner stopped in the middle of a step,
+// running the steps left when the step is retryable and compensating the saga otherwise, and retries
+// the compensations that failed once their delay is over
+func (o *Orchestrator) Resume(ctx context.Context) error {
+	if len(o.definitions) == 0 {
+		return nil
+	}
+
+	types := make([]string, 0, len(o.definitions))
+	for sagaType := range o.definitions {
+		types = append(types, sagaType)
+	}
+
+	var sagas []*models.Saga
+	if err := o.db.
+		Where("type IN ? AND status IN ? AND next_attempt_at < ?", types, []string{sagastatuses.Running, sagastatuses.Compensating}, time.Now()).
+		Order("id").
+		Limit(resumeBatchSize).
+		Find(&sagas).Error; err != nil {
+		return err
+	}
+
+	for _, saga := range sagas {
+		def := o.definitions[saga.Type]
+
+		data := def.NewData()
+		r := o.newRun(def, saga, data)
+
+		// Nothing can be compensated without the data
+		if err := json.Unmarshal(saga.Data, data); err != nil {
+			if err := r.update(o.db, map[string]interface{}{
+				"status":     sagastatuses.Failed,
+				"last_error": truncate(fmt.Sprintf("Invalid saga data: %v", err), maxErrorLength),
+			}); err != nil && !errors.Is(err, errLost) {
+				return err
+			}
+			log.Printf("Failed %s saga %d: %v", r.saga.Type, r.saga.ID, err)
+			continue
+		}
+
+		if saga.Status == sagastatuses.Running && (saga.Step == len(def.Steps) || def.Steps[saga.Step].Retryable) {
+			// Taking the saga over stops its runner, should it still be running
+			if err := r.update(o.db, map[string]interface{}{}); err != nil {
+				if errors.Is(err, errLost) {
+					continue
+				}
+				return err
+			}
+
+			if err := r.forward(ctx); err != nil && !errors.Is(err, errLost) {
+				log.Printf("Failed to resume %s saga %d: %v", r.saga.Type, r.saga.ID, err)
+			}
+			continue
+		}
+
+		changes := map[string]interface{}{"status": sagastatuses.Compensating}
+		if saga.Status == sagastatuses.Running && saga.Step < len(def.Steps) {
+			changes["last_error"] = fmt.Sprintf("The %s step didn't finish in time", def.Steps[saga.Step].Name)
+		}
+
+		if err := r.update(o.db, changes); err != nil {
+			if errors.Is(err, errLost) {
+				continue
+			}
+			return err
+		}
+
+		r.compensate(ctx)
+	}
+
+	return nil
+}
+
+// run is a saga being run by this process
+type run struct {
+	o    *Orchestrator
+	def  *Definition
+	saga *models.Saga
+	data any
+}
+
+func (o *Orchestrator) newRun(def *Definition, saga *models.Saga, data any) *run {
+	return &run{
+		o:    o,
+		def:  def,
+		saga: saga,
+		data: data,
+	}
+}
+
+// forward runs the steps left, then forgets the saga. When a step fails its error is returned once
+// the steps done are compensated, or left to Resume if compensating fails too.
+func (r *run) forward(ctx context.Context) error {
+	for r.saga.Step < len(r.def.Steps) {
+		step := r.def.Steps[r.saga.Step]
+
+		committed := false
+		commit := func(tx *gorm.DB) error {
+			if err := r.update(tx, r.stepDone()); err != nil {
+				return err
+			}
+			committed = true
+			return nil
+		}
+
+		if err := step.Action(ctx, r.data, commit); err != nil {
+			log.Printf("Failed to run the %s step of %s saga %d: %v", step.Name, r.saga.Type, r.saga.ID, err)
+
+			// The request may be gone, the steps done are undone regardless
+			ctx = context.WithoutCancel(ctx)
+			if err := r.update(r.o.db, map[string]interface{}{
+				"status":     sagastatuses.Compensating,
+				"last_error": truncate(fmt.Sprintf("The %s step failed: %v", step.Name, err), maxErrorLength),
+			}); err == nil {
+				r.compensate(ctx)
+			}
+			return err
+		}
+
+		if committed {
+			r.applied(r.stepDone())
+			continue
+		}
+		if err := r.update(r.o.db, r.stepDone()); err != nil {
+			return err
+		}
+	}
+
+	// A completed saga has nothing left to undo
+	result := r.o.db.Where("id = ? AND version = ?", r.saga.ID, r.saga.Version).Delete(&models.Saga{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errLost
+	}
+
+	return nil
+}
+
+// compensate undoes the steps done in reverse order, then forgets the saga. A compensation that
+// fails is retried by Resume after a delay.
+func (r *run) compensate(ctx context.Context) {
+	for r.saga.Step > 0 {
+		step := r.def.Steps[r.saga.Step-1]
+
+		if step.Compensate != nil {
+			if err := step.Compensate(ctx, r.data); err != nil {
+				r.retryLater(step.Name, err)
+				return
+			}
+		}
+
+		if err := r.update(r.o.db, map[string]interface{}{"step": r.saga.Step - 1}); err != nil {
+			log.Printf("Failed to record the compensation of the %s step of %s saga %d: %v", step.Name, r.saga.Type, r.saga.ID, err)
+			return
+		}
+	}
+
+	if err := r.o.db.Where("id = ? AND version = ?", r.saga.ID, r.saga.Version).Delete(&models.Saga{}).Error; err != nil {
+		log.Printf("Failed to delete compensated %s saga %d: %v", r.saga.Type, r.saga.ID, err)
+		return
+	}
+	log.Printf("Compensated %s saga %d: %s", r.saga.Type, r.saga.ID, r.saga.LastError)
+}
+
+// retryLater leaves a failed compensation to Resume, or fails the saga when it has no retries left
+func (r *run) retryLater(stepName string, compensateErr error) {
+	attempts := r.saga.Attempts + 1
+	changes := map[string]interface{}{
+		"attempts":   attempts,
+		"last_error": truncate(fmt.Sprintf("Compensating the %s step failed: %v", stepName, compensateErr), maxErrorLength),
+	}
+
+	if attempts > len(r.o.retryDelays) {
+		changes["status"] = sagastatuses.Failed
+	} else {
+		changes["next_attempt_at"] = time.Now().Add(r.o.retryDelays[attempts-1])
+	}
+
+	if err := r.update(r.o.db, changes); err != nil {
+		log.Printf("Failed to record the failed compensation of the %s step of %s saga %d: %v", stepName, r.saga.Type, r.saga.ID, err)
+		return
+	}
+
+	if r.saga.Status == sagastatuses.Failed {
+		log.Printf("Failed %s saga %d after %d attempts to compensate the %s step: %v", r.saga.Type, r.saga.ID, attempts, stepName, compensateErr)
+		return
+	}
+	log.Printf("Failed to compensate the %s step of %s saga %d, retrying later: %v", stepName, r.saga.Type, r.saga.ID, compensateErr)
+}
+
+// stepDone returns the changes recording the step being run as done
+func (r *run) stepDone() map[string]interface{} {
+	data, err := json.Marshal(r.data)
+	if err != nil {
+		// Data that was marshalled once always is again
+		panic(err)
+	}
+
+	return map[string]interface{}{"step": r.saga.Step + 1, "data": data}
+}
+
+// update applies the changes to the saga in tx unless another runner updated it since, pushing back
+// when it's picked up by Resume. The saga in memory is updated too unless tx is a transaction, whose
+// caller applies the changes once it's committed.
+func (r *run) update(tx *gorm.DB, changes map[string]interface{}) error {
+	changes["version"] = r.saga.Version + 1
+	if _, ok := changes["next_attempt_at"]; !ok {
+		changes["next_attempt_at"] = time.Now().Add(r.o.stepTimeout)
+	}
+
+	result := tx.Model(&models.Saga{}).Where("id = ? AND version = ?", r.saga.ID, r.saga.Version).Updates(changes)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errLost
+	}
+
+	if tx == r.o.db {
+		r.applied(changes)
+	}
+	return nil
+}
+
+// applied updates the saga in memory with changes saved to the database
+func (r *run) applied(changes map[string]interface{}) {
+	r.saga.Version++
+	if step, ok := changes["step"].(int); ok {
+		r.saga.Step = step
+	}
+	if data, ok := changes["data"].([]byte); ok {
+		r.saga.Data = data
+	}
+	if status, ok := changes["status"].(string); ok {
+		r.saga.Status = status
+	}
+	if attempts, ok := changes["attempts"].(int); ok {
+		r.saga.Attempts = attempts
+	}
+	if lastError, ok := changes["last_error"].(string); ok {
+		r.saga.LastError = lastError
+	}
+}
+
+func truncate(text string, maxLength int) string {
+	if len(text) <= maxLength {
+		return text
+	}
+	return strings.ToValidUTF8(text[:maxLength], "")
+}
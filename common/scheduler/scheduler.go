@@ -0,0 +1,119 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Job is a unit of periodic work. Returned errors are logged and the job is retried on the next tick.
+type Job func(ctx context.Context) error
+
+type namedJob struct {
+	name string
+	run  Job
+}
+
+// Scheduler runs registered jobs on a fixed interval. Only one replica runs the jobs at a time:
+// leadership is held through a PostgreSQL session-level advisory lock on a dedicated connection,
+// so when the leader dies its session ends, the lock is released and another replica takes over.
+type Scheduler struct {
+	db       *gorm.DB
+	lockKey  int64
+	interval time.Duration
+	jobs     []namedJob
+}
+
+func NewScheduler(db *gorm.DB, lockKey int64, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		db:       db,
+		lockKey:  lockKey,
+		interval: interval,
+	}
+}
+
+func (s *Scheduler) AddJob(name string, job Job) {
+	s.jobs = append(s.jobs, namedJob{name: name, run: job})
+}
+
+// Run blocks until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	var leaderConn *sql.Conn
+	defer func() {
+		if leaderConn != nil {
+			s.releaseLeadership(leaderConn)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if leaderConn != nil {
+			// The lock lives as long as the session, so a broken connection means lost leadership
+			if err := leaderConn.PingContext(ctx); err != nil {
+				log.Printf("Scheduler lost leadership: %v", err)
+				leaderConn.Close()
+				leaderConn = nil
+			}
+		}
+
+		if leaderConn == nil {
+			leaderConn = s.tryAcquireLeadership(ctx)
+			if leaderConn == nil {
+				continue
+			}
+		}
+
+		for _, job := range s.jobs {
+			if err := job.run(ctx); err != nil {
+				log.Printf("Scheduler job %s failed: %v", job.name, err)
+			}
+		}
+	}
+}
+
+func (s *Scheduler) tryAcquireLeadership(ctx context.Context) *sql.Conn {
+	sqlDB, err := s.db.DB()
+	if err != nil {
+		log.Printf("Scheduler could not get sql.DB: %v", err)
+		return nil
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		log.Printf("Scheduler could not get a connection: %v", err)
+		return nil
+	}
+
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", s.lockKey).Scan(&acquired); err != nil {
+		log.Printf("Scheduler could not try the advisory lock: %v", err)
+		conn.Close()
+		return nil
+	}
+
+	if !acquired {
+		conn.Close()
+		return nil
+	}
+
+	log.Printf("Scheduler acquired leadership (lock key %d)", s.lockKey)
+	return conn
+}
+
+func (s *Scheduler) releaseLeadership(conn *sql.Conn) {
+	if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", s.lockKey); err != nil {
+		log.Printf("Scheduler could not release the advisory lock: %v", err)
+	}
+	conn.Close()
+}
@@ -0,0 +1,62 @@
+package transactions
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type contextKey struct{}
+
+// scope is a transaction shared by the calls made with a context
+type scope struct {
+	tx          *gorm.DB
+	afterCommit []func()
+}
+
+// Run runs fn in a transaction of db. The repositories given the ctx passed to fn write in that
+// transaction, so their writes are committed or rolled back together with fn's. The functions
+// registered with AfterCommit run once it's committed.
+func Run(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	s := &scope{}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		s.tx = tx
+		return fn(context.WithValue(ctx, contextKey{}, s))
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, f := range s.afterCommit {
+		f()
+	}
+	return nil
+}
+
+// FromContext returns the transaction the writes made with ctx join, nil when each write has a
+// transaction of its own
+func FromContext(ctx context.Context) *gorm.DB {
+	if s, ok := ctx.Value(contextKey{}).(*scope); ok {
+		return s.tx
+	}
+	return nil
+}
+
+// AfterCommit runs fn once the transaction of ctx is committed, or right away when ctx has none.
+// What can't be rolled back, such as removing files, waits for the writes it follows to be kept.
+func AfterCommit(ctx context.Context, fn func()) {
+	if s, ok := ctx.Value(contextKey{}).(*scope); ok {
+		s.afterCommit = append(s.afterCommit, fn)
+		return
+	}
+	fn()
+}
+
+// Join runs fn in tx when it's set, in a savepoint so fn's writes are undone together when it
+// fails, or in a new transaction of db otherwise
+func Join(db, tx *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if tx != nil {
+		return tx.Transaction(fn)
+	}
+	return db.Transaction(fn)
+}
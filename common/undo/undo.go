@@ -0,0 +1,130 @@
+package undo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"gorm.io/gorm"
+
+	"github.com/sm888sm/halten-backend/common/constants/contextkeys"
+	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/models"
+)
+
+const (
+	EntityBoard = "board"
+	EntityList  = "list"
+	EntityCard  = "card"
+)
+
+const (
+	ActionArchive = "archive"
+	ActionRestore = "restore"
+	ActionMove    = "move"
+	ActionDelete  = "delete"
+)
+
+// LogSize is how many actions are kept per user session. Older ones can no longer be undone.
+const LogSize = 20
+
+// SessionMetadataKey is the gRPC metadata key carrying the client session actions are logged under
+const SessionMetadataKey = "sessionID"
+
+// Actor is the user session an action is recorded for
+type Actor struct {
+	UserID    uint64
+	SessionID string
+}
+
+// ActorFromContext returns the user session making the request, or nil when the request has no
+// authenticated user
+func ActorFromContext(ctx context.Context) *Actor {
+	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
+	if !ok {
+		return nil
+	}
+
+	return &Actor{UserID: userID, SessionID: SessionFromContext(ctx)}
+}
+
+// SessionFromContext returns the client session sent in the request metadata, if any
+func SessionFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if sessionIDs := md.Get(SessionMetadataKey); len(sessionIDs) == 1 {
+			return sessionIDs[0]
+		}
+	}
+	return ""
+}
+
+// Move is the inverse of a move, the list and position the entity was moved away from. ListID is
+// only set for cards.
+type Move struct {
+	ListID   uint64 `json:"listID,omitempty"`
+	Position int64  `json:"position"`
+}
+
+// Delete is the inverse of a delete. The entity can only be brought back while it is still deleted
+// at that time, i.e. it hasn't been restored or purged since.
+type Delete struct {
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// Timestamp returns the current time at the precision PostgreSQL stores, so a deletion time kept in
+// the undo log compares equal to the deleted_at written with it
+func Timestamp() time.Time {
+	return time.Now().Truncate(time.Microsecond)
+}
+
+// Entry is an action to be recorded along with its inverse
+type Entry struct {
+	BoardID    uint64
+	EntityType string
+	EntityID   uint64
+	Action     string
+	Version    uint64      // Version of the entity after the action
+	Inverse    interface{} // Move or Delete, nil for archive and restore which are their own inverse
+}
+
+// Record adds the entry to the actor's undo log within the transaction of the action, dropping
+// entries beyond LogSize. It does nothing without an actor.
+func Record(tx *gorm.DB, actor *Actor, entry *Entry) error {
+	if actor == nil {
+		return nil
+	}
+
+	undoEntry := models.UndoEntry{
+		UserID:     actor.UserID,
+		SessionID:  actor.SessionID,
+		BoardID:    entry.BoardID,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Action:     entry.Action,
+		Version:    entry.Version,
+	}
+
+	if entry.Inverse != nil {
+		inverse, err := json.Marshal(entry.Inverse)
+		if err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		undoEntry.Inverse = string(inverse)
+	}
+
+	if err := tx.Create(&undoEntry).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	if err := tx.Unscoped().
+		Where("user_id = ? AND session_id = ?", actor.UserID, actor.SessionID).
+		Where("id NOT IN (?)", tx.Model(&models.UndoEntry{}).Select("id").
+			Where("user_id = ? AND session_id = ?", actor.UserID, actor.SessionID).
+			Order("id DESC").Limit(LogSize)).
+		Delete(&models.UndoEntry{}).Error; err != nil {
+		return errorhandlers.NewGrpcInternalError()
+	}
+
+	return nil
+}
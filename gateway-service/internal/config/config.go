@@ -3,11 +3,14 @@ package config
 import (
 	"os"
 	"strconv"
+
+	"github.com/sm888sm/halten-backend/common/constants/messagebuses"
 )
 
 type Config struct {
 	Port      string
 	SecretKey string
+	AdminKey  string // Key admin endpoints are called with, they're disabled without one
 	Database  DatabaseConfig
 	RabbitMQ  RabbitMQConfig
 	Services  ServiceConfig
@@ -31,6 +34,7 @@ type ServiceConfig struct {
 
 type RabbitMQConfig struct { // Add this struct
 	URL string
+	Bus string // messagebuses.RabbitMQ, or messagebuses.Memory when every service runs in one process
 }
 
 func LoadConfig() (*Config, error) {
@@ -44,9 +48,15 @@ func LoadConfig() (*Config, error) {
 		dbPort = 5432 // Default PostgreSQL port
 	}
 
+	messageBus := os.Getenv("MESSAGE_BUS")
+	if messageBus == "" {
+		messageBus = messagebuses.RabbitMQ // Default message bus
+	}
+
 	return &Config{
 		Port:      port, // Or your default
 		SecretKey: os.Getenv("SECRET_KEY"),
+		AdminKey:  os.Getenv("ADMIN_API_KEY"),
 		Database: DatabaseConfig{
 			Driver:   "postgres", // Changed to postgres
 			Host:     os.Getenv("DB_HOST"),
@@ -63,6 +73,7 @@ func LoadConfig() (*Config, error) {
 		},
 		RabbitMQ: RabbitMQConfig{ // Add this line
 			URL: os.Getenv("RABBITMQ_URL"),
+			Bus: messageBus,
 		},
 	}, nil
 }
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/common/messaging/bus/rabbitmqbus"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/connection"
+	"github.com/sm888sm/halten-backend/common/responsehandlers"
+)
+
+type AdminHandler struct {
+	conn *connection.Manager // nil with the memory bus, whose dead letters stay in each service
+}
+
+var errDeadLettersUnavailable = errorhandlers.NewAPIError(http.StatusNotImplemented, "Dead letters are only available with the RabbitMQ message bus")
+
+func NewAdminHandler(conn *connection.Manager) *AdminHandler {
+	return &AdminHandler{conn: conn}
+}
+
+type GetDeadLettersQuery struct {
+	Limit int `form:"limit,default=50" binding:"min=1,max=500"`
+}
+
+// GetDeadLetters lists the oldest messages that failed every attempt, without removing them
+func (h *AdminHandler) GetDeadLetters(c *gin.Context) {
+	var query GetDeadLettersQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid query parameters"))
+		return
+	}
+
+	if h.conn == nil {
+		errorhandlers.HandleError(c, errDeadLettersUnavailable)
+		return
+	}
+
+	deadLetters, err := rabbitmqbus.PeekDeadLetters(h.conn, query.Limit)
+	if err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewHttpInternalError())
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, "Dead letters retrieved successfully", deadLetters)
+}
+
+type ReplayDeadLettersBody struct {
+	MessageIDs []string `json:"messageIDs"` // Every dead letter is replayed when empty
+}
+
+// ReplayDeadLetters sends dead letters back to the queue they failed in, to be handled again
+func (h *AdminHandler) ReplayDeadLetters(c *gin.Context) {
+	var body ReplayDeadLettersBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	if h.conn == nil {
+		errorhandlers.HandleError(c, errDeadLettersUnavailable)
+		return
+	}
+
+	replayed, err := rabbitmqbus.ReplayDeadLetters(h.conn, body.MessageIDs)
+	if err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewHttpInternalError())
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, "Dead letters replayed successfully", gin.H{"messageIDs": replayed})
+}
@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 
@@ -10,8 +12,12 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/gin-gonic/gin"
+	"github.com/sm888sm/halten-backend/common/constants/exportstatuses"
+	"github.com/sm888sm/halten-backend/common/constants/importsources"
+	"github.com/sm888sm/halten-backend/common/constants/trashitemtypes"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
 	"github.com/sm888sm/halten-backend/common/responsehandlers"
+	"github.com/sm888sm/halten-backend/common/undo"
 	external_services "github.com/sm888sm/halten-backend/gateway-service/external/services"
 )
 
@@ -108,6 +114,10 @@ func (h *BoardHandler) GetBoardByID(c *gin.Context) {
 		return
 	}
 
+	if setETag(c, resp.Board.Version) {
+		return
+	}
+
 	responsehandlers.Success(c, http.StatusOK, "Board retrieved successfully", resp)
 }
 
@@ -241,6 +251,171 @@ func (h *BoardHandler) GetArchivedBoardList(c *gin.Context) {
 	responsehandlers.SuccessWithPagination(c, http.StatusOK, "Archived board list retrieved successfully", res.Boards, res.Pagination)
 }
 
+type GetTrashQuery struct {
+	PageNumber uint64 `form:"pageNumber,default=1"`
+	PageSize   uint64 `form:"pageSize,default=20"`
+}
+
+func (h *BoardHandler) GetTrash(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query GetTrashQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request query"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	req := &pb_board.GetTrashRequest{
+		PageNumber: query.PageNumber,
+		PageSize:   query.PageSize,
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := boardClient.GetTrash(ctx, req)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.SuccessWithPagination(c, http.StatusOK, "Trash retrieved successfully", res.Items, res.Pagination)
+}
+
+func (h *BoardHandler) Undo(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), undo.SessionMetadataKey, getSessionID(c))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	res, err := boardClient.Undo(ctx, &pb_board.UndoRequest{})
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, res.Message, res)
+}
+
+// maxImportFileSize is the largest board export accepted for an import
+const maxImportFileSize = 32 << 20
+
+type ImportBoardQuery struct {
+	DryRun bool `form:"dryRun"`
+}
+
+// ImportTrelloBoard queues an import of a Trello board export, uploaded as the file field of a
+// multipart form. It answers right away, the import's outcome is followed with GetBoardImport.
+func (h *BoardHandler) ImportTrelloBoard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var query ImportBoardQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid query parameters"))
+		return
+	}
+
+	content, err := readImportFile(c, maxImportFileSize)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.ImportBoardRequest{
+		Source:  importsources.Trello,
+		DryRun:  query.DryRun,
+		Content: content,
+	}
+
+	res, err := boardClient.ImportBoard(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusAccepted, res.Message, res.Import)
+}
+
+type GetBoardImportUri struct {
+	ImportID uint64 `uri:"importID" binding:"required"`
+}
+
+func (h *BoardHandler) GetBoardImport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri GetBoardImportUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.GetBoardImportRequest{
+		ImportID: uri.ImportID,
+	}
+
+	res, err := boardClient.GetBoardImport(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, "Import retrieved successfully", res.Import)
+}
+
 /*
 ****************************
 * Authorization Required *
@@ -264,6 +439,12 @@ func (h *BoardHandler) UpdateBoardName(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	var body UpdateBoardNameBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
@@ -285,7 +466,7 @@ func (h *BoardHandler) UpdateBoardName(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcReq := &pb_board.UpdateBoardNameRequest{Name: body.Name}
+	grpcReq := &pb_board.UpdateBoardNameRequest{Name: body.Name, ExpectedVersion: expectedVersion}
 
 	res, err := boardClient.UpdateBoardName(ctx, grpcReq)
 	if err != nil {
@@ -524,6 +705,12 @@ func (h *BoardHandler) ChangeBoardVisibility(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	var body ChangeBoardVisibilityBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
@@ -545,7 +732,7 @@ func (h *BoardHandler) ChangeBoardVisibility(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcReq := &pb_board.ChangeBoardVisibilityRequest{Visibility: body.Visibility}
+	grpcReq := &pb_board.ChangeBoardVisibilityRequest{Visibility: body.Visibility, ExpectedVersion: expectedVersion}
 
 	res, err := boardClient.ChangeBoardVisibility(ctx, grpcReq)
 	if err != nil {
@@ -556,25 +743,31 @@ func (h *BoardHandler) ChangeBoardVisibility(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
 }
 
-type AddLabelUri struct {
+type SetCardHistoryRetentionUri struct {
 	BoardID uint64 `uri:"boardID" binding:"required"`
 }
 
-type AddLabelBody struct {
-	Name  string `json:"name" binding:"required"`
-	Color string `json:"color" binding:"required"`
+type SetCardHistoryRetentionBody struct {
+	CardHistoryLimit int32 `json:"card_history_limit"`
+	CardHistoryDays  int32 `json:"card_history_days"`
 }
 
-func (h *BoardHandler) AddLabel(c *gin.Context) {
+func (h *BoardHandler) SetCardHistoryRetention(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri AddLabelUri
+	var uri SetCardHistoryRetentionUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
-	var body AddLabelBody
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	var body SetCardHistoryRetentionBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
 		return
@@ -595,38 +788,45 @@ func (h *BoardHandler) AddLabel(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcReq := &pb_board.AddLabelRequest{
-		Name:  body.Name,
-		Color: body.Color,
+	grpcReq := &pb_board.SetCardHistoryRetentionRequest{
+		CardHistoryLimit: body.CardHistoryLimit,
+		CardHistoryDays:  body.CardHistoryDays,
+		ExpectedVersion:  expectedVersion,
 	}
 
-	res, err := boardClient.AddLabel(ctx, grpcReq)
+	res, err := boardClient.SetCardHistoryRetention(ctx, grpcReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
 	}
 
-	responsehandlers.Success(c, http.StatusOK, "Label added successfully", res.Label)
+	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
 }
 
-type RemoveLabelUri struct {
+type SetWIPLimitPolicyUri struct {
 	BoardID uint64 `uri:"boardID" binding:"required"`
 }
 
-type RemoveLabelBody struct {
-	LabelID uint64 `json:"labelID" binding:"required"`
+type SetWIPLimitPolicyBody struct {
+	WIPLimitPolicy string `json:"wipLimitPolicy" binding:"required"`
 }
 
-func (h *BoardHandler) RemoveLabel(c *gin.Context) {
+func (h *BoardHandler) SetWIPLimitPolicy(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri RemoveLabelUri
+	var uri SetWIPLimitPolicyUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
-	var body RemoveLabelBody
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	var body SetWIPLimitPolicyBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
 		return
@@ -647,9 +847,12 @@ func (h *BoardHandler) RemoveLabel(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcReq := &pb_board.RemoveLabelRequest{LabelID: body.LabelID}
+	grpcReq := &pb_board.SetWIPLimitPolicyRequest{
+		WipLimitPolicy:  body.WIPLimitPolicy,
+		ExpectedVersion: expectedVersion,
+	}
 
-	res, err := boardClient.RemoveLabel(ctx, grpcReq)
+	res, err := boardClient.SetWIPLimitPolicy(ctx, grpcReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -658,19 +861,30 @@ func (h *BoardHandler) RemoveLabel(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
 }
 
-type ArchiveBoardUri struct {
+type AddLabelUri struct {
 	BoardID uint64 `uri:"boardID" binding:"required"`
 }
 
-func (h *BoardHandler) ArchiveBoard(c *gin.Context) {
+type AddLabelBody struct {
+	Name  string `json:"name" binding:"required"`
+	Color string `json:"color" binding:"required"`
+}
+
+func (h *BoardHandler) AddLabel(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri ArchiveBoardUri
+	var uri AddLabelUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
+	var body AddLabelBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -686,30 +900,43 @@ func (h *BoardHandler) ArchiveBoard(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcReq := &pb_board.ArchiveBoardRequest{}
+	grpcReq := &pb_board.AddLabelRequest{
+		Name:  body.Name,
+		Color: body.Color,
+	}
 
-	res, err := boardClient.ArchiveBoard(ctx, grpcReq)
+	res, err := boardClient.AddLabel(ctx, grpcReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
 	}
 
-	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
+	responsehandlers.Success(c, http.StatusOK, "Label added successfully", res.Label)
 }
 
-type RestoreBoardUri struct {
+type RemoveLabelUri struct {
 	BoardID uint64 `uri:"boardID" binding:"required"`
 }
 
-func (h *BoardHandler) RestoreBoard(c *gin.Context) {
+type RemoveLabelBody struct {
+	LabelID uint64 `json:"labelID" binding:"required"`
+}
+
+func (h *BoardHandler) RemoveLabel(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri RestoreBoardUri
+	var uri RemoveLabelUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
+	var body RemoveLabelBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -725,9 +952,9 @@ func (h *BoardHandler) RestoreBoard(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcReq := &pb_board.RestoreBoardRequest{}
+	grpcReq := &pb_board.RemoveLabelRequest{LabelID: body.LabelID}
 
-	res, err := boardClient.RestoreBoard(ctx, grpcReq)
+	res, err := boardClient.RemoveLabel(ctx, grpcReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -736,19 +963,37 @@ func (h *BoardHandler) RestoreBoard(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
 }
 
-type DeleteBoardUri struct {
+type CustomFieldOptionBody struct {
+	OptionID uint64 `json:"optionID"`
+	Value    string `json:"value" binding:"required"`
+	Color    string `json:"color"`
+}
+
+type AddCustomFieldUri struct {
 	BoardID uint64 `uri:"boardID" binding:"required"`
 }
 
-func (h *BoardHandler) DeleteBoard(c *gin.Context) {
+type AddCustomFieldBody struct {
+	Name    string                   `json:"name" binding:"required"`
+	Type    string                   `json:"type" binding:"required"`
+	Options []*CustomFieldOptionBody `json:"options"`
+}
+
+func (h *BoardHandler) AddCustomField(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri DeleteBoardUri
+	var uri AddCustomFieldUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
+	var body AddCustomFieldBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -764,29 +1009,529 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcReq := &pb_board.DeleteBoardRequest{}
+	grpcReq := &pb_board.AddCustomFieldRequest{
+		Name:    body.Name,
+		Type:    body.Type,
+		Options: convertCustomFieldOptionsToProto(body.Options),
+	}
 
-	res, err := boardClient.DeleteBoard(ctx, grpcReq)
+	res, err := boardClient.AddCustomField(ctx, grpcReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
 	}
 
-	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
+	responsehandlers.Success(c, http.StatusOK, "Custom field added successfully", res.CustomField)
 }
 
-// Helpers
+type UpdateCustomFieldUri struct {
+	BoardID       uint64 `uri:"boardID" binding:"required"`
+	CustomFieldID uint64 `uri:"customFieldID" binding:"required"`
+}
 
-func (h *BoardHandler) CheckVisibility(ctx context.Context, userID, boardID uint64) error {
-	authClient, err := h.services.GetAuthClient()
-	if err != nil {
-		return err
+type UpdateCustomFieldBody struct {
+	Name    string                   `json:"name" binding:"required"`
+	Options []*CustomFieldOptionBody `json:"options"`
+}
+
+func (h *BoardHandler) UpdateCustomField(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri UpdateCustomFieldUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
 	}
 
-	_, err = authClient.CheckBoardVisibility(ctx, &pb_auth.CheckBoardVisibilityRequest{
-		UserID:  userID,
-		BoardID: boardID,
-	})
+	var body UpdateCustomFieldBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.UpdateCustomFieldRequest{
+		CustomFieldID: uri.CustomFieldID,
+		Name:          body.Name,
+		Options:       convertCustomFieldOptionsToProto(body.Options),
+	}
+
+	res, err := boardClient.UpdateCustomField(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, "Custom field updated successfully", res.CustomField)
+}
+
+type RemoveCustomFieldUri struct {
+	BoardID       uint64 `uri:"boardID" binding:"required"`
+	CustomFieldID uint64 `uri:"customFieldID" binding:"required"`
+}
+
+func (h *BoardHandler) RemoveCustomField(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri RemoveCustomFieldUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.RemoveCustomFieldRequest{CustomFieldID: uri.CustomFieldID}
+
+	res, err := boardClient.RemoveCustomField(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
+}
+
+func convertCustomFieldOptionsToProto(options []*CustomFieldOptionBody) []*pb_board.CustomFieldOption {
+	var protoOptions []*pb_board.CustomFieldOption
+	for _, option := range options {
+		protoOptions = append(protoOptions, &pb_board.CustomFieldOption{
+			OptionID: option.OptionID,
+			Value:    option.Value,
+			Color:    option.Color,
+		})
+	}
+	return protoOptions
+}
+
+type ArchiveBoardUri struct {
+	BoardID uint64 `uri:"boardID" binding:"required"`
+}
+
+func (h *BoardHandler) ArchiveBoard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri ArchiveBoardUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10), undo.SessionMetadataKey, getSessionID(c))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.ArchiveBoardRequest{ExpectedVersion: expectedVersion}
+
+	res, err := boardClient.ArchiveBoard(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
+}
+
+type RestoreBoardUri struct {
+	BoardID uint64 `uri:"boardID" binding:"required"`
+}
+
+func (h *BoardHandler) RestoreBoard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri RestoreBoardUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10), undo.SessionMetadataKey, getSessionID(c))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.RestoreBoardRequest{ExpectedVersion: expectedVersion}
+
+	res, err := boardClient.RestoreBoard(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
+}
+
+type DeleteBoardUri struct {
+	BoardID uint64 `uri:"boardID" binding:"required"`
+}
+
+func (h *BoardHandler) DeleteBoard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri DeleteBoardUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10), undo.SessionMetadataKey, getSessionID(c))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.DeleteBoardRequest{}
+
+	res, err := boardClient.DeleteBoard(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
+}
+
+type RestoreTrashItemUri struct {
+	Type string `uri:"type" binding:"required,oneof=board list card"`
+	ID   uint64 `uri:"id" binding:"required"`
+}
+
+func (h *BoardHandler) RestoreTrashItem(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri RestoreTrashItemUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	// Lists and cards are authorized against the board they belong to
+	boardID := uri.ID
+	switch uri.Type {
+	case trashitemtypes.List:
+		res, err := boardClient.GetBoardIDByList(ctx, &pb_board.GetBoardIDByListRequest{ListID: uri.ID})
+		if err != nil {
+			errorhandlers.HandleError(c, err)
+			return
+		}
+		boardID = res.BoardID
+	case trashitemtypes.Card:
+		res, err := boardClient.GetBoardIDByCard(ctx, &pb_board.GetBoardIDByCardRequest{CardID: uri.ID})
+		if err != nil {
+			errorhandlers.HandleError(c, err)
+			return
+		}
+		boardID = res.BoardID
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.RestoreTrashItemRequest{
+		Type: uri.Type,
+		Id:   uri.ID,
+	}
+
+	res, err := boardClient.RestoreTrashItem(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, res.Message, nil)
+}
+
+type ExportBoardUri struct {
+	BoardID uint64 `uri:"boardID" binding:"required"`
+}
+
+type ExportBoardBody struct {
+	Format string `json:"format" binding:"required"`
+}
+
+// BoardExportResponse is the state of an export, with the link to download its file once it's
+// completed
+type BoardExportResponse struct {
+	*pb_board.BoardExport
+	DownloadURL string `json:"downloadURL,omitempty"`
+}
+
+// ExportBoard queues an export of the board. It answers right away, the export's progress is
+// followed with GetBoardExport.
+func (h *BoardHandler) ExportBoard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri ExportBoardUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var body ExportBoardBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.ExportBoardRequest{
+		Format: body.Format,
+	}
+
+	res, err := boardClient.ExportBoard(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusAccepted, res.Message, convertBoardExport(res.Export))
+}
+
+type GetBoardExportUri struct {
+	BoardID  uint64 `uri:"boardID" binding:"required"`
+	ExportID uint64 `uri:"exportID" binding:"required"`
+}
+
+func (h *BoardHandler) GetBoardExport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri GetBoardExportUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcReq := &pb_board.GetBoardExportRequest{
+		ExportID: uri.ExportID,
+	}
+
+	res, err := boardClient.GetBoardExport(ctx, grpcReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, "Export retrieved successfully", convertBoardExport(res.Export))
+}
+
+type DownloadBoardExportUri struct {
+	BoardID  uint64 `uri:"boardID" binding:"required"`
+	ExportID uint64 `uri:"exportID" binding:"required"`
+}
+
+// DownloadBoardExport relays the file of a completed export from the board service
+func (h *BoardHandler) DownloadBoardExport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri DownloadBoardExportUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(uri.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	stream, err := boardClient.DownloadBoardExport(ctx, &pb_board.DownloadBoardExportRequest{ExportID: uri.ExportID})
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	// The export is checked before the first chunk, so the client still gets a regular error response
+	res, err := stream.Recv()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.FileName))
+	c.Header("Content-Type", res.ContentType)
+	c.Status(http.StatusOK)
+
+	for {
+		if _, err := c.Writer.Write(res.Chunk); err != nil {
+			return
+		}
+
+		if res, err = stream.Recv(); err != nil {
+			// The headers are already sent, so a broken stream can only cut the download short
+			if err != io.EOF {
+				c.Abort()
+			}
+			return
+		}
+	}
+}
+
+// Helpers
+
+func convertBoardExport(boardExport *pb_board.BoardExport) *BoardExportResponse {
+	res := &BoardExportResponse{BoardExport: boardExport}
+	if boardExport.Status == exportstatuses.Completed {
+		res.DownloadURL = fmt.Sprintf("/boards/%d/exports/%d/download", boardExport.BoardID, boardExport.ExportID)
+	}
+	return res
+}
+
+func (h *BoardHandler) CheckVisibility(ctx context.Context, userID, boardID uint64) error {
+	authClient, err := h.services.GetAuthClient()
+	if err != nil {
+		return err
+	}
+
+	_, err = authClient.CheckBoardVisibility(ctx, &pb_auth.CheckBoardVisibilityRequest{
+		UserID:  userID,
+		BoardID: boardID,
+	})
 
 	return err
 }
+
+// readImportFile reads the file uploaded for an import, of at most maxSize bytes
+func readImportFile(c *gin.Context, maxSize int64) ([]byte, error) {
+	// The limit leaves room for the rest of the multipart form
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSize+1<<20)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return nil, errorhandlers.NewAPIError(http.StatusBadRequest, "File is required")
+	}
+	if fileHeader.Size > maxSize {
+		return nil, errorhandlers.NewAPIError(http.StatusRequestEntityTooLarge, fmt.Sprintf("File must be at most %d MB", maxSize>>20))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, errorhandlers.NewAPIError(http.StatusBadRequest, "File is required")
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errorhandlers.NewAPIError(http.StatusBadRequest, "File couldn't be read")
+	}
+	return content, nil
+}
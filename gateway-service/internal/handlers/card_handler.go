@@ -2,18 +2,25 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"math"
 	"net/http"
 	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
 	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
 	"github.com/sm888sm/halten-backend/common/responsehandlers"
+	"github.com/sm888sm/halten-backend/common/undo"
 	external_services "github.com/sm888sm/halten-backend/gateway-service/external/services"
 	pb_auth "github.com/sm888sm/halten-backend/user-service/api/pb"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -89,6 +96,10 @@ func (h *CardHandler) GetCardByID(c *gin.Context) {
 		return
 	}
 
+	if setETag(c, resp.Card.Version) {
+		return
+	}
+
 	responsehandlers.Success(c, http.StatusOK, "Card retrieved successfully", resp)
 }
 
@@ -111,6 +122,12 @@ func (h *CardHandler) GetCardsByBoard(c *gin.Context) {
 		return
 	}
 
+	filters, sort, err := getCustomFieldQuery(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	if err := h.CheckVisibility(ctx, userID, uri.BoardID); err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -125,7 +142,10 @@ func (h *CardHandler) GetCardsByBoard(c *gin.Context) {
 	md := metadata.Pairs("boardID", strconv.FormatUint(uri.BoardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcCardReq := &pb_card.GetCardsByBoardRequest{}
+	grpcCardReq := &pb_card.GetCardsByBoardRequest{
+		Filters: filters,
+		Sort:    sort,
+	}
 
 	res, err := cardClient.GetCardsByBoard(ctx, grpcCardReq)
 	if err != nil {
@@ -149,6 +169,12 @@ func (h *CardHandler) GetCardsByList(c *gin.Context) {
 		return
 	}
 
+	filters, sort, err := getCustomFieldQuery(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -183,7 +209,11 @@ func (h *CardHandler) GetCardsByList(c *gin.Context) {
 		return
 	}
 
-	grpcCardReq := &pb_card.GetCardsByListRequest{ListID: uri.ListID}
+	grpcCardReq := &pb_card.GetCardsByListRequest{
+		ListID:  uri.ListID,
+		Filters: filters,
+		Sort:    sort,
+	}
 
 	res, err := cardClient.GetCardsByList(ctx, grpcCardReq)
 	if err != nil {
@@ -256,7 +286,7 @@ func (h *CardHandler) CreateCard(c *gin.Context) {
 		return
 	}
 
-	responsehandlers.Success(c, http.StatusOK, "Card created successfully", grpcCardRes.Card)
+	responsehandlers.SuccessWithWarnings(c, http.StatusOK, "Card created successfully", grpcCardRes.Card, wipLimitWarnings(grpcCardRes.WipLimitWarning))
 }
 
 type MoveCardPositionUri struct {
@@ -278,6 +308,12 @@ func (h *CardHandler) MoveCardPosition(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	var body MoveCardPositionBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
@@ -314,14 +350,15 @@ func (h *CardHandler) MoveCardPosition(c *gin.Context) {
 
 	boardID := grpcBoardRes.BoardID
 
-	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10), undo.SessionMetadataKey, getSessionID(c))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	grpcCardReq := &pb_card.MoveCardPositionRequest{
-		CardID:    uri.CardID,
-		Position:  body.Position,
-		OldListID: body.OldListID,
-		NewListID: body.NewListID,
+		CardID:          uri.CardID,
+		Position:        body.Position,
+		OldListID:       body.OldListID,
+		NewListID:       body.NewListID,
+		ExpectedVersion: expectedVersion,
 	}
 
 	grpcCardRes, err := cardClient.MoveCardPosition(ctx, grpcCardReq)
@@ -330,7 +367,7 @@ func (h *CardHandler) MoveCardPosition(c *gin.Context) {
 		return
 	}
 
-	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
+	responsehandlers.SuccessWithWarnings(c, http.StatusOK, grpcCardRes.Message, nil, wipLimitWarnings(grpcCardRes.WipLimitWarning))
 }
 
 type UpdateCardNameUri struct {
@@ -350,6 +387,12 @@ func (h *CardHandler) UpdateCardName(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	var body UpdateCardNameBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
@@ -390,8 +433,9 @@ func (h *CardHandler) UpdateCardName(c *gin.Context) {
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	grpcCardReq := &pb_card.UpdateCardNameRequest{
-		CardID: uri.CardID,
-		Name:   body.Name,
+		CardID:          uri.CardID,
+		Name:            body.Name,
+		ExpectedVersion: expectedVersion,
 	}
 
 	grpcCardRes, err := cardClient.UpdateCardName(ctx, grpcCardReq)
@@ -404,7 +448,7 @@ func (h *CardHandler) UpdateCardName(c *gin.Context) {
 }
 
 type UpdateCardDescriptionUri struct {
-	CardID uint64 `uri:"listID" binding:"required"`
+	CardID uint64 `uri:"cardID" binding:"required"`
 }
 
 type UpdateCardDescriptionBody struct {
@@ -420,6 +464,12 @@ func (h *CardHandler) UpdateCardDescription(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	var body UpdateCardDescriptionBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
@@ -460,8 +510,9 @@ func (h *CardHandler) UpdateCardDescription(c *gin.Context) {
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	grpcCardReq := &pb_card.UpdateCardDescriptionRequest{
-		CardID:      uri.CardID,
-		Description: body.Description,
+		CardID:          uri.CardID,
+		Description:     body.Description,
+		ExpectedVersion: expectedVersion,
 	}
 
 	grpcCardRes, err := cardClient.UpdateCardDescription(ctx, grpcCardReq)
@@ -473,6 +524,223 @@ func (h *CardHandler) UpdateCardDescription(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
 }
 
+type EditCardDescriptionUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
+}
+
+// DescriptionMessage is the JSON form of the messages exchanged over the description WebSocket.
+// Clients send edit and cursor messages, the server sends snapshot, ack, edit and presence
+// messages. Operations are arrays where a positive number retains that many characters, a string
+// inserts it and a negative number deletes that many characters.
+type DescriptionMessage struct {
+	Type         string               `json:"type"`
+	Revision     uint64               `json:"revision"`
+	Content      string               `json:"content,omitempty"`
+	Operation    []interface{}        `json:"operation,omitempty"`
+	UserID       uint64               `json:"user_id,omitempty"`
+	Position     *int64               `json:"position,omitempty"`
+	SelectionEnd *int64               `json:"selection_end,omitempty"`
+	Left         bool                 `json:"left,omitempty"`
+	Presence     []DescriptionMessage `json:"presence,omitempty"`
+}
+
+// Origins are checked by the upgrader's default, which only accepts same host requests
+var descriptionUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+}
+
+// EditCardDescription upgrades to a WebSocket relaying a collaborative editing session of the
+// card's description
+func (h *CardHandler) EditCardDescription(c *gin.Context) {
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+
+	var uri EditCardDescriptionUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, &pb_board.GetBoardIDByCardRequest{CardID: uri.CardID})
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(grpcBoardRes.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	stream, err := cardClient.EditCardDescription(ctx)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	if err := stream.Send(&pb_card.EditCardDescriptionRequest{
+		Payload: &pb_card.EditCardDescriptionRequest_Join{Join: &pb_card.JoinDescriptionSession{CardID: uri.CardID}},
+	}); err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	// Joining fails before the upgrade, so the client still gets a regular error response
+	snapshot, err := stream.Recv()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	conn, err := descriptionUpgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// The upgrader has already written the error response
+		return
+	}
+	defer conn.Close()
+
+	go func() {
+		defer cancel()
+		relayDescriptionMessages(conn, stream)
+	}()
+
+	for res := snapshot; ; {
+		if err := conn.WriteJSON(convertDescriptionResponse(res)); err != nil {
+			return
+		}
+
+		if res, err = stream.Recv(); err != nil {
+			message := "Session ended"
+			if st, ok := status.FromError(err); ok && st.Code() != codes.Canceled {
+				message = st.Message()
+			}
+			conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, message), time.Now().Add(time.Second))
+			return
+		}
+	}
+}
+
+// relayDescriptionMessages forwards the client's edits and cursor moves until the socket closes
+func relayDescriptionMessages(conn *websocket.Conn, stream pb_card.CardService_EditCardDescriptionClient) {
+	defer stream.CloseSend()
+
+	for {
+		var message DescriptionMessage
+		if err := conn.ReadJSON(&message); err != nil {
+			return
+		}
+
+		req, err := convertDescriptionMessage(&message)
+		if err != nil {
+			conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseUnsupportedData, err.Error()), time.Now().Add(time.Second))
+			return
+		}
+
+		if err := stream.Send(req); err != nil {
+			return
+		}
+	}
+}
+
+func convertDescriptionMessage(message *DescriptionMessage) (*pb_card.EditCardDescriptionRequest, error) {
+	switch message.Type {
+	case "edit":
+		operation := make([]*pb_card.OperationComponent, 0, len(message.Operation))
+		for _, component := range message.Operation {
+			switch value := component.(type) {
+			case string:
+				operation = append(operation, &pb_card.OperationComponent{Kind: &pb_card.OperationComponent_Insert{Insert: value}})
+			case float64:
+				if value != math.Trunc(value) || value == 0 {
+					return nil, errors.New("invalid operation")
+				}
+				if value > 0 {
+					operation = append(operation, &pb_card.OperationComponent{Kind: &pb_card.OperationComponent_Retain{Retain: int64(value)}})
+				} else {
+					operation = append(operation, &pb_card.OperationComponent{Kind: &pb_card.OperationComponent_Delete{Delete: int64(-value)}})
+				}
+			default:
+				return nil, errors.New("invalid operation")
+			}
+		}
+
+		return &pb_card.EditCardDescriptionRequest{Payload: &pb_card.EditCardDescriptionRequest_Edit{
+			Edit: &pb_card.DescriptionEdit{Revision: message.Revision, Operation: operation},
+		}}, nil
+	case "cursor":
+		if message.Position == nil {
+			return nil, errors.New("invalid cursor")
+		}
+
+		cursor := &pb_card.DescriptionCursor{Revision: message.Revision, Position: *message.Position, SelectionEnd: *message.Position}
+		if message.SelectionEnd != nil {
+			cursor.SelectionEnd = *message.SelectionEnd
+		}
+
+		return &pb_card.EditCardDescriptionRequest{Payload: &pb_card.EditCardDescriptionRequest_Cursor{Cursor: cursor}}, nil
+	default:
+		return nil, errors.New("unknown message type")
+	}
+}
+
+func convertDescriptionResponse(res *pb_card.EditCardDescriptionResponse) *DescriptionMessage {
+	switch payload := res.Payload.(type) {
+	case *pb_card.EditCardDescriptionResponse_Snapshot:
+		message := &DescriptionMessage{
+			Type:     "snapshot",
+			Revision: payload.Snapshot.Revision,
+			Content:  payload.Snapshot.Content,
+		}
+		for _, presence := range payload.Snapshot.Presence {
+			message.Presence = append(message.Presence, *convertDescriptionPresence(presence))
+		}
+		return message
+	case *pb_card.EditCardDescriptionResponse_Ack:
+		return &DescriptionMessage{Type: "ack", Revision: payload.Ack.Revision}
+	case *pb_card.EditCardDescriptionResponse_Edit:
+		operation := make([]interface{}, 0, len(payload.Edit.Operation))
+		for _, component := range payload.Edit.Operation {
+			switch kind := component.Kind.(type) {
+			case *pb_card.OperationComponent_Retain:
+				operation = append(operation, kind.Retain)
+			case *pb_card.OperationComponent_Insert:
+				operation = append(operation, kind.Insert)
+			case *pb_card.OperationComponent_Delete:
+				operation = append(operation, -kind.Delete)
+			}
+		}
+		return &DescriptionMessage{Type: "edit", Revision: payload.Edit.Revision, UserID: payload.Edit.UserID, Operation: operation}
+	default:
+		return convertDescriptionPresence(res.GetPresence())
+	}
+}
+
+func convertDescriptionPresence(presence *pb_card.DescriptionPresence) *DescriptionMessage {
+	message := &DescriptionMessage{Type: "presence", UserID: presence.UserID, Left: presence.Left}
+	if presence.Cursor != nil {
+		message.Position = &presence.Cursor.Position
+		message.SelectionEnd = &presence.Cursor.SelectionEnd
+	}
+	return message
+}
+
 type AddCardLabelUri struct {
 	CardID  uint64 `uri:"listID" binding:"required"`
 	LabelID uint64 `uri:"labelID" binding:"required"`
@@ -613,6 +881,12 @@ func (h *CardHandler) SetCardDates(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	var body SetCardDatesBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
@@ -688,9 +962,10 @@ func (h *CardHandler) SetCardDates(c *gin.Context) {
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	grpcCardReq := &pb_card.SetCardDatesRequest{
-		CardID:    uri.CardID,
-		StartDate: startDate,
-		DueDate:   dueDate,
+		CardID:          uri.CardID,
+		StartDate:       startDate,
+		DueDate:         dueDate,
+		ExpectedVersion: expectedVersion,
 	}
 
 	grpcCardRes, err := cardClient.SetCardDates(ctx, grpcCardReq)
@@ -702,19 +977,29 @@ func (h *CardHandler) SetCardDates(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
 }
 
-type ToggleCardCompletedUri struct {
-	CardID uint64 `uri:"listID" binding:"required"`
+type SetCardRemindersUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
 }
 
-func (h *CardHandler) ToggleCardCompleted(c *gin.Context) {
+type SetCardRemindersBody struct {
+	Offsets []int64 `json:"offsets"`
+}
+
+func (h *CardHandler) SetCardReminders(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri ToggleCardCompletedUri
+	var uri SetCardRemindersUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
+	var body SetCardRemindersBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -748,11 +1033,12 @@ func (h *CardHandler) ToggleCardCompleted(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcCardReq := &pb_card.ToggleCardCompletedRequest{
-		CardID: uri.CardID,
+	grpcCardReq := &pb_card.SetCardRemindersRequest{
+		CardID:        uri.CardID,
+		OffsetMinutes: body.Offsets,
 	}
 
-	grpcCardRes, err := cardClient.ToggleCardCompleted(ctx, grpcCardReq)
+	grpcCardRes, err := cardClient.SetCardReminders(ctx, grpcCardReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -761,20 +1047,38 @@ func (h *CardHandler) ToggleCardCompleted(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
 }
 
-type AddCardAttachmentUri struct {
-	CardID       uint64 `uri:"listID" binding:"required"`
-	AttachmentID uint64 `uri:"attachmentID" binding:"required"`
+type SetCardRecurrenceUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
 }
 
-func (h *CardHandler) AddCardAttachment(c *gin.Context) {
+type SetCardRecurrenceBody struct {
+	Frequency    string   `json:"frequency" binding:"required"`
+	Interval     int32    `json:"interval"`
+	Weekdays     []string `json:"weekdays"`
+	MonthDay     int32    `json:"monthDay"`
+	TargetListID uint64   `json:"targetListID"`
+}
+
+func (h *CardHandler) SetCardRecurrence(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri AddCardAttachmentUri
+	var uri SetCardRecurrenceUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
+	var body SetCardRecurrenceBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	// Default to every period
+	if body.Interval == 0 {
+		body.Interval = 1
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -808,12 +1112,16 @@ func (h *CardHandler) AddCardAttachment(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcCardReq := &pb_card.AddCardAttachmentRequest{
+	grpcCardReq := &pb_card.SetCardRecurrenceRequest{
 		CardID:       uri.CardID,
-		AttachmentID: uri.AttachmentID,
+		Frequency:    body.Frequency,
+		Interval:     body.Interval,
+		Weekdays:     body.Weekdays,
+		MonthDay:     body.MonthDay,
+		TargetListID: body.TargetListID,
 	}
 
-	grpcCardRes, err := cardClient.AddCardAttachment(ctx, grpcCardReq)
+	grpcCardRes, err := cardClient.SetCardRecurrence(ctx, grpcCardReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -822,15 +1130,14 @@ func (h *CardHandler) AddCardAttachment(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
 }
 
-type RemoveCardAttachmentUri struct {
-	CardID       uint64 `uri:"listID" binding:"required"`
-	AttachmentID uint64 `uri:"attachmentID" binding:"required"`
+type RemoveCardRecurrenceUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
 }
 
-func (h *CardHandler) RemoveCardAttachment(c *gin.Context) {
+func (h *CardHandler) RemoveCardRecurrence(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri RemoveCardAttachmentUri
+	var uri RemoveCardRecurrenceUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
@@ -869,12 +1176,11 @@ func (h *CardHandler) RemoveCardAttachment(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcCardReq := &pb_card.RemoveCardAttachmentRequest{
-		CardID:       uri.CardID,
-		AttachmentID: uri.AttachmentID,
+	grpcCardReq := &pb_card.RemoveCardRecurrenceRequest{
+		CardID: uri.CardID,
 	}
 
-	grpcCardRes, err := cardClient.RemoveCardAttachment(ctx, grpcCardReq)
+	grpcCardRes, err := cardClient.RemoveCardRecurrence(ctx, grpcCardReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -883,29 +1189,62 @@ func (h *CardHandler) RemoveCardAttachment(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
 }
 
-type AddCardCommentUri struct {
-	CardID uint64 `uri:"listID" binding:"required"`
+type SetCardCustomFieldValueUri struct {
+	CardID        uint64 `uri:"cardID" binding:"required"`
+	CustomFieldID uint64 `uri:"customFieldID" binding:"required"`
 }
 
-type AddCardCommentBody struct {
-	Content string `uri:"content" binding:"required"`
+// SetCardCustomFieldValueBody takes exactly one value, the one matching the custom field type
+type SetCardCustomFieldValueBody struct {
+	Text     *string    `json:"text"`
+	Number   *float64   `json:"number"`
+	Date     *time.Time `json:"date"`
+	Checkbox *bool      `json:"checkbox"`
+	OptionID *uint64    `json:"optionID"`
 }
 
-func (h *CardHandler) AddCardComment(c *gin.Context) {
+func (h *CardHandler) SetCardCustomFieldValue(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri AddCardCommentUri
+	var uri SetCardCustomFieldValueUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
-	var body AddCardCommentBody
+	var body SetCardCustomFieldValueBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
 		return
 	}
 
+	value := &pb_card.CustomFieldValue{CustomFieldID: uri.CustomFieldID}
+	values := 0
+	if body.Text != nil {
+		value.Value = &pb_card.CustomFieldValue_TextValue{TextValue: *body.Text}
+		values++
+	}
+	if body.Number != nil {
+		value.Value = &pb_card.CustomFieldValue_NumberValue{NumberValue: *body.Number}
+		values++
+	}
+	if body.Date != nil {
+		value.Value = &pb_card.CustomFieldValue_DateValue{DateValue: timestamppb.New(*body.Date)}
+		values++
+	}
+	if body.Checkbox != nil {
+		value.Value = &pb_card.CustomFieldValue_CheckboxValue{CheckboxValue: *body.Checkbox}
+		values++
+	}
+	if body.OptionID != nil {
+		value.Value = &pb_card.CustomFieldValue_OptionID{OptionID: *body.OptionID}
+		values++
+	}
+	if values != 1 {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Exactly one value is required"))
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -939,12 +1278,12 @@ func (h *CardHandler) AddCardComment(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcCardReq := &pb_card.AddCardCommentRequest{
-		CardID:  uri.CardID,
-		Content: body.Content,
+	grpcCardReq := &pb_card.SetCardCustomFieldValueRequest{
+		CardID: uri.CardID,
+		Value:  value,
 	}
 
-	grpcCardRes, err := cardClient.AddCardComment(ctx, grpcCardReq)
+	grpcCardRes, err := cardClient.SetCardCustomFieldValue(ctx, grpcCardReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -953,15 +1292,15 @@ func (h *CardHandler) AddCardComment(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
 }
 
-type RemoveCardCommentUri struct {
-	CardID    uint64 `uri:"listID" binding:"required"`
-	CommendID uint64 `uri:"commentID" binding:"required"`
+type RemoveCardCustomFieldValueUri struct {
+	CardID        uint64 `uri:"cardID" binding:"required"`
+	CustomFieldID uint64 `uri:"customFieldID" binding:"required"`
 }
 
-func (h *CardHandler) RemoveCardComment(c *gin.Context) {
+func (h *CardHandler) RemoveCardCustomFieldValue(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri RemoveCardCommentUri
+	var uri RemoveCardCustomFieldValueUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
@@ -1000,12 +1339,12 @@ func (h *CardHandler) RemoveCardComment(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcCardReq := &pb_card.RemoveCardCommentRequest{
-		CardID:    uri.CardID,
-		CommentID: uri.CommendID,
+	grpcCardReq := &pb_card.RemoveCardCustomFieldValueRequest{
+		CardID:        uri.CardID,
+		CustomFieldID: uri.CustomFieldID,
 	}
 
-	grpcCardRes, err := cardClient.RemoveCardComment(ctx, grpcCardReq)
+	grpcCardRes, err := cardClient.RemoveCardCustomFieldValue(ctx, grpcCardReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -1014,26 +1353,22 @@ func (h *CardHandler) RemoveCardComment(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
 }
 
-type AddCardMembersUri struct {
-	CardID uint64 `uri:"cardID" binding:"required"`
-}
-
-type AddCardMembersBody struct {
-	UserIDs []uint64 `json:"userIDs" binding:"required"`
+type ToggleCardCompletedUri struct {
+	CardID uint64 `uri:"listID" binding:"required"`
 }
 
-func (h *CardHandler) AddCardMembers(c *gin.Context) {
+func (h *CardHandler) ToggleCardCompleted(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri AddCardMembersUri
+	var uri ToggleCardCompletedUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
-	var body AddCardMembersBody
-	if err := c.ShouldBindJSON(&body); err != nil {
-		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
 		return
 	}
 
@@ -1070,12 +1405,12 @@ func (h *CardHandler) AddCardMembers(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcCardReq := &pb_card.AddCardMembersRequest{
-		CardID:  uri.CardID,
-		UserIDs: body.UserIDs,
+	grpcCardReq := &pb_card.ToggleCardCompletedRequest{
+		CardID:          uri.CardID,
+		ExpectedVersion: expectedVersion,
 	}
 
-	grpcCardRes, err := cardClient.AddCardMembers(ctx, grpcCardReq)
+	grpcCardRes, err := cardClient.ToggleCardCompleted(ctx, grpcCardReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -1084,29 +1419,20 @@ func (h *CardHandler) AddCardMembers(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
 }
 
-type RemoveCardMembersUri struct {
-	CardID uint64 `uri:"cardID" binding:"required"`
-}
-
-type RemoveCardMembersBody struct {
-	UserIDs []uint64 `json:"userIDs" binding:"required"`
+type AddCardAttachmentUri struct {
+	CardID       uint64 `uri:"listID" binding:"required"`
+	AttachmentID uint64 `uri:"attachmentID" binding:"required"`
 }
 
-func (h *CardHandler) RemoveCardMembers(c *gin.Context) {
+func (h *CardHandler) AddCardAttachment(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	var uri RemoveCardMembersUri
+	var uri AddCardAttachmentUri
 	if err := c.ShouldBindUri(&uri); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
 		return
 	}
 
-	var body RemoveCardMembersBody
-	if err := c.ShouldBindJSON(&body); err != nil {
-		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
-		return
-	}
-
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -1140,12 +1466,12 @@ func (h *CardHandler) RemoveCardMembers(c *gin.Context) {
 	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcCardReq := &pb_card.RemoveCardMembersRequest{
-		CardID:  uri.CardID,
-		UserIDs: body.UserIDs,
+	grpcCardReq := &pb_card.AddCardAttachmentRequest{
+		CardID:       uri.CardID,
+		AttachmentID: uri.AttachmentID,
 	}
 
-	grpcCardRes, err := cardClient.RemoveCardMembers(ctx, grpcCardReq)
+	grpcCardRes, err := cardClient.AddCardAttachment(ctx, grpcCardReq)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
 		return
@@ -1154,8 +1480,845 @@ func (h *CardHandler) RemoveCardMembers(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
 }
 
-type ArchiveCardUri struct {
-	CardID uint64 `uri:"cardID" binding:"required"`
+type RemoveCardAttachmentUri struct {
+	CardID       uint64 `uri:"listID" binding:"required"`
+	AttachmentID uint64 `uri:"attachmentID" binding:"required"`
+}
+
+func (h *CardHandler) RemoveCardAttachment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri RemoveCardAttachmentUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.RemoveCardAttachmentRequest{
+		CardID:       uri.CardID,
+		AttachmentID: uri.AttachmentID,
+	}
+
+	grpcCardRes, err := cardClient.RemoveCardAttachment(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
+}
+
+type AddCardCommentUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
+}
+
+type AddCardCommentBody struct {
+	Content  string `json:"content" binding:"required"`
+	ParentID uint64 `json:"parentID"`
+}
+
+func (h *CardHandler) AddCardComment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri AddCardCommentUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var body AddCardCommentBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.AddCardCommentRequest{
+		CardID:   uri.CardID,
+		Content:  body.Content,
+		ParentID: body.ParentID,
+	}
+
+	grpcCardRes, err := cardClient.AddCardComment(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, gin.H{"commentID": grpcCardRes.CommentID})
+}
+
+type RemoveCardCommentUri struct {
+	CardID    uint64 `uri:"cardID" binding:"required"`
+	CommendID uint64 `uri:"commentID" binding:"required"`
+}
+
+func (h *CardHandler) RemoveCardComment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri RemoveCardCommentUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.RemoveCardCommentRequest{
+		CardID:    uri.CardID,
+		CommentID: uri.CommendID,
+	}
+
+	grpcCardRes, err := cardClient.RemoveCardComment(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
+}
+
+type UpdateCardCommentUri struct {
+	CardID    uint64 `uri:"cardID" binding:"required"`
+	CommentID uint64 `uri:"commentID" binding:"required"`
+}
+
+type UpdateCardCommentBody struct {
+	Content string `json:"content" binding:"required"`
+}
+
+func (h *CardHandler) UpdateCardComment(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri UpdateCardCommentUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var body UpdateCardCommentBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.UpdateCardCommentRequest{
+		CardID:    uri.CardID,
+		CommentID: uri.CommentID,
+		Content:   body.Content,
+	}
+
+	grpcCardRes, err := cardClient.UpdateCardComment(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
+}
+
+type GetCardCommentsUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
+}
+
+type GetCardCommentsQuery struct {
+	PageNumber uint64 `form:"pageNumber,default=1"`
+	PageSize   uint64 `form:"pageSize,default=20"`
+}
+
+func (h *CardHandler) GetCardComments(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri GetCardCommentsUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var query GetCardCommentsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request query"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.GetCardCommentsRequest{
+		CardID:     uri.CardID,
+		PageNumber: query.PageNumber,
+		PageSize:   query.PageSize,
+	}
+
+	grpcCardRes, err := cardClient.GetCardComments(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.SuccessWithPagination(c, http.StatusOK, "Comments retrieved successfully", grpcCardRes.Comments, grpcCardRes.Pagination)
+}
+
+type GetCardHistoryUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
+}
+
+type GetCardHistoryQuery struct {
+	PageNumber uint64 `form:"pageNumber,default=1"`
+	PageSize   uint64 `form:"pageSize,default=20"`
+}
+
+func (h *CardHandler) GetCardHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri GetCardHistoryUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var query GetCardHistoryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request query"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.GetCardHistoryRequest{
+		CardID:     uri.CardID,
+		PageNumber: query.PageNumber,
+		PageSize:   query.PageSize,
+	}
+
+	grpcCardRes, err := cardClient.GetCardHistory(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.SuccessWithPagination(c, http.StatusOK, "Card history retrieved successfully", grpcCardRes.Versions, grpcCardRes.Pagination)
+}
+
+type RestoreCardVersionUri struct {
+	CardID  uint64 `uri:"cardID" binding:"required"`
+	Version uint64 `uri:"version" binding:"required"`
+}
+
+type RestoreCardVersionBody struct {
+	Fields []string `json:"fields"` // Empty restores every field
+}
+
+func (h *CardHandler) RestoreCardVersion(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri RestoreCardVersionUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	// The body is optional
+	var body RestoreCardVersionBody
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+			return
+		}
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.RestoreCardVersionRequest{
+		CardID:          uri.CardID,
+		Version:         uri.Version,
+		Fields:          body.Fields,
+		ExpectedVersion: expectedVersion,
+	}
+
+	grpcCardRes, err := cardClient.RestoreCardVersion(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
+}
+
+type GetCardCommentHistoryUri struct {
+	CardID    uint64 `uri:"cardID" binding:"required"`
+	CommentID uint64 `uri:"commentID" binding:"required"`
+}
+
+func (h *CardHandler) GetCardCommentHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri GetCardCommentHistoryUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.GetCardCommentHistoryRequest{
+		CardID:    uri.CardID,
+		CommentID: uri.CommentID,
+	}
+
+	grpcCardRes, err := cardClient.GetCardCommentHistory(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, "Comment history retrieved successfully", grpcCardRes.Edits)
+}
+
+type AddCommentReactionUri struct {
+	CardID    uint64 `uri:"cardID" binding:"required"`
+	CommentID uint64 `uri:"commentID" binding:"required"`
+}
+
+type AddCommentReactionBody struct {
+	Emoji string `json:"emoji" binding:"required"`
+}
+
+func (h *CardHandler) AddCommentReaction(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri AddCommentReactionUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var body AddCommentReactionBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.AddCommentReactionRequest{
+		CardID:    uri.CardID,
+		CommentID: uri.CommentID,
+		Emoji:     body.Emoji,
+	}
+
+	grpcCardRes, err := cardClient.AddCommentReaction(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
+}
+
+type RemoveCommentReactionUri struct {
+	CardID    uint64 `uri:"cardID" binding:"required"`
+	CommentID uint64 `uri:"commentID" binding:"required"`
+}
+
+type RemoveCommentReactionBody struct {
+	Emoji string `json:"emoji" binding:"required"`
+}
+
+func (h *CardHandler) RemoveCommentReaction(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri RemoveCommentReactionUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var body RemoveCommentReactionBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.RemoveCommentReactionRequest{
+		CardID:    uri.CardID,
+		CommentID: uri.CommentID,
+		Emoji:     body.Emoji,
+	}
+
+	grpcCardRes, err := cardClient.RemoveCommentReaction(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
+}
+
+type AddCardMembersUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
+}
+
+type AddCardMembersBody struct {
+	UserIDs []uint64 `json:"userIDs" binding:"required"`
+}
+
+func (h *CardHandler) AddCardMembers(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri AddCardMembersUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var body AddCardMembersBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.AddCardMembersRequest{
+		CardID:  uri.CardID,
+		UserIDs: body.UserIDs,
+	}
+
+	grpcCardRes, err := cardClient.AddCardMembers(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
+}
+
+type RemoveCardMembersUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
+}
+
+type RemoveCardMembersBody struct {
+	UserIDs []uint64 `json:"userIDs" binding:"required"`
+}
+
+func (h *CardHandler) RemoveCardMembers(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri RemoveCardMembersUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var body RemoveCardMembersBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByCardRequest{
+		CardID: uri.CardID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByCard(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.RemoveCardMembersRequest{
+		CardID:  uri.CardID,
+		UserIDs: body.UserIDs,
+	}
+
+	grpcCardRes, err := cardClient.RemoveCardMembers(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, nil)
+}
+
+type ArchiveCardUri struct {
+	CardID uint64 `uri:"cardID" binding:"required"`
 }
 
 func (h *CardHandler) ArchiveCard(c *gin.Context) {
@@ -1167,6 +2330,12 @@ func (h *CardHandler) ArchiveCard(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -1197,11 +2366,12 @@ func (h *CardHandler) ArchiveCard(c *gin.Context) {
 
 	boardID := grpcBoardResp.BoardID
 
-	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10), undo.SessionMetadataKey, getSessionID(c))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	grpcCardReq := &pb_card.ArchiveCardRequest{
-		CardID: uri.CardID,
+		CardID:          uri.CardID,
+		ExpectedVersion: expectedVersion,
 	}
 
 	grpcCardRes, err := cardClient.ArchiveCard(ctx, grpcCardReq)
@@ -1226,6 +2396,12 @@ func (h *CardHandler) RestoreCard(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -1256,10 +2432,10 @@ func (h *CardHandler) RestoreCard(c *gin.Context) {
 
 	boardID := grpcBoardResp.BoardID
 
-	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10), undo.SessionMetadataKey, getSessionID(c))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
-	grpcCardReq := &pb_card.RestoreCardRequest{CardID: uri.CardID}
+	grpcCardReq := &pb_card.RestoreCardRequest{CardID: uri.CardID, ExpectedVersion: expectedVersion}
 
 	grpcCardRes, err := cardClient.RestoreCard(ctx, grpcCardReq)
 	if err != nil {
@@ -1313,7 +2489,7 @@ func (h *CardHandler) DeleteCard(c *gin.Context) {
 
 	boardID := grpcBoardResp.BoardID
 
-	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10), undo.SessionMetadataKey, getSessionID(c))
 	ctx = metadata.NewOutgoingContext(ctx, md)
 
 	grpcCardReq := &pb_card.DeleteCardRequest{
@@ -1344,3 +2520,344 @@ func (h *CardHandler) CheckVisibility(ctx context.Context, userID, boardID uint6
 
 	return err
 }
+
+type BulkUpdateCardsBody struct {
+	BoardID   uint64     `json:"boardID" binding:"required"`
+	CardIDs   []uint64   `json:"cardIDs" binding:"required"`
+	Operation string     `json:"operation" binding:"required,oneof=move archive restore add_label remove_label add_member remove_member set_due_date complete"`
+	ListID    uint64     `json:"listID"`
+	LabelID   uint64     `json:"labelID"`
+	UserID    uint64     `json:"userID"`
+	DueDate   *time.Time `json:"dueDate"`
+}
+
+type BulkUpdateCardsData struct {
+	Results   []*pb_card.BulkCardResult `json:"results"`
+	Succeeded int32                     `json:"succeeded"`
+	Failed    int32                     `json:"failed"`
+}
+
+// BulkUpdateCards applies one operation to many cards of a board. Cards that can't be updated are
+// reported in the results while the others are still changed.
+func (h *CardHandler) BulkUpdateCards(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var body BulkUpdateCardsBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(body.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.BulkUpdateCardsRequest{
+		CardIDs:   body.CardIDs,
+		Operation: body.Operation,
+		ListID:    body.ListID,
+		LabelID:   body.LabelID,
+		UserID:    body.UserID,
+	}
+	if body.DueDate != nil {
+		grpcCardReq.DueDate = timestamppb.New(*body.DueDate)
+	}
+
+	grpcCardRes, err := cardClient.BulkUpdateCards(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	var warnings []string
+	for _, result := range grpcCardRes.Results {
+		warnings = append(warnings, wipLimitWarnings(result.WipLimitWarning)...)
+	}
+
+	data := &BulkUpdateCardsData{
+		Results:   grpcCardRes.Results,
+		Succeeded: grpcCardRes.Succeeded,
+		Failed:    grpcCardRes.Failed,
+	}
+
+	responsehandlers.SuccessWithWarnings(c, http.StatusOK, grpcCardRes.Message, data, warnings)
+}
+
+type ListCardsUri struct {
+	ListID uint64 `uri:"listID" binding:"required"`
+}
+
+func (h *CardHandler) ArchiveAllCardsInList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri ListCardsUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByList(ctx, &pb_board.GetBoardIDByListRequest{ListID: uri.ListID})
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(grpcBoardRes.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardRes, err := cardClient.ArchiveAllCardsInList(ctx, &pb_card.ArchiveAllCardsInListRequest{ListID: uri.ListID})
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, grpcCardRes.CardIDs)
+}
+
+type MoveAllCardsInListBody struct {
+	TargetListID uint64 `json:"targetListID" binding:"required"`
+}
+
+// MoveAllCardsInList appends the cards of a list to another list, which may be on another board
+func (h *CardHandler) MoveAllCardsInList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri ListCardsUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var body MoveAllCardsInListBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByList(ctx, &pb_board.GetBoardIDByListRequest{ListID: uri.ListID})
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcTargetBoardRes, err := boardClient.GetBoardIDByList(ctx, &pb_board.GetBoardIDByListRequest{ListID: body.TargetListID})
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(grpcBoardRes.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.MoveAllCardsInListRequest{
+		ListID:        uri.ListID,
+		TargetListID:  body.TargetListID,
+		TargetBoardID: grpcTargetBoardRes.BoardID,
+	}
+
+	grpcCardRes, err := cardClient.MoveAllCardsInList(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.SuccessWithWarnings(c, http.StatusOK, grpcCardRes.Message, grpcCardRes.CardIDs, wipLimitWarnings(grpcCardRes.WipLimitWarning))
+}
+
+// maxCardImportFileSize is the largest CSV file accepted for a card import
+const maxCardImportFileSize = 2 << 20
+
+type ImportCardsForm struct {
+	Partial bool   `form:"partial"`
+	Columns string `form:"columns"` // JSON object mapping headers to card fields
+}
+
+// ImportCards adds cards to the end of a list from a CSV file, uploaded as the file field of a
+// multipart form. When rows have errors and nothing was imported, it answers 422 with the errors.
+func (h *CardHandler) ImportCards(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri ListCardsUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	content, err := readImportFile(c, maxCardImportFileSize)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	var form ImportCardsForm
+	if err := c.ShouldBind(&form); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid form fields"))
+		return
+	}
+
+	var columns map[string]string
+	if form.Columns != "" {
+		if err := json.Unmarshal([]byte(form.Columns), &columns); err != nil {
+			errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Columns must be a JSON object of headers to card fields"))
+			return
+		}
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByList(ctx, &pb_board.GetBoardIDByListRequest{ListID: uri.ListID})
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(grpcBoardRes.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.ImportCardsRequest{
+		ListID:  uri.ListID,
+		Content: content,
+		Columns: columns,
+		Partial: form.Partial,
+	}
+
+	grpcCardRes, err := cardClient.ImportCards(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	if grpcCardRes.Imported == 0 {
+		responsehandlers.Success(c, http.StatusUnprocessableEntity, grpcCardRes.Message, grpcCardRes)
+		return
+	}
+
+	responsehandlers.SuccessWithWarnings(c, http.StatusCreated, grpcCardRes.Message, grpcCardRes, wipLimitWarnings(grpcCardRes.WipLimitWarning))
+}
+
+type SortCardsInListBody struct {
+	SortBy        string `json:"sortBy" binding:"required,oneof=due_date created_at name custom_field"`
+	CustomFieldID uint64 `json:"customFieldID"`
+	Descending    bool   `json:"descending"`
+}
+
+func (h *CardHandler) SortCardsInList(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri ListCardsUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	var body SortCardsInListBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	cardClient, err := h.services.GetCardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByList(ctx, &pb_board.GetBoardIDByListRequest{ListID: uri.ListID})
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(grpcBoardRes.BoardID, 10))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	grpcCardReq := &pb_card.SortCardsInListRequest{
+		ListID:        uri.ListID,
+		SortBy:        body.SortBy,
+		CustomFieldID: body.CustomFieldID,
+		Descending:    body.Descending,
+	}
+
+	grpcCardRes, err := cardClient.SortCardsInList(ctx, grpcCardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcCardRes.Message, grpcCardRes.CardIDs)
+}
@@ -1,7 +1,13 @@
 package handlers
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
 	"github.com/gin-gonic/gin"
+	pb_card "github.com/sm888sm/halten-backend/card-service/api/pb"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
 )
 
@@ -18,3 +24,99 @@ func getUserID(c *gin.Context) (uint64, error) {
 
 	return userID, nil
 }
+
+// getCustomFieldQuery reads custom field filters given as filter=<customFieldID>:<operator>[:<value>]
+// and a sort given as sort=<customFieldID>, or sort=-<customFieldID> for descending order
+func getCustomFieldQuery(c *gin.Context) ([]*pb_card.CustomFieldFilter, *pb_card.CustomFieldSort, error) {
+	var filters []*pb_card.CustomFieldFilter
+	for _, rawFilter := range c.QueryArray("filter") {
+		parts := strings.SplitN(rawFilter, ":", 3)
+		if len(parts) < 2 {
+			return nil, nil, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid filter parameter")
+		}
+
+		customFieldID, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return nil, nil, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid filter parameter")
+		}
+
+		filter := &pb_card.CustomFieldFilter{
+			CustomFieldID: customFieldID,
+			Operator:      parts[1],
+		}
+		if len(parts) == 3 {
+			filter.Value = parts[2]
+		}
+
+		filters = append(filters, filter)
+	}
+
+	var sort *pb_card.CustomFieldSort
+	if rawSort := c.Query("sort"); rawSort != "" {
+		sort = &pb_card.CustomFieldSort{}
+		if strings.HasPrefix(rawSort, "-") {
+			sort.Descending = true
+			rawSort = rawSort[1:]
+		}
+
+		customFieldID, err := strconv.ParseUint(rawSort, 10, 64)
+		if err != nil {
+			return nil, nil, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid sort parameter")
+		}
+		sort.CustomFieldID = customFieldID
+	}
+
+	return filters, sort, nil
+}
+
+// setETag exposes the version of the returned resource so that clients can send it back in If-Match.
+// It reports whether the client's If-None-Match already matches, in which case 304 has been written.
+func setETag(c *gin.Context, version uint64) bool {
+	etag := `"` + strconv.FormatUint(version, 10) + `"`
+	c.Header("ETag", etag)
+
+	if c.GetHeader("If-None-Match") == etag {
+		c.Status(http.StatusNotModified)
+		return true
+	}
+
+	return false
+}
+
+// getSessionID reads the client session undoable actions are logged under from X-Session-ID. Clients
+// send one per tab so that undo only takes back actions made in the same tab. Values too long to be
+// stored are ignored.
+func getSessionID(c *gin.Context) string {
+	sessionID := c.GetHeader("X-Session-ID")
+	if len(sessionID) > 64 {
+		return ""
+	}
+	return sessionID
+}
+
+// getIfMatchVersion reads the version an update is based on from If-Match. A missing header or *
+// returns zero, which skips the version check.
+func getIfMatchVersion(c *gin.Context) (uint64, error) {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, nil
+	}
+
+	ifMatch = strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`)
+
+	version, err := strconv.ParseUint(ifMatch, 10, 64)
+	if err != nil || version == 0 {
+		return 0, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid If-Match header")
+	}
+
+	return version, nil
+}
+
+// wipLimitWarnings turns the warning returned when a card is added to a list at its WIP limit on a
+// board that only warns into response warnings.
+func wipLimitWarnings(warning *pb_card.WIPLimitWarning) []string {
+	if warning == nil {
+		return nil
+	}
+	return []string{fmt.Sprintf("List %q is over its WIP limit of %d cards with %d cards", warning.ListName, warning.WipLimit, warning.CardCount)}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
 	"github.com/sm888sm/halten-backend/common/responsehandlers"
+	"github.com/sm888sm/halten-backend/common/undo"
 	external_services "github.com/sm888sm/halten-backend/gateway-service/external/services"
 )
 
@@ -89,6 +90,10 @@ func (h *ListHandler) GetListByID(c *gin.Context) {
 		return
 	}
 
+	if len(grpcListResp.Lists) == 1 && setETag(c, grpcListResp.Lists[0].Version) {
+		return
+	}
+
 	responsehandlers.Success(c, http.StatusOK, "List retrieved successfully", grpcListResp.Lists)
 }
 
@@ -198,6 +203,12 @@ func (h *ListHandler) MoveListPosition(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	var body MoveListPositionBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
@@ -234,10 +245,10 @@ func (h *ListHandler) MoveListPosition(c *gin.Context) {
 
 	boardID := grpcBoardRes.BoardID
 
-	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10), undo.SessionMetadataKey, getSessionID(c))
 	ctx = metadata.NewOutgoingContext(c.Request.Context(), md)
 
-	grpcListReq := &pb_list.MoveListPositionRequest{ListID: uri.ListID, Position: body.Position}
+	grpcListReq := &pb_list.MoveListPositionRequest{ListID: uri.ListID, Position: body.Position, ExpectedVersion: expectedVersion}
 
 	grpcListRes, err := listClient.MoveListPosition(ctx, grpcListReq)
 	if err != nil {
@@ -265,6 +276,12 @@ func (h *ListHandler) UpdateListName(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	var body UpdateListNameBody
 	if err := c.ShouldBindJSON(&body); err != nil {
 		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
@@ -305,8 +322,9 @@ func (h *ListHandler) UpdateListName(c *gin.Context) {
 	ctx = metadata.NewOutgoingContext(c.Request.Context(), md)
 
 	grpcListReq := &pb_list.UpdateListNameRequest{
-		ListID: uri.ListID,
-		Name:   body.Name,
+		ListID:          uri.ListID,
+		Name:            body.Name,
+		ExpectedVersion: expectedVersion,
 	}
 
 	grpcListRes, err := listClient.UpdateListName(ctx, grpcListReq)
@@ -318,6 +336,83 @@ func (h *ListHandler) UpdateListName(c *gin.Context) {
 	responsehandlers.Success(c, http.StatusOK, grpcListRes.Message, nil)
 }
 
+type SetListWIPLimitUri struct {
+	ListID uint64 `uri:"listID" binding:"required"`
+}
+
+type SetListWIPLimitBody struct {
+	WIPLimit int32 `json:"wipLimit"`
+}
+
+func (h *ListHandler) SetListWIPLimit(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	var uri SetListWIPLimitUri
+	if err := c.ShouldBindUri(&uri); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid URI parameters"))
+		return
+	}
+
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	var body SetListWIPLimitBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		errorhandlers.HandleError(c, errorhandlers.NewAPIError(http.StatusBadRequest, "Invalid request body"))
+		return
+	}
+
+	userID, err := getUserID(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardClient, err := h.services.GetBoardClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	listClient, err := h.services.GetListClient()
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	grpcBoardReq := &pb_board.GetBoardIDByListRequest{
+		ListID: uri.ListID,
+	}
+
+	grpcBoardRes, err := boardClient.GetBoardIDByList(ctx, grpcBoardReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	boardID := grpcBoardRes.BoardID
+
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	ctx = metadata.NewOutgoingContext(c.Request.Context(), md)
+
+	grpcListReq := &pb_list.SetListWIPLimitRequest{
+		ListID:          uri.ListID,
+		WipLimit:        body.WIPLimit,
+		ExpectedVersion: expectedVersion,
+	}
+
+	grpcListRes, err := listClient.SetListWIPLimit(ctx, grpcListReq)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
+	responsehandlers.Success(c, http.StatusOK, grpcListRes.Message, nil)
+}
+
 type ArchiveListUri struct {
 	ListID uint64 `uri:"listID" binding:"required"`
 }
@@ -331,6 +426,12 @@ func (h *ListHandler) ArchiveList(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -361,10 +462,10 @@ func (h *ListHandler) ArchiveList(c *gin.Context) {
 
 	boardID := grpcBoardResp.BoardID
 
-	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10), undo.SessionMetadataKey, getSessionID(c))
 	ctx = metadata.NewOutgoingContext(c.Request.Context(), md)
 
-	grpcListReq := &pb_list.ArchiveListRequest{ListID: uri.ListID}
+	grpcListReq := &pb_list.ArchiveListRequest{ListID: uri.ListID, ExpectedVersion: expectedVersion}
 
 	grpcListRes, err := listClient.ArchiveList(ctx, grpcListReq)
 	if err != nil {
@@ -388,6 +489,12 @@ func (h *ListHandler) RestoreList(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		errorhandlers.HandleError(c, err)
+		return
+	}
+
 	userID, err := getUserID(c)
 	if err != nil {
 		errorhandlers.HandleError(c, err)
@@ -418,10 +525,10 @@ func (h *ListHandler) RestoreList(c *gin.Context) {
 
 	boardID := grpcBoardResp.BoardID
 
-	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10), undo.SessionMetadataKey, getSessionID(c))
 	ctx = metadata.NewOutgoingContext(c.Request.Context(), md)
 
-	grpcListReq := &pb_list.RestoreListRequest{ListID: uri.ListID}
+	grpcListReq := &pb_list.RestoreListRequest{ListID: uri.ListID, ExpectedVersion: expectedVersion}
 
 	grpcListRes, err := listClient.RestoreList(ctx, grpcListReq)
 	if err != nil {
@@ -475,7 +582,7 @@ func (h *ListHandler) DeleteList(c *gin.Context) {
 
 	boardID := grpcBoardResp.BoardID
 
-	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10))
+	md := metadata.Pairs("userID", strconv.FormatUint(userID, 10), "boardID", strconv.FormatUint(boardID, 10), undo.SessionMetadataKey, getSessionID(c))
 	ctx = metadata.NewOutgoingContext(c.Request.Context(), md)
 
 	grpcListReq := &pb_list.DeleteListRequest{}
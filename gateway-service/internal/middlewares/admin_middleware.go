@@ -0,0 +1,27 @@
+package middlewares
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sm888sm/halten-backend/common/errorhandlers"
+)
+
+// AdminKeyHeader is the header admin requests carry the admin key in
+const AdminKeyHeader = "X-Admin-Key"
+
+// AdminMiddleware lets through the requests carrying the admin key. Without a configured key every
+// request is refused.
+func AdminMiddleware(adminKey string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(AdminKeyHeader)
+		if adminKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(adminKey)) != 1 {
+			c.JSON(http.StatusForbidden, errorhandlers.NewAPIError(http.StatusForbidden, "Admin key required"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
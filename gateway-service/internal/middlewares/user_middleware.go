@@ -6,6 +6,7 @@ import (
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
 	external_services "github.com/sm888sm/halten-backend/gateway-service/external/services"
 	pb_user "github.com/sm888sm/halten-backend/user-service/api/pb"
@@ -15,6 +16,12 @@ func UserMiddleware(services *external_services.Services, secretKey string) gin.
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 		authHeader := c.GetHeader("Authorization")
+
+		// Browsers can't set headers on WebSocket handshakes, so those pass the token in the query
+		if authHeader == "" && websocket.IsWebSocketUpgrade(c.Request) && c.Query("access_token") != "" {
+			authHeader = "Bearer " + c.Query("access_token")
+		}
+
 		if authHeader == "" {
 			c.JSON(http.StatusUnauthorized, errorhandlers.NewAPIError(http.StatusUnauthorized, "Authorization header not provided"))
 			c.Abort()
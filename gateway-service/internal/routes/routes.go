@@ -2,18 +2,20 @@ package routes
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/connection"
 	external_services "github.com/sm888sm/halten-backend/gateway-service/external/services"
 	"github.com/sm888sm/halten-backend/gateway-service/internal/handlers"
 	"github.com/sm888sm/halten-backend/gateway-service/internal/middlewares"
 )
 
-func SetupRoutes(r *gin.Engine, svc *external_services.Services, secretKey string) {
+func SetupRoutes(r *gin.Engine, svc *external_services.Services, conn *connection.Manager, secretKey, adminKey string) {
 
 	userHandler := handlers.NewUserHandler(svc)
 	authHandler := handlers.NewAuthHandler(svc)
 	boardHandler := handlers.NewBoardHandler(svc)
 	listHandler := handlers.NewListHandler(svc)
 	cardHandler := handlers.NewCardHandler(svc)
+	adminHandler := handlers.NewAdminHandler(conn)
 
 	userRoutes := r.Group("/user")
 	userRoutes.POST("/create", userHandler.CreateUser)
@@ -37,10 +39,14 @@ func SetupRoutes(r *gin.Engine, svc *external_services.Services, secretKey strin
 		boardRoutes.GET("/:boardID", boardHandler.GetBoardByID)
 		boardRoutes.GET("/:boardID/users", boardHandler.GetBoardMembers)
 		boardRoutes.GET("/archived", boardHandler.GetArchivedBoardList)
+		boardRoutes.GET("/:boardID/exports/:exportID", boardHandler.GetBoardExport)
+		boardRoutes.GET("/:boardID/exports/:exportID/download", boardHandler.DownloadBoardExport)
 
 		boardRoutes.POST("/", boardHandler.CreateBoard)
 
 		boardRoutes.POST("/:boardID/labels", boardHandler.AddLabel)
+		boardRoutes.POST("/:boardID/custom-fields", boardHandler.AddCustomField)
+		boardRoutes.POST("/:boardID/exports", boardHandler.ExportBoard)
 
 		boardRoutes.PUT("/:boardID/name", boardHandler.UpdateBoardName)
 		boardRoutes.PUT("/:boardID/users/add", boardHandler.AddBoardUsers)
@@ -48,11 +54,37 @@ func SetupRoutes(r *gin.Engine, svc *external_services.Services, secretKey strin
 		boardRoutes.PUT("/:boardID/users/role", boardHandler.AssignBoardUsersRole)
 		boardRoutes.PUT("/:boardID/owner", boardHandler.ChangeBoardOwner)
 		boardRoutes.PUT("/:boardID/visibility", boardHandler.ChangeBoardVisibility)
+		boardRoutes.PUT("/:boardID/card-history-retention", boardHandler.SetCardHistoryRetention)
+		boardRoutes.PUT("/:boardID/wip-limit-policy", boardHandler.SetWIPLimitPolicy)
 		boardRoutes.PUT("/:boardID/archive", boardHandler.ArchiveBoard)
 		boardRoutes.PUT("/:boardID/restore", boardHandler.RestoreBoard)
+		boardRoutes.PUT("/:boardID/custom-fields/:customFieldID", boardHandler.UpdateCustomField)
 
 		boardRoutes.DELETE("/:boardID", boardHandler.DeleteBoard)
 		boardRoutes.DELETE("/:boardID/labels/:labelID", boardHandler.RemoveLabel)
+		boardRoutes.DELETE("/:boardID/custom-fields/:customFieldID", boardHandler.RemoveCustomField)
+	}
+
+	trashRoutes := r.Group("/trash")
+	trashRoutes.Use(middlewares.UserMiddleware(svc, secretKey))
+	{
+		trashRoutes.GET("/", boardHandler.GetTrash)
+
+		trashRoutes.PUT("/:type/:id/restore", boardHandler.RestoreTrashItem)
+	}
+
+	undoRoutes := r.Group("/undo")
+	undoRoutes.Use(middlewares.UserMiddleware(svc, secretKey))
+	{
+		undoRoutes.POST("/", boardHandler.Undo)
+	}
+
+	importRoutes := r.Group("/imports")
+	importRoutes.Use(middlewares.UserMiddleware(svc, secretKey))
+	{
+		importRoutes.GET("/:importID", boardHandler.GetBoardImport)
+
+		importRoutes.POST("/trello", boardHandler.ImportTrelloBoard)
 	}
 
 	listRoutes := r.Group("/lists")
@@ -65,6 +97,7 @@ func SetupRoutes(r *gin.Engine, svc *external_services.Services, secretKey strin
 
 		listRoutes.PUT("/:listID/move", listHandler.MoveListPosition)
 		listRoutes.PUT("/:listID/name", listHandler.UpdateListName)
+		listRoutes.PUT("/:listID/wip-limit", listHandler.SetListWIPLimit)
 		listRoutes.PUT("/:listID/archive", listHandler.ArchiveList)
 		listRoutes.PUT("/:listID/restore", listHandler.RestoreList)
 
@@ -77,24 +110,50 @@ func SetupRoutes(r *gin.Engine, svc *external_services.Services, secretKey strin
 		cardRoutes.GET("/:cardID", cardHandler.GetCardByID)
 		cardRoutes.GET("/list/:listID", cardHandler.GetCardsByList)
 		cardRoutes.GET("/board/:boardID", cardHandler.GetCardsByBoard)
+		cardRoutes.GET("/:cardID/comments", cardHandler.GetCardComments)
+		cardRoutes.GET("/:cardID/description/edit", cardHandler.EditCardDescription)
+		cardRoutes.GET("/:cardID/history", cardHandler.GetCardHistory)
+		cardRoutes.GET("/:cardID/comment/:commentID/history", cardHandler.GetCardCommentHistory)
 
 		cardRoutes.POST("/", cardHandler.CreateCard)
+		cardRoutes.POST("/bulk", cardHandler.BulkUpdateCards)
+		cardRoutes.POST("/list/:listID/import", cardHandler.ImportCards)
 		cardRoutes.POST("/:cardID/attachment/:attachmentID", cardHandler.AddCardAttachment)
 		cardRoutes.POST("/:cardID/comment", cardHandler.AddCardComment)
+		cardRoutes.POST("/:cardID/comment/:commentID/reactions", cardHandler.AddCommentReaction)
 
+		cardRoutes.PUT("/list/:listID/archive", cardHandler.ArchiveAllCardsInList)
+		cardRoutes.PUT("/list/:listID/move", cardHandler.MoveAllCardsInList)
+		cardRoutes.PUT("/list/:listID/sort", cardHandler.SortCardsInList)
 		cardRoutes.PUT("/:cardID/move", cardHandler.MoveCardPosition)
 		cardRoutes.PUT("/:cardID/name", cardHandler.UpdateCardName)
+		cardRoutes.PUT("/:cardID/comment/:commentID", cardHandler.UpdateCardComment)
 		cardRoutes.PUT("/:cardID/label/:labelID", cardHandler.AddCardLabel)
 		cardRoutes.PUT("/:cardID/dates", cardHandler.SetCardDates)
+		cardRoutes.PUT("/:cardID/reminders", cardHandler.SetCardReminders)
+		cardRoutes.PUT("/:cardID/recurrence", cardHandler.SetCardRecurrence)
+		cardRoutes.PUT("/:cardID/custom-fields/:customFieldID", cardHandler.SetCardCustomFieldValue)
 		cardRoutes.PUT("/:cardID/completed", cardHandler.ToggleCardCompleted)
 		cardRoutes.PUT("/:cardID/members", cardHandler.AddCardMembers)
 		cardRoutes.PUT("/:cardID/archive", cardHandler.ArchiveCard)
 		cardRoutes.PUT("/:cardID/restore", cardHandler.RestoreCard)
+		cardRoutes.PUT("/:cardID/history/:version/restore", cardHandler.RestoreCardVersion)
 
 		cardRoutes.DELETE("/:cardID/label/:labelID", cardHandler.RemoveCardLabel)
 		cardRoutes.DELETE("/:cardID/attachment/:attachmentID", cardHandler.RemoveCardAttachment)
 		cardRoutes.DELETE("/:cardID/comment/:commentID", cardHandler.RemoveCardComment)
+		cardRoutes.DELETE("/:cardID/comment/:commentID/reactions", cardHandler.RemoveCommentReaction)
 		cardRoutes.DELETE("/:cardID/members", cardHandler.RemoveCardMembers)
+		cardRoutes.DELETE("/:cardID/recurrence", cardHandler.RemoveCardRecurrence)
+		cardRoutes.DELETE("/:cardID/custom-fields/:customFieldID", cardHandler.RemoveCardCustomFieldValue)
 		cardRoutes.DELETE("/:cardID", cardHandler.DeleteCard)
 	}
+
+	adminRoutes := r.Group("/admin")
+	adminRoutes.Use(middlewares.AdminMiddleware(adminKey))
+	{
+		adminRoutes.GET("/dead-letters", adminHandler.GetDeadLetters)
+
+		adminRoutes.POST("/dead-letters/replay", adminHandler.ReplayDeadLetters)
+	}
 }
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"log"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/sm888sm/halten-backend/gateway-service/internal/config"
+	"github.com/sm888sm/halten-backend/gateway-service/internal/connections/rabbitmq"
+
+	external_services "github.com/sm888sm/halten-backend/gateway-service/external/services"
+	"github.com/sm888sm/halten-backend/gateway-service/internal/routes"
+)
+
+// Run serves gateway-service on addr, :<PORT> when empty, with the configuration of the
+// environment. It blocks while serving, stopping the process when the service can't start.
+func Run(addr string) {
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Error loading configuration: %v", err)
+	}
+
+	// Connect to RabbitMQ
+	err = rabbitmq.Connect(&cfg.RabbitMQ)
+	if err != nil {
+		log.Fatalf("Error connecting to RabbitMQ: %v", err)
+	}
+
+	// Get services
+	svc := external_services.GetServices(&cfg.Services)
+
+	defer svc.Close()
+
+	// Initialize Gin
+	r := gin.Default()
+
+	// Setup routes
+	routes.SetupRoutes(r, svc, rabbitmq.GetConnection(), cfg.SecretKey, cfg.AdminKey)
+
+	// Start the Gin server
+	if addr == "" {
+		addr = ":" + cfg.Port
+	}
+	r.Run(addr)
+	log.Println("Application started.")
+}
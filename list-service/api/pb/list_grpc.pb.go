@@ -25,7 +25,9 @@ type ListServiceClient interface {
 	CreateList(ctx context.Context, in *CreateListRequest, opts ...grpc.CallOption) (*CreateListResponse, error)
 	GetListByID(ctx context.Context, in *GetListByIDRequest, opts ...grpc.CallOption) (*GetListByIDResponse, error)
 	GetListsByBoard(ctx context.Context, in *GetListsByBoardRequest, opts ...grpc.CallOption) (*GetListsByBoardResponse, error)
+	// TODO : Add GetArchivedListsByBoard
 	UpdateListName(ctx context.Context, in *UpdateListNameRequest, opts ...grpc.CallOption) (*UpdateListNameResponse, error)
+	SetListWIPLimit(ctx context.Context, in *SetListWIPLimitRequest, opts ...grpc.CallOption) (*SetListWIPLimitResponse, error)
 	MoveListPosition(ctx context.Context, in *MoveListPositionRequest, opts ...grpc.CallOption) (*MoveListPositionResponse, error)
 	ArchiveList(ctx context.Context, in *ArchiveListRequest, opts ...grpc.CallOption) (*ArchiveListResponse, error)
 	RestoreList(ctx context.Context, in *RestoreListRequest, opts ...grpc.CallOption) (*RestoreListResponse, error)
@@ -76,6 +78,15 @@ func (c *listServiceClient) UpdateListName(ctx context.Context, in *UpdateListNa
 	return out, nil
 }
 
+func (c *listServiceClient) SetListWIPLimit(ctx context.Context, in *SetListWIPLimitRequest, opts ...grpc.CallOption) (*SetListWIPLimitResponse, error) {
+	out := new(SetListWIPLimitResponse)
+	err := c.cc.Invoke(ctx, "/listpb.ListService/SetListWIPLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *listServiceClient) MoveListPosition(ctx context.Context, in *MoveListPositionRequest, opts ...grpc.CallOption) (*MoveListPositionResponse, error) {
 	out := new(MoveListPositionResponse)
 	err := c.cc.Invoke(ctx, "/listpb.ListService/MoveListPosition", in, out, opts...)
@@ -119,7 +130,9 @@ type ListServiceServer interface {
 	CreateList(context.Context, *CreateListRequest) (*CreateListResponse, error)
 	GetListByID(context.Context, *GetListByIDRequest) (*GetListByIDResponse, error)
 	GetListsByBoard(context.Context, *GetListsByBoardRequest) (*GetListsByBoardResponse, error)
+	// TODO : Add GetArchivedListsByBoard
 	UpdateListName(context.Context, *UpdateListNameRequest) (*UpdateListNameResponse, error)
+	SetListWIPLimit(context.Context, *SetListWIPLimitRequest) (*SetListWIPLimitResponse, error)
 	MoveListPosition(context.Context, *MoveListPositionRequest) (*MoveListPositionResponse, error)
 	ArchiveList(context.Context, *ArchiveListRequest) (*ArchiveListResponse, error)
 	RestoreList(context.Context, *RestoreListRequest) (*RestoreListResponse, error)
@@ -143,6 +156,9 @@ func (UnimplementedListServiceServer) GetListsByBoard(context.Context, *GetLists
 func (UnimplementedListServiceServer) UpdateListName(context.Context, *UpdateListNameRequest) (*UpdateListNameResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateListName not implemented")
 }
+func (UnimplementedListServiceServer) SetListWIPLimit(context.Context, *SetListWIPLimitRequest) (*SetListWIPLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetListWIPLimit not implemented")
+}
 func (UnimplementedListServiceServer) MoveListPosition(context.Context, *MoveListPositionRequest) (*MoveListPositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveListPosition not implemented")
 }
@@ -240,6 +256,24 @@ func _ListService_UpdateListName_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ListService_SetListWIPLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetListWIPLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListServiceServer).SetListWIPLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/listpb.ListService/SetListWIPLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListServiceServer).SetListWIPLimit(ctx, req.(*SetListWIPLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ListService_MoveListPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MoveListPositionRequest)
 	if err := dec(in); err != nil {
@@ -335,6 +369,10 @@ var ListService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateListName",
 			Handler:    _ListService_UpdateListName_Handler,
 		},
+		{
+			MethodName: "SetListWIPLimit",
+			Handler:    _ListService_SetListWIPLimit_Handler,
+		},
 		{
 			MethodName: "MoveListPosition",
 			Handler:    _ListService_MoveListPosition_Handler,
@@ -3,13 +3,18 @@ package config
 import (
 	"os"
 	"strconv"
+	"time"
+
+	"github.com/sm888sm/halten-backend/common/constants/messagebuses"
 )
 
 type Config struct {
-	Port     int
-	Database DatabaseConfig
-	RabbitMQ RabbitMQConfig
-	Services ServiceConfig
+	Port        int
+	Database    DatabaseConfig
+	RabbitMQ    RabbitMQConfig
+	Services    ServiceConfig
+	Outbox      OutboxConfig
+	MetricsAddr string // Serves the consumer metrics when set, such as :9090
 }
 
 type DatabaseConfig struct {
@@ -30,6 +35,12 @@ type ServiceConfig struct {
 
 type RabbitMQConfig struct { // Add this struct
 	URL string
+	Bus string // messagebuses.RabbitMQ, or messagebuses.Memory when every service runs in one process
+}
+
+type OutboxConfig struct {
+	Interval  time.Duration // How often the relay publishes the messages waiting in the outbox
+	Retention time.Duration // How long sent messages are kept
 }
 
 func LoadConfig() (*Config, error) {
@@ -43,6 +54,21 @@ func LoadConfig() (*Config, error) {
 		dbPort = 5432 // Default PostgreSQL port
 	}
 
+	outboxInterval, err := strconv.Atoi(os.Getenv("OUTBOX_INTERVAL_MILLISECONDS"))
+	if err != nil {
+		outboxInterval = 500 // Default outbox relay interval
+	}
+
+	outboxRetentionHours, err := strconv.Atoi(os.Getenv("OUTBOX_RETENTION_HOURS"))
+	if err != nil {
+		outboxRetentionHours = 24 // Default outbox retention
+	}
+
+	messageBus := os.Getenv("MESSAGE_BUS")
+	if messageBus == "" {
+		messageBus = messagebuses.RabbitMQ // Default message bus
+	}
+
 	return &Config{
 		Port: port, // Or your default
 		Database: DatabaseConfig{
@@ -60,6 +86,12 @@ func LoadConfig() (*Config, error) {
 		},
 		RabbitMQ: RabbitMQConfig{ // Add this line
 			URL: os.Getenv("RABBITMQ_URL"),
+			Bus: messageBus,
+		},
+		Outbox: OutboxConfig{
+			Interval:  time.Duration(outboxInterval) * time.Millisecond,
+			Retention: time.Duration(outboxRetentionHours) * time.Hour,
 		},
+		MetricsAddr: os.Getenv("METRICS_ADDR"),
 	}, nil
 }
@@ -2,90 +2,62 @@ package consumer
 
 import (
 	"context"
-	"fmt"
 
+	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
+	"github.com/sm888sm/halten-backend/common/messaging/bus"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/consumers"
 	pb_list "github.com/sm888sm/halten-backend/list-service/api/pb"
 	"github.com/sm888sm/halten-backend/list-service/internal/services"
-	"github.com/streadway/amqp"
-	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 )
 
 type ListConsumer struct {
-	Channel     *amqp.Channel
+	Bus         bus.Bus
+	DB          *gorm.DB
 	ListService *services.ListService
 }
 
-func NewListConsumer(ch *amqp.Channel, listService *services.ListService) *ListConsumer {
-	return &ListConsumer{Channel: ch, ListService: listService}
+func NewListConsumer(b bus.Bus, db *gorm.DB, listService *services.ListService) *ListConsumer {
+	return &ListConsumer{Bus: b, DB: db, ListService: listService}
 }
 
 func (c *ListConsumer) ConsumeListMessages(ctx context.Context) error {
-	ch := c.Channel
-
-	q, err := ch.QueueDeclare(
-		"",
-		false,
-		false,
-		true,
-		false,
-		nil)
-	if err != nil {
-		return err
-	}
-
-	// Bind the queue to multiple routing keys
-	err = ch.QueueBind(
-		q.Name,
-		"list.*", // Use a wildlist to match any routing key that starts with "list."
-		"halten",
-		false,
-		nil)
-	if err != nil {
-		return err
-	}
+	consumer := consumers.NewConsumer(c.DB, &consumers.Queue{
+		Name:        "list-service.list",
+		Prefetch:    10,
+		RetryDelays: consumers.DefaultRetryDelays,
+	})
+
+	consumer.Handle(events.ListDelete, func(ctx context.Context, msg *consumers.Message) error {
+		req := &pb_list.DeleteListRequest{}
+		if err := events.Unpack(msg.Event, req); err != nil {
+			return consumers.Permanent(err)
+		}
 
-	msgs, err := ch.Consume(
-		q.Name,
-		"",
-		true,
-		false,
-		false,
-		false,
-		nil)
-	if err != nil {
+		_, err := c.ListService.DeleteList(ctx, req)
 		return err
-	}
+	})
 
-	go func() {
-		for d := range msgs {
-			fmt.Println("Received a message: ", d.RoutingKey)
-			switch d.RoutingKey {
-			case "list.delete":
-				req := &pb_list.DeleteListRequest{}
-				err := proto.Unmarshal(d.Body, req)
-				if err != nil {
-					// Log the error or handle it as needed
-					continue
-				}
-
-				_, err = c.ListService.DeleteList(ctx, req)
-				if err != nil {
-					// TODO: Log the error or handle it as needed
-				}
-
-				continue
-
-			case "list.update":
-				// Handle list.update messages
-				// ...
+	return consumer.Run(ctx, c.Bus)
+}
 
-				// Add more cases as needed
-			default:
-				// Log or handle unknown routing keys
-				// ...
-			}
+// ConsumeBoardMessages deletes the lists of boards deleted for good
+func (c *ListConsumer) ConsumeBoardMessages(ctx context.Context) error {
+	consumer := consumers.NewConsumer(c.DB, &consumers.Queue{
+		Name:        "list-service.board",
+		Prefetch:    10,
+		RetryDelays: consumers.DefaultRetryDelays,
+	})
+
+	consumer.Handle(events.BoardListsCleanupRequested, func(ctx context.Context, msg *consumers.Message) error {
+		event := &pb_board.BoardCleanupEvent{}
+		if err := events.Unpack(msg.Event, event); err != nil {
+			return consumers.Permanent(err)
 		}
-	}()
 
-	return nil
+		return c.ListService.PurgeBoardLists(ctx, event)
+	})
+
+	return consumer.Run(ctx, c.Bus)
 }
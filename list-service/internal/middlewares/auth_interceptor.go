@@ -29,6 +29,7 @@ var (
 	checkRole = map[string]string{
 		"/proto.ListService/CreateList":       roles.MemberRole,
 		"/proto.BoardService/UpdateListName":  roles.MemberRole,
+		"/proto.ListService/SetListWIPLimit":  roles.MemberRole,
 		"/proto.ListService/MoveListPosition": roles.MemberRole,
 		"/proto.ListService/ArchiveList":      roles.MemberRole,
 		"/proto.ListService/RestoreList":      roles.MemberRole,
@@ -36,6 +36,10 @@ func (v *ValidatorInterceptor) ValidationInterceptor(ctx context.Context, req in
 		if err := validateUpdateListNameRequest(req.(*pb_list.UpdateListNameRequest)); err != nil {
 			return nil, err
 		}
+	case "/proto.ListService/SetListWIPLimit":
+		if err := validateSetListWIPLimitRequest(req.(*pb_list.SetListWIPLimitRequest)); err != nil {
+			return nil, err
+		}
 	case "/proto.ListService/MoveListPosition":
 		if err := validateMoveListPositionRequest(req.(*pb_list.MoveListPositionRequest)); err != nil {
 			return nil, err
@@ -96,6 +100,20 @@ func validateUpdateListNameRequest(req *pb_list.UpdateListNameRequest) error {
 	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
 }
 
+func validateSetListWIPLimitRequest(req *pb_list.SetListWIPLimitRequest) error {
+	fieldErrors := make(map[string]errorhandlers.FieldError)
+
+	if req.WipLimit < 0 {
+		fieldErrors["WIPLimit"] = errorhandlers.FieldError{
+			Code:    fielderrors.ErrOutOfRange,
+			Message: "WIPLimit cannot be negative",
+			Field:   "WIPLimit",
+		}
+	}
+
+	return errorhandlers.CreateGrpcErrorFromFieldErrors(fieldErrors)
+}
+
 func validateMoveListPositionRequest(req *pb_list.MoveListPositionRequest) error {
 	fieldErrors := make(map[string]errorhandlers.FieldError)
 
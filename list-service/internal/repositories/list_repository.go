@@ -2,9 +2,12 @@ package repositories
 
 import (
 	"errors"
-	"sort"
+	"fmt"
 
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
+	"github.com/sm888sm/halten-backend/common/positions"
+	"github.com/sm888sm/halten-backend/common/transactions"
+	"github.com/sm888sm/halten-backend/common/undo"
 	models "github.com/sm888sm/halten-backend/models"
 
 	"gorm.io/gorm"
@@ -20,18 +23,18 @@ func NewListRepository(db *gorm.DB) *GormListRepository {
 }
 
 func (r *GormListRepository) CreateList(req *CreateListRequest) (*CreateListResponse, error) {
-	var maxPosition int64
-	if err := r.db.Model(&models.List{}).Where("board_id = ?", req.List.BoardID).Select("max(position)").Row().Scan(&maxPosition); err != nil {
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errorhandlers.NewGrpcInternalError()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := r.lockBoard(tx, req.List.BoardID); err != nil {
+			return err
 		}
-		// If no other list exists, set maxPosition to 0
-		maxPosition = 0
-	}
 
-	req.List.Position = maxPosition + 1
+		sequence := &positions.Sequence{Table: "lists", Column: "board_id", ParentID: req.List.BoardID}
+		position, err := sequence.Append(tx)
+		if err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		req.List.Position = position
 
-	err := r.db.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Create(req.List).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
@@ -61,25 +64,64 @@ func (r *GormListRepository) GetListByID(req *GetListRequest) (*GetListResponse,
 func (r *GormListRepository) GetListsByBoard(req *GetListsByBoardRequest) (*GetListsByBoardResponse, error) {
 
 	var lists []*models.List
-	if err := r.db.Where("board_id = ?", req.BoardID).Find(&lists).Error; err != nil {
+	if err := r.db.Where("board_id = ?", req.BoardID).Order("position, id").Find(&lists).Error; err != nil {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	var counts []struct {
+		ListID    uint64
+		CardCount int64
+	}
+	if err := r.db.Model(&models.Card{}).
+		Select("list_id, COUNT(*) AS card_count").
+		Where("board_id = ? AND is_archived = ?", req.BoardID, false).
+		Group("list_id").
+		Scan(&counts).Error; err != nil {
 		return nil, errorhandlers.NewGrpcInternalError()
 	}
 
-	return &GetListsByBoardResponse{Lists: lists}, nil
+	cardCounts := make(map[uint64]int64, len(counts))
+	for _, count := range counts {
+		cardCounts[count.ListID] = count.CardCount
+	}
+
+	return &GetListsByBoardResponse{Lists: lists, CardCounts: cardCounts}, nil
 }
 
 func (r *GormListRepository) UpdateListName(req *UpdateListNameRequest) error {
 
-	var existingList models.List
-	if err := r.db.Where("id = ? AND board_id = ?", req.ID, req.BoardID).First(&existingList).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errorhandlers.NewGrpcNotFoundError("List not found")
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		list, err := r.lockList(tx, req.ID, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
 		}
-		return errorhandlers.NewGrpcInternalError()
-	}
+
+		if list.Name == req.Name {
+			return nil
+		}
+
+		if err := tx.Model(list).Updates(map[string]interface{}{"name": req.Name, "version": list.Version + 1}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		return nil
+	})
+}
+
+// SetListWIPLimit changes how many cards the list should hold. Lowering the limit below the cards
+// already in the list is allowed; it only affects cards added afterwards.
+func (r *GormListRepository) SetListWIPLimit(req *SetListWIPLimitRequest) error {
 
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Model(&existingList).Updates(existingList).Update("name", req.Name).Error; err != nil {
+		list, err := r.lockList(tx, req.ListID, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		if list.WIPLimit == req.WIPLimit {
+			return nil
+		}
+
+		if err := tx.Model(list).Updates(map[string]interface{}{"wip_limit": req.WIPLimit, "version": list.Version + 1}).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
 		return nil
@@ -88,80 +130,161 @@ func (r *GormListRepository) UpdateListName(req *UpdateListNameRequest) error {
 
 func (r *GormListRepository) MoveListPosition(req *MoveListPositionRequest) error {
 
-	var count int64
-	r.db.Model(&models.List{}).Where("id = ? AND board_id = ?", req.ID, req.BoardID).Count(&count)
-	if count == 0 {
-		return errorhandlers.NewGrpcNotFoundError("List not found")
-	}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Moves on the same board are serialized on the board row; only the moved list is written
+		if err := r.lockBoard(tx, req.BoardID); err != nil {
+			return err
+		}
+
+		list, err := r.lockList(tx, req.ID, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		sequence := &positions.Sequence{Table: "lists", Column: "board_id", ParentID: req.BoardID}
+		position, err := sequence.Place(tx, list.ID, req.Position)
+		if err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		previousPosition, version := list.Position, list.Version+1
+		if err := tx.Model(list).Updates(map[string]interface{}{"position": position, "version": version}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    req.BoardID,
+			EntityType: undo.EntityList,
+			EntityID:   list.ID,
+			Action:     undo.ActionMove,
+			Version:    version,
+			Inverse:    &undo.Move{Position: previousPosition},
+		})
+	})
+}
+
+func (r *GormListRepository) ArchiveList(req *ArchiveListRequest) error {
 
 	return r.db.Transaction(func(tx *gorm.DB) error {
-		// Get all lists
-		var lists []*models.List
-		if err := tx.Where("board_id = ?", req.BoardID).Find(&lists).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errorhandlers.NewGrpcNotFoundError("List not found")
-			}
+		list, err := r.lockList(tx, req.ListID, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
+		}
+
+		version := list.Version + 1
+		if err := tx.Model(list).Updates(map[string]interface{}{"is_archived": true, "version": version}).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
 
-		// Sort lists by position
-		sort.Slice(lists, func(i, j int) bool {
-			return lists[i].Position < lists[j].Position
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    req.BoardID,
+			EntityType: undo.EntityList,
+			EntityID:   list.ID,
+			Action:     undo.ActionArchive,
+			Version:    version,
 		})
+	})
+}
+
+func (r *GormListRepository) RestoreList(req *RestoreListRequest) error {
 
-		// Update the positions of the lists
-		for i, l := range lists {
-			if l.ID == req.ID {
-				l.Position = req.Position
-			} else {
-				// Adjust the position to start from 1 and ensure no gaps
-				l.Position = int64(i + 1)
-				if l.Position >= req.Position {
-					l.Position++
-				}
-			}
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		list, err := r.lockList(tx, req.ListID, req.BoardID, req.ExpectedVersion)
+		if err != nil {
+			return err
 		}
 
-		// Save the updated lists
-		for _, l := range lists {
-			if err := tx.Save(l).Error; err != nil {
-				return errorhandlers.NewGrpcInternalError()
-			}
+		version := list.Version + 1
+		if err := tx.Model(list).Updates(map[string]interface{}{"is_archived": false, "version": version}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
 		}
 
-		return nil
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    req.BoardID,
+			EntityType: undo.EntityList,
+			EntityID:   list.ID,
+			Action:     undo.ActionRestore,
+			Version:    version,
+		})
 	})
 }
 
-func (r *GormListRepository) ArchiveList(req *ArchiveListRequest) error {
-	if err := r.db.Model(&models.List{}).Where("id = ? AND board_id = ?", req.ListID, req.BoardID).Update("archived", true).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
+func (r *GormListRepository) DeleteList(req *DeleteListRequest) error {
+
+	return transactions.Join(r.db, req.Tx, func(tx *gorm.DB) error {
+		// Cards share the list's deletion time so restoring the list brings back exactly what was
+		// deleted with it
+		now := undo.Timestamp()
+		result := tx.Model(&models.List{}).Where("id = ? AND board_id = ?", req.ID, req.BoardID).UpdateColumn("deleted_at", now)
+		if result.Error != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+		if result.RowsAffected == 0 {
 			return errorhandlers.NewGrpcNotFoundError("List not found")
 		}
+
+		if err := tx.Model(&models.Card{}).Where("list_id = ?", req.ID).UpdateColumn("deleted_at", now).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		return undo.Record(tx, req.Actor, &undo.Entry{
+			BoardID:    req.BoardID,
+			EntityType: undo.EntityList,
+			EntityID:   req.ID,
+			Action:     undo.ActionDelete,
+			Inverse:    &undo.Delete{DeletedAt: now},
+		})
+	})
+}
+
+// lockBoard locks the board row until the end of the transaction, serializing list position changes
+func (r *GormListRepository) lockBoard(tx *gorm.DB, boardID uint64) error {
+	var board models.Board
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").Where("id = ?", boardID).First(&board).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorhandlers.NewGrpcNotFoundError("Board not found")
+		}
 		return errorhandlers.NewGrpcInternalError()
 	}
+
 	return nil
 }
 
-func (r *GormListRepository) RestoreList(req *RestoreListRequest) error {
-	if err := r.db.Model(&models.List{}).Where("id = ? AND board_id = ?", req.ListID, req.BoardID).Update("archived", false).Error; err != nil {
+// lockList loads the list and locks its row until the end of the transaction. When an expected
+// version is given, a list changed since the client read it is rejected as a conflict.
+func (r *GormListRepository) lockList(tx *gorm.DB, listID uint64, boardID uint64, expectedVersion uint64) (*models.List, error) {
+	var list models.List
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND board_id = ?", listID, boardID).First(&list).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return errorhandlers.NewGrpcNotFoundError("List not found")
+			return nil, errorhandlers.NewGrpcNotFoundError("List not found")
 		}
-		return errorhandlers.NewGrpcInternalError()
+		return nil, errorhandlers.NewGrpcInternalError()
 	}
-	return nil
+
+	if expectedVersion != 0 && list.Version != expectedVersion {
+		return nil, errorhandlers.NewGrpcConflictError(fmt.Sprintf("List has been modified, current version is %d", list.Version))
+	}
+
+	return &list, nil
 }
 
-func (r *GormListRepository) DeleteList(req *DeleteListRequest) error {
+// PurgeBoardLists hard-deletes every list of a board being deleted for good, whose cards are gone
+// already. A board whose lists are already gone is confirmed again.
+func (r *GormListRepository) PurgeBoardLists(req *PurgeBoardListsRequest) error {
+	return transactions.Join(r.db, req.Tx, func(tx *gorm.DB) error {
+		listIDs := tx.Unscoped().Model(&models.List{}).Select("id").Where("board_id = ?", req.BoardID)
 
-	return r.db.Transaction(func(tx *gorm.DB) error {
-		if err := tx.Where("id = ? AND board_id = ?", req.ID, req.BoardID).Delete(&models.List{}).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return errorhandlers.NewGrpcNotFoundError("List not found")
-			}
+		if err := tx.Unscoped().Where("list_id IN (?)", listIDs).Delete(&models.Watch{}).Error; err != nil {
 			return errorhandlers.NewGrpcInternalError()
 		}
+		if err := tx.Unscoped().Where("board_id = ?", req.BoardID).Delete(&models.List{}).Error; err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
+		if err := req.Completed.Write(tx, req.BoardID); err != nil {
+			return errorhandlers.NewGrpcInternalError()
+		}
+
 		return nil
 	})
 }
@@ -1,7 +1,11 @@
 package repositories
 
 import (
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+	"github.com/sm888sm/halten-backend/common/undo"
 	models "github.com/sm888sm/halten-backend/models"
+
+	"gorm.io/gorm"
 )
 
 type CreateListRequest struct {
@@ -29,37 +33,60 @@ type GetListsByBoardRequest struct {
 }
 
 type GetListsByBoardResponse struct {
-	Lists []*models.List
+	Lists      []*models.List
+	CardCounts map[uint64]int64 // Unarchived cards per list, the ones counting towards WIP limits
 }
 
 type UpdateListNameRequest struct {
-	ID      uint64
-	Name    string
-	BoardID uint64
-	UserID  uint64
+	ID              uint64
+	Name            string
+	BoardID         uint64
+	UserID          uint64
+	ExpectedVersion uint64 // Zero skips the version check
 }
 
 type ArchiveListRequest struct {
-	ListID  uint64
-	BoardID uint64
+	ListID          uint64
+	BoardID         uint64
+	ExpectedVersion uint64      // Zero skips the version check
+	Actor           *undo.Actor // Records the action in the actor's undo log when set
 }
 
 type RestoreListRequest struct {
-	ListID  uint64
-	BoardID uint64
+	ListID          uint64
+	BoardID         uint64
+	ExpectedVersion uint64      // Zero skips the version check
+	Actor           *undo.Actor // Records the action in the actor's undo log when set
 }
 
 type DeleteListRequest struct {
 	ID      uint64
 	BoardID uint64
 	UserID  uint64
+	Actor   *undo.Actor // Records the action in the actor's undo log when set
+	Tx      *gorm.DB    // Transaction to write in, a new one when nil
+}
+
+type SetListWIPLimitRequest struct {
+	ListID          uint64
+	BoardID         uint64
+	WIPLimit        int
+	ExpectedVersion uint64 // Zero skips the version check
 }
 
 type MoveListPositionRequest struct {
-	ID       uint64
-	Position int64
-	BoardID  uint64
-	UserID   uint64
+	ID              uint64
+	Position        int64
+	BoardID         uint64
+	UserID          uint64
+	ExpectedVersion uint64      // Zero skips the version check
+	Actor           *undo.Actor // Records the action in the actor's undo log when set
+}
+
+type PurgeBoardListsRequest struct {
+	BoardID   uint64
+	Completed publishers.Event // Written with the board ID, confirming the lists are gone
+	Tx        *gorm.DB         // Transaction to write in, a new one when nil
 }
 
 type ListRepository interface {
@@ -67,8 +94,10 @@ type ListRepository interface {
 	GetListByID(req *GetListRequest) (*GetListResponse, error)
 	GetListsByBoard(req *GetListsByBoardRequest) (*GetListsByBoardResponse, error)
 	UpdateListName(req *UpdateListNameRequest) error
+	SetListWIPLimit(req *SetListWIPLimitRequest) error
 	MoveListPosition(req *MoveListPositionRequest) error
 	ArchiveList(req *ArchiveListRequest) error
 	RestoreList(req *RestoreListRequest) error
 	DeleteList(req *DeleteListRequest) error
+	PurgeBoardLists(req *PurgeBoardListsRequest) error
 }
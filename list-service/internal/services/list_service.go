@@ -3,21 +3,29 @@ package services
 import (
 	"context"
 
+	pb_board "github.com/sm888sm/halten-backend/board-service/api/pb"
 	"github.com/sm888sm/halten-backend/common/constants/contextkeys"
+	"github.com/sm888sm/halten-backend/common/constants/deletionsteps"
 	"github.com/sm888sm/halten-backend/common/errorhandlers"
 	"github.com/sm888sm/halten-backend/common/helpers"
+	"github.com/sm888sm/halten-backend/common/messaging/events"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+	"github.com/sm888sm/halten-backend/common/transactions"
+	"github.com/sm888sm/halten-backend/common/undo"
 	pb "github.com/sm888sm/halten-backend/list-service/api/pb"
 	"github.com/sm888sm/halten-backend/list-service/internal/repositories"
 	models "github.com/sm888sm/halten-backend/models"
+	"google.golang.org/protobuf/proto"
 )
 
 type ListService struct {
-	listRepo repositories.ListRepository
+	listRepo   repositories.ListRepository
+	publishers *publishers.Publishers
 	pb.UnimplementedListServiceServer
 }
 
-func NewListService(repo repositories.ListRepository) *ListService {
-	return &ListService{listRepo: repo}
+func NewListService(repo repositories.ListRepository, publishers *publishers.Publishers) *ListService {
+	return &ListService{listRepo: repo, publishers: publishers}
 }
 
 /*
@@ -54,6 +62,8 @@ func (s *ListService) GetListsByID(ctx context.Context, req *pb.GetListByIDReque
 			BoardID:  list.BoardID,
 			Name:     list.Name,
 			Position: list.Position,
+			Version:  list.Version,
+			WipLimit: int32(list.WIPLimit),
 		}
 	}
 
@@ -88,11 +98,16 @@ func (s *ListService) GetListsByBoard(ctx context.Context, req *pb.GetListsByBoa
 	}
 
 	for i, list := range repoRes.Lists {
+		cardCount := repoRes.CardCounts[list.ID]
 		res.Lists[i] = &pb.List{
-			ListID:   list.ID,
-			BoardID:  list.BoardID,
-			Name:     list.Name,
-			Position: list.Position,
+			ListID:       list.ID,
+			BoardID:      list.BoardID,
+			Name:         list.Name,
+			Position:     list.Position,
+			Version:      list.Version,
+			WipLimit:     int32(list.WIPLimit),
+			CardCount:    cardCount,
+			OverWipLimit: list.WIPLimit > 0 && cardCount > int64(list.WIPLimit),
 		}
 	}
 
@@ -135,6 +150,7 @@ func (s *ListService) CreateList(ctx context.Context, req *pb.CreateListRequest)
 			BoardID:  resp.List.BoardID,
 			Name:     resp.List.Name,
 			Position: resp.List.Position,
+			Version:  resp.List.Version,
 		},
 	}, nil
 }
@@ -151,10 +167,11 @@ func (s *ListService) UpdateListName(ctx context.Context, req *pb.UpdateListName
 	}
 
 	updateReq := &repositories.UpdateListNameRequest{
-		ID:      req.ListID,
-		Name:    req.Name,
-		BoardID: boardID,
-		UserID:  userID,
+		ID:              req.ListID,
+		Name:            req.Name,
+		BoardID:         boardID,
+		UserID:          userID,
+		ExpectedVersion: req.ExpectedVersion,
 	}
 
 	if err := s.listRepo.UpdateListName(updateReq); err != nil {
@@ -166,6 +183,26 @@ func (s *ListService) UpdateListName(ctx context.Context, req *pb.UpdateListName
 	}, nil
 }
 
+func (s *ListService) SetListWIPLimit(ctx context.Context, req *pb.SetListWIPLimitRequest) (*pb.SetListWIPLimitResponse, error) {
+	boardID, ok := ctx.Value(contextkeys.BoardIDKey{}).(uint64)
+	if !ok {
+		return nil, errorhandlers.NewGrpcInternalError()
+	}
+
+	if err := s.listRepo.SetListWIPLimit(&repositories.SetListWIPLimitRequest{
+		ListID:          req.ListID,
+		BoardID:         boardID,
+		WIPLimit:        int(req.WipLimit),
+		ExpectedVersion: req.ExpectedVersion,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &pb.SetListWIPLimitResponse{
+		Message: "List WIP limit updated successfully",
+	}, nil
+}
+
 func (s *ListService) MoveListPosition(ctx context.Context, req *pb.MoveListPositionRequest) (*pb.MoveListPositionResponse, error) {
 	userID, ok := ctx.Value(contextkeys.UserIDKey{}).(uint64)
 	if !ok {
@@ -178,10 +215,12 @@ func (s *ListService) MoveListPosition(ctx context.Context, req *pb.MoveListPosi
 	}
 
 	moveListPositionReq := &repositories.MoveListPositionRequest{
-		ID:       req.ListID,
-		Position: req.Position,
-		BoardID:  boardID,
-		UserID:   userID,
+		ID:              req.ListID,
+		Position:        req.Position,
+		BoardID:         boardID,
+		UserID:          userID,
+		ExpectedVersion: req.ExpectedVersion,
+		Actor:           undo.ActorFromContext(ctx),
 	}
 
 	if err := s.listRepo.MoveListPosition(moveListPositionReq); err != nil {
@@ -200,8 +239,10 @@ func (s *ListService) ArchiveList(ctx context.Context, req *pb.ArchiveListReques
 	}
 
 	archiveListReq := &repositories.ArchiveListRequest{
-		ListID:  req.ListID,
-		BoardID: boardID,
+		ListID:          req.ListID,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
+		Actor:           undo.ActorFromContext(ctx),
 	}
 
 	if err := s.listRepo.ArchiveList(archiveListReq); err != nil {
@@ -220,8 +261,10 @@ func (s *ListService) RestoreList(ctx context.Context, req *pb.RestoreListReques
 	}
 
 	restoreReq := &repositories.RestoreListRequest{
-		ListID:  req.ListID,
-		BoardID: boardID,
+		ListID:          req.ListID,
+		BoardID:         boardID,
+		ExpectedVersion: req.ExpectedVersion,
+		Actor:           undo.ActorFromContext(ctx),
 	}
 
 	if err := s.listRepo.RestoreList(restoreReq); err != nil {
@@ -248,6 +291,8 @@ func (s *ListService) DeleteList(ctx context.Context, req *pb.DeleteListRequest)
 		ID:      req.ListID,
 		BoardID: boardID,
 		UserID:  userID,
+		Actor:   undo.ActorFromContext(ctx),
+		Tx:      transactions.FromContext(ctx),
 	}
 
 	err := s.listRepo.DeleteList(deleteReq)
@@ -257,3 +302,15 @@ func (s *ListService) DeleteList(ctx context.Context, req *pb.DeleteListRequest)
 
 	return &pb.DeleteListResponse{Message: "List deleted successfully"}, nil
 }
+
+// PurgeBoardLists deletes the lists of a board being deleted for good, then confirms it to
+// board-service
+func (s *ListService) PurgeBoardLists(ctx context.Context, event *pb_board.BoardCleanupEvent) error {
+	return s.listRepo.PurgeBoardLists(&repositories.PurgeBoardListsRequest{
+		BoardID: event.BoardID,
+		Completed: publishers.NewEvent(s.publishers.BoardPublisher, publishers.BoardCleanupCompleted, events.MetadataFromContext(ctx), func(ids []uint64) proto.Message {
+			return &pb_board.BoardCleanupEvent{BoardID: ids[0], Step: deletionsteps.Lists}
+		}),
+		Tx: transactions.FromContext(ctx),
+	})
+}
@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/sm888sm/halten-backend/list-service/api/pb"
+	external_services "github.com/sm888sm/halten-backend/list-service/external/services"
+	consumer "github.com/sm888sm/halten-backend/list-service/internal/messaging/rabbitmq/consumer"
+
+	"github.com/sm888sm/halten-backend/common/messaging/outbox"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/consumers"
+	"github.com/sm888sm/halten-backend/common/messaging/rabbitmq/publishers"
+
+	"github.com/sm888sm/halten-backend/list-service/internal/config"
+	"github.com/sm888sm/halten-backend/list-service/internal/connections/db"
+	"github.com/sm888sm/halten-backend/list-service/internal/connections/rabbitmq"
+
+	"github.com/sm888sm/halten-backend/list-service/internal/middlewares"
+	"github.com/sm888sm/halten-backend/list-service/internal/repositories"
+	"github.com/sm888sm/halten-backend/list-service/internal/services"
+)
+
+// Run serves list-service on addr, :<PORT> when empty, with the configuration of the environment. It
+// blocks, stopping the process when the service can't start or stops serving.
+func Run(addr string) {
+	// Load configuration
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Error loading configuration: %v", err)
+	}
+
+	// Connect to database
+	err = db.Connect(&cfg.Database)
+	if err != nil {
+		log.Fatalf("Error connecting to database: %v", err)
+	} else {
+		log.Printf("Successfully connected to the database.")
+	}
+
+	sqlDB, err := db.SQLConn.DB()
+	if err != nil {
+		log.Fatalf("Error getting underlying sql.DB: %v", err)
+	}
+	defer sqlDB.Close()
+
+	// Connect to RabbitMQ
+	err = rabbitmq.Connect(&cfg.RabbitMQ)
+	if err != nil {
+		log.Fatalf("Error connecting to RabbitMQ: %v", err)
+	} else {
+		log.Printf("Successfully connected to RabbitMQ.")
+	}
+
+	// Initialize repositories
+	listRepo := repositories.NewListRepository(db.SQLConn)
+
+	// Initialize external services
+	svc := external_services.GetServices(&cfg.Services)
+	defer svc.Close()
+
+	// Initialize publishers
+	publishers := &publishers.Publishers{
+		BoardPublisher: publishers.NewBoardPublisher(),
+	}
+
+	// Publish the messages written to the outbox
+	relay := outbox.NewRelay(db.SQLConn, rabbitmq.GetBus(), cfg.Outbox.Interval, cfg.Outbox.Retention)
+	go relay.Run(context.Background())
+
+	// Initialize services
+	listService := services.NewListService(listRepo, publishers)
+
+	// Create gRPC server with validation interceptor
+
+	AuthInterceptor := middlewares.NewAuthInterceptor(db.SQLConn, svc)
+	validatorInterceptor := middlewares.NewValidatorInterceptor(db.SQLConn)
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			AuthInterceptor.AuthInterceptor,
+			validatorInterceptor.ValidationInterceptor,
+		),
+	)
+
+	// Register services
+	pb.RegisterListServiceServer(grpcServer, listService)
+
+	// Run RabbitMQ Consumer
+	runListConsumer(listService)
+
+	// Expose the consumer metrics
+	if cfg.MetricsAddr != "" {
+		go consumers.ServeMetrics(cfg.MetricsAddr)
+	}
+
+	// Start listening
+	if addr == "" {
+		addr = fmt.Sprintf(":%d", cfg.Port)
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+
+	log.Printf("Service listening on %s", addr)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+}
+
+func runListConsumer(boardService *services.ListService) {
+	// Each queue is consumed on a subscription of its own
+	b := rabbitmq.GetBus()
+
+	// Initialize your consumer here.
+	c := consumer.NewListConsumer(b, db.SQLConn, boardService)
+
+	// Run the consumer in a separate goroutine because it's a blocking operation
+	go func() {
+		err := c.ConsumeListMessages(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to consume messages: %v", err)
+		}
+	}()
+
+	go func() {
+		err := c.ConsumeBoardMessages(context.Background())
+		if err != nil {
+			log.Fatalf("Failed to consume board messages: %v", err)
+		}
+	}()
+}
@@ -2,13 +2,18 @@ package models
 
 type Board struct {
 	BaseModel
-	UserID     uint64
-	Name       string `gorm:"type:varchar(50)"`
-	Visibility string `gorm:"type:visibility_enum;default:'private'"`
-	IsArchived bool
-	Members    []BoardMember `gorm:"foreignKey:BoardID"`
-	Lists      []List        `gorm:"foreignKey:BoardID"`
-	Cards      []Card        `gorm:"foreignKey:BoardID"`
-	Watches    []Watch       `gorm:"foreignKey:BoardID"`
-	Labels     []Label       `gorm:"foreignKey:BoardID"`
+	UserID           uint64
+	Name             string `gorm:"type:varchar(50)"`
+	Visibility       string `gorm:"type:visibility_enum;default:'private'"`
+	IsArchived       bool
+	Version          uint64        `gorm:"not null;default:1"`                         // Incremented on every update, for optimistic concurrency
+	CardHistoryLimit int           `gorm:"not null;default:0"`                         // Versions kept per card, zero keeps all
+	CardHistoryDays  int           `gorm:"not null;default:0"`                         // Days versions are kept for, zero keeps them forever
+	WIPLimitPolicy   string        `gorm:"type:varchar(10);not null;default:'refuse'"` // refuse or warn when a card is added to a full list
+	Members          []BoardMember `gorm:"foreignKey:BoardID"`
+	Lists            []List        `gorm:"foreignKey:BoardID"`
+	Cards            []Card        `gorm:"foreignKey:BoardID"`
+	Watches          []Watch       `gorm:"foreignKey:BoardID"`
+	Labels           []Label       `gorm:"foreignKey:BoardID"`
+	CustomFields     []CustomField `gorm:"foreignKey:BoardID"`
 }
@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// BoardDeletion tracks a board being deleted for good, one step at a time. The board stays in the
+// trash, where it can no longer be restored, until every step is confirmed and the row is removed.
+type BoardDeletion struct {
+	BoardID       uint64    `gorm:"primaryKey;autoIncrement:false"`
+	Status        string    `gorm:"type:varchar(10);not null;default:'deleting';index"` // deleting or failed
+	Step          string    `gorm:"type:varchar(10);not null"`                          // Step waiting for its confirmation
+	Attempts      int       `gorm:"not null;default:1"`                                 // Requests sent for the step
+	LastError     string    `gorm:"type:varchar(255)"`
+	StepStartedAt time.Time `gorm:"not null;index"` // When the step was last requested
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// BoardExport is an export of a board requested by a user and generated in the background
+type BoardExport struct {
+	BaseModel
+	BoardID     uint64 `gorm:"not null;index"`
+	UserID      uint64 `gorm:"not null"`
+	Format      string `gorm:"type:varchar(10);not null"`                   // json, csv or markdown
+	Status      string `gorm:"type:varchar(10);not null;default:'pending'"` // pending, running, completed or failed
+	Progress    int    `gorm:"not null;default:0"`                          // Percentage of the board written so far
+	FileName    string `gorm:"type:varchar(255)"`
+	FilePath    string `gorm:"type:varchar(255)"` // Relative to the export directory
+	Error       string `gorm:"type:varchar(255)"`
+	CompletedAt *time.Time
+}
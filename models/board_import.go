@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// BoardImport is an import of a board from another tool, requested by a user and run in the
+// background
+type BoardImport struct {
+	BaseModel
+	UserID      uint64  `gorm:"not null;index"`
+	BoardID     *uint64 // The created board, never set for a dry run
+	Source      string  `gorm:"type:varchar(10);not null"`                   // trello
+	DryRun      bool    `gorm:"not null;default:false"`                      // Only reports what would be imported
+	Status      string  `gorm:"type:varchar(10);not null;default:'pending'"` // pending, running, completed or failed
+	Content     []byte  `gorm:"type:bytea"`                                  // The uploaded file, cleared once the import has run
+	Report      string  `gorm:"type:text"`                                   // JSON encoded report of what was imported and skipped
+	Error       string  `gorm:"type:varchar(255)"`
+	CompletedAt *time.Time
+}
@@ -15,9 +15,15 @@ type Card struct {
 	IsCompleted bool
 	StartDate   *time.Time
 	DueDate     *time.Time
-	Attachments []Attachment `gorm:"foreignKey:CardID"`
-	Comments    []Comment    `gorm:"foreignKey:CardID"`
-	Labels      []Label      `gorm:"many2many:card_labels"`
-	Members     []CardMember `gorm:"foreignKey:CardID"`
-	Watches     []Watch      `gorm:"foreignKey:CardID"`
+	Version     uint64 `gorm:"not null;default:1"` // Incremented on every update, for optimistic concurrency
+	// OverdueNotifiedAt is set once the card.overdue event has been emitted
+	OverdueNotifiedAt *time.Time
+	Attachments       []Attachment           `gorm:"foreignKey:CardID"`
+	Comments          []Comment              `gorm:"foreignKey:CardID"`
+	Labels            []Label                `gorm:"many2many:card_labels"`
+	Members           []CardMember           `gorm:"foreignKey:CardID"`
+	Watches           []Watch                `gorm:"foreignKey:CardID"`
+	Reminders         []CardReminder         `gorm:"foreignKey:CardID"`
+	Recurrence        *CardRecurrence        `gorm:"foreignKey:CardID"`
+	CustomFieldValues []CardCustomFieldValue `gorm:"foreignKey:CardID"`
 }
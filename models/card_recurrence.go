@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+)
+
+// CardRecurrence is attached to the latest card of a recurring series. Whenever a copy is created
+// the recurrence moves over to the copy.
+type CardRecurrence struct {
+	BaseModel
+	CardID       uint64 `gorm:"not null;uniqueIndex"`
+	Frequency    string `gorm:"type:varchar(10);not null"` // daily, weekly or monthly
+	Interval     int    `gorm:"not null;default:1"`
+	Weekdays     string `gorm:"type:varchar(20)"` // comma separated RRULE weekdays, e.g. MO,WE,FR
+	MonthDay     int
+	TargetListID uint64    `gorm:"not null"`
+	NextRunAt    time.Time `gorm:"not null;index"`
+}
@@ -0,0 +1,12 @@
+package models
+
+import (
+	"time"
+)
+
+type CardReminder struct {
+	BaseModel
+	CardID        uint64 `gorm:"not null;index"`
+	OffsetMinutes int64  `gorm:"not null"`
+	NotifiedAt    *time.Time
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+)
+
+// CardVersion is a snapshot of the fields of a card kept in its history, taken whenever one of
+// them changes
+type CardVersion struct {
+	BaseModel
+	CardID      uint64 `gorm:"not null;uniqueIndex:idx_card_version"`
+	Version     uint64 `gorm:"not null;uniqueIndex:idx_card_version"` // Version of the card the snapshot was taken at
+	Name        string `gorm:"type:varchar(50);not null"`
+	Description string `gorm:"type:varchar(16384)"`
+	StartDate   *time.Time
+	DueDate     *time.Time
+	LabelIDs    string `gorm:"type:text"` // comma separated label IDs
+	MemberIDs   string `gorm:"type:text"` // comma separated user IDs
+}
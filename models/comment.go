@@ -1,9 +1,40 @@
 package models
 
+import (
+	"time"
+)
+
 type Comment struct {
 	BaseModel
-	CardID  uint64
-	UserID  uint64
-	Content string `gorm:"type:varchar(1024)"`
-	User    User   `gorm:"foreignKey:UserID"`
+	CardID    uint64
+	UserID    uint64
+	ParentID  *uint64 `gorm:"index"` // Set on replies, always pointing to the top-level comment
+	Content   string  `gorm:"type:varchar(1024)"`
+	EditedAt  *time.Time
+	User      User              `gorm:"foreignKey:UserID"`
+	Replies   []Comment         `gorm:"foreignKey:ParentID"`
+	Edits     []CommentEdit     `gorm:"foreignKey:CommentID"`
+	Reactions []CommentReaction `gorm:"foreignKey:CommentID"`
+	Mentions  []CommentMention  `gorm:"foreignKey:CommentID"`
+}
+
+// CommentEdit keeps the content a comment had before an edit
+type CommentEdit struct {
+	BaseModel
+	CommentID uint64 `gorm:"not null;index"`
+	Content   string `gorm:"type:varchar(1024)"`
+}
+
+type CommentReaction struct {
+	BaseModel
+	CommentID uint64 `gorm:"not null;uniqueIndex:idx_comment_reaction"`
+	UserID    uint64 `gorm:"not null;uniqueIndex:idx_comment_reaction"`
+	Emoji     string `gorm:"type:varchar(32);not null;uniqueIndex:idx_comment_reaction"`
+}
+
+// CommentMention records a user mentioned in a comment, so edits only notify new mentions
+type CommentMention struct {
+	BaseModel
+	CommentID uint64 `gorm:"not null;index"`
+	UserID    uint64 `gorm:"not null"`
 }
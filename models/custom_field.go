@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+)
+
+// CustomField is a board-level field definition whose values are set per card
+type CustomField struct {
+	BaseModel
+	BoardID  uint64              `gorm:"not null;index"`
+	Name     string              `gorm:"type:varchar(50);not null"`
+	Type     string              `gorm:"type:varchar(10);not null"` // text, number, date, checkbox or select
+	Position int64               `gorm:"not null"`
+	Options  []CustomFieldOption `gorm:"foreignKey:CustomFieldID"`
+}
+
+// CustomFieldOption is one choice of a single-select custom field
+type CustomFieldOption struct {
+	BaseModel
+	CustomFieldID uint64 `gorm:"not null;index"`
+	Value         string `gorm:"type:varchar(50);not null"`
+	Color         string `gorm:"type:varchar(7)"`
+	Position      int64  `gorm:"not null"`
+}
+
+// CardCustomFieldValue holds a card's value for a custom field, in the column matching the field type
+type CardCustomFieldValue struct {
+	BaseModel
+	CardID        uint64  `gorm:"not null;uniqueIndex:idx_card_custom_field"`
+	CustomFieldID uint64  `gorm:"not null;uniqueIndex:idx_card_custom_field"`
+	TextValue     *string `gorm:"type:varchar(255)"`
+	NumberValue   *float64
+	DateValue     *time.Time
+	CheckboxValue *bool
+	OptionID      *uint64
+}
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// DescriptionSession is the lease of the card-service replica holding the collaborative editing
+// session of a card's description. Every editor of the card joins the session on that replica.
+type DescriptionSession struct {
+	CardID    uint64    `gorm:"primaryKey;autoIncrement:false"`
+	Owner     string    `gorm:"type:varchar(255);not null"` // Address other replicas reach the owner at
+	ExpiresAt time.Time `gorm:"not null;index"`             // Renewed by the owner while the session is open
+}
@@ -6,6 +6,8 @@ type List struct {
 	Name       string `gorm:"type:varchar(50)"`
 	Position   int64
 	IsArchived bool
+	WIPLimit   int     `gorm:"not null;default:0"` // Most unarchived cards the list should hold, zero for no limit
+	Version    uint64  `gorm:"not null;default:1"` // Incremented on every update, for optimistic concurrency
 	Cards      []Card  `gorm:"foreignKey:ListID"`
 	Watches    []Watch `gorm:"foreignKey:ListID"`
 }
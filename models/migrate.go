@@ -3,6 +3,7 @@ package models
 import (
 	"fmt"
 
+	"github.com/sm888sm/halten-backend/common/positions"
 	"gorm.io/gorm"
 )
 
@@ -18,6 +19,9 @@ func Migrate(db *gorm.DB) {
 		fmt.Println("Error creating visibility_enum:", result.Error)
 	}
 
+	// Cards overdue before overdue notices existed were never notified, checked before the column is added
+	backfillOverdue := !db.Migrator().HasColumn(&Card{}, "OverdueNotifiedAt")
+
 	// Migrate the schema
 	db.AutoMigrate(
 		&ActivityLog{},
@@ -25,10 +29,67 @@ func Migrate(db *gorm.DB) {
 		&Board{},
 		&List{},
 		&Card{},
+		&CardVersion{},
+		&CardReminder{},
+		&CardRecurrence{},
+		&CustomField{},
+		&CustomFieldOption{},
+		&CardCustomFieldValue{},
+		&Comment{},
+		&CommentEdit{},
+		&CommentReaction{},
+		&CommentMention{},
 		&Attachment{},
 		&Label{},
 		&Notification{},
 		&BoardMember{},
 		&Watch{},
+		&UndoEntry{},
+		&BoardExport{},
+		&BoardImport{},
+		&OutboxMessage{},
+		&ProcessedMessage{},
+		&BoardDeletion{},
+		&Saga{},
+		&DescriptionSession{},
 	)
+
+	migratePositions(db)
+	if backfillOverdue {
+		migrateOverdueNotices(db)
+	}
+}
+
+// migrateOverdueNotices marks the cards already overdue as notified, so the first scheduler run
+// doesn't send card.overdue for every card that has ever been overdue
+func migrateOverdueNotices(db *gorm.DB) {
+	if result := db.Exec("UPDATE cards SET overdue_notified_at = NOW() WHERE due_date IS NOT NULL AND due_date <= NOW() AND overdue_notified_at IS NULL"); result.Error != nil {
+		fmt.Printf("Error migrating overdue notices: %v\n", result.Error)
+	}
+}
+
+// migratePositions spreads dense positions (1, 2, 3, ...) from before sparse positioning apart.
+// Sequences whose positions are all below the gap are renumbered, which keeps their order, so
+// running it again is harmless.
+func migratePositions(db *gorm.DB) {
+	sequences := []struct {
+		table  string
+		column string
+	}{
+		{table: "lists", column: "board_id"},
+		{table: "cards", column: "list_id"},
+	}
+
+	for _, sequence := range sequences {
+		query := fmt.Sprintf(`UPDATE %[1]s SET position = ranked.rank * ?
+			FROM (
+				SELECT id, ROW_NUMBER() OVER (PARTITION BY %[2]s ORDER BY position, id) AS rank FROM %[1]s
+				WHERE deleted_at IS NULL AND %[2]s IN (SELECT %[2]s FROM %[1]s WHERE deleted_at IS NULL GROUP BY %[2]s HAVING MAX(position) < ?)
+			) ranked
+			WHERE %[1]s.id = ranked.id`, sequence.table, sequence.column)
+
+		if result := db.Exec(query, positions.Gap, positions.Gap); result.Error != nil {
+			fmt.Printf("Error migrating %s positions: %v\n", sequence.table, result.Error)
+		}
+	}
 }
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// OutboxMessage is an event written in the transaction of the change it announces and published
+// to RabbitMQ afterwards by the outbox relay
+type OutboxMessage struct {
+	ID          uint64     `gorm:"primarykey"`
+	MessageID   string     `gorm:"type:varchar(36);not null;uniqueIndex"` // Published as the message ID, consumers deduplicate on it
+	RoutingKey  string     `gorm:"type:varchar(100);not null"`
+	ContentType string     `gorm:"type:varchar(50);not null"`
+	Body        []byte     `gorm:"type:bytea;not null"`
+	Attempts    int        `gorm:"not null;default:0"` // Failed publishes so far
+	LastError   string     `gorm:"type:varchar(255)"`
+	CreatedAt   time.Time  `gorm:"not null"`
+	SentAt      *time.Time `gorm:"index:idx_outbox_messages_pending,where:sent_at IS NULL"`
+}
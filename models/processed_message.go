@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// ProcessedMessage records a message a consumer has handled, so a redelivery of it is skipped
+type ProcessedMessage struct {
+	Consumer    string    `gorm:"type:varchar(100);primaryKey"` // Queue the message was consumed from
+	MessageID   string    `gorm:"type:varchar(64);primaryKey"`
+	ProcessedAt time.Time `gorm:"not null;index"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// Saga is a flow of steps across services, recorded step by step so it can be compensated when it
+// fails or the service stops in the middle of it
+type Saga struct {
+	ID            uint64    `gorm:"primarykey"`
+	Type          string    `gorm:"type:varchar(50);not null"`
+	Status        string    `gorm:"type:varchar(20);not null;index"`
+	Step          int       `gorm:"not null;default:0"` // Steps done, which are undone in reverse when compensating
+	Data          []byte    `gorm:"type:jsonb;not null"`
+	Version       uint64    `gorm:"not null;default:1"` // Incremented by every update, a runner whose update misses it lost the saga
+	Attempts      int       `gorm:"not null;default:0"` // Failed compensations so far
+	LastError     string    `gorm:"type:varchar(255)"`
+	NextAttemptAt time.Time `gorm:"not null;index"` // When the saga is picked up if nothing updated it since
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,15 @@
+package models
+
+// UndoEntry records how to reverse an archive, restore, move or delete made by a user, so that the
+// user can take it back for a while
+type UndoEntry struct {
+	BaseModel
+	UserID     uint64 `gorm:"not null;index:idx_undo_session"`
+	SessionID  string `gorm:"type:varchar(64);not null;index:idx_undo_session"` // Client session, empty when the client doesn't send one
+	BoardID    uint64 `gorm:"not null"`
+	EntityType string `gorm:"type:varchar(10);not null"` // board, list or card
+	EntityID   uint64 `gorm:"not null"`
+	Action     string `gorm:"type:varchar(10);not null"` // archive, restore, move or delete
+	Version    uint64 // Version of the entity right after the action, zero for deletes
+	Inverse    string `gorm:"type:text"` // JSON encoded state the entity goes back to
+}
@@ -3,15 +3,18 @@ package config
 import (
 	"os"
 	"strconv"
+
+	"github.com/sm888sm/halten-backend/common/constants/messagebuses"
 )
 
 type Config struct {
-	Port       int
-	Database   DatabaseConfig
-	SecretKey  string
-	BcryptCost int
-	RabbitMQ   RabbitMQConfig
-	Services   ServiceConfig
+	Port        int
+	Database    DatabaseConfig
+	SecretKey   string
+	BcryptCost  int
+	RabbitMQ    RabbitMQConfig
+	Services    ServiceConfig
+	MetricsAddr string // Serves the consumer metrics when set, such as :9090
 }
 
 type DatabaseConfig struct {
@@ -32,6 +35,7 @@ type ServiceConfig struct {
 
 type RabbitMQConfig struct { // Add this struct
 	URL string
+	Bus string // messagebuses.RabbitMQ, or messagebuses.Memory when every service runs in one process
 }
 
 func LoadConfig() (*Config, error) {
@@ -50,6 +54,11 @@ func LoadConfig() (*Config, error) {
 		bcryptCost = 10 // Default bcrypt cost
 	}
 
+	messageBus := os.Getenv("MESSAGE_BUS")
+	if messageBus == "" {
+		messageBus = messagebuses.RabbitMQ // Default message bus
+	}
+
 	return &Config{
 		Port: port, // Or your default
 		Database: DatabaseConfig{
@@ -70,6 +79,8 @@ func LoadConfig() (*Config, error) {
 		},
 		RabbitMQ: RabbitMQConfig{ // Add this line
 			URL: os.Getenv("RABBITMQ_URL"),
+			Bus: messageBus,
 		},
+		MetricsAddr: os.Getenv("METRICS_ADDR"),
 	}, nil
 }